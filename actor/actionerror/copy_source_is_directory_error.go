@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// CopySourceIsDirectoryError is returned when copying a directory without
+// requesting a recursive copy.
+type CopySourceIsDirectoryError struct {
+	Path string
+}
+
+func (e CopySourceIsDirectoryError) Error() string {
+	return fmt.Sprintf("%s is a directory", e.Path)
+}
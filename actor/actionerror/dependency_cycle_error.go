@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DependencyCycleError is returned when the depends-on properties of the apps
+// in a manifest form a cycle.
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("Apps depend on each other in a cycle: %s", strings.Join(e.AppNames, " -> "))
+}
@@ -0,0 +1,9 @@
+package actionerror
+
+// DeploymentCanceledError is returned when a rolling deployment was canceled
+// and the application has been rolled back to its previous droplet.
+type DeploymentCanceledError struct{}
+
+func (DeploymentCanceledError) Error() string {
+	return "deployment was canceled"
+}
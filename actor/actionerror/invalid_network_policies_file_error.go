@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidNetworkPoliciesFileError is returned when a network policies file
+// cannot be parsed or contains an invalid policy.
+type InvalidNetworkPoliciesFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidNetworkPoliciesFileError) Error() string {
+	return fmt.Sprintf("invalid network policies file %s: %s", e.Path, e.Message)
+}
@@ -0,0 +1,39 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SecurityGroupRuleError is a single problem found at Line and Column of the
+// security group rules File. Key is the path to the offending key, such as
+// [1].ports, and is empty when the file is not valid JSON.
+type SecurityGroupRuleError struct {
+	File    string
+	Line    int
+	Column  int
+	Key     string
+	Message string
+}
+
+func (e SecurityGroupRuleError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Column, e.Key, e.Message)
+}
+
+// InvalidSecurityGroupRulesError is returned when a security group rules file
+// contains rules that the Cloud Controller would reject. It contains every
+// problem found in the file.
+type InvalidSecurityGroupRulesError struct {
+	Errors []SecurityGroupRuleError
+}
+
+func (e InvalidSecurityGroupRulesError) Error() string {
+	var messages []string
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidTrustedPluginKeyError is returned when a trusted plugin key is not a
+// base64 encoded ed25519 public key.
+type InvalidTrustedPluginKeyError struct {
+	Name string
+}
+
+func (e InvalidTrustedPluginKeyError) Error() string {
+	return fmt.Sprintf("plugin key %s is not a base64 encoded ed25519 public key", e.Name)
+}
@@ -0,0 +1,10 @@
+package actionerror
+
+// NoTrustedPluginKeysError is returned when a signature is required but no
+// trusted plugin keys have been added.
+type NoTrustedPluginKeysError struct {
+}
+
+func (NoTrustedPluginKeysError) Error() string {
+	return "no trusted plugin keys"
+}
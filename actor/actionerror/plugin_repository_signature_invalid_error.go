@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginRepositorySignatureInvalidError is returned when the signature of a
+// plugin repository index does not verify against any trusted plugin key.
+type PluginRepositorySignatureInvalidError struct {
+	RepositoryName string
+}
+
+func (e PluginRepositorySignatureInvalidError) Error() string {
+	return fmt.Sprintf("plugin repository %s signature does not match any trusted key", e.RepositoryName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginRepositorySignatureMissingError is returned when a signature is
+// required but the plugin repository does not serve a signature of its index.
+type PluginRepositorySignatureMissingError struct {
+	RepositoryName string
+}
+
+func (e PluginRepositorySignatureMissingError) Error() string {
+	return fmt.Sprintf("plugin repository %s is not signed", e.RepositoryName)
+}
@@ -0,0 +1,10 @@
+package actionerror
+
+// PluginSignatureInvalidError is returned when the signature of a plugin
+// binary does not verify against any trusted plugin key.
+type PluginSignatureInvalidError struct {
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "plugin binary signature does not match any trusted key"
+}
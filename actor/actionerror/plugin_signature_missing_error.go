@@ -0,0 +1,10 @@
+package actionerror
+
+// PluginSignatureMissingError is returned when a signature is required but
+// the plugin binary does not have one.
+type PluginSignatureMissingError struct {
+}
+
+func (PluginSignatureMissingError) Error() string {
+	return "plugin binary is not signed"
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ProcessHasNoRunningInstancesError is returned when trying to perform an
+// action on every running instance of a process that has none.
+type ProcessHasNoRunningInstancesError struct {
+	ProcessType string
+}
+
+func (e ProcessHasNoRunningInstancesError) Error() string {
+	return fmt.Sprintf("Process %s has no running instances", e.ProcessType)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// SecurityGroupNameTakenError is returned when creating a security group with
+// the name of an existing security group.
+type SecurityGroupNameTakenError struct {
+	Name string
+}
+
+func (e SecurityGroupNameTakenError) Error() string {
+	return fmt.Sprintf("Security group '%s' already exists.", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// TaskFailedError is returned when a task that is being waited for fails.
+type TaskFailedError struct {
+	Name          string
+	FailureReason string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task '%s' failed: %s", e.Name, e.FailureReason)
+}
@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskTimeoutError is returned when a task that is being waited for does not
+// finish within the timeout, after it has been cancelled.
+type TaskTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for task '%s' to finish", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// TrustedPluginKeyNameTakenError is returned when adding a trusted plugin key
+// with the name of an existing key.
+type TrustedPluginKeyNameTakenError struct {
+	Name string
+}
+
+func (e TrustedPluginKeyNameTakenError) Error() string {
+	return fmt.Sprintf("plugin key named '%s' already exists", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// TrustedPluginKeyNotFoundError is returned when a trusted plugin key cannot
+// be found.
+type TrustedPluginKeyNotFoundError struct {
+	Name string
+}
+
+func (e TrustedPluginKeyNotFoundError) Error() string {
+	return fmt.Sprintf("plugin key %s not found", e.Name)
+}
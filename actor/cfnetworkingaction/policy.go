@@ -3,26 +3,58 @@ package cfnetworkingaction
 import (
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 type Policy struct {
-	SourceName      string
-	DestinationName string
-	Protocol        string
-	StartPort       int
-	EndPort         int
+	SourceName           string
+	DestinationName      string
+	Protocol             string
+	StartPort            int
+	EndPort              int
+	DestinationSpaceName string
+	DestinationOrgName   string
 }
 
-func (actor Actor) AddNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+// policyApp is the source or destination app of a policy.
+type policyApp struct {
+	name      string
+	spaceGUID string
+	spaceName string
+	orgName   string
+}
+
+// GetSpaceGUIDByNameAndOrganizationName returns the GUID of the space with the
+// given name in the organization with the given name.
+func (actor Actor) GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	org, warnings, err := actor.V3Actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	space, warnings, err := actor.V3Actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	return space.GUID, allWarnings, nil
+}
+
+func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+	var allWarnings Warnings
+
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -61,21 +93,9 @@ func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings,
 		return []Policy{}, allWarnings, err
 	}
 
-	appNameByGuid := map[string]string{}
-	for _, app := range applications {
-		appNameByGuid[app.GUID] = app.Name
-	}
-
-	var policies []Policy
-	emptyPolicy := Policy{}
-	for _, v1Policy := range v1Policies {
-		policy := actor.transformPolicy(appNameByGuid, v1Policy)
-		if policy != emptyPolicy {
-			policies = append(policies, policy)
-		}
-	}
-
-	return policies, allWarnings, nil
+	policies, transformWarnings, err := actor.transformPolicies(spaceGUID, applications, v1Policies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	return policies, allWarnings, err
 }
 
 func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName string) ([]Policy, Warnings, error) {
@@ -88,11 +108,6 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	appNameByGuid := map[string]string{}
-	for _, app := range applications {
-		appNameByGuid[app.GUID] = app.Name
-	}
-
 	var v1Policies []cfnetv1.Policy
 
 	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
@@ -107,30 +122,28 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
+	var srcAppPolicies []cfnetv1.Policy
 	for _, v1Policy := range v1Policies {
 		if v1Policy.Source.ID == appGUID {
-			policy := actor.transformPolicy(appNameByGuid, v1Policy)
-			if policy != emptyPolicy {
-				policies = append(policies, policy)
-			}
+			srcAppPolicies = append(srcAppPolicies, v1Policy)
 		}
 	}
 
-	return policies, allWarnings, nil
+	policies, transformWarnings, err := actor.transformPolicies(spaceGUID, applications, srcAppPolicies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	return policies, allWarnings, err
 }
 
-func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -164,16 +177,111 @@ func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, proto
 	return allWarnings, actionerror.PolicyDoesNotExistError{}
 }
 
-func (Actor) transformPolicy(appNameByGuid map[string]string, v1Policy cfnetv1.Policy) Policy {
-	srcName, srcOk := appNameByGuid[v1Policy.Source.ID]
-	dstName, dstOk := appNameByGuid[v1Policy.Destination.ID]
+// transformPolicies converts the policies whose source app is in the space,
+// looking up the destination apps in other spaces along with the names of
+// their spaces and orgs. Policies to apps that the user cannot see are
+// omitted.
+func (actor Actor) transformPolicies(spaceGUID string, spaceApps []v3action.Application, v1Policies []cfnetv1.Policy) ([]Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	appByGUID := map[string]policyApp{}
+	for _, app := range spaceApps {
+		appByGUID[app.GUID] = policyApp{name: app.Name, spaceGUID: spaceGUID}
+	}
+
+	var srcPolicies []cfnetv1.Policy
+	var otherAppGUIDs []string
+	isOtherApp := map[string]bool{}
+	for _, v1Policy := range v1Policies {
+		if _, ok := appByGUID[v1Policy.Source.ID]; !ok {
+			continue
+		}
+		srcPolicies = append(srcPolicies, v1Policy)
+
+		destGUID := v1Policy.Destination.ID
+		if _, ok := appByGUID[destGUID]; !ok && !isOtherApp[destGUID] {
+			isOtherApp[destGUID] = true
+			otherAppGUIDs = append(otherAppGUIDs, destGUID)
+		}
+	}
+
+	otherApps, warnings, err := actor.V3Actor.GetApplicationsByGUIDs(otherAppGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	spaceGUIDs := []string{spaceGUID}
+	isSpaceListed := map[string]bool{spaceGUID: true}
+	for _, app := range otherApps {
+		appByGUID[app.GUID] = policyApp{name: app.Name, spaceGUID: app.SpaceGUID}
+		if !isSpaceListed[app.SpaceGUID] {
+			isSpaceListed[app.SpaceGUID] = true
+			spaceGUIDs = append(spaceGUIDs, app.SpaceGUID)
+		}
+	}
+
+	spaces, warnings, err := actor.V3Actor.GetSpacesByGUIDs(spaceGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	var orgGUIDs []string
+	isOrgListed := map[string]bool{}
+	for _, space := range spaces {
+		orgGUID := space.Relationships[constant.RelationshipTypeOrganization].GUID
+		if !isOrgListed[orgGUID] {
+			isOrgListed[orgGUID] = true
+			orgGUIDs = append(orgGUIDs, orgGUID)
+		}
+	}
+
+	orgs, warnings, err := actor.V3Actor.GetOrganizationsByGUIDs(orgGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
+	orgNameByGUID := map[string]string{}
+	for _, org := range orgs {
+		orgNameByGUID[org.GUID] = org.Name
+	}
+	spaceByGUID := map[string]v3action.Space{}
+	for _, space := range spaces {
+		spaceByGUID[space.GUID] = space
+	}
+	for guid, app := range appByGUID {
+		space := spaceByGUID[app.spaceGUID]
+		app.spaceName = space.Name
+		app.orgName = orgNameByGUID[space.Relationships[constant.RelationshipTypeOrganization].GUID]
+		appByGUID[guid] = app
+	}
+
+	var policies []Policy
+	emptyPolicy := Policy{}
+	for _, v1Policy := range srcPolicies {
+		policy := actor.transformPolicy(appByGUID, v1Policy)
+		if policy != emptyPolicy {
+			policies = append(policies, policy)
+		}
+	}
+
+	return policies, allWarnings, nil
+}
+
+func (Actor) transformPolicy(appByGUID map[string]policyApp, v1Policy cfnetv1.Policy) Policy {
+	src, srcOk := appByGUID[v1Policy.Source.ID]
+	dst, dstOk := appByGUID[v1Policy.Destination.ID]
 	if srcOk && dstOk {
 		return Policy{
-			SourceName:      srcName,
-			DestinationName: dstName,
-			Protocol:        string(v1Policy.Destination.Protocol),
-			StartPort:       v1Policy.Destination.Ports.Start,
-			EndPort:         v1Policy.Destination.Ports.End,
+			SourceName:           src.name,
+			DestinationName:      dst.name,
+			Protocol:             string(v1Policy.Destination.Protocol),
+			StartPort:            v1Policy.Destination.Ports.Start,
+			EndPort:              v1Policy.Destination.Ports.End,
+			DestinationSpaceName: dst.spaceName,
+			DestinationOrgName:   dst.orgName,
 		}
 	}
 	return Policy{}
@@ -0,0 +1,352 @@
+package cfnetworkingaction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// policyBatchSize is the maximum number of policies created or removed by a
+// single request to the networking API.
+const policyBatchSize = 100
+
+// PolicyPlan is the set of changes that make the network policies between the
+// apps in a space match a network policies file.
+type PolicyPlan struct {
+	Additions []Policy
+	Removals  []Policy
+}
+
+// HasChanges returns true if the plan adds or removes any policies.
+func (plan PolicyPlan) HasChanges() bool {
+	return len(plan.Additions) > 0 || len(plan.Removals) > 0
+}
+
+type policiesFile struct {
+	Policies []policiesFileEntry `yaml:"policies"`
+}
+
+type policiesFileEntry struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+	Protocol    string `yaml:"protocol"`
+	Ports       string `yaml:"ports"`
+}
+
+// ExportNetworkPoliciesBySpace writes the network policies between the apps in
+// the space to a YAML file at path, and returns them. Policies to apps in other
+// spaces are not exported.
+func (actor Actor) ExportNetworkPoliciesBySpace(spaceGUID string, path string) ([]Policy, Warnings, error) {
+	policies, warnings, err := actor.policiesWithinSpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	file := policiesFile{Policies: []policiesFileEntry{}}
+	for _, policy := range policies {
+		ports := strconv.Itoa(policy.StartPort)
+		if policy.EndPort != policy.StartPort {
+			ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+		}
+
+		file.Policies = append(file.Policies, policiesFileEntry{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       ports,
+		})
+	}
+
+	raw, err := yaml.Marshal(file)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	err = ioutil.WriteFile(path, raw, 0644)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return policies, warnings, nil
+}
+
+// PlanNetworkPoliciesBySpace reads the network policies file at path and
+// returns the policies that have to be added and removed for the policies
+// between the apps in the space to match it. Policies to or from apps in
+// other spaces are not changed.
+func (actor Actor) PlanNetworkPoliciesBySpace(spaceGUID string, path string) (PolicyPlan, Warnings, error) {
+	desired, err := readPoliciesFile(path)
+	if err != nil {
+		return PolicyPlan{}, nil, err
+	}
+
+	applications, v3Warnings, err := actor.V3Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings := Warnings(v3Warnings)
+	if err != nil {
+		return PolicyPlan{}, allWarnings, err
+	}
+
+	appExists := map[string]bool{}
+	for _, app := range applications {
+		appExists[app.Name] = true
+	}
+	for _, policy := range desired {
+		for _, appName := range []string{policy.SourceName, policy.DestinationName} {
+			if !appExists[appName] {
+				return PolicyPlan{}, allWarnings, actionerror.ApplicationNotFoundError{Name: appName}
+			}
+		}
+	}
+
+	current, warnings, err := actor.policiesWithinSpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyPlan{}, allWarnings, err
+	}
+
+	var plan PolicyPlan
+	plan.Additions = subtractPolicies(desired, current)
+	plan.Removals = subtractPolicies(current, desired)
+	return plan, allWarnings, nil
+}
+
+// ApplyNetworkPolicyPlan creates and removes the policies in the plan, in
+// batches of up to policyBatchSize policies.
+func (actor Actor) ApplyNetworkPolicyPlan(spaceGUID string, plan PolicyPlan) (Warnings, error) {
+	if !plan.HasChanges() {
+		return nil, nil
+	}
+
+	applications, v3Warnings, err := actor.V3Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings := Warnings(v3Warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	appGUIDByName := map[string]string{}
+	for _, app := range applications {
+		appGUIDByName[app.Name] = app.GUID
+	}
+
+	additions, err := toV1Policies(appGUIDByName, plan.Additions)
+	if err != nil {
+		return allWarnings, err
+	}
+	removals, err := toV1Policies(appGUIDByName, plan.Removals)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for start := 0; start < len(additions); start += policyBatchSize {
+		err = actor.NetworkingClient.CreatePolicies(additions[start:minInt(start+policyBatchSize, len(additions))])
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for start := 0; start < len(removals); start += policyBatchSize {
+		err = actor.NetworkingClient.RemovePolicies(removals[start:minInt(start+policyBatchSize, len(removals))])
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// policiesWithinSpace returns the sorted policies whose source and destination
+// apps are both in the space.
+func (actor Actor) policiesWithinSpace(spaceGUID string) ([]Policy, Warnings, error) {
+	applications, v3Warnings, err := actor.V3Actor.GetApplicationsBySpace(spaceGUID)
+	warnings := Warnings(v3Warnings)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	v1Policies, err := actor.NetworkingClient.ListPolicies()
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	appNameByGUID := map[string]string{}
+	for _, app := range applications {
+		appNameByGUID[app.GUID] = app.Name
+	}
+
+	var policies []Policy
+	for _, v1Policy := range v1Policies {
+		srcName, srcOk := appNameByGUID[v1Policy.Source.ID]
+		destName, destOk := appNameByGUID[v1Policy.Destination.ID]
+		if srcOk && destOk {
+			policies = append(policies, Policy{
+				SourceName:      srcName,
+				DestinationName: destName,
+				Protocol:        string(v1Policy.Destination.Protocol),
+				StartPort:       v1Policy.Destination.Ports.Start,
+				EndPort:         v1Policy.Destination.Ports.End,
+			})
+		}
+	}
+
+	sortPolicies(policies)
+	return policies, warnings, nil
+}
+
+func readPoliciesFile(path string) ([]Policy, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file policiesFile
+	err = yaml.UnmarshalStrict(raw, &file)
+	if err != nil {
+		return nil, actionerror.InvalidNetworkPoliciesFileError{Path: path, Message: err.Error()}
+	}
+
+	var policies []Policy
+	seen := map[Policy]bool{}
+	for i, entry := range file.Policies {
+		policy, err := entry.toPolicy()
+		if err != nil {
+			return nil, actionerror.InvalidNetworkPoliciesFileError{
+				Path:    path,
+				Message: fmt.Sprintf("policy %d: %s", i+1, err),
+			}
+		}
+
+		if !seen[policy] {
+			seen[policy] = true
+			policies = append(policies, policy)
+		}
+	}
+
+	sortPolicies(policies)
+	return policies, nil
+}
+
+func (entry policiesFileEntry) toPolicy() (Policy, error) {
+	if entry.Source == "" || entry.Destination == "" {
+		return Policy{}, fmt.Errorf("source and destination are required")
+	}
+
+	protocol := strings.ToLower(entry.Protocol)
+	if protocol != "tcp" && protocol != "udp" {
+		return Policy{}, fmt.Errorf("protocol must be tcp or udp")
+	}
+
+	startPort, endPort, err := parsePorts(entry.Ports)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	return Policy{
+		SourceName:      entry.Source,
+		DestinationName: entry.Destination,
+		Protocol:        protocol,
+		StartPort:       startPort,
+		EndPort:         endPort,
+	}, nil
+}
+
+// parsePorts parses a port, such as 8080, or a range of ports, such as
+// 8080-8090.
+func parsePorts(ports string) (int, int, error) {
+	portsErr := fmt.Errorf("ports must be a port or a range of ports between 1 and 65535, such as 8080 or 8080-8090")
+
+	bounds := strings.Split(ports, "-")
+	if len(bounds) > 2 {
+		return 0, 0, portsErr
+	}
+
+	var parsed []int
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(strings.TrimSpace(bound))
+		if err != nil || port < 1 || port > 65535 {
+			return 0, 0, portsErr
+		}
+		parsed = append(parsed, port)
+	}
+
+	startPort, endPort := parsed[0], parsed[len(parsed)-1]
+	if startPort > endPort {
+		return 0, 0, portsErr
+	}
+	return startPort, endPort, nil
+}
+
+func toV1Policies(appGUIDByName map[string]string, policies []Policy) ([]cfnetv1.Policy, error) {
+	var v1Policies []cfnetv1.Policy
+	for _, policy := range policies {
+		srcGUID, ok := appGUIDByName[policy.SourceName]
+		if !ok {
+			return nil, actionerror.ApplicationNotFoundError{Name: policy.SourceName}
+		}
+		destGUID, ok := appGUIDByName[policy.DestinationName]
+		if !ok {
+			return nil, actionerror.ApplicationNotFoundError{Name: policy.DestinationName}
+		}
+
+		v1Policies = append(v1Policies, cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{
+				ID: srcGUID,
+			},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destGUID,
+				Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+				Ports: cfnetv1.Ports{
+					Start: policy.StartPort,
+					End:   policy.EndPort,
+				},
+			},
+		})
+	}
+	return v1Policies, nil
+}
+
+// subtractPolicies returns the policies in a that are not in b.
+func subtractPolicies(a []Policy, b []Policy) []Policy {
+	inB := map[Policy]bool{}
+	for _, policy := range b {
+		inB[policy] = true
+	}
+
+	var difference []Policy
+	for _, policy := range a {
+		if !inB[policy] {
+			difference = append(difference, policy)
+		}
+	}
+	return difference
+}
+
+func sortPolicies(policies []Policy) {
+	sort.Slice(policies, func(i int, j int) bool {
+		a, b := policies[i], policies[j]
+		switch {
+		case a.SourceName != b.SourceName:
+			return a.SourceName < b.SourceName
+		case a.DestinationName != b.DestinationName:
+			return a.DestinationName < b.DestinationName
+		case a.Protocol != b.Protocol:
+			return a.Protocol < b.Protocol
+		case a.StartPort != b.StartPort:
+			return a.StartPort < b.StartPort
+		default:
+			return a.EndPort < b.EndPort
+		}
+	})
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
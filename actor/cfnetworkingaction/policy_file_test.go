@@ -0,0 +1,316 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy File", func() {
+	var (
+		actor                *Actor
+		fakeV3Actor          *cfnetworkingactionfakes.FakeV3Actor
+		fakeNetworkingClient *cfnetworkingactionfakes.FakeNetworkingClient
+
+		tempDir    string
+		filePath   string
+		warnings   Warnings
+		executeErr error
+	)
+
+	v1Policy := func(srcGUID string, destGUID string, protocol string, startPort int, endPort int) cfnetv1.Policy {
+		return cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: srcGUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destGUID,
+				Protocol: cfnetv1.PolicyProtocol(protocol),
+				Ports:    cfnetv1.Ports{Start: startPort, End: endPort},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeV3Actor = new(cfnetworkingactionfakes.FakeV3Actor)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+
+		fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+			{Name: "appA", GUID: "appAGUID"},
+			{Name: "appB", GUID: "appBGUID"},
+			{Name: "appC", GUID: "appCGUID"},
+		}, v3action.Warnings{"GetApplicationsBySpaceWarning"}, nil)
+
+		fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+			v1Policy("appBGUID", "appCGUID", "udp", 53, 53),
+			v1Policy("appAGUID", "appBGUID", "tcp", 8080, 8090),
+			v1Policy("appAGUID", "appOtherSpaceGUID", "tcp", 8080, 8080),
+		}, nil)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "network-policies")
+		Expect(err).ToNot(HaveOccurred())
+		filePath = filepath.Join(tempDir, "policies.yml")
+
+		actor = NewActor(fakeNetworkingClient, fakeV3Actor)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("ExportNetworkPoliciesBySpace", func() {
+		var policies []Policy
+
+		JustBeforeEach(func() {
+			policies, warnings, executeErr = actor.ExportNetworkPoliciesBySpace("space", filePath)
+		})
+
+		It("writes the sorted policies between apps in the space to the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning"))
+			Expect(policies).To(Equal([]Policy{
+				{SourceName: "appA", DestinationName: "appB", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+				{SourceName: "appB", DestinationName: "appC", Protocol: "udp", StartPort: 53, EndPort: 53},
+			}))
+
+			raw, err := ioutil.ReadFile(filePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(MatchYAML(`
+policies:
+- source: appA
+  destination: appB
+  protocol: tcp
+  ports: 8080-8090
+- source: appB
+  destination: appC
+  protocol: udp
+  ports: "53"
+`))
+		})
+
+		Context("when listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("banana"))
+			})
+
+			It("returns the error and does not write the file", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				_, err := os.Stat(filePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("PlanNetworkPoliciesBySpace", func() {
+		var (
+			fileContents string
+			plan         PolicyPlan
+		)
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(filePath, []byte(fileContents), 0600)).To(Succeed())
+			plan, warnings, executeErr = actor.PlanNetworkPoliciesBySpace("space", filePath)
+		})
+
+		Context("when the file is valid", func() {
+			BeforeEach(func() {
+				fileContents = `
+policies:
+- source: appA
+  destination: appB
+  protocol: tcp
+  ports: 8080-8090
+- source: appC
+  destination: appA
+  protocol: TCP
+  ports: 9000
+- source: appC
+  destination: appA
+  protocol: tcp
+  ports: "9000"
+`
+			})
+
+			It("returns the policies to add and remove", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning", "GetApplicationsBySpaceWarning"))
+				Expect(plan).To(Equal(PolicyPlan{
+					Additions: []Policy{
+						{SourceName: "appC", DestinationName: "appA", Protocol: "tcp", StartPort: 9000, EndPort: 9000},
+					},
+					Removals: []Policy{
+						{SourceName: "appB", DestinationName: "appC", Protocol: "udp", StartPort: 53, EndPort: 53},
+					},
+				}))
+				Expect(plan.HasChanges()).To(BeTrue())
+			})
+		})
+
+		Context("when the file matches the current policies", func() {
+			BeforeEach(func() {
+				fileContents = `
+policies:
+- source: appB
+  destination: appC
+  protocol: udp
+  ports: 53
+- source: appA
+  destination: appB
+  protocol: tcp
+  ports: 8080-8090
+`
+			})
+
+			It("returns an empty plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.HasChanges()).To(BeFalse())
+			})
+		})
+
+		Context("when the file references an app that is not in the space", func() {
+			BeforeEach(func() {
+				fileContents = `
+policies:
+- source: appA
+  destination: appD
+  protocol: tcp
+  ports: 8080
+`
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "appD"}))
+				Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning"))
+				Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		DescribeTable("when the file is invalid",
+			func(contents string, message string) {
+				Expect(ioutil.WriteFile(filePath, []byte(contents), 0600)).To(Succeed())
+				_, _, err := actor.PlanNetworkPoliciesBySpace("space", filePath)
+				Expect(err).To(MatchError(actionerror.InvalidNetworkPoliciesFileError{Path: filePath, Message: message}))
+			},
+
+			Entry("missing the destination",
+				"policies:\n- source: appA\n  protocol: tcp\n  ports: 8080\n",
+				"policy 1: source and destination are required"),
+			Entry("unknown protocol",
+				"policies:\n- source: appA\n  destination: appB\n  protocol: icmp\n  ports: 8080\n",
+				"policy 1: protocol must be tcp or udp"),
+			Entry("port out of range",
+				"policies:\n- source: appA\n  destination: appB\n  protocol: tcp\n  ports: 70000\n",
+				"policy 1: ports must be a port or a range of ports between 1 and 65535, such as 8080 or 8080-8090"),
+			Entry("reversed port range",
+				"policies:\n- source: appA\n  destination: appB\n  protocol: tcp\n  ports: 9000-8000\n",
+				"policy 1: ports must be a port or a range of ports between 1 and 65535, such as 8080 or 8080-8090"),
+			Entry("missing ports",
+				"policies:\n- source: appA\n  destination: appB\n  protocol: tcp\n",
+				"policy 1: ports must be a port or a range of ports between 1 and 65535, such as 8080 or 8080-8090"),
+		)
+
+		Context("when the file has unknown fields", func() {
+			BeforeEach(func() {
+				fileContents = "policies:\n- source: appA\n  destination: appB\n  protocol: tcp\n  ports: 8080\n  port: 8080\n"
+			})
+
+			It("returns an InvalidNetworkPoliciesFileError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.InvalidNetworkPoliciesFileError{}))
+			})
+		})
+	})
+
+	Describe("ApplyNetworkPolicyPlan", func() {
+		var plan PolicyPlan
+
+		BeforeEach(func() {
+			plan = PolicyPlan{
+				Additions: []Policy{
+					{SourceName: "appC", DestinationName: "appA", Protocol: "tcp", StartPort: 9000, EndPort: 9000},
+				},
+				Removals: []Policy{
+					{SourceName: "appB", DestinationName: "appC", Protocol: "udp", StartPort: 53, EndPort: 53},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyNetworkPolicyPlan("space", plan)
+		})
+
+		It("creates and removes the policies", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning"))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("appCGUID", "appAGUID", "tcp", 9000, 9000),
+			}))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("appBGUID", "appCGUID", "udp", 53, 53),
+			}))
+		})
+
+		Context("when there are more policies than fit in one request", func() {
+			BeforeEach(func() {
+				plan = PolicyPlan{}
+				for port := 1; port <= 250; port++ {
+					plan.Additions = append(plan.Additions, Policy{SourceName: "appA", DestinationName: "appB", Protocol: "tcp", StartPort: port, EndPort: port})
+				}
+			})
+
+			It("creates them in batches", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(3))
+				Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(HaveLen(100))
+				Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(1)).To(HaveLen(100))
+				Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(2)).To(HaveLen(50))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an app in the plan has been deleted", func() {
+			BeforeEach(func() {
+				plan.Additions[0].SourceName = "appD"
+			})
+
+			It("returns an ApplicationNotFoundError without changing any policies", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "appD"}))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("banana"))
+			})
+
+			It("returns the error without removing any policies", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plan is empty", func() {
+			BeforeEach(func() {
+				plan = PolicyPlan{}
+			})
+
+			It("does nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV3Actor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
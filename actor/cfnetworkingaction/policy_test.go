@@ -8,6 +8,8 @@ import (
 	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -46,7 +48,7 @@ var _ = Describe("Policy", func() {
 			protocol := "tcp"
 			startPort := 8080
 			endPort := 8090
-			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, spaceGuid, destApp, protocol, startPort, endPort)
 		})
 
 		It("creates policies", func() {
@@ -342,6 +344,107 @@ var _ = Describe("Policy", func() {
 			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(BeNil())
 		})
 
+		Context("when a policy's destination app is in another space", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appBGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8080,
+							End:   8080,
+						},
+					},
+				}, {
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appCGUID",
+						Protocol: "udp",
+						Ports: cfnetv1.Ports{
+							Start: 53,
+							End:   53,
+						},
+					},
+				}, {
+					Source: cfnetv1.PolicySource{
+						ID: "appCGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appAGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8080,
+							End:   8080,
+						},
+					},
+				}}, nil)
+
+				fakeV3Actor.GetApplicationsByGUIDsReturns([]v3action.Application{
+					{Name: "appC", GUID: "appCGUID", SpaceGUID: "otherSpaceGUID"},
+				}, v3action.Warnings{"GetApplicationsByGUIDsWarning"}, nil)
+				fakeV3Actor.GetSpacesByGUIDsReturns([]v3action.Space{
+					{Name: "space", GUID: "space", Relationships: ccv3.Relationships{
+						constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "orgGUID"},
+					}},
+					{Name: "otherSpace", GUID: "otherSpaceGUID", Relationships: ccv3.Relationships{
+						constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "otherOrgGUID"},
+					}},
+				}, v3action.Warnings{"GetSpacesByGUIDsWarning"}, nil)
+				fakeV3Actor.GetOrganizationsByGUIDsReturns([]v3action.Organization{
+					{Name: "org", GUID: "orgGUID"},
+					{Name: "otherOrg", GUID: "otherOrgGUID"},
+				}, v3action.Warnings{"GetOrganizationsByGUIDsWarning"}, nil)
+			})
+
+			It("lists the policies from apps in the space with the destination space and org", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(Equal([]Policy{{
+					SourceName:           "appA",
+					DestinationName:      "appB",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8080,
+					DestinationSpaceName: "space",
+					DestinationOrgName:   "org",
+				}, {
+					SourceName:           "appA",
+					DestinationName:      "appC",
+					Protocol:             "udp",
+					StartPort:            53,
+					EndPort:              53,
+					DestinationSpaceName: "otherSpace",
+					DestinationOrgName:   "otherOrg",
+				}}))
+				Expect(warnings).To(ConsistOf(
+					"GetApplicationsBySpaceWarning",
+					"GetApplicationsByGUIDsWarning",
+					"GetSpacesByGUIDsWarning",
+					"GetOrganizationsByGUIDsWarning",
+				))
+
+				Expect(fakeV3Actor.GetApplicationsByGUIDsCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.GetApplicationsByGUIDsArgsForCall(0)).To(Equal([]string{"appCGUID"}))
+				Expect(fakeV3Actor.GetSpacesByGUIDsArgsForCall(0)).To(Equal([]string{"space", "otherSpaceGUID"}))
+				Expect(fakeV3Actor.GetOrganizationsByGUIDsArgsForCall(0)).To(ConsistOf("orgGUID", "otherOrgGUID"))
+			})
+
+			Context("when looking up the destination apps fails", func() {
+				BeforeEach(func() {
+					fakeV3Actor.GetApplicationsByGUIDsReturns(nil, v3action.Warnings{"GetApplicationsByGUIDsWarning"}, errors.New("banana"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("banana"))
+					Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning", "GetApplicationsByGUIDsWarning"))
+				})
+			})
+		})
+
 		Context("when getting the applications fails", func() {
 			BeforeEach(func() {
 				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{}, []string{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
@@ -390,7 +493,7 @@ var _ = Describe("Policy", func() {
 			protocol := "udp"
 			startPort := 123
 			endPort := 345
-			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, spaceGuid, destApp, protocol, startPort, endPort)
 		})
 		It("removes policies", func() {
 			Expect(warnings).To(Equal(Warnings([]string{"v3ActorWarningA", "v3ActorWarningB"})))
@@ -476,4 +579,61 @@ var _ = Describe("Policy", func() {
 			})
 		})
 	})
+
+	Describe("AddNetworkPolicy to an app in another space", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.AddNetworkPolicy("space", "appA", "otherSpace", "appB", "tcp", 8080, 8080)
+		})
+
+		It("looks up the destination app in the destination space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+			sourceAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(sourceAppName).To(Equal("appA"))
+			Expect(spaceGUID).To(Equal("space"))
+
+			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
+			Expect(destAppName).To(Equal("appB"))
+			Expect(spaceGUID).To(Equal("otherSpace"))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("GetSpaceGUIDByNameAndOrganizationName", func() {
+		var spaceGUID string
+
+		BeforeEach(func() {
+			fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{GUID: "orgGUID"}, v3action.Warnings{"GetOrganizationByNameWarning"}, nil)
+			fakeV3Actor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "spaceGUID"}, v3action.Warnings{"GetSpaceByNameAndOrganizationWarning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			spaceGUID, warnings, executeErr = actor.GetSpaceGUIDByNameAndOrganizationName("some-space", "some-org")
+		})
+
+		It("returns the GUID of the space in the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(spaceGUID).To(Equal("spaceGUID"))
+			Expect(warnings).To(ConsistOf("GetOrganizationByNameWarning", "GetSpaceByNameAndOrganizationWarning"))
+
+			Expect(fakeV3Actor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			spaceName, orgGUID := fakeV3Actor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("orgGUID"))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{}, v3action.Warnings{"GetOrganizationByNameWarning"}, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("GetOrganizationByNameWarning"))
+				Expect(fakeV3Actor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -8,10 +8,13 @@ import "code.cloudfoundry.org/cli/util/configv3"
 type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(repoName string, repoURL string)
+	AddTrustedPluginKey(name string, publicKey string)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
+	RemoveTrustedPluginKey(name string)
+	TrustedPluginKeys() []configv3.TrustedPluginKey
 	WritePluginConfig() error
 }
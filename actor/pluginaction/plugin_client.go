@@ -6,5 +6,7 @@ import "code.cloudfoundry.org/cli/api/plugin"
 
 type PluginClient interface {
 	GetPluginRepository(repositoryURL string) (plugin.PluginRepository, error)
+	GetPluginRepositorySignature(repositoryURL string) (string, error)
+	GetPluginSignature(signatureURL string) (string, error)
 	DownloadPlugin(pluginURL string, path string, proxyReader plugin.ProxyReader) error
 }
@@ -9,10 +9,15 @@ import (
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name      string
+	Version   string
+	URL       string
+	Checksum  string
+	Signature string
+
+	// RepositoryVerified is true when the index of the repository the plugin
+	// was found in is signed by a trusted plugin key.
+	RepositoryVerified bool
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -67,6 +72,11 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 		return PluginInfo{}, err
 	}
 
+	repositoryVerified, err := actor.verifyPluginRepositorySignature(pluginRepo, pluginRepository.RawIndex)
+	if err != nil {
+		return PluginInfo{}, err
+	}
+
 	var pluginFoundWithIncompatibleBinary bool
 
 	for _, plugin := range pluginRepository.Plugins {
@@ -74,10 +84,12 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:               plugin.Name,
+						Version:            plugin.Version,
+						URL:                pluginBinary.URL,
+						Checksum:           pluginBinary.Checksum,
+						Signature:          pluginBinary.Signature,
+						RepositoryVerified: repositoryVerified,
 					}, nil
 				}
 			}
@@ -1,6 +1,7 @@
 package pluginaction_test
 
 import (
+	"encoding/base64"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -10,17 +11,20 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
 )
 
 var _ = Describe("plugin info actions", func() {
 	var (
 		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
 		fakeClient *pluginactionfakes.FakePluginClient
 	)
 
 	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
 		fakeClient = new(pluginactionfakes.FakePluginClient)
-		actor = NewActor(nil, fakeClient)
+		actor = NewActor(fakeConfig, fakeClient)
 	})
 
 	Describe("GetPluginInfoFromRepositoriesForPlatform", func() {
@@ -47,7 +51,7 @@ var _ = Describe("plugin info actions", func() {
 								Name:    "some-plugin",
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
-									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
+									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", Signature: "some-signature"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
@@ -71,6 +75,7 @@ var _ = Describe("plugin info actions", func() {
 								},
 							},
 						},
+						RawIndex: []byte("some-raw-index"),
 					}, nil)
 				})
 
@@ -97,7 +102,85 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Signature).To(Equal("some-signature"))
+						Expect(pluginInfo.RepositoryVerified).To(BeFalse())
 						Expect(repos).To(ConsistOf("some-repo"))
+
+						Expect(fakeClient.GetPluginRepositorySignatureCallCount()).To(Equal(0))
+					})
+
+					Context("when there are trusted plugin keys", func() {
+						var privateKey ed25519.PrivateKey
+
+						BeforeEach(func() {
+							var (
+								publicKey ed25519.PublicKey
+								err       error
+							)
+							publicKey, privateKey, err = ed25519.GenerateKey(nil)
+							Expect(err).ToNot(HaveOccurred())
+
+							fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+								{Name: "some-key", PublicKey: base64.StdEncoding.EncodeToString(publicKey)},
+							})
+						})
+
+						Context("when the repository index is signed by a trusted key", func() {
+							BeforeEach(func() {
+								signature := ed25519.Sign(privateKey, []byte("some-raw-index"))
+								fakeClient.GetPluginRepositorySignatureReturns(base64.StdEncoding.EncodeToString(signature), nil)
+							})
+
+							It("returns the plugin info of a verified repository", func() {
+								pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "osx")
+								Expect(err).ToNot(HaveOccurred())
+								Expect(pluginInfo.RepositoryVerified).To(BeTrue())
+
+								Expect(fakeClient.GetPluginRepositorySignatureCallCount()).To(Equal(1))
+								Expect(fakeClient.GetPluginRepositorySignatureArgsForCall(0)).To(Equal("some-url"))
+							})
+						})
+
+						Context("when the repository index is not signed", func() {
+							BeforeEach(func() {
+								fakeClient.GetPluginRepositorySignatureReturns("", nil)
+							})
+
+							It("returns the plugin info of an unverified repository", func() {
+								pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "osx")
+								Expect(err).ToNot(HaveOccurred())
+								Expect(pluginInfo.RepositoryVerified).To(BeFalse())
+							})
+						})
+
+						Context("when the repository index signature does not match", func() {
+							BeforeEach(func() {
+								signature := ed25519.Sign(privateKey, []byte("some-other-index"))
+								fakeClient.GetPluginRepositorySignatureReturns(base64.StdEncoding.EncodeToString(signature), nil)
+							})
+
+							It("returns a PluginRepositorySignatureInvalidError", func() {
+								_, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "osx")
+								Expect(err).To(MatchError(actionerror.FetchingPluginInfoFromRepositoryError{
+									RepositoryName: "some-repo",
+									Err:            actionerror.PluginRepositorySignatureInvalidError{RepositoryName: "some-repo"},
+								}))
+							})
+						})
+
+						Context("when getting the repository index signature errors", func() {
+							BeforeEach(func() {
+								fakeClient.GetPluginRepositorySignatureReturns("", errors.New("some-error"))
+							})
+
+							It("returns a FetchingPluginInfoFromRepositoryError", func() {
+								_, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "osx")
+								Expect(err).To(MatchError(actionerror.FetchingPluginInfoFromRepositoryError{
+									RepositoryName: "some-repo",
+									Err:            errors.New("some-error"),
+								}))
+							})
+						})
 					})
 				})
 			})
@@ -122,7 +205,7 @@ var _ = Describe("plugin info actions", func() {
 								Name:    "some-plugin",
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
-									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
+									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", Signature: "some-signature"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
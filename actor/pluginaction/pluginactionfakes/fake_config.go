@@ -9,7 +9,7 @@ import (
 )
 
 type FakeConfig struct {
-	AddPluginStub        func(configv3.Plugin)
+	AddPluginStub        func(arg1 configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
@@ -20,6 +20,12 @@ type FakeConfig struct {
 		repoName string
 		repoURL  string
 	}
+	AddTrustedPluginKeyStub        func(name string, publicKey string)
+	addTrustedPluginKeyMutex       sync.RWMutex
+	addTrustedPluginKeyArgsForCall []struct {
+		name      string
+		publicKey string
+	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -60,11 +66,25 @@ type FakeConfig struct {
 	pluginsReturnsOnCall map[int]struct {
 		result1 []configv3.Plugin
 	}
-	RemovePluginStub        func(string)
+	RemovePluginStub        func(arg1 string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveTrustedPluginKeyStub        func(name string)
+	removeTrustedPluginKeyMutex       sync.RWMutex
+	removeTrustedPluginKeyArgsForCall []struct {
+		name string
+	}
+	TrustedPluginKeysStub        func() []configv3.TrustedPluginKey
+	trustedPluginKeysMutex       sync.RWMutex
+	trustedPluginKeysArgsForCall []struct{}
+	trustedPluginKeysReturns     struct {
+		result1 []configv3.TrustedPluginKey
+	}
+	trustedPluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.TrustedPluginKey
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct{}
@@ -127,6 +147,31 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL
 }
 
+func (fake *FakeConfig) AddTrustedPluginKey(name string, publicKey string) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	fake.addTrustedPluginKeyArgsForCall = append(fake.addTrustedPluginKeyArgsForCall, struct {
+		name      string
+		publicKey string
+	}{name, publicKey})
+	fake.recordInvocation("AddTrustedPluginKey", []interface{}{name, publicKey})
+	fake.addTrustedPluginKeyMutex.Unlock()
+	if fake.AddTrustedPluginKeyStub != nil {
+		fake.AddTrustedPluginKeyStub(name, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCallCount() int {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return len(fake.addTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyArgsForCall(i int) (string, string) {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return fake.addTrustedPluginKeyArgsForCall[i].name, fake.addTrustedPluginKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -322,6 +367,70 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemoveTrustedPluginKey(name string) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	fake.removeTrustedPluginKeyArgsForCall = append(fake.removeTrustedPluginKeyArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveTrustedPluginKey", []interface{}{name})
+	fake.removeTrustedPluginKeyMutex.Unlock()
+	if fake.RemoveTrustedPluginKeyStub != nil {
+		fake.RemoveTrustedPluginKeyStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCallCount() int {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return len(fake.removeTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyArgsForCall(i int) string {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return fake.removeTrustedPluginKeyArgsForCall[i].name
+}
+
+func (fake *FakeConfig) TrustedPluginKeys() []configv3.TrustedPluginKey {
+	fake.trustedPluginKeysMutex.Lock()
+	ret, specificReturn := fake.trustedPluginKeysReturnsOnCall[len(fake.trustedPluginKeysArgsForCall)]
+	fake.trustedPluginKeysArgsForCall = append(fake.trustedPluginKeysArgsForCall, struct{}{})
+	fake.recordInvocation("TrustedPluginKeys", []interface{}{})
+	fake.trustedPluginKeysMutex.Unlock()
+	if fake.TrustedPluginKeysStub != nil {
+		return fake.TrustedPluginKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.trustedPluginKeysReturns.result1
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCallCount() int {
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
+	return len(fake.trustedPluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturns(result1 []configv3.TrustedPluginKey) {
+	fake.TrustedPluginKeysStub = nil
+	fake.trustedPluginKeysReturns = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturnsOnCall(i int, result1 []configv3.TrustedPluginKey) {
+	fake.TrustedPluginKeysStub = nil
+	if fake.trustedPluginKeysReturnsOnCall == nil {
+		fake.trustedPluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TrustedPluginKey
+		})
+	}
+	fake.trustedPluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -369,6 +478,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -379,6 +490,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -22,6 +22,32 @@ type FakePluginClient struct {
 		result1 plugin.PluginRepository
 		result2 error
 	}
+	GetPluginRepositorySignatureStub        func(repositoryURL string) (string, error)
+	getPluginRepositorySignatureMutex       sync.RWMutex
+	getPluginRepositorySignatureArgsForCall []struct {
+		repositoryURL string
+	}
+	getPluginRepositorySignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	getPluginRepositorySignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetPluginSignatureStub        func(signatureURL string) (string, error)
+	getPluginSignatureMutex       sync.RWMutex
+	getPluginSignatureArgsForCall []struct {
+		signatureURL string
+	}
+	getPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	getPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DownloadPluginStub        func(pluginURL string, path string, proxyReader plugin.ProxyReader) error
 	downloadPluginMutex       sync.RWMutex
 	downloadPluginArgsForCall []struct {
@@ -90,6 +116,108 @@ func (fake *FakePluginClient) GetPluginRepositoryReturnsOnCall(i int, result1 pl
 	}{result1, result2}
 }
 
+func (fake *FakePluginClient) GetPluginRepositorySignature(repositoryURL string) (string, error) {
+	fake.getPluginRepositorySignatureMutex.Lock()
+	ret, specificReturn := fake.getPluginRepositorySignatureReturnsOnCall[len(fake.getPluginRepositorySignatureArgsForCall)]
+	fake.getPluginRepositorySignatureArgsForCall = append(fake.getPluginRepositorySignatureArgsForCall, struct {
+		repositoryURL string
+	}{repositoryURL})
+	fake.recordInvocation("GetPluginRepositorySignature", []interface{}{repositoryURL})
+	fake.getPluginRepositorySignatureMutex.Unlock()
+	if fake.GetPluginRepositorySignatureStub != nil {
+		return fake.GetPluginRepositorySignatureStub(repositoryURL)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginRepositorySignatureReturns.result1, fake.getPluginRepositorySignatureReturns.result2
+}
+
+func (fake *FakePluginClient) GetPluginRepositorySignatureCallCount() int {
+	fake.getPluginRepositorySignatureMutex.RLock()
+	defer fake.getPluginRepositorySignatureMutex.RUnlock()
+	return len(fake.getPluginRepositorySignatureArgsForCall)
+}
+
+func (fake *FakePluginClient) GetPluginRepositorySignatureArgsForCall(i int) string {
+	fake.getPluginRepositorySignatureMutex.RLock()
+	defer fake.getPluginRepositorySignatureMutex.RUnlock()
+	return fake.getPluginRepositorySignatureArgsForCall[i].repositoryURL
+}
+
+func (fake *FakePluginClient) GetPluginRepositorySignatureReturns(result1 string, result2 error) {
+	fake.GetPluginRepositorySignatureStub = nil
+	fake.getPluginRepositorySignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginClient) GetPluginRepositorySignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetPluginRepositorySignatureStub = nil
+	if fake.getPluginRepositorySignatureReturnsOnCall == nil {
+		fake.getPluginRepositorySignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getPluginRepositorySignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginClient) GetPluginSignature(signatureURL string) (string, error) {
+	fake.getPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.getPluginSignatureReturnsOnCall[len(fake.getPluginSignatureArgsForCall)]
+	fake.getPluginSignatureArgsForCall = append(fake.getPluginSignatureArgsForCall, struct {
+		signatureURL string
+	}{signatureURL})
+	fake.recordInvocation("GetPluginSignature", []interface{}{signatureURL})
+	fake.getPluginSignatureMutex.Unlock()
+	if fake.GetPluginSignatureStub != nil {
+		return fake.GetPluginSignatureStub(signatureURL)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginSignatureReturns.result1, fake.getPluginSignatureReturns.result2
+}
+
+func (fake *FakePluginClient) GetPluginSignatureCallCount() int {
+	fake.getPluginSignatureMutex.RLock()
+	defer fake.getPluginSignatureMutex.RUnlock()
+	return len(fake.getPluginSignatureArgsForCall)
+}
+
+func (fake *FakePluginClient) GetPluginSignatureArgsForCall(i int) string {
+	fake.getPluginSignatureMutex.RLock()
+	defer fake.getPluginSignatureMutex.RUnlock()
+	return fake.getPluginSignatureArgsForCall[i].signatureURL
+}
+
+func (fake *FakePluginClient) GetPluginSignatureReturns(result1 string, result2 error) {
+	fake.GetPluginSignatureStub = nil
+	fake.getPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginClient) GetPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetPluginSignatureStub = nil
+	if fake.getPluginSignatureReturnsOnCall == nil {
+		fake.getPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePluginClient) DownloadPlugin(pluginURL string, path string, proxyReader plugin.ProxyReader) error {
 	fake.downloadPluginMutex.Lock()
 	ret, specificReturn := fake.downloadPluginReturnsOnCall[len(fake.downloadPluginArgsForCall)]
@@ -145,6 +273,10 @@ func (fake *FakePluginClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getPluginRepositoryMutex.RLock()
 	defer fake.getPluginRepositoryMutex.RUnlock()
+	fake.getPluginRepositorySignatureMutex.RLock()
+	defer fake.getPluginRepositorySignatureMutex.RUnlock()
+	fake.getPluginSignatureMutex.RLock()
+	defer fake.getPluginSignatureMutex.RUnlock()
 	fake.downloadPluginMutex.RLock()
 	defer fake.downloadPluginMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,119 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"golang.org/x/crypto/ed25519"
+)
+
+// signatureSuffix is appended to the location of a plugin binary or
+// repository index to get the location of its detached signature.
+const signatureSuffix = ".sig"
+
+// GetDetachedPluginSignature returns the signature stored next to the plugin
+// binary at pluginLocation, which is either a URL or a path on disk, with
+// ".sig" appended. An empty signature is returned when there is none.
+func (actor Actor) GetDetachedPluginSignature(pluginLocation string) (string, error) {
+	if util.IsHTTPScheme(pluginLocation) {
+		return actor.client.GetPluginSignature(pluginLocation + signatureSuffix)
+	}
+
+	rawSignature, err := ioutil.ReadFile(pluginLocation + signatureSuffix)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(rawSignature)), nil
+}
+
+// VerifyPluginSignature verifies the signature of the plugin binary at path
+// against the trusted plugin keys, and returns the name of the key that
+// signed it. An unsigned binary, or a signed binary when there are no trusted
+// keys, is only an error when requireSignature is true; in that case an empty
+// key name is returned.
+func (actor Actor) VerifyPluginSignature(path string, signature string, requireSignature bool) (string, error) {
+	trustedKeys := actor.config.TrustedPluginKeys()
+	switch {
+	case requireSignature && len(trustedKeys) == 0:
+		return "", actionerror.NoTrustedPluginKeysError{}
+	case requireSignature && signature == "":
+		return "", actionerror.PluginSignatureMissingError{}
+	case signature == "" || len(trustedKeys) == 0:
+		return "", nil
+	}
+
+	binary, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	keyName, verified := verifySignature(trustedKeys, binary, signature)
+	if !verified {
+		return "", actionerror.PluginSignatureInvalidError{}
+	}
+	return keyName, nil
+}
+
+// verifyPluginRepositorySignature verifies the signature of the index of the
+// repository against the trusted plugin keys, and returns whether the index
+// was verified. A repository is not verified when there are no trusted keys or
+// it does not serve a signature.
+func (actor Actor) verifyPluginRepositorySignature(pluginRepo configv3.PluginRepository, rawIndex []byte) (bool, error) {
+	trustedKeys := actor.config.TrustedPluginKeys()
+	if len(trustedKeys) == 0 {
+		return false, nil
+	}
+
+	signature, err := actor.client.GetPluginRepositorySignature(pluginRepo.URL)
+	if err != nil {
+		return false, err
+	}
+	if signature == "" {
+		return false, nil
+	}
+
+	if _, verified := verifySignature(trustedKeys, rawIndex, signature); !verified {
+		return false, actionerror.PluginRepositorySignatureInvalidError{RepositoryName: pluginRepo.Name}
+	}
+	return true, nil
+}
+
+// verifySignature returns the name of the first trusted key that the base64
+// encoded ed25519 signature of data verifies against.
+func verifySignature(trustedKeys []configv3.TrustedPluginKey, data []byte, signature string) (string, bool) {
+	rawSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(rawSignature) != ed25519.SignatureSize {
+		return "", false
+	}
+
+	for _, key := range trustedKeys {
+		publicKey, err := decodePublicKey(key.PublicKey)
+		if err != nil {
+			continue
+		}
+		if ed25519.Verify(publicKey, data, rawSignature) {
+			return key.Name, true
+		}
+	}
+	return "", false
+}
+
+func decodePublicKey(encodedKey string) (ed25519.PublicKey, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(rawKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes", ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(rawKey), nil
+}
@@ -0,0 +1,195 @@
+package pluginaction_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signatures", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		fakeClient *pluginactionfakes.FakePluginClient
+		file       *os.File
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakeClient)
+
+		var err error
+		file, err = ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+
+		err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Remove(file.Name() + ".sig")
+		err := os.Remove(file.Name())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("GetDetachedPluginSignature", func() {
+		Context("when the plugin location is a URL", func() {
+			BeforeEach(func() {
+				fakeClient.GetPluginSignatureReturns("some-signature", nil)
+			})
+
+			It("gets the signature from the URL with .sig appended", func() {
+				signature, err := actor.GetDetachedPluginSignature("https://example.com/some-plugin")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(signature).To(Equal("some-signature"))
+
+				Expect(fakeClient.GetPluginSignatureCallCount()).To(Equal(1))
+				Expect(fakeClient.GetPluginSignatureArgsForCall(0)).To(Equal("https://example.com/some-plugin.sig"))
+			})
+
+			Context("when getting the signature errors", func() {
+				BeforeEach(func() {
+					fakeClient.GetPluginSignatureReturns("", errors.New("some-error"))
+				})
+
+				It("returns the error", func() {
+					_, err := actor.GetDetachedPluginSignature("https://example.com/some-plugin")
+					Expect(err).To(MatchError("some-error"))
+				})
+			})
+		})
+
+		Context("when the plugin location is a file with a signature file next to it", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(file.Name()+".sig", []byte("some-signature\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the contents of the signature file", func() {
+				signature, err := actor.GetDetachedPluginSignature(file.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(signature).To(Equal("some-signature"))
+			})
+		})
+
+		Context("when the plugin location is a file without a signature file", func() {
+			It("returns an empty signature", func() {
+				signature, err := actor.GetDetachedPluginSignature(file.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(signature).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("VerifyPluginSignature", func() {
+		var (
+			privateKey ed25519.PrivateKey
+			signature  string
+
+			requireSignature bool
+			keyName          string
+			executeErr       error
+		)
+
+		BeforeEach(func() {
+			publicKey, key, err := ed25519.GenerateKey(nil)
+			Expect(err).ToNot(HaveOccurred())
+			privateKey = key
+
+			otherPublicKey, _, err := ed25519.GenerateKey(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+				{Name: "other-key", PublicKey: base64.StdEncoding.EncodeToString(otherPublicKey)},
+				{Name: "some-key", PublicKey: base64.StdEncoding.EncodeToString(publicKey)},
+			})
+
+			signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo")))
+			requireSignature = false
+		})
+
+		JustBeforeEach(func() {
+			keyName, executeErr = actor.VerifyPluginSignature(file.Name(), signature, requireSignature)
+		})
+
+		Context("when the signature matches a trusted key", func() {
+			It("returns the name of the key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(keyName).To(Equal("some-key"))
+			})
+		})
+
+		Context("when the signature does not match any trusted key", func() {
+			BeforeEach(func() {
+				signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("bar")))
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		Context("when the signature is not base64 encoded", func() {
+			BeforeEach(func() {
+				signature = "not a signature"
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		Context("when there is no signature", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("does not verify the binary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(keyName).To(BeEmpty())
+			})
+
+			Context("when a signature is required", func() {
+				BeforeEach(func() {
+					requireSignature = true
+				})
+
+				It("returns a PluginSignatureMissingError", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginSignatureMissingError{}))
+				})
+			})
+		})
+
+		Context("when there are no trusted keys", func() {
+			BeforeEach(func() {
+				fakeConfig.TrustedPluginKeysReturns(nil)
+			})
+
+			It("does not verify the binary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(keyName).To(BeEmpty())
+			})
+
+			Context("when a signature is required", func() {
+				BeforeEach(func() {
+					requireSignature = true
+				})
+
+				It("returns a NoTrustedPluginKeysError", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoTrustedPluginKeysError{}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package pluginaction
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// AddTrustedPluginKey adds the base64 encoded ed25519 public key to the
+// trusted plugin keys under the given name.
+func (actor Actor) AddTrustedPluginKey(keyName string, publicKey string) error {
+	publicKey = strings.TrimSpace(publicKey)
+	if _, err := decodePublicKey(publicKey); err != nil {
+		return actionerror.InvalidTrustedPluginKeyError{Name: keyName}
+	}
+
+	for _, key := range actor.config.TrustedPluginKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			return actionerror.TrustedPluginKeyNameTakenError{Name: key.Name}
+		}
+	}
+
+	actor.config.AddTrustedPluginKey(keyName, publicKey)
+	return nil
+}
+
+// RemoveTrustedPluginKey removes the trusted plugin key with the given name.
+func (actor Actor) RemoveTrustedPluginKey(keyName string) error {
+	for _, key := range actor.config.TrustedPluginKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			actor.config.RemoveTrustedPluginKey(key.Name)
+			return nil
+		}
+	}
+	return actionerror.TrustedPluginKeyNotFoundError{Name: keyName}
+}
+
+// GetTrustedPluginKeys returns the trusted plugin keys, sorted by name.
+func (actor Actor) GetTrustedPluginKeys() []configv3.TrustedPluginKey {
+	return actor.config.TrustedPluginKeys()
+}
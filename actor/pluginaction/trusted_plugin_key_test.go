@@ -0,0 +1,74 @@
+package pluginaction_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("trusted plugin key actions", func() {
+	const publicKey = "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+			{Name: "Some-Key", PublicKey: publicKey},
+		})
+	})
+
+	Describe("AddTrustedPluginKey", func() {
+		It("adds the key to the config", func() {
+			err := actor.AddTrustedPluginKey("other-key", " "+publicKey+"\n")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(1))
+			name, key := fakeConfig.AddTrustedPluginKeyArgsForCall(0)
+			Expect(name).To(Equal("other-key"))
+			Expect(key).To(Equal(publicKey))
+		})
+
+		Context("when the key is not a base64 encoded ed25519 public key", func() {
+			It("returns an InvalidTrustedPluginKeyError", func() {
+				err := actor.AddTrustedPluginKey("other-key", "c29tZS1rZXk=")
+				Expect(err).To(MatchError(actionerror.InvalidTrustedPluginKeyError{Name: "other-key"}))
+				Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a key with the same name exists", func() {
+			It("returns a TrustedPluginKeyNameTakenError", func() {
+				err := actor.AddTrustedPluginKey("some-key", publicKey)
+				Expect(err).To(MatchError(actionerror.TrustedPluginKeyNameTakenError{Name: "Some-Key"}))
+				Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RemoveTrustedPluginKey", func() {
+		It("removes the key from the config", func() {
+			err := actor.RemoveTrustedPluginKey("some-key")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.RemoveTrustedPluginKeyCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemoveTrustedPluginKeyArgsForCall(0)).To(Equal("Some-Key"))
+		})
+
+		Context("when the key does not exist", func() {
+			It("returns a TrustedPluginKeyNotFoundError", func() {
+				err := actor.RemoveTrustedPluginKey("other-key")
+				Expect(err).To(MatchError(actionerror.TrustedPluginKeyNotFoundError{Name: "other-key"}))
+				Expect(fakeConfig.RemoveTrustedPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
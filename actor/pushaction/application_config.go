@@ -28,6 +28,10 @@ type ApplicationConfig struct {
 	Archive            bool
 	Path               string
 	DropletPath        string
+
+	// DependsOn lists the apps in the same push that must be started before
+	// this app is started.
+	DependsOn []string
 }
 
 func (config ApplicationConfig) CreatingApplication() bool {
@@ -70,6 +74,7 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 			config = ApplicationConfig{
 				DropletPath: absPath,
 				NoRoute:     app.NoRoute,
+				DependsOn:   app.DependsOn,
 			}
 		} else {
 			absPath, err = filepath.EvalSymlinks(app.Path)
@@ -77,8 +82,9 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 				return nil, nil, err
 			}
 			config = ApplicationConfig{
-				Path:    absPath,
-				NoRoute: app.NoRoute,
+				Path:      absPath,
+				NoRoute:   app.NoRoute,
+				DependsOn: app.DependsOn,
 			}
 		}
 
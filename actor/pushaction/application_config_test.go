@@ -1087,6 +1087,17 @@ var _ = Describe("Application Config", func() {
 			})
 		})
 
+		Context("when the app depends on other apps", func() {
+			BeforeEach(func() {
+				manifestApps[0].DependsOn = []string{"some-other-app"}
+			})
+
+			It("sets the dependencies on the config", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DependsOn).To(Equal([]string{"some-other-app"}))
+			})
+		})
+
 		Context("when buildpacks (plural) are provided", func() {
 			BeforeEach(func() {
 				manifestApps[0].Buildpacks = []string{
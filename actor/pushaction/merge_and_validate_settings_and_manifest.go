@@ -53,6 +53,12 @@ func (actor Actor) MergeAndValidateSettingsAndManifests(cmdLineSettings CommandL
 		log.Errorln("validation error post merge:", err)
 		return nil, err
 	}
+
+	err = actor.validateDependencies(mergedApps)
+	if err != nil {
+		log.Errorln("validating dependencies:", err)
+		return nil, err
+	}
 	return mergedApps, nil
 }
 
@@ -250,6 +256,58 @@ func (actor Actor) validateMergedSettings(apps []manifest.Application) error {
 	return nil
 }
 
+// validateDependencies ensures that the depends-on properties of the apps do
+// not form a cycle. Dependencies on apps that are not being pushed are
+// ignored.
+func (Actor) validateDependencies(apps []manifest.Application) error {
+	dependencies := map[string][]string{}
+	for _, app := range apps {
+		dependencies[app.Name] = app.DependsOn
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := map[string]int{}
+	var path []string
+
+	var visit func(appName string) error
+	visit = func(appName string) error {
+		switch states[appName] {
+		case visited:
+			return nil
+		case visiting:
+			for i, name := range path {
+				if name == appName {
+					return actionerror.DependencyCycleError{AppNames: append(append([]string{}, path[i:]...), appName)}
+				}
+			}
+		}
+
+		states[appName] = visiting
+		path = append(path, appName)
+		for _, dependency := range dependencies[appName] {
+			if _, ok := dependencies[dependency]; !ok {
+				continue
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[appName] = visited
+		return nil
+	}
+
+	for _, app := range apps {
+		if err := visit(app.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (actor Actor) validateRoute(route string) error {
 	_, err := url.Parse(route)
 	if err != nil || !actor.urlValidator.MatchString(route) {
@@ -149,6 +149,53 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 				})
 			})
 		})
+
+		Context("when the apps depend on each other", func() {
+			Context("when the dependencies do not form a cycle", func() {
+				BeforeEach(func() {
+					apps = []manifest.Application{
+						{Name: "app-1", DependsOn: []string{"app-2", "some-app-not-being-pushed"}},
+						{Name: "app-2"},
+					}
+				})
+
+				It("keeps the dependencies", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(mergedApps[0].DependsOn).To(Equal([]string{"app-2", "some-app-not-being-pushed"}))
+				})
+			})
+
+			Context("when the dependencies form a cycle", func() {
+				BeforeEach(func() {
+					apps = []manifest.Application{
+						{Name: "app-1", DependsOn: []string{"app-2"}},
+						{Name: "app-2", DependsOn: []string{"app-3"}},
+						{Name: "app-3", DependsOn: []string{"app-1"}},
+					}
+				})
+
+				It("returns a DependencyCycleError", func() {
+					Expect(executeErr).To(MatchError(actionerror.DependencyCycleError{
+						AppNames: []string{"app-1", "app-2", "app-3", "app-1"},
+					}))
+				})
+			})
+
+			Context("when an app depends on itself", func() {
+				BeforeEach(func() {
+					apps = []manifest.Application{
+						{Name: "app-1", DependsOn: []string{"app-1"}},
+						{Name: "app-2"},
+					}
+				})
+
+				It("returns a DependencyCycleError", func() {
+					Expect(executeErr).To(MatchError(actionerror.DependencyCycleError{
+						AppNames: []string{"app-1", "app-1"},
+					}))
+				})
+			})
+		})
 	})
 
 	Describe("defaulting values", func() {
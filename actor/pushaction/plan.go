@@ -0,0 +1,88 @@
+package pushaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/util/manifest"
+	log "github.com/sirupsen/logrus"
+)
+
+// Plan is the set of changes that Apply would make to bring an application to
+// its desired state.
+type Plan struct {
+	// Config is the application config with its resources matched against the
+	// Cloud Controller's resource cache.
+	Config ApplicationConfig
+
+	RoutesToCreate []v2action.Route
+	RoutesToMap    []v2action.Route
+	RoutesToUnmap  []v2action.Route
+	ServicesToBind []string
+}
+
+// PlanApplication returns the changes that Apply would make for the config.
+// It follows the same rules as Apply, and matches the config's resources the
+// same way, but does not change anything on the Cloud Controller.
+func (actor Actor) PlanApplication(config ApplicationConfig) (Plan, Warnings) {
+	log.WithField("app_name", config.DesiredApplication.Name).Info("planning application")
+
+	var warnings Warnings
+	if config.DropletPath == "" && config.DesiredApplication.DockerImage == "" {
+		config, warnings = actor.SetMatchedResources(config)
+	}
+
+	plan := Plan{Config: config}
+
+	if config.NoRoute {
+		plan.RoutesToUnmap = config.CurrentRoutes
+	} else {
+		for _, route := range config.DesiredRoutes {
+			if route.GUID == "" {
+				plan.RoutesToCreate = append(plan.RoutesToCreate, route)
+			} else if !actor.routeInListByGUID(route, config.CurrentRoutes) {
+				plan.RoutesToMap = append(plan.RoutesToMap, route)
+			}
+		}
+	}
+
+	for serviceInstanceName := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceInstanceName]; !ok {
+			plan.ServicesToBind = append(plan.ServicesToBind, serviceInstanceName)
+		}
+	}
+	sort.Strings(plan.ServicesToBind)
+
+	return plan, warnings
+}
+
+// PlanPush returns the push state of every application that pushing the
+// merged manifest and command line settings would create or update. Each
+// state is conceptualized the same way a push conceptualizes it, so nothing
+// is changed on the Cloud Controller.
+func (actor Actor) PlanPush(spaceGUID string, settings CommandLineSettings, manifestApps []manifest.Application) ([]PushState, Warnings, error) {
+	apps, err := actor.MergeAndValidateSettingsAndManifests(settings, manifestApps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		states   []PushState
+		warnings Warnings
+	)
+	for _, app := range apps {
+		appSettings := settings
+		appSettings.Name = app.Name
+		appSettings.ProvidedAppPath = app.Path
+
+		log.WithField("app_name", app.Name).Info("planning push state")
+		appStates, stateWarnings, err := actor.Conceptualize(appSettings, spaceGUID)
+		warnings = append(warnings, stateWarnings...)
+		if err != nil {
+			return nil, warnings, err
+		}
+		states = append(states, appStates...)
+	}
+
+	return states, warnings, nil
+}
@@ -0,0 +1,242 @@
+package pushaction_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		actor           *Actor
+		fakeV2Actor     *pushactionfakes.FakeV2Actor
+		fakeV3Actor     *pushactionfakes.FakeV3Actor
+		fakeSharedActor *pushactionfakes.FakeSharedActor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+		fakeSharedActor = new(pushactionfakes.FakeSharedActor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor, fakeSharedActor)
+	})
+
+	Describe("PlanApplication", func() {
+		var (
+			config   ApplicationConfig
+			plan     Plan
+			warnings Warnings
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{Name: "some-app"},
+				},
+				AllResources: []v2action.Resource{{Filename: "file-1"}, {Filename: "file-2"}},
+				CurrentRoutes: []v2action.Route{
+					{GUID: "current-route-guid", Host: "current"},
+				},
+				DesiredRoutes: []v2action.Route{
+					{GUID: "current-route-guid", Host: "current"},
+					{GUID: "existing-route-guid", Host: "existing"},
+					{Host: "new"},
+				},
+				CurrentServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1"},
+				},
+				DesiredServices: map[string]v2action.ServiceInstance{
+					"service-1": {Name: "service-1"},
+					"service-3": {Name: "service-3"},
+					"service-2": {Name: "service-2"},
+				},
+			}
+
+			fakeV2Actor.ResourceMatchReturns(
+				[]v2action.Resource{{Filename: "file-1"}},
+				[]v2action.Resource{{Filename: "file-2"}},
+				v2action.Warnings{"resource-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings = actor.PlanApplication(config)
+		})
+
+		It("matches the resources the same way a push does", func() {
+			Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(config.AllResources))
+
+			Expect(plan.Config.MatchedResources).To(ConsistOf(v2action.Resource{Filename: "file-1"}))
+			Expect(plan.Config.UnmatchedResources).To(ConsistOf(v2action.Resource{Filename: "file-2"}))
+			Expect(warnings).To(ConsistOf("resource-warning"))
+		})
+
+		It("plans the routes to create and map", func() {
+			Expect(plan.RoutesToCreate).To(ConsistOf(v2action.Route{Host: "new"}))
+			Expect(plan.RoutesToMap).To(ConsistOf(v2action.Route{GUID: "existing-route-guid", Host: "existing"}))
+			Expect(plan.RoutesToUnmap).To(BeEmpty())
+		})
+
+		It("plans the services to bind in order", func() {
+			Expect(plan.ServicesToBind).To(Equal([]string{"service-2", "service-3"}))
+		})
+
+		Context("when no route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+			})
+
+			It("plans to unmap the current routes", func() {
+				Expect(plan.RoutesToCreate).To(BeEmpty())
+				Expect(plan.RoutesToMap).To(BeEmpty())
+				Expect(plan.RoutesToUnmap).To(Equal(config.CurrentRoutes))
+			})
+		})
+
+		Context("when the app is pushed from a docker image", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.DockerImage = "some-image"
+			})
+
+			It("does not match resources", func() {
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+				Expect(warnings).To(BeEmpty())
+			})
+		})
+
+		Context("when the app is pushed from a droplet", func() {
+			BeforeEach(func() {
+				config.DropletPath = "some-droplet"
+			})
+
+			It("does not match resources", func() {
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PlanPush", func() {
+		var (
+			settings     CommandLineSettings
+			manifestApps []manifest.Application
+
+			states     []PushState
+			warnings   Warnings
+			executeErr error
+
+			pwd string
+		)
+
+		BeforeEach(func() {
+			var err error
+			pwd, err = os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+
+			settings = CommandLineSettings{CurrentDirectory: pwd}
+			manifestApps = nil
+
+			fakeV3Actor.GetApplicationByNameAndSpaceStub = func(appName string, _ string) (v3action.Application, v3action.Warnings, error) {
+				if appName == "existing-app" {
+					return v3action.Application{Name: appName, GUID: "existing-app-guid"}, v3action.Warnings{"get-app-warning"}, nil
+				}
+				return v3action.Application{}, v3action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: appName}
+			}
+			fakeSharedActor.GatherDirectoryResourcesReturns([]sharedaction.Resource{{Filename: "some-file"}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			states, warnings, executeErr = actor.PlanPush("some-space-guid", settings, manifestApps)
+		})
+
+		Context("when there is no manifest", func() {
+			BeforeEach(func() {
+				settings.Name = "existing-app"
+			})
+
+			It("conceptualizes the app from the command line settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(states).To(HaveLen(1))
+				Expect(states[0].Application).To(Equal(v3action.Application{Name: "existing-app", GUID: "existing-app-guid"}))
+				Expect(states[0].SpaceGUID).To(Equal("some-space-guid"))
+				Expect(states[0].BitsPath).To(Equal(pwd))
+				Expect(states[0].AllResources).To(ConsistOf(sharedaction.Resource{Filename: "some-file"}))
+			})
+		})
+
+		Context("when there is a manifest", func() {
+			BeforeEach(func() {
+				manifestApps = []manifest.Application{
+					{Name: "existing-app", Path: "pushactionfakes"},
+					{Name: "new-app"},
+				}
+			})
+
+			It("conceptualizes every app from the merged manifest and command line settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-app-warning"))
+
+				Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+				Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(2))
+				Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(0)).To(Equal(filepath.Join(pwd, "pushactionfakes")))
+				Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(1)).To(Equal(pwd))
+
+				Expect(states).To(HaveLen(2))
+				Expect(states[0].Application).To(Equal(v3action.Application{Name: "existing-app", GUID: "existing-app-guid"}))
+				Expect(states[0].BitsPath).To(Equal(filepath.Join(pwd, "pushactionfakes")))
+				Expect(states[1].Application).To(Equal(v3action.Application{Name: "new-app"}))
+				Expect(states[1].BitsPath).To(Equal(pwd))
+			})
+
+			Context("when the app name is also given on the command line", func() {
+				BeforeEach(func() {
+					settings.Name = "new-app"
+				})
+
+				It("only conceptualizes that app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(states).To(HaveLen(1))
+					Expect(states[0].Application.Name).To(Equal("new-app"))
+				})
+			})
+		})
+
+		Context("when merging the settings and manifest fails", func() {
+			BeforeEach(func() {
+				settings.Name = "some-other-app"
+				manifestApps = []manifest.Application{{Name: "existing-app"}, {Name: "new-app"}}
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppNotFoundInManifestError{Name: "some-other-app"}))
+				Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when conceptualizing an app fails", func() {
+			BeforeEach(func() {
+				settings.Name = "existing-app"
+				fakeV3Actor.GetApplicationByNameAndSpaceStub = nil
+				fakeV3Actor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+})
@@ -5,11 +5,14 @@ package sharedaction
 // Actor handles all shared actions
 type Actor struct {
 	Config Config
+
+	resourceCacheState *resourceCacheState
 }
 
 // NewActor returns an Actor with default settings
 func NewActor(config Config) *Actor {
 	return &Actor{
-		Config: config,
+		Config:             config,
+		resourceCacheState: new(resourceCacheState),
 	}
 }
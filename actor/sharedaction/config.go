@@ -9,5 +9,6 @@ type Config interface {
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	RefreshToken() string
+	ResourceCacheFilePath() string
 	Verbose() (bool, []string)
 }
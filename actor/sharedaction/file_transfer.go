@@ -0,0 +1,338 @@
+package sharedaction
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+type FileTransferDirection int
+
+const (
+	UploadToApp FileTransferDirection = iota
+	DownloadFromApp
+)
+
+//go:generate counterfeiter . FileTransferProgressBar
+
+type FileTransferProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+	Ready()
+	Complete()
+}
+
+type FileTransferOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	Direction          FileTransferDirection
+	// LocalPath uses the local path separator, while RemotePath always uses
+	// forward slashes.
+	LocalPath  string
+	RemotePath string
+	Recursive  bool
+}
+
+type fileTransferEntry struct {
+	source      string
+	destination string
+	mode        os.FileMode
+	size        int64
+	isDir       bool
+}
+
+// fileTransfer copies files and directories between the local machine and an
+// app instance, in the direction given by its functions.
+type fileTransfer struct {
+	entries []fileTransferEntry
+	mkdir   func(name string, mode os.FileMode) error
+	open    func(name string) (io.ReadCloser, error)
+	create  func(name string, mode os.FileMode) (io.WriteCloser, error)
+}
+
+//go:generate counterfeiter . FileTransferSession
+
+// FileTransferSession is an SFTP session with an app instance. Remote paths
+// always use forward slashes.
+type FileTransferSession interface {
+	// TransferFiles copies files in the direction, and between the paths,
+	// given by the options. The connection options are ignored.
+	TransferFiles(options FileTransferOptions, progressBar FileTransferProgressBar) error
+	RealPath(remotePath string) (string, error)
+	Stat(remotePath string) (os.FileInfo, error)
+	ReadDir(remotePath string) ([]os.FileInfo, error)
+	Mkdir(remotePath string) error
+	Close() error
+}
+
+type fileTransferSession struct {
+	sshClient SecureShellClient
+	session   clissh.SFTPSession
+}
+
+// TransferFiles copies a file, or a directory when Recursive is set, to or
+// from an app instance over SFTP. As with scp, a source copied onto an
+// existing directory is placed inside it.
+func (actor Actor) TransferFiles(sshClient SecureShellClient, options FileTransferOptions, progressBar FileTransferProgressBar) error {
+	session, err := actor.StartFileTransferSession(sshClient, options)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	return session.TransferFiles(options, progressBar)
+}
+
+// StartFileTransferSession connects to the app instance given by the options
+// and starts an SFTP session, which must be closed when it is no longer
+// needed.
+func (Actor) StartFileTransferSession(sshClient SecureShellClient, options FileTransferOptions) (FileTransferSession, error) {
+	err := sshClient.Connect(options.Username, options.Passcode, options.Endpoint, options.HostKeyFingerprint, options.SkipHostValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := sshClient.SFTPSession()
+	if err != nil {
+		_ = sshClient.Close()
+		return nil, err
+	}
+
+	return fileTransferSession{sshClient: sshClient, session: session}, nil
+}
+
+func (s fileTransferSession) TransferFiles(options FileTransferOptions, progressBar FileTransferProgressBar) error {
+	var (
+		transfer fileTransfer
+		err      error
+	)
+	if options.Direction == DownloadFromApp {
+		transfer, err = planDownload(s.session, options.RemotePath, options.LocalPath, options.Recursive)
+	} else {
+		transfer, err = planUpload(s.session, options.LocalPath, options.RemotePath, options.Recursive)
+	}
+	if err != nil {
+		return err
+	}
+
+	return transfer.run(progressBar)
+}
+
+func (s fileTransferSession) RealPath(remotePath string) (string, error) {
+	return s.session.RealPath(remotePath)
+}
+
+func (s fileTransferSession) Stat(remotePath string) (os.FileInfo, error) {
+	return s.session.Stat(remotePath)
+}
+
+func (s fileTransferSession) ReadDir(remotePath string) ([]os.FileInfo, error) {
+	return s.session.ReadDir(remotePath)
+}
+
+func (s fileTransferSession) Mkdir(remotePath string) error {
+	return s.session.Mkdir(remotePath, 0755)
+}
+
+// Close closes the SFTP session and the connection to the app instance.
+func (s fileTransferSession) Close() error {
+	sessionErr := s.session.Close()
+	err := s.sshClient.Close()
+	if sessionErr != nil {
+		return sessionErr
+	}
+	return err
+}
+
+func planUpload(session clissh.SFTPSession, localPath string, remotePath string, recursive bool) (fileTransfer, error) {
+	transfer := fileTransfer{
+		mkdir: func(name string, mode os.FileMode) error {
+			err := session.Mkdir(name, mode)
+			if err != nil {
+				if info, statErr := session.Stat(name); statErr == nil && info.IsDir() {
+					return nil
+				}
+			}
+			return err
+		},
+		open: func(name string) (io.ReadCloser, error) {
+			return os.Open(name)
+		},
+		create: session.Create,
+	}
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return transfer, err
+	}
+	if info.IsDir() && !recursive {
+		return transfer, actionerror.CopySourceIsDirectoryError{Path: localPath}
+	}
+
+	remoteInfo, err := session.Stat(remotePath)
+	if err == nil && remoteInfo.IsDir() {
+		absPath, absErr := filepath.Abs(localPath)
+		if absErr != nil {
+			return transfer, absErr
+		}
+		remotePath = path.Join(remotePath, filepath.Base(absPath))
+	} else if err != nil && !os.IsNotExist(err) {
+		return transfer, err
+	}
+
+	err = filepath.Walk(localPath, func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(localPath, name)
+		if err != nil {
+			return err
+		}
+
+		transfer.entries = append(transfer.entries, fileTransferEntry{
+			source:      name,
+			destination: path.Join(remotePath, filepath.ToSlash(relPath)),
+			mode:        info.Mode().Perm(),
+			size:        info.Size(),
+			isDir:       info.IsDir(),
+		})
+		return nil
+	})
+
+	return transfer, err
+}
+
+func planDownload(session clissh.SFTPSession, remotePath string, localPath string, recursive bool) (fileTransfer, error) {
+	transfer := fileTransfer{
+		mkdir: func(name string, mode os.FileMode) error {
+			err := os.Mkdir(name, mode)
+			if os.IsExist(err) {
+				if info, statErr := os.Stat(name); statErr == nil && info.IsDir() {
+					return nil
+				}
+			}
+			return err
+		},
+		open: session.Open,
+		create: func(name string, mode os.FileMode) (io.WriteCloser, error) {
+			return os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+		},
+	}
+
+	info, err := session.Stat(remotePath)
+	if err != nil {
+		return transfer, err
+	}
+	if info.IsDir() && !recursive {
+		return transfer, actionerror.CopySourceIsDirectoryError{Path: remotePath}
+	}
+
+	if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	err = transfer.addRemoteEntries(session, remotePath, localPath, info)
+	return transfer, err
+}
+
+func (transfer *fileTransfer) addRemoteEntries(session clissh.SFTPSession, remotePath string, localPath string, info os.FileInfo) error {
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		return nil
+	}
+
+	transfer.entries = append(transfer.entries, fileTransferEntry{
+		source:      remotePath,
+		destination: localPath,
+		mode:        info.Mode().Perm(),
+		size:        info.Size(),
+		isDir:       info.IsDir(),
+	})
+	if !info.IsDir() {
+		return nil
+	}
+
+	children, err := session.ReadDir(remotePath)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		err = transfer.addRemoteEntries(session, path.Join(remotePath, child.Name()), filepath.Join(localPath, child.Name()), child)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run copies the entries in order, so that directories are created before
+// their contents, and displays the progress across all of the files.
+func (transfer fileTransfer) run(progressBar FileTransferProgressBar) error {
+	var totalSize int64
+	for _, entry := range transfer.entries {
+		if !entry.isDir {
+			totalSize += entry.size
+		}
+	}
+
+	progressReader, progressWriter := io.Pipe()
+	// Ready unblocks NewProgressBarWrapper, which starts displaying the bar.
+	go progressBar.Ready()
+	progressProxy := progressBar.NewProgressBarWrapper(progressReader, totalSize)
+
+	progressDone := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, progressProxy)
+		close(progressDone)
+	}()
+
+	var err error
+	for _, entry := range transfer.entries {
+		err = transfer.copy(entry, progressWriter)
+		if err != nil {
+			break
+		}
+	}
+
+	_ = progressWriter.Close()
+	<-progressDone
+	progressBar.Complete()
+
+	return err
+}
+
+func (transfer fileTransfer) copy(entry fileTransferEntry, progress io.Writer) error {
+	if entry.isDir {
+		return transfer.mkdir(entry.destination, entry.mode)
+	}
+
+	source, err := transfer.open(entry.source)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := transfer.create(entry.destination, entry.mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, io.TeeReader(source, progress))
+	if err != nil {
+		_ = destination.Close()
+		return err
+	}
+
+	return destination.Close()
+}
@@ -0,0 +1,417 @@
+package sharedaction_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type remoteFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+}
+
+func (i remoteFileInfo) Name() string       { return i.name }
+func (i remoteFileInfo) Size() int64        { return i.size }
+func (i remoteFileInfo) Mode() os.FileMode  { return i.mode }
+func (i remoteFileInfo) ModTime() time.Time { return time.Time{} }
+func (i remoteFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i remoteFileInfo) Sys() interface{}   { return nil }
+
+type remoteFile struct {
+	*bytes.Buffer
+	closed bool
+}
+
+func (f *remoteFile) Close() error {
+	f.closed = true
+	return nil
+}
+
+var _ = Describe("File Transfer Actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+		fakeSFTPSession       *clisshfakes.FakeSFTPSession
+		fakeProgressBar       *sharedactionfakes.FakeFileTransferProgressBar
+		progressBytes         *bytes.Buffer
+
+		localDir        string
+		transferOptions FileTransferOptions
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		fakeSFTPSession = new(clisshfakes.FakeSFTPSession)
+		fakeSecureShellClient.SFTPSessionReturns(fakeSFTPSession, nil)
+
+		progressBytes = new(bytes.Buffer)
+		fakeProgressBar = new(sharedactionfakes.FakeFileTransferProgressBar)
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+			return io.TeeReader(reader, progressBytes)
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "file-transfer")
+		Expect(err).ToNot(HaveOccurred())
+
+		transferOptions = FileTransferOptions{
+			Username:           "some-user",
+			Passcode:           "some-passcode",
+			Endpoint:           "some-endpoint",
+			HostKeyFingerprint: "some-fingerprint",
+			SkipHostValidation: true,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	Describe("TransferFiles", func() {
+		JustBeforeEach(func() {
+			executeErr = actor.TransferFiles(fakeSecureShellClient, transferOptions, fakeProgressBar)
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+
+				Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+				usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+				Expect(usernameArg).To(Equal("some-user"))
+				Expect(passcodeArg).To(Equal("some-passcode"))
+				Expect(endpointArg).To(Equal("some-endpoint"))
+				Expect(fingerprintArg).To(Equal("some-fingerprint"))
+				Expect(skipHostValidationArg).To(BeTrue())
+				Expect(fakeSecureShellClient.SFTPSessionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when starting the sftp session fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.SFTPSessionReturns(nil, errors.New("some-sftp-error"))
+			})
+
+			It("closes the client and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-sftp-error"))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when uploading to the app", func() {
+			var remoteFiles map[string]*remoteFile
+
+			BeforeEach(func() {
+				transferOptions.Direction = UploadToApp
+				transferOptions.RemotePath = "/home/vcap/some-target"
+
+				remoteFiles = map[string]*remoteFile{}
+				fakeSFTPSession.StatReturns(nil, &os.PathError{Op: "stat", Path: "/home/vcap/some-target", Err: os.ErrNotExist})
+				fakeSFTPSession.CreateStub = func(path string, _ os.FileMode) (io.WriteCloser, error) {
+					remoteFiles[path] = &remoteFile{Buffer: new(bytes.Buffer)}
+					return remoteFiles[path], nil
+				}
+			})
+
+			Context("when the source is a file", func() {
+				BeforeEach(func() {
+					transferOptions.LocalPath = filepath.Join(localDir, "some-file")
+					Expect(ioutil.WriteFile(transferOptions.LocalPath, []byte("some-content"), 0640)).To(Succeed())
+				})
+
+				It("copies the file to the remote path and displays the progress", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeSFTPSession.CreateCallCount()).To(Equal(1))
+					path, mode := fakeSFTPSession.CreateArgsForCall(0)
+					Expect(path).To(Equal("/home/vcap/some-target"))
+					Expect(mode).To(Equal(os.FileMode(0640)))
+					Expect(remoteFiles[path].String()).To(Equal("some-content"))
+					Expect(remoteFiles[path].closed).To(BeTrue())
+
+					Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
+					Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+					_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+					Expect(size).To(BeEquivalentTo(len("some-content")))
+					Expect(progressBytes.String()).To(Equal("some-content"))
+					Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+					Expect(fakeSFTPSession.CloseCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+				})
+
+				Context("when the remote path is a directory", func() {
+					BeforeEach(func() {
+						fakeSFTPSession.StatReturns(remoteFileInfo{name: "some-target", mode: os.ModeDir | 0755}, nil)
+					})
+
+					It("copies the file into the directory", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(remoteFiles).To(HaveKey("/home/vcap/some-target/some-file"))
+					})
+				})
+
+				Context("when creating the remote file fails", func() {
+					BeforeEach(func() {
+						fakeSFTPSession.CreateStub = nil
+						fakeSFTPSession.CreateReturns(nil, errors.New("some-create-error"))
+					})
+
+					It("completes the progress bar and returns the error", func() {
+						Expect(executeErr).To(MatchError("some-create-error"))
+						Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the source is a directory", func() {
+				BeforeEach(func() {
+					transferOptions.LocalPath = filepath.Join(localDir, "some-dir")
+					Expect(os.MkdirAll(filepath.Join(transferOptions.LocalPath, "some-subdir"), 0750)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(transferOptions.LocalPath, "some-file"), []byte("some-content"), 0644)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(transferOptions.LocalPath, "some-subdir", "some-other-file"), []byte("some-other-content"), 0644)).To(Succeed())
+				})
+
+				Context("when the copy is not recursive", func() {
+					It("returns a CopySourceIsDirectoryError", func() {
+						Expect(executeErr).To(MatchError(actionerror.CopySourceIsDirectoryError{Path: transferOptions.LocalPath}))
+						Expect(fakeSFTPSession.MkdirCallCount()).To(Equal(0))
+						Expect(fakeSFTPSession.CreateCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the copy is recursive", func() {
+					BeforeEach(func() {
+						transferOptions.Recursive = true
+					})
+
+					It("creates the directories before copying their files", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSFTPSession.MkdirCallCount()).To(Equal(2))
+						path, mode := fakeSFTPSession.MkdirArgsForCall(0)
+						Expect(path).To(Equal("/home/vcap/some-target"))
+						Expect(mode).To(Equal(os.FileMode(0750)))
+						path, _ = fakeSFTPSession.MkdirArgsForCall(1)
+						Expect(path).To(Equal("/home/vcap/some-target/some-subdir"))
+
+						Expect(remoteFiles["/home/vcap/some-target/some-file"].String()).To(Equal("some-content"))
+						Expect(remoteFiles["/home/vcap/some-target/some-subdir/some-other-file"].String()).To(Equal("some-other-content"))
+
+						_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+						Expect(size).To(BeEquivalentTo(len("some-content") + len("some-other-content")))
+					})
+
+					Context("when the remote directories already exist", func() {
+						BeforeEach(func() {
+							fakeSFTPSession.MkdirReturns(errors.New("some-mkdir-error"))
+							fakeSFTPSession.StatReturnsOnCall(0, nil, &os.PathError{Op: "stat", Path: "/home/vcap/some-target", Err: os.ErrNotExist})
+							fakeSFTPSession.StatReturns(remoteFileInfo{name: "some-dir", mode: os.ModeDir | 0755}, nil)
+						})
+
+						It("copies into the existing directories", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeSFTPSession.StatCallCount()).To(Equal(3))
+							Expect(fakeSFTPSession.StatArgsForCall(1)).To(Equal("/home/vcap/some-target"))
+							Expect(fakeSFTPSession.StatArgsForCall(2)).To(Equal("/home/vcap/some-target/some-subdir"))
+							Expect(remoteFiles).To(HaveLen(2))
+						})
+					})
+
+					Context("when creating a remote directory fails", func() {
+						BeforeEach(func() {
+							fakeSFTPSession.MkdirReturns(errors.New("some-mkdir-error"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("some-mkdir-error"))
+							Expect(fakeSFTPSession.CreateCallCount()).To(Equal(0))
+						})
+					})
+				})
+			})
+		})
+
+		Context("when downloading from the app", func() {
+			BeforeEach(func() {
+				transferOptions.Direction = DownloadFromApp
+				transferOptions.LocalPath = filepath.Join(localDir, "some-target")
+			})
+
+			Context("when the source is a file", func() {
+				BeforeEach(func() {
+					transferOptions.RemotePath = "/home/vcap/some-file"
+					fakeSFTPSession.StatReturns(remoteFileInfo{name: "some-file", size: int64(len("some-content")), mode: 0600}, nil)
+					fakeSFTPSession.OpenReturns(ioutil.NopCloser(bytes.NewBufferString("some-content")), nil)
+				})
+
+				It("copies the file to the local path and displays the progress", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeSFTPSession.OpenCallCount()).To(Equal(1))
+					Expect(fakeSFTPSession.OpenArgsForCall(0)).To(Equal("/home/vcap/some-file"))
+
+					content, err := ioutil.ReadFile(transferOptions.LocalPath)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(content)).To(Equal("some-content"))
+
+					_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+					Expect(size).To(BeEquivalentTo(len("some-content")))
+					Expect(progressBytes.String()).To(Equal("some-content"))
+					Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+				})
+
+				Context("when the local path is a directory", func() {
+					BeforeEach(func() {
+						transferOptions.LocalPath = localDir
+					})
+
+					It("copies the file into the directory", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						content, err := ioutil.ReadFile(filepath.Join(localDir, "some-file"))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(content)).To(Equal("some-content"))
+					})
+				})
+
+				Context("when the source does not exist", func() {
+					BeforeEach(func() {
+						fakeSFTPSession.StatReturns(nil, errors.New("some-stat-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-stat-error"))
+						Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the source is a directory", func() {
+				BeforeEach(func() {
+					transferOptions.RemotePath = "/home/vcap/some-dir"
+					fakeSFTPSession.StatReturns(remoteFileInfo{name: "some-dir", mode: os.ModeDir | 0755}, nil)
+					fakeSFTPSession.ReadDirStub = func(path string) ([]os.FileInfo, error) {
+						switch path {
+						case "/home/vcap/some-dir":
+							return []os.FileInfo{
+								remoteFileInfo{name: "some-file", size: int64(len("some-content")), mode: 0644},
+								remoteFileInfo{name: "some-subdir", mode: os.ModeDir | 0755},
+								remoteFileInfo{name: "some-link", mode: os.ModeSymlink | 0777},
+							}, nil
+						default:
+							return nil, nil
+						}
+					}
+					fakeSFTPSession.OpenReturns(ioutil.NopCloser(bytes.NewBufferString("some-content")), nil)
+				})
+
+				Context("when the copy is not recursive", func() {
+					It("returns a CopySourceIsDirectoryError", func() {
+						Expect(executeErr).To(MatchError(actionerror.CopySourceIsDirectoryError{Path: "/home/vcap/some-dir"}))
+						Expect(fakeSFTPSession.ReadDirCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the copy is recursive", func() {
+					BeforeEach(func() {
+						transferOptions.Recursive = true
+					})
+
+					It("copies the directories and regular files", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSFTPSession.ReadDirCallCount()).To(Equal(2))
+						Expect(fakeSFTPSession.ReadDirArgsForCall(1)).To(Equal("/home/vcap/some-dir/some-subdir"))
+
+						content, err := ioutil.ReadFile(filepath.Join(transferOptions.LocalPath, "some-file"))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(content)).To(Equal("some-content"))
+						Expect(filepath.Join(transferOptions.LocalPath, "some-subdir")).To(BeADirectory())
+						Expect(filepath.Join(transferOptions.LocalPath, "some-link")).ToNot(BeAnExistingFile())
+					})
+				})
+			})
+		})
+	})
+
+	Describe("StartFileTransferSession", func() {
+		var session FileTransferSession
+
+		JustBeforeEach(func() {
+			session, executeErr = actor.StartFileTransferSession(fakeSecureShellClient, transferOptions)
+		})
+
+		It("connects to the app instance and starts an sftp session", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+			Expect(fakeSecureShellClient.SFTPSessionCallCount()).To(Equal(1))
+		})
+
+		It("browses the app instance through the sftp session", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			fakeSFTPSession.RealPathReturns("/home/vcap", nil)
+			realPath, err := session.RealPath(".")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(realPath).To(Equal("/home/vcap"))
+			Expect(fakeSFTPSession.RealPathArgsForCall(0)).To(Equal("."))
+
+			fakeSFTPSession.ReadDirReturns([]os.FileInfo{remoteFileInfo{name: "some-file"}}, nil)
+			infos, err := session.ReadDir("/home/vcap")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infos).To(ConsistOf(remoteFileInfo{name: "some-file"}))
+
+			Expect(session.Mkdir("/home/vcap/some-dir")).To(Succeed())
+			path, mode := fakeSFTPSession.MkdirArgsForCall(0)
+			Expect(path).To(Equal("/home/vcap/some-dir"))
+			Expect(mode).To(Equal(os.FileMode(0755)))
+		})
+
+		It("closes the sftp session and the client when it is closed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+
+			Expect(session.Close()).To(Succeed())
+			Expect(fakeSFTPSession.CloseCallCount()).To(Equal(1))
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when starting the sftp session fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.SFTPSessionReturns(nil, errors.New("some-sftp-error"))
+			})
+
+			It("closes the client and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-sftp-error"))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
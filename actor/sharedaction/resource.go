@@ -119,6 +119,8 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, err
 	}
 
+	cache := actor.loadResourceCache(evalDir)
+
 	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -152,22 +154,29 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			// any resource matching on symlinks.
 			resource.Mode = fixMode(info.Mode())
 		default:
-			// If the file is regular we want to open
-			// and calculate the sha of the file
-			file, err := os.Open(fullPath)
-			if err != nil {
-				return err
-			}
-			defer file.Close()
-
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
-			if err != nil {
-				return err
+			// If the file is regular and unchanged since it was last hashed we
+			// reuse the cached sha, otherwise we open and calculate the sha of
+			// the file
+			sha, ok := cache.lookup(fullPath, info)
+			if !ok {
+				file, err := os.Open(fullPath)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				sum := sha1.New()
+				_, err = io.Copy(sum, file)
+				if err != nil {
+					return err
+				}
+
+				sha = fmt.Sprintf("%x", sum.Sum(nil))
 			}
+			cache.store(fullPath, info, sha)
 
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
+			resource.SHA1 = sha
 			resource.Size = info.Size()
 		}
 
@@ -179,6 +188,10 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if walkErr == nil {
+		actor.saveResourceCache(cache)
+	}
+
 	return resources, walkErr
 }
 
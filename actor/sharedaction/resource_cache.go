@@ -0,0 +1,243 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const resourceCacheMinimumAge = 2 * time.Second
+
+// ResourceCacheStats counts the files whose SHA1 was reused from the resource
+// cache and the files that had to be hashed.
+type ResourceCacheStats struct {
+	Hits   int
+	Misses int
+}
+
+type resourceCacheEntry struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mod_time"`
+	Inode   uint64 `json:"inode"`
+	SHA1    string `json:"sha1"`
+}
+
+type resourceCacheFile struct {
+	Entries map[string]resourceCacheEntry `json:"entries"`
+}
+
+// resourceCacheState is shared between copies of an Actor, so that the stats
+// of every directory gathered by a command are reported together.
+type resourceCacheState struct {
+	mutex sync.Mutex
+	stats ResourceCacheStats
+}
+
+// resourceCache holds the cached SHA1s of the files under a single directory
+// while it is being gathered.
+type resourceCache struct {
+	path     string
+	rootDir  string
+	existing map[string]resourceCacheEntry
+	seen     map[string]resourceCacheEntry
+	stats    ResourceCacheStats
+}
+
+// ClearResourceCache deletes the cached SHA1s of all files.
+func (actor Actor) ClearResourceCache() error {
+	path := actor.resourceCacheFilePath()
+	if path == "" {
+		return nil
+	}
+
+	err := os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// ResourceCacheStats returns the number of cache hits and misses since the
+// actor was created.
+func (actor Actor) ResourceCacheStats() ResourceCacheStats {
+	if actor.resourceCacheState == nil {
+		return ResourceCacheStats{}
+	}
+
+	actor.resourceCacheState.mutex.Lock()
+	defer actor.resourceCacheState.mutex.Unlock()
+	return actor.resourceCacheState.stats
+}
+
+func (actor Actor) resourceCacheFilePath() string {
+	if actor.Config == nil {
+		return ""
+	}
+	return actor.Config.ResourceCacheFilePath()
+}
+
+// loadResourceCache reads the cached SHA1s for the files under rootDir. A
+// missing or unreadable cache is treated as empty.
+func (actor Actor) loadResourceCache(rootDir string) *resourceCache {
+	cache := &resourceCache{
+		path:     actor.resourceCacheFilePath(),
+		seen:     map[string]resourceCacheEntry{},
+		existing: map[string]resourceCacheEntry{},
+	}
+	if cache.path == "" {
+		return cache
+	}
+
+	absRootDir, err := filepath.Abs(rootDir)
+	if err != nil {
+		log.Errorln("resolving resource cache root:", err)
+		cache.path = ""
+		return cache
+	}
+	cache.rootDir = absRootDir
+
+	cache.existing = readResourceCacheFile(cache.path).Entries
+	return cache
+}
+
+// lookup returns the cached SHA1 of the file, when its size, modification time
+// and inode have not changed since it was cached.
+func (cache *resourceCache) lookup(fullPath string, info os.FileInfo) (string, bool) {
+	key, err := filepath.Abs(fullPath)
+	if err != nil {
+		cache.stats.Misses++
+		return "", false
+	}
+
+	entry, ok := cache.existing[key]
+	if ok && entry == newResourceCacheEntry(info, entry.SHA1) {
+		cache.stats.Hits++
+		cache.seen[key] = entry
+		return entry.SHA1, true
+	}
+
+	cache.stats.Misses++
+	return "", false
+}
+
+// store caches the SHA1 of the file. Files modified in the last few seconds
+// are not cached, since a further change within the resolution of the file
+// system's modification time would go unnoticed.
+func (cache *resourceCache) store(fullPath string, info os.FileInfo, sha1 string) {
+	if time.Since(info.ModTime()) < resourceCacheMinimumAge {
+		return
+	}
+
+	key, err := filepath.Abs(fullPath)
+	if err != nil {
+		return
+	}
+	cache.seen[key] = newResourceCacheEntry(info, sha1)
+}
+
+// saveResourceCache writes the SHA1s of the files seen in this walk back to the
+// cache file. Entries for files under other directories are kept, while
+// entries for files under the gathered directory that no longer exist are
+// dropped.
+func (actor Actor) saveResourceCache(cache *resourceCache) {
+	if actor.resourceCacheState != nil {
+		actor.resourceCacheState.mutex.Lock()
+		defer actor.resourceCacheState.mutex.Unlock()
+		actor.resourceCacheState.stats.Hits += cache.stats.Hits
+		actor.resourceCacheState.stats.Misses += cache.stats.Misses
+	}
+
+	log.WithFields(log.Fields{
+		"hits":   cache.stats.Hits,
+		"misses": cache.stats.Misses,
+	}).Info("resource cache")
+
+	if cache.path == "" {
+		return
+	}
+
+	// Re-read the file so that entries written by other directories gathered
+	// since this cache was loaded are not lost.
+	contents := readResourceCacheFile(cache.path)
+	prefix := cache.rootDir + string(filepath.Separator)
+	for key := range contents.Entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(contents.Entries, key)
+		}
+	}
+	for key, entry := range cache.seen {
+		contents.Entries[key] = entry
+	}
+
+	err := writeResourceCacheFile(cache.path, contents)
+	if err != nil {
+		log.Errorln("writing resource cache:", err)
+	}
+}
+
+func newResourceCacheEntry(info os.FileInfo, sha1 string) resourceCacheEntry {
+	return resourceCacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Inode:   fileInode(info),
+		SHA1:    sha1,
+	}
+}
+
+func readResourceCacheFile(path string) resourceCacheFile {
+	var contents resourceCacheFile
+
+	raw, err := ioutil.ReadFile(path)
+	if err == nil {
+		err = json.Unmarshal(raw, &contents)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		log.Errorln("reading resource cache:", err)
+	}
+
+	if contents.Entries == nil {
+		contents.Entries = map[string]resourceCacheEntry{}
+	}
+	return contents
+}
+
+// writeResourceCacheFile replaces the cache file with a temp file, so that a
+// concurrent reader never sees a partially written cache.
+func writeResourceCacheFile(path string, contents resourceCacheFile) error {
+	raw, err := json.Marshal(contents)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "temp-resource-cache")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	err = os.Rename(tempFile.Name(), path)
+	if err != nil {
+		os.Remove(tempFile.Name())
+	}
+	return err
+}
@@ -0,0 +1,164 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Cache Actions", func() {
+	var (
+		fakeConfig *sharedactionfakes.FakeConfig
+		actor      *Actor
+		srcDir     string
+		cacheDir   string
+		cachePath  string
+		anHourAgo  time.Time
+	)
+
+	writeFile := func(name string, contents string) {
+		path := filepath.Join(srcDir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		Expect(os.Chtimes(path, anHourAgo, anHourAgo)).To(Succeed())
+	}
+
+	shaOf := func(resources []Resource, filename string) string {
+		for _, resource := range resources {
+			if resource.Filename == filename {
+				return resource.SHA1
+			}
+		}
+		Fail("resource not found: " + filename)
+		return ""
+	}
+
+	BeforeEach(func() {
+		var err error
+		srcDir, err = ioutil.TempDir("", "resource-cache-actions-src")
+		Expect(err).ToNot(HaveOccurred())
+		cacheDir, err = ioutil.TempDir("", "resource-cache-actions-cache")
+		Expect(err).ToNot(HaveOccurred())
+		cachePath = filepath.Join(cacheDir, ".cf", "resource_cache.json")
+
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.ResourceCacheFilePathReturns(cachePath)
+		actor = NewActor(fakeConfig)
+
+		anHourAgo = time.Now().Add(-time.Hour)
+		writeFile("file-1", "some-contents")
+		writeFile("file-2", "some-other-contents")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	Describe("GatherDirectoryResources", func() {
+		It("hashes the files and caches their SHA1s", func() {
+			resources, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shaOf(resources, "file-1")).To(Equal("21202296bf50267250155e46d3b9eb3e4c1acb7e"))
+
+			Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 0, Misses: 2}))
+			Expect(cachePath).To(BeAnExistingFile())
+		})
+
+		Context("when the files have been cached", func() {
+			var firstResources []Resource
+
+			BeforeEach(func() {
+				var err error
+				firstResources, err = actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				actor = NewActor(fakeConfig)
+			})
+
+			It("reuses the cached SHA1s of unchanged files", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources).To(Equal(firstResources))
+
+				Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 2, Misses: 0}))
+			})
+
+			Context("when a file has changed", func() {
+				BeforeEach(func() {
+					writeFile("file-2", "some-changed-contents")
+				})
+
+				It("hashes the changed file again", func() {
+					resources, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(shaOf(resources, "file-2")).ToNot(Equal(shaOf(firstResources, "file-2")))
+
+					Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 1, Misses: 1}))
+				})
+			})
+
+			Context("when a file was modified too recently to be cached", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(filepath.Join(srcDir, "file-3"), []byte("some-new-contents"), 0600)).To(Succeed())
+
+					_, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+					actor = NewActor(fakeConfig)
+				})
+
+				It("hashes the file again", func() {
+					_, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 2, Misses: 1}))
+				})
+			})
+
+			Context("when the cache has been cleared", func() {
+				BeforeEach(func() {
+					Expect(actor.ClearResourceCache()).To(Succeed())
+				})
+
+				It("hashes all of the files again", func() {
+					Expect(cachePath).ToNot(BeAnExistingFile())
+
+					_, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 0, Misses: 2}))
+				})
+			})
+		})
+
+		Context("when the cache file is corrupt", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(cachePath, []byte("not json"), 0600)).To(Succeed())
+			})
+
+			It("hashes the files and replaces the cache", func() {
+				_, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 0, Misses: 2}))
+
+				actor = NewActor(fakeConfig)
+				_, err = actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actor.ResourceCacheStats()).To(Equal(ResourceCacheStats{Hits: 2, Misses: 0}))
+			})
+		})
+	})
+
+	Describe("ClearResourceCache", func() {
+		Context("when there is no cache", func() {
+			It("does not return an error", func() {
+				Expect(actor.ClearResourceCache()).To(Succeed())
+			})
+		})
+	})
+})
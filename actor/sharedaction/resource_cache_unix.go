@@ -0,0 +1,17 @@
+// +build !windows
+
+package sharedaction
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode returns the inode of the file, so that a file replaced by another
+// with the same size and modification time is not mistaken for it.
+func fileInode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
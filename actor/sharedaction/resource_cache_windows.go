@@ -0,0 +1,11 @@
+// +build windows
+
+package sharedaction
+
+import "os"
+
+// fileInode is always 0 on Windows, where os.FileInfo does not include the
+// file index; the size and modification time are used on their own.
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
@@ -53,6 +53,15 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct{}
+	resourceCacheFilePathReturns     struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct{}
@@ -268,6 +277,46 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheFilePathReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -324,6 +373,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedSpaceMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -56,6 +56,28 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func(remotePortForwardSpecs []clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		dynamicPortForwardSpecs []clissh.DynamicPortForward
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
@@ -65,6 +87,17 @@ type FakeSecureShellClient struct {
 	waitReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SFTPSessionStub        func() (clissh.SFTPSession, error)
+	sFTPSessionMutex       sync.RWMutex
+	sFTPSessionArgsForCall []struct{}
+	sFTPSessionReturns     struct {
+		result1 clissh.SFTPSession
+		result2 error
+	}
+	sFTPSessionReturnsOnCall map[int]struct {
+		result1 clissh.SFTPSession
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -268,6 +301,112 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error {
+	var remotePortForwardSpecsCopy []clissh.RemotePortForward
+	if remotePortForwardSpecs != nil {
+		remotePortForwardSpecsCopy = make([]clissh.RemotePortForward, len(remotePortForwardSpecs))
+		copy(remotePortForwardSpecsCopy, remotePortForwardSpecs)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}{remotePortForwardSpecsCopy})
+	fake.recordInvocation("RemotePortForward", []interface{}{remotePortForwardSpecsCopy})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub(remotePortForwardSpecs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.remotePortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return fake.remotePortForwardArgsForCall[i].remotePortForwardSpecs
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error {
+	var dynamicPortForwardSpecsCopy []clissh.DynamicPortForward
+	if dynamicPortForwardSpecs != nil {
+		dynamicPortForwardSpecsCopy = make([]clissh.DynamicPortForward, len(dynamicPortForwardSpecs))
+		copy(dynamicPortForwardSpecsCopy, dynamicPortForwardSpecs)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		dynamicPortForwardSpecs []clissh.DynamicPortForward
+	}{dynamicPortForwardSpecsCopy})
+	fake.recordInvocation("DynamicPortForward", []interface{}{dynamicPortForwardSpecsCopy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub(dynamicPortForwardSpecs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dynamicPortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []clissh.DynamicPortForward {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return fake.dynamicPortForwardArgsForCall[i].dynamicPortForwardSpecs
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -308,6 +447,49 @@ func (fake *FakeSecureShellClient) WaitReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) SFTPSession() (clissh.SFTPSession, error) {
+	fake.sFTPSessionMutex.Lock()
+	ret, specificReturn := fake.sFTPSessionReturnsOnCall[len(fake.sFTPSessionArgsForCall)]
+	fake.sFTPSessionArgsForCall = append(fake.sFTPSessionArgsForCall, struct{}{})
+	fake.recordInvocation("SFTPSession", []interface{}{})
+	fake.sFTPSessionMutex.Unlock()
+	if fake.SFTPSessionStub != nil {
+		return fake.SFTPSessionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.sFTPSessionReturns.result1, fake.sFTPSessionReturns.result2
+}
+
+func (fake *FakeSecureShellClient) SFTPSessionCallCount() int {
+	fake.sFTPSessionMutex.RLock()
+	defer fake.sFTPSessionMutex.RUnlock()
+	return len(fake.sFTPSessionArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) SFTPSessionReturns(result1 clissh.SFTPSession, result2 error) {
+	fake.SFTPSessionStub = nil
+	fake.sFTPSessionReturns = struct {
+		result1 clissh.SFTPSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureShellClient) SFTPSessionReturnsOnCall(i int, result1 clissh.SFTPSession, result2 error) {
+	fake.SFTPSessionStub = nil
+	if fake.sFTPSessionReturnsOnCall == nil {
+		fake.sFTPSessionReturnsOnCall = make(map[int]struct {
+			result1 clissh.SFTPSession
+			result2 error
+		})
+	}
+	fake.sFTPSessionReturnsOnCall[i] = struct {
+		result1 clissh.SFTPSession
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -319,8 +501,14 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
+	fake.sFTPSessionMutex.RLock()
+	defer fake.sFTPSessionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -13,16 +13,32 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
+// SSHLogin is the username and one-time passcode used to connect to an app
+// instance.
+type SSHLogin struct {
+	Username string
+	Passcode string
+}
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands           []string
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	// AdditionalLogins connect to more app instances through the same
+	// endpoint. Forwarded connections are spread across all the instances.
+	AdditionalLogins        []SSHLogin
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -32,11 +48,28 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	}
 	defer sshClient.Close()
 
+	for _, login := range sshOptions.AdditionalLogins {
+		err = sshClient.Connect(login.Username, login.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = sshClient.LocalPortForward(convertActorToSSHPackageForwardingSpecs(sshOptions.LocalPortForwardSpecs))
 	if err != nil {
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -54,3 +87,23 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
+
+func convertActorToSSHPackageDynamicForwardingSpecs(actorSpecs []DynamicPortForward) []clissh.DynamicPortForward {
+	sshPackageSpecs := []clissh.DynamicPortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.DynamicPortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
@@ -53,6 +53,37 @@ var _ = Describe("SSH Actions", func() {
 			Expect(skipHostValidationArg).To(BeTrue())
 		})
 
+		Context("when additional logins are provided", func() {
+			BeforeEach(func() {
+				sshOptions.AdditionalLogins = []SSHLogin{
+					{Username: "some-other-user", Passcode: "some-other-passcode"},
+				}
+			})
+
+			It("connects to every instance before forwarding ports", func() {
+				Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(2))
+				usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(1)
+				Expect(usernameArg).To(Equal("some-other-user"))
+				Expect(passcodeArg).To(Equal("some-other-passcode"))
+				Expect(endpointArg).To(Equal("some-endpoint"))
+				Expect(fingerprintArg).To(Equal("some-fingerprint"))
+				Expect(skipHostValidationArg).To(BeTrue())
+				Expect(fakeSecureShellClient.LocalPortForwardCallCount()).To(Equal(1))
+			})
+
+			Context("when connecting to an additional instance fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.ConnectReturnsOnCall(1, errors.New("some-connect-error"))
+				})
+
+				It("closes the client and returns the error", func() {
+					Expect(executeErr).To(MatchError("some-connect-error"))
+					Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.LocalPortForwardCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when connecting fails", func() {
 			BeforeEach(func() {
 				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
@@ -89,6 +120,56 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("forwards the remote and dynamic ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+			})
+
+			Context("when remote and dynamic port forwarding are requested", func() {
+				BeforeEach(func() {
+					sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+						{RemoteNetwork: "unix", RemoteAddress: "/remote.sock", LocalNetwork: "tcp", LocalAddress: "local-address"},
+					}
+					sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+						{LocalNetwork: "tcp", LocalAddress: "local-socks-address"},
+					}
+				})
+
+				It("forwards the ports", func() {
+					Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+						[]clissh.RemotePortForward{
+							{RemoteNetwork: "unix", RemoteAddress: "/remote.sock", LocalNetwork: "tcp", LocalAddress: "local-address"},
+						},
+					))
+					Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+						[]clissh.DynamicPortForward{
+							{LocalNetwork: "tcp", LocalAddress: "local-socks-address"},
+						},
+					))
+				})
+			})
+
+			Context("when remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+				})
+			})
+
 			Context("when local port forwarding fails", func() {
 				BeforeEach(func() {
 					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
@@ -12,6 +12,7 @@ import (
 type CloudControllerClient interface {
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
+	CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
 	CreateServiceBinding(appGUID string, serviceBindingGUID string, bindingName string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
@@ -64,6 +65,7 @@ type CloudControllerClient interface {
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	UpdateResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	UpdateRouteApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
+	UpdateSecurityGroup(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
 	UpdateSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
@@ -21,6 +21,19 @@ type SecurityGroupWithOrganizationSpaceAndLifecycle struct {
 	Lifecycle     constant.SecurityGroupLifecycle
 }
 
+// SecurityGroupRulesChanges are the rules that are added to and removed from
+// SecurityGroup when its rules are replaced.
+type SecurityGroupRulesChanges struct {
+	SecurityGroup SecurityGroup
+	Added         []ccv2.SecurityGroupRule
+	Removed       []ccv2.SecurityGroupRule
+}
+
+// HasChanges returns true when replacing the rules adds or removes any rule.
+func (changes SecurityGroupRulesChanges) HasChanges() bool {
+	return len(changes.Added) > 0 || len(changes.Removed) > 0
+}
+
 func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (Warnings, error) {
 	var (
 		warnings ccv2.Warnings
@@ -39,6 +52,16 @@ func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID
 	return Warnings(warnings), err
 }
 
+// CreateSecurityGroup creates a security group with the provided name and
+// rules.
+func (actor Actor) CreateSecurityGroup(securityGroupName string, rules []ccv2.SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	securityGroup, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(securityGroupName, rules)
+	if _, ok := err.(ccerror.SecurityGroupNameTakenError); ok {
+		return SecurityGroup{}, Warnings(warnings), actionerror.SecurityGroupNameTakenError{Name: securityGroupName}
+	}
+	return SecurityGroup(securityGroup), Warnings(warnings), err
+}
+
 func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGroup, Warnings, error) {
 	securityGroups, warnings, err := actor.CloudControllerClient.GetSecurityGroups(ccv2.Filter{
 		Type:     constant.NameFilter,
@@ -55,12 +78,43 @@ func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGro
 	}
 
 	securityGroup := SecurityGroup{
-		Name: securityGroups[0].Name,
-		GUID: securityGroups[0].GUID,
+		Name:  securityGroups[0].Name,
+		GUID:  securityGroups[0].GUID,
+		Rules: securityGroups[0].Rules,
 	}
 	return securityGroup, Warnings(warnings), nil
 }
 
+// GetSecurityGroupRulesChangesByName returns the security group with the
+// provided name and the rules that replacing its rules with the provided
+// rules would add and remove.
+func (actor Actor) GetSecurityGroupRulesChangesByName(securityGroupName string, rules []ccv2.SecurityGroupRule) (SecurityGroupRulesChanges, Warnings, error) {
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(securityGroupName)
+	if err != nil {
+		return SecurityGroupRulesChanges{}, warnings, err
+	}
+
+	changes := SecurityGroupRulesChanges{SecurityGroup: securityGroup}
+
+	unmatched := append([]ccv2.SecurityGroupRule{}, securityGroup.Rules...)
+	for _, rule := range rules {
+		matched := false
+		for i, existingRule := range unmatched {
+			if existingRule == rule {
+				unmatched = append(unmatched[:i], unmatched[i+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			changes.Added = append(changes.Added, rule)
+		}
+	}
+	changes.Removed = unmatched
+
+	return changes, warnings, nil
+}
+
 type SpaceWithLifecycle struct {
 	ccv2.Space
 	Lifecycle constant.SecurityGroupLifecycle
@@ -390,3 +444,10 @@ func (actor Actor) isStagingSecurityGroupBoundToSpace(securityGroupName string,
 	})
 	return len(ccv2SecurityGroups) > 0, Warnings(warnings), err
 }
+
+// UpdateSecurityGroupRules replaces all the rules of the security group with
+// the provided GUID.
+func (actor Actor) UpdateSecurityGroupRules(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateSecurityGroup(securityGroupGUID, rules)
+	return Warnings(warnings), err
+}
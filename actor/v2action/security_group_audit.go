@@ -0,0 +1,473 @@
+package v2action
+
+import (
+	"encoding/binary"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// SecurityGroupBinding is how a security group applies to a space.
+type SecurityGroupBinding string
+
+const (
+	// SecurityGroupBindingGlobal indicates a security group that is a running
+	// or staging default, and applies to every space.
+	SecurityGroupBindingGlobal SecurityGroupBinding = "global"
+
+	// SecurityGroupBindingSpace indicates a security group bound to the space.
+	SecurityGroupBindingSpace SecurityGroupBinding = "space"
+)
+
+// SecurityGroupRuleOrigin is a security group that contributes a rule to the
+// effective rules of a space.
+type SecurityGroupRuleOrigin struct {
+	SecurityGroupName string
+	Binding           SecurityGroupBinding
+}
+
+// EffectiveSecurityGroupRule is an egress rule that applies to a space in a
+// lifecycle phase. Identical rules from several security groups are merged
+// into one rule with an origin for each group.
+type EffectiveSecurityGroupRule struct {
+	Lifecycle   constant.SecurityGroupLifecycle
+	Protocol    string
+	Destination string
+	Ports       string
+	// Type and Code are the ICMP type and code of icmp rules; -1 allows all
+	// types or codes.
+	Type    types.NullInt
+	Code    types.NullInt
+	Origins []SecurityGroupRuleOrigin
+}
+
+// SecurityGroupFindingType is the kind of problem found with an effective
+// rule.
+type SecurityGroupFindingType string
+
+const (
+	// SecurityGroupFindingBroad indicates a rule allowing traffic to every
+	// IPv4 address on every port.
+	SecurityGroupFindingBroad SecurityGroupFindingType = "broad"
+
+	// SecurityGroupFindingDuplicate indicates a rule that is defined by more
+	// than one security group.
+	SecurityGroupFindingDuplicate SecurityGroupFindingType = "duplicate"
+
+	// SecurityGroupFindingShadowed indicates a rule whose traffic is entirely
+	// allowed by another rule, which makes it redundant.
+	SecurityGroupFindingShadowed SecurityGroupFindingType = "shadowed"
+
+	// SecurityGroupFindingOverlapping indicates a rule that allows some of the
+	// traffic allowed by another rule.
+	SecurityGroupFindingOverlapping SecurityGroupFindingType = "overlapping"
+
+	// SecurityGroupFindingUnrecognized indicates a rule whose protocol,
+	// destination or ports could not be parsed, so it was not compared with
+	// other rules.
+	SecurityGroupFindingUnrecognized SecurityGroupFindingType = "unrecognized"
+)
+
+// SecurityGroupFinding is a problem found with an effective rule. Related is
+// the rule shadowing or overlapping Rule, and is nil for other findings.
+type SecurityGroupFinding struct {
+	Type    SecurityGroupFindingType
+	Rule    EffectiveSecurityGroupRule
+	Related *EffectiveSecurityGroupRule
+}
+
+// SecurityGroupAudit is the effective egress rules of a space and the problems
+// found with them.
+type SecurityGroupAudit struct {
+	SpaceName       string
+	Running         []EffectiveSecurityGroupRule
+	Staging         []EffectiveSecurityGroupRule
+	Findings        []SecurityGroupFinding
+	StagingIncluded bool
+}
+
+// GetSecurityGroupAuditByOrganizationAndSpaceName returns the effective egress
+// rules of the space, which are the rules of the running and staging default
+// security groups merged with those of the security groups bound to the space,
+// and the problems found with them. Staging rules are only audited when
+// includeStaging is true.
+func (actor Actor) GetSecurityGroupAuditByOrganizationAndSpaceName(orgGUID string, spaceName string, includeStaging bool) (SecurityGroupAudit, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SecurityGroupAudit{}, allWarnings, err
+	}
+
+	ccv2SecurityGroups, ccv2Warnings, err := actor.CloudControllerClient.GetSecurityGroups()
+	allWarnings = append(allWarnings, ccv2Warnings...)
+	if err != nil {
+		return SecurityGroupAudit{}, allWarnings, err
+	}
+
+	var runningDefaults, stagingDefaults []SecurityGroup
+	for _, securityGroup := range ccv2SecurityGroups {
+		if securityGroup.RunningDefault {
+			runningDefaults = append(runningDefaults, SecurityGroup(securityGroup))
+		}
+		if securityGroup.StagingDefault {
+			stagingDefaults = append(stagingDefaults, SecurityGroup(securityGroup))
+		}
+	}
+
+	runningSecurityGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SecurityGroupAudit{}, allWarnings, err
+	}
+
+	audit := SecurityGroupAudit{
+		SpaceName:       space.Name,
+		Running:         mergeSecurityGroupRules(constant.SecurityGroupLifecycleRunning, runningDefaults, runningSecurityGroups),
+		StagingIncluded: includeStaging,
+	}
+
+	if includeStaging {
+		stagingSecurityGroups, warnings, err := actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SecurityGroupAudit{}, allWarnings, err
+		}
+		audit.Staging = mergeSecurityGroupRules(constant.SecurityGroupLifecycleStaging, stagingDefaults, stagingSecurityGroups)
+	}
+
+	audit.Findings = append(auditSecurityGroupRules(audit.Running), auditSecurityGroupRules(audit.Staging)...)
+
+	return audit, allWarnings, nil
+}
+
+// mergeSecurityGroupRules returns the rules of the global and space security
+// groups in the lifecycle, merging identical rules. Rules are ordered by
+// protocol, destination, ports and ICMP type and code.
+func mergeSecurityGroupRules(lifecycle constant.SecurityGroupLifecycle, globalSecurityGroups []SecurityGroup, spaceSecurityGroups []SecurityGroup) []EffectiveSecurityGroupRule {
+	var rules []EffectiveSecurityGroupRule
+
+	addRules := func(securityGroups []SecurityGroup, binding SecurityGroupBinding) {
+		sorted := make([]SecurityGroup, len(securityGroups))
+		copy(sorted, securityGroups)
+		sort.Slice(sorted, func(i int, j int) bool {
+			return sorted[i].Name < sorted[j].Name
+		})
+
+		for _, securityGroup := range sorted {
+			origin := SecurityGroupRuleOrigin{SecurityGroupName: securityGroup.Name, Binding: binding}
+			for _, rule := range securityGroup.Rules {
+				index := -1
+				for i, existing := range rules {
+					if existing.Protocol == rule.Protocol && existing.Destination == rule.Destination && existing.Ports == rule.Ports &&
+						existing.Type == rule.Type && existing.Code == rule.Code {
+						index = i
+						break
+					}
+				}
+
+				if index == -1 {
+					rules = append(rules, EffectiveSecurityGroupRule{
+						Lifecycle:   lifecycle,
+						Protocol:    rule.Protocol,
+						Destination: rule.Destination,
+						Ports:       rule.Ports,
+						Type:        rule.Type,
+						Code:        rule.Code,
+					})
+					index = len(rules) - 1
+				}
+				if !hasOrigin(rules[index].Origins, origin) {
+					rules[index].Origins = append(rules[index].Origins, origin)
+				}
+			}
+		}
+	}
+
+	addRules(globalSecurityGroups, SecurityGroupBindingGlobal)
+	addRules(spaceSecurityGroups, SecurityGroupBindingSpace)
+
+	sort.SliceStable(rules, func(i int, j int) bool {
+		if rules[i].Protocol != rules[j].Protocol {
+			return rules[i].Protocol < rules[j].Protocol
+		}
+		if rules[i].Destination != rules[j].Destination {
+			return rules[i].Destination < rules[j].Destination
+		}
+		if rules[i].Ports != rules[j].Ports {
+			return rules[i].Ports < rules[j].Ports
+		}
+		if icmpValue(rules[i].Type) != icmpValue(rules[j].Type) {
+			return icmpValue(rules[i].Type) < icmpValue(rules[j].Type)
+		}
+		return icmpValue(rules[i].Code) < icmpValue(rules[j].Code)
+	})
+
+	return rules
+}
+
+func hasOrigin(origins []SecurityGroupRuleOrigin, origin SecurityGroupRuleOrigin) bool {
+	for _, existing := range origins {
+		if existing == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// auditSecurityGroupRules returns the problems found with the rules of a
+// single lifecycle.
+func auditSecurityGroupRules(rules []EffectiveSecurityGroupRule) []SecurityGroupFinding {
+	var findings []SecurityGroupFinding
+
+	parsed := make([]parsedSecurityGroupRule, len(rules))
+	valid := make([]bool, len(rules))
+	for i, rule := range rules {
+		parsed[i], valid[i] = parseSecurityGroupRule(rule)
+		switch {
+		case !valid[i]:
+			findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingUnrecognized, Rule: rule})
+			continue
+		case parsed[i].broad():
+			findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingBroad, Rule: rule})
+		}
+		if len(rule.Origins) > 1 {
+			findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingDuplicate, Rule: rule})
+		}
+	}
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if !valid[i] || !valid[j] {
+				continue
+			}
+
+			switch {
+			case parsed[i].covers(parsed[j]):
+				findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingShadowed, Rule: rules[j], Related: &rules[i]})
+			case parsed[j].covers(parsed[i]):
+				findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingShadowed, Rule: rules[i], Related: &rules[j]})
+			case parsed[i].overlaps(parsed[j]):
+				findings = append(findings, SecurityGroupFinding{Type: SecurityGroupFindingOverlapping, Rule: rules[i], Related: &rules[j]})
+			}
+		}
+	}
+
+	return findings
+}
+
+const (
+	minPort = 1
+	maxPort = 65535
+
+	// allICMP is the ICMP type or code that allows all types or codes.
+	allICMP = -1
+)
+
+type addressRange struct {
+	start uint32
+	end   uint32
+}
+
+type portRange struct {
+	start int
+	end   int
+}
+
+// parsedSecurityGroupRule is a rule's traffic as a protocol, a range of IPv4
+// addresses and ranges of ports, or the ICMP type and code of icmp rules.
+// Rules for all protocols allow every port.
+type parsedSecurityGroupRule struct {
+	protocol     string
+	destinations addressRange
+	ports        []portRange
+	icmpType     int
+	icmpCode     int
+}
+
+func parseSecurityGroupRule(rule EffectiveSecurityGroupRule) (parsedSecurityGroupRule, bool) {
+	protocol := strings.ToLower(rule.Protocol)
+	destinations, ok := parseDestination(rule.Destination)
+	if !ok {
+		return parsedSecurityGroupRule{}, false
+	}
+
+	parsed := parsedSecurityGroupRule{protocol: protocol, destinations: destinations}
+
+	switch protocol {
+	case "all":
+		parsed.ports = []portRange{{start: minPort, end: maxPort}}
+	case "icmp":
+		parsed.icmpType = icmpValue(rule.Type)
+		parsed.icmpCode = icmpValue(rule.Code)
+	case "tcp", "udp":
+		parsed.ports, ok = parsePorts(rule.Ports)
+		if !ok {
+			return parsedSecurityGroupRule{}, false
+		}
+	default:
+		return parsedSecurityGroupRule{}, false
+	}
+
+	return parsed, true
+}
+
+// parseDestination parses a single address, a CIDR block such as
+// "10.0.0.0/8" or a range such as "10.0.0.1-10.0.0.255".
+func parseDestination(destination string) (addressRange, bool) {
+	if _, network, err := net.ParseCIDR(destination); err == nil {
+		start, ok := ipv4ToUint32(network.IP)
+		if !ok {
+			return addressRange{}, false
+		}
+		ones, bits := network.Mask.Size()
+		return addressRange{start: start, end: start | uint32(1<<uint(bits-ones)-1)}, true
+	}
+
+	parts := strings.SplitN(destination, "-", 2)
+	start, ok := ipv4ToUint32(net.ParseIP(strings.TrimSpace(parts[0])))
+	if !ok {
+		return addressRange{}, false
+	}
+	if len(parts) == 1 {
+		return addressRange{start: start, end: start}, true
+	}
+
+	end, ok := ipv4ToUint32(net.ParseIP(strings.TrimSpace(parts[1])))
+	if !ok || end < start {
+		return addressRange{}, false
+	}
+	return addressRange{start: start, end: end}, true
+}
+
+// icmpValue returns an ICMP type or code, treating an unset one as allowing
+// all types or codes.
+func icmpValue(value types.NullInt) int {
+	if !value.IsSet {
+		return allICMP
+	}
+	return value.Value
+}
+
+func ipv4ToUint32(ip net.IP) (uint32, bool) {
+	ip = ip.To4()
+	if ip == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(ip), true
+}
+
+// parsePorts parses a list of ports and port ranges such as "80,443,8080-8090"
+// into sorted, non-overlapping ranges.
+func parsePorts(ports string) ([]portRange, bool) {
+	var ranges []portRange
+	for _, part := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, false
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, false
+			}
+		}
+		if start < minPort || end > maxPort || end < start {
+			return nil, false
+		}
+
+		ranges = append(ranges, portRange{start: start, end: end})
+	}
+
+	sort.Slice(ranges, func(i int, j int) bool { return ranges[i].start < ranges[j].start })
+
+	merged := ranges[:1]
+	for _, next := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if next.start <= last.end+1 {
+			if next.end > last.end {
+				last.end = next.end
+			}
+			continue
+		}
+		merged = append(merged, next)
+	}
+	return merged, true
+}
+
+func (rule parsedSecurityGroupRule) broad() bool {
+	allDestinations := rule.destinations.start == 0 && rule.destinations.end == ^uint32(0)
+	allPorts := len(rule.ports) == 1 && rule.ports[0] == portRange{start: minPort, end: maxPort}
+	return allDestinations && allPorts
+}
+
+// covers returns whether all the traffic allowed by other is allowed by rule.
+func (rule parsedSecurityGroupRule) covers(other parsedSecurityGroupRule) bool {
+	if rule.protocol != "all" && rule.protocol != other.protocol {
+		return false
+	}
+	if rule.destinations.start > other.destinations.start || rule.destinations.end < other.destinations.end {
+		return false
+	}
+
+	switch {
+	case rule.protocol == "all":
+		return true
+	case rule.protocol == "icmp":
+		return (rule.icmpType == allICMP || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == allICMP || rule.icmpCode == other.icmpCode)
+	}
+
+	for _, otherPorts := range other.ports {
+		covered := false
+		for _, ports := range rule.ports {
+			if ports.start <= otherPorts.start && otherPorts.end <= ports.end {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps returns whether some of the traffic allowed by other is allowed by
+// rule.
+func (rule parsedSecurityGroupRule) overlaps(other parsedSecurityGroupRule) bool {
+	if rule.protocol != "all" && other.protocol != "all" && rule.protocol != other.protocol {
+		return false
+	}
+	if rule.destinations.end < other.destinations.start || other.destinations.end < rule.destinations.start {
+		return false
+	}
+
+	switch {
+	case rule.protocol == "all" || other.protocol == "all":
+		return true
+	case rule.protocol == "icmp":
+		return icmpOverlaps(rule.icmpType, other.icmpType) && icmpOverlaps(rule.icmpCode, other.icmpCode)
+	}
+
+	for _, ports := range rule.ports {
+		for _, otherPorts := range other.ports {
+			if ports.start <= otherPorts.end && otherPorts.start <= ports.end {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func icmpOverlaps(value int, other int) bool {
+	return value == allICMP || other == allICMP || value == other
+}
@@ -0,0 +1,293 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Audit Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSecurityGroupAuditByOrganizationAndSpaceName", func() {
+		var (
+			includeStaging bool
+			audit          SecurityGroupAudit
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			includeStaging = true
+
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+				ccv2.Warnings{"get-space-warning"},
+				nil)
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						Name:           "public-networks",
+						RunningDefault: true,
+						StagingDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+						},
+					},
+					{Name: "not-a-default"},
+				},
+				ccv2.Warnings{"get-security-groups-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						Name: "web",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+							{Protocol: "tcp", Destination: "10.1.0.0/16", Ports: "443"},
+						},
+					},
+					{
+						Name: "database",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.1.0.0/16", Ports: "400-500"},
+						},
+					},
+				},
+				ccv2.Warnings{"get-running-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceStagingSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						Name: "wide-open",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0/0"},
+							{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+							{Protocol: "tcp", Destination: "not-an-address", Ports: "80"},
+						},
+					},
+				},
+				ccv2.Warnings{"get-staging-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			audit, warnings, executeErr = actor.GetSecurityGroupAuditByOrganizationAndSpaceName("some-org-guid", "some-space", includeStaging)
+		})
+
+		It("merges the default and space security group rules of each lifecycle", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning", "get-security-groups-warning", "get-running-warning", "get-staging-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpaceSecurityGroupsCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceSecurityGroupsArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(audit.SpaceName).To(Equal("some-space"))
+			Expect(audit.StagingIncluded).To(BeTrue())
+			Expect(audit.Running).To(Equal([]EffectiveSecurityGroupRule{
+				{
+					Lifecycle:   constant.SecurityGroupLifecycleRunning,
+					Protocol:    "all",
+					Destination: "0.0.0.0-9.255.255.255",
+					Origins:     []SecurityGroupRuleOrigin{{SecurityGroupName: "public-networks", Binding: SecurityGroupBindingGlobal}},
+				},
+				{
+					Lifecycle:   constant.SecurityGroupLifecycleRunning,
+					Protocol:    "tcp",
+					Destination: "10.0.0.0/8",
+					Ports:       "443",
+					Origins:     []SecurityGroupRuleOrigin{{SecurityGroupName: "web", Binding: SecurityGroupBindingSpace}},
+				},
+				{
+					Lifecycle:   constant.SecurityGroupLifecycleRunning,
+					Protocol:    "tcp",
+					Destination: "10.1.0.0/16",
+					Ports:       "400-500",
+					Origins:     []SecurityGroupRuleOrigin{{SecurityGroupName: "database", Binding: SecurityGroupBindingSpace}},
+				},
+				{
+					Lifecycle:   constant.SecurityGroupLifecycleRunning,
+					Protocol:    "tcp",
+					Destination: "10.1.0.0/16",
+					Ports:       "443",
+					Origins:     []SecurityGroupRuleOrigin{{SecurityGroupName: "web", Binding: SecurityGroupBindingSpace}},
+				},
+			}))
+
+			Expect(audit.Staging).To(HaveLen(3))
+			Expect(audit.Staging[0].Destination).To(Equal("0.0.0.0-9.255.255.255"))
+			Expect(audit.Staging[0].Origins).To(Equal([]SecurityGroupRuleOrigin{
+				{SecurityGroupName: "public-networks", Binding: SecurityGroupBindingGlobal},
+				{SecurityGroupName: "wide-open", Binding: SecurityGroupBindingSpace},
+			}))
+		})
+
+		It("reports overlapping, shadowed, broad, duplicate and unrecognized rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			type finding struct {
+				Type      SecurityGroupFindingType
+				Lifecycle constant.SecurityGroupLifecycle
+				Rule      string
+				Related   string
+			}
+			var findings []finding
+			for _, f := range audit.Findings {
+				converted := finding{Type: f.Type, Lifecycle: f.Rule.Lifecycle, Rule: f.Rule.Destination + ":" + f.Rule.Ports}
+				if f.Related != nil {
+					converted.Related = f.Related.Destination + ":" + f.Related.Ports
+				}
+				findings = append(findings, converted)
+			}
+
+			Expect(findings).To(ConsistOf(
+				finding{Type: SecurityGroupFindingOverlapping, Lifecycle: "running", Rule: "10.0.0.0/8:443", Related: "10.1.0.0/16:400-500"},
+				finding{Type: SecurityGroupFindingShadowed, Lifecycle: "running", Rule: "10.1.0.0/16:443", Related: "10.0.0.0/8:443"},
+				finding{Type: SecurityGroupFindingShadowed, Lifecycle: "running", Rule: "10.1.0.0/16:443", Related: "10.1.0.0/16:400-500"},
+				finding{Type: SecurityGroupFindingBroad, Lifecycle: "staging", Rule: "0.0.0.0/0:"},
+				finding{Type: SecurityGroupFindingDuplicate, Lifecycle: "staging", Rule: "0.0.0.0-9.255.255.255:"},
+				finding{Type: SecurityGroupFindingShadowed, Lifecycle: "staging", Rule: "0.0.0.0-9.255.255.255:", Related: "0.0.0.0/0:"},
+				finding{Type: SecurityGroupFindingUnrecognized, Lifecycle: "staging", Rule: "not-an-address:80"},
+			))
+		})
+
+		Context("when the space has icmp rules", func() {
+			icmpRule := func(icmpType int, icmpCode int) ccv2.SecurityGroupRule {
+				return ccv2.SecurityGroupRule{
+					Protocol:    "icmp",
+					Destination: "192.168.0.0/16",
+					Type:        types.NullInt{IsSet: true, Value: icmpType},
+					Code:        types.NullInt{IsSet: true, Value: icmpCode},
+				}
+			}
+
+			BeforeEach(func() {
+				includeStaging = false
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{Name: "ping", Rules: []ccv2.SecurityGroupRule{icmpRule(8, 0), icmpRule(0, 0)}},
+						{Name: "ping-copy", Rules: []ccv2.SecurityGroupRule{icmpRule(8, 0)}},
+						{Name: "unreachable", Rules: []ccv2.SecurityGroupRule{icmpRule(3, -1)}},
+					},
+					ccv2.Warnings{"get-running-warning"},
+					nil)
+			})
+
+			It("only merges rules with the same ICMP type and code", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var icmpRules []EffectiveSecurityGroupRule
+				for _, rule := range audit.Running {
+					if rule.Protocol == "icmp" {
+						icmpRules = append(icmpRules, rule)
+					}
+				}
+				Expect(icmpRules).To(HaveLen(3))
+				Expect(icmpRules[0].Type.Value).To(Equal(0))
+				Expect(icmpRules[0].Origins).To(HaveLen(1))
+				Expect(icmpRules[1].Type.Value).To(Equal(3))
+				Expect(icmpRules[1].Code.Value).To(Equal(-1))
+				Expect(icmpRules[2].Type.Value).To(Equal(8))
+				Expect(icmpRules[2].Origins).To(Equal([]SecurityGroupRuleOrigin{
+					{SecurityGroupName: "ping", Binding: SecurityGroupBindingSpace},
+					{SecurityGroupName: "ping-copy", Binding: SecurityGroupBindingSpace},
+				}))
+			})
+
+			It("compares the ICMP types and codes of rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var findingTypes []SecurityGroupFindingType
+				for _, finding := range audit.Findings {
+					if finding.Rule.Protocol == "icmp" {
+						findingTypes = append(findingTypes, finding.Type)
+					}
+				}
+				Expect(findingTypes).To(ConsistOf(SecurityGroupFindingDuplicate))
+			})
+
+			Context("when a rule allows all ICMP types and codes", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+						[]ccv2.SecurityGroup{
+							{Name: "ping", Rules: []ccv2.SecurityGroupRule{icmpRule(8, 0)}},
+							{Name: "all-icmp", Rules: []ccv2.SecurityGroupRule{icmpRule(-1, -1)}},
+						},
+						ccv2.Warnings{"get-running-warning"},
+						nil)
+				})
+
+				It("reports the rules it shadows", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					var shadowed []SecurityGroupFinding
+					for _, finding := range audit.Findings {
+						if finding.Type == SecurityGroupFindingShadowed {
+							shadowed = append(shadowed, finding)
+						}
+					}
+					Expect(shadowed).To(HaveLen(1))
+					Expect(shadowed[0].Rule.Type.Value).To(Equal(8))
+					Expect(shadowed[0].Related.Type.Value).To(Equal(-1))
+				})
+			})
+		})
+
+		Context("when staging is not included", func() {
+			BeforeEach(func() {
+				includeStaging = false
+			})
+
+			It("does not audit the staging rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceStagingSecurityGroupsCallCount()).To(Equal(0))
+				Expect(audit.StagingIncluded).To(BeFalse())
+				Expect(audit.Staging).To(BeEmpty())
+				for _, finding := range audit.Findings {
+					Expect(finding.Rule.Lifecycle).To(Equal(constant.SecurityGroupLifecycleRunning))
+				}
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a space not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+			})
+		})
+
+		Context("when getting the security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get security groups error")
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-security-groups-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-space-warning", "get-security-groups-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,350 @@
+package v2action
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+)
+
+const (
+	minICMPValue = -1
+	maxICMPValue = 255
+)
+
+// jsonNode is a decoded JSON value together with the offset it was written
+// at. Objects keep their keys in the order they were written.
+type jsonNode struct {
+	offset int64
+	value  interface{}
+	keys   []jsonKey
+	items  []jsonNode
+}
+
+type jsonKey struct {
+	name   string
+	offset int64
+	value  jsonNode
+}
+
+type jsonSyntaxError struct {
+	offset  int64
+	message string
+}
+
+func (e jsonSyntaxError) Error() string {
+	return e.message
+}
+
+// ReadSecurityGroupRulesFile reads the security group rules in the JSON file
+// at path and checks them against the rules the Cloud Controller accepts.
+// When the file is invalid, an InvalidSecurityGroupRulesError containing
+// every problem is returned.
+func (Actor) ReadSecurityGroupRulesFile(path string) ([]ccv2.SecurityGroupRule, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := securityGroupRulesValidator{file: path, raw: raw}
+
+	document, err := decodeJSONNode(raw)
+	if err != nil {
+		if syntaxErr, ok := err.(jsonSyntaxError); ok {
+			validator.addError(syntaxErr.offset, "", syntaxErr.message)
+			return nil, validator.result()
+		}
+		return nil, err
+	}
+
+	rules := validator.validateRules(document)
+	if err := validator.result(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+type securityGroupRulesValidator struct {
+	file   string
+	raw    []byte
+	errors []actionerror.SecurityGroupRuleError
+}
+
+func (v *securityGroupRulesValidator) validateRules(document jsonNode) []ccv2.SecurityGroupRule {
+	if _, ok := document.value.([]interface{}); !ok {
+		v.addError(document.offset, "", "the file must contain a list of rules")
+		return nil
+	}
+
+	rules := []ccv2.SecurityGroupRule{}
+	for i, item := range document.items {
+		rules = append(rules, v.validateRule(fmt.Sprintf("[%d]", i), item))
+	}
+	return rules
+}
+
+func (v *securityGroupRulesValidator) validateRule(path string, node jsonNode) ccv2.SecurityGroupRule {
+	var rule ccv2.SecurityGroupRule
+	if _, ok := node.value.(map[string]interface{}); !ok {
+		v.addError(node.offset, path, "must be an object")
+		return rule
+	}
+
+	values := map[string]jsonNode{}
+	for _, key := range node.keys {
+		keyPath := path + "." + key.name
+		switch key.name {
+		case "code", "description", "destination", "log", "ports", "protocol", "type":
+		default:
+			v.addError(key.offset, keyPath, "unknown key")
+			continue
+		}
+		if _, found := values[key.name]; found {
+			v.addError(key.offset, keyPath, "is specified more than once")
+			continue
+		}
+		values[key.name] = key.value
+	}
+
+	rule.Protocol = v.stringValue(path+".protocol", values["protocol"])
+	rule.Destination = v.stringValue(path+".destination", values["destination"])
+	rule.Ports = v.stringValue(path+".ports", values["ports"])
+	rule.Description = v.stringValue(path+".description", values["description"])
+	rule.Log = v.boolValue(path+".log", values["log"])
+	rule.Type = v.icmpValue(path+".type", values["type"])
+	rule.Code = v.icmpValue(path+".code", values["code"])
+
+	var validProtocol bool
+	if protocol, found := values["protocol"]; !found {
+		v.addError(node.offset, path+".protocol", "is required")
+	} else if _, isString := protocol.value.(string); isString {
+		switch rule.Protocol {
+		case "tcp", "udp", "icmp", "all":
+			validProtocol = true
+		default:
+			v.addError(protocol.offset, path+".protocol", fmt.Sprintf("'%s' is not a valid protocol, it must be one of tcp, udp, icmp or all", rule.Protocol))
+		}
+	}
+
+	if destination, found := values["destination"]; !found {
+		v.addError(node.offset, path+".destination", "is required")
+	} else if _, isString := destination.value.(string); isString {
+		if _, valid := parseDestination(rule.Destination); !valid {
+			v.addError(destination.offset, path+".destination", fmt.Sprintf("'%s' is not a valid destination, it must be a CIDR such as 10.0.0.0/8, an IP address such as 10.0.0.1 or an IP range such as 10.0.0.1-10.0.0.9", rule.Destination))
+		}
+	}
+
+	ports, hasPorts := values["ports"]
+	switch rule.Protocol {
+	case "tcp", "udp":
+		if !hasPorts {
+			v.addError(node.offset, path+".ports", fmt.Sprintf("is required for %s rules", rule.Protocol))
+		} else if _, isString := ports.value.(string); isString {
+			if _, valid := parsePorts(rule.Ports); !valid {
+				v.addError(ports.offset, path+".ports", fmt.Sprintf("'%s' is not valid, it must be a port such as 443, a list of ports such as 80,443 or a port range such as 8000-9000 between %d and %d", rule.Ports, minPort, maxPort))
+			}
+		}
+	case "icmp", "all":
+		if hasPorts {
+			v.addError(ports.offset, path+".ports", fmt.Sprintf("is not allowed for %s rules", rule.Protocol))
+		}
+	}
+
+	if !validProtocol {
+		return rule
+	}
+
+	for _, key := range []string{"type", "code"} {
+		icmpValue, found := values[key]
+		switch {
+		case rule.Protocol == "icmp" && !found:
+			v.addError(node.offset, path+"."+key, "is required for icmp rules")
+		case rule.Protocol != "icmp" && found:
+			v.addError(icmpValue.offset, path+"."+key, "is only allowed for icmp rules")
+		}
+	}
+
+	if logValue, found := values["log"]; found && rule.Protocol != "tcp" {
+		v.addError(logValue.offset, path+".log", "is only allowed for tcp rules")
+	}
+
+	return rule
+}
+
+func (v *securityGroupRulesValidator) stringValue(path string, node jsonNode) string {
+	if node.value == nil {
+		return ""
+	}
+	value, ok := node.value.(string)
+	if !ok {
+		v.addError(node.offset, path, "must be a string")
+	}
+	return value
+}
+
+func (v *securityGroupRulesValidator) boolValue(path string, node jsonNode) bool {
+	if node.value == nil {
+		return false
+	}
+	value, ok := node.value.(bool)
+	if !ok {
+		v.addError(node.offset, path, "must be a boolean")
+	}
+	return value
+}
+
+func (v *securityGroupRulesValidator) icmpValue(path string, node jsonNode) types.NullInt {
+	if node.value == nil {
+		return types.NullInt{}
+	}
+
+	number, ok := node.value.(json.Number)
+	if !ok {
+		v.addError(node.offset, path, "must be an integer")
+		return types.NullInt{}
+	}
+
+	value, err := strconv.Atoi(number.String())
+	if err != nil {
+		v.addError(node.offset, path, "must be an integer")
+		return types.NullInt{}
+	}
+	if value < minICMPValue || value > maxICMPValue {
+		v.addError(node.offset, path, fmt.Sprintf("%d is out of range, it must be between %d and %d", value, minICMPValue, maxICMPValue))
+	}
+	return types.NullInt{IsSet: true, Value: value}
+}
+
+func (v *securityGroupRulesValidator) addError(offset int64, key string, message string) {
+	line, column := 1, 1
+	for i := int64(0); i < offset && i < int64(len(v.raw)); i++ {
+		if v.raw[i] == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	v.errors = append(v.errors, actionerror.SecurityGroupRuleError{
+		File:    v.file,
+		Line:    line,
+		Column:  column,
+		Key:     key,
+		Message: message,
+	})
+}
+
+func (v *securityGroupRulesValidator) result() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(v.errors, func(i int, j int) bool {
+		if v.errors[i].Line == v.errors[j].Line {
+			return v.errors[i].Column < v.errors[j].Column
+		}
+		return v.errors[i].Line < v.errors[j].Line
+	})
+	return actionerror.InvalidSecurityGroupRulesError{Errors: v.errors}
+}
+
+// decodeJSONNode decodes a single JSON document, recording the offset of
+// every value and object key. Malformed JSON is returned as a
+// jsonSyntaxError.
+func decodeJSONNode(raw []byte) (jsonNode, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	node, err := decodeNextJSONNode(decoder, raw)
+	switch e := err.(type) {
+	case nil:
+	case *json.SyntaxError:
+		offset := e.Offset
+		if offset > 0 {
+			offset--
+		}
+		return jsonNode{}, jsonSyntaxError{offset: offset, message: e.Error()}
+	default:
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return jsonNode{}, jsonSyntaxError{offset: int64(len(raw)), message: "unexpected end of JSON input"}
+		}
+		return jsonNode{}, err
+	}
+
+	offset := nextJSONOffset(decoder, raw)
+	if _, err := decoder.Token(); err != io.EOF {
+		return jsonNode{}, jsonSyntaxError{offset: offset, message: "unexpected content after the list of rules"}
+	}
+	return node, nil
+}
+
+func decodeNextJSONNode(decoder *json.Decoder, raw []byte) (jsonNode, error) {
+	node := jsonNode{offset: nextJSONOffset(decoder, raw)}
+
+	token, err := decoder.Token()
+	if err != nil {
+		return jsonNode{}, err
+	}
+
+	switch token {
+	case json.Delim('['):
+		for decoder.More() {
+			item, err := decodeNextJSONNode(decoder, raw)
+			if err != nil {
+				return jsonNode{}, err
+			}
+			node.items = append(node.items, item)
+		}
+		node.value = []interface{}{}
+	case json.Delim('{'):
+		for decoder.More() {
+			keyOffset := nextJSONOffset(decoder, raw)
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return jsonNode{}, err
+			}
+			value, err := decodeNextJSONNode(decoder, raw)
+			if err != nil {
+				return jsonNode{}, err
+			}
+			node.keys = append(node.keys, jsonKey{name: keyToken.(string), offset: keyOffset, value: value})
+		}
+		node.value = map[string]interface{}{}
+	default:
+		if token == nil {
+			node.value = struct{}{}
+		} else {
+			node.value = token
+		}
+		return node, nil
+	}
+
+	// consume the closing delimiter
+	if _, err := decoder.Token(); err != nil {
+		return jsonNode{}, err
+	}
+	return node, nil
+}
+
+// nextJSONOffset returns the offset of the next value in raw, skipping the
+// whitespace and separators that precede it.
+func nextJSONOffset(decoder *json.Decoder, raw []byte) int64 {
+	offset := decoder.InputOffset()
+	for offset < int64(len(raw)) {
+		switch raw[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
@@ -0,0 +1,167 @@
+package v2action_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rules File Actions", func() {
+	var (
+		actor     *Actor
+		tempDir   string
+		rulesPath string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil, nil)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "security-group-rules-test")
+		Expect(err).ToNot(HaveOccurred())
+		rulesPath = filepath.Join(tempDir, "rules.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("ReadSecurityGroupRulesFile", func() {
+		var (
+			rawRules   string
+			rules      []ccv2.SecurityGroupRule
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(rulesPath, []byte(rawRules), 0600)).To(Succeed())
+			rules, executeErr = actor.ReadSecurityGroupRulesFile(rulesPath)
+		})
+
+		Context("when the rules are valid", func() {
+			BeforeEach(func() {
+				rawRules = `[
+  {
+    "protocol": "tcp",
+    "destination": "10.0.11.0/24",
+    "ports": "80,443",
+    "description": "Allow http and https traffic from ZoneA",
+    "log": true
+  },
+  {"protocol": "udp", "destination": "10.0.0.1-10.0.0.9", "ports": "8000-9000"},
+  {"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1},
+  {"protocol": "all", "destination": "0.0.0.0/0"}
+]`
+			})
+
+			It("returns the rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal([]ccv2.SecurityGroupRule{
+					{
+						Protocol:    "tcp",
+						Destination: "10.0.11.0/24",
+						Ports:       "80,443",
+						Description: "Allow http and https traffic from ZoneA",
+						Log:         true,
+					},
+					{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "8000-9000"},
+					{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+					{Protocol: "all", Destination: "0.0.0.0/0"},
+				}))
+			})
+		})
+
+		Context("when the file contains an empty list", func() {
+			BeforeEach(func() {
+				rawRules = `[]`
+			})
+
+			It("returns no rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(rules).To(BeEmpty())
+			})
+		})
+
+		Context("when the rules are invalid", func() {
+			BeforeEach(func() {
+				rawRules = `[
+  {"protocol": "tcp", "destination": "10.0.0.0/33", "ports": "0-80"},
+  {"protocol": "icmp", "destination": "10.0.0.1", "type": 256, "ports": "80"},
+  {"protocol": "udp", "destination": "10.0.0.9-10.0.0.1", "log": "yes", "extra": 1},
+  {"protocol": "sctp", "description": 42},
+  "not-a-rule"
+]`
+			})
+
+			It("returns every problem with its location", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+					Errors: []actionerror.SecurityGroupRuleError{
+						{File: rulesPath, Line: 2, Column: 38, Key: "[0].destination", Message: "'10.0.0.0/33' is not a valid destination, it must be a CIDR such as 10.0.0.0/8, an IP address such as 10.0.0.1 or an IP range such as 10.0.0.1-10.0.0.9"},
+						{File: rulesPath, Line: 2, Column: 62, Key: "[0].ports", Message: "'0-80' is not valid, it must be a port such as 443, a list of ports such as 80,443 or a port range such as 8000-9000 between 1 and 65535"},
+						{File: rulesPath, Line: 3, Column: 3, Key: "[1].code", Message: "is required for icmp rules"},
+						{File: rulesPath, Line: 3, Column: 59, Key: "[1].type", Message: "256 is out of range, it must be between -1 and 255"},
+						{File: rulesPath, Line: 3, Column: 73, Key: "[1].ports", Message: "is not allowed for icmp rules"},
+						{File: rulesPath, Line: 4, Column: 3, Key: "[2].ports", Message: "is required for udp rules"},
+						{File: rulesPath, Line: 4, Column: 38, Key: "[2].destination", Message: "'10.0.0.9-10.0.0.1' is not a valid destination, it must be a CIDR such as 10.0.0.0/8, an IP address such as 10.0.0.1 or an IP range such as 10.0.0.1-10.0.0.9"},
+						{File: rulesPath, Line: 4, Column: 66, Key: "[2].log", Message: "must be a boolean"},
+						{File: rulesPath, Line: 4, Column: 66, Key: "[2].log", Message: "is only allowed for tcp rules"},
+						{File: rulesPath, Line: 4, Column: 73, Key: "[2].extra", Message: "unknown key"},
+						{File: rulesPath, Line: 5, Column: 3, Key: "[3].destination", Message: "is required"},
+						{File: rulesPath, Line: 5, Column: 16, Key: "[3].protocol", Message: "'sctp' is not a valid protocol, it must be one of tcp, udp, icmp or all"},
+						{File: rulesPath, Line: 5, Column: 39, Key: "[3].description", Message: "must be a string"},
+						{File: rulesPath, Line: 6, Column: 3, Key: "[4]", Message: "must be an object"},
+					},
+				}))
+			})
+		})
+
+		Context("when the file does not contain a list", func() {
+			BeforeEach(func() {
+				rawRules = `{"protocol": "tcp"}`
+			})
+
+			It("returns an error at the start of the file", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+					Errors: []actionerror.SecurityGroupRuleError{
+						{File: rulesPath, Line: 1, Column: 1, Message: "the file must contain a list of rules"},
+					},
+				}))
+			})
+		})
+
+		Context("when the file is not valid JSON", func() {
+			BeforeEach(func() {
+				rawRules = "[\n  {\"protocol\": tcp}\n]"
+			})
+
+			It("returns the syntax error with its location", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+					Errors: []actionerror.SecurityGroupRuleError{
+						{File: rulesPath, Line: 2, Column: 17, Message: "invalid character 'c' in literal true (expecting 'r')"},
+					},
+				}))
+			})
+		})
+
+		Context("when the file is truncated", func() {
+			BeforeEach(func() {
+				rawRules = "[\n  {\"protocol\": \"tcp\""
+			})
+
+			It("returns an error at the end of the file", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecurityGroupRulesError{
+					Errors: []actionerror.SecurityGroupRuleError{
+						{File: rulesPath, Line: 2, Column: 20, Message: "unexpected end of JSON input"},
+					},
+				}))
+			})
+		})
+	})
+})
@@ -1274,6 +1274,177 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		var (
+			rules         []ccv2.SecurityGroupRule
+			securityGroup SecurityGroup
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			rules = []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}}
+		})
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, executeErr = actor.CreateSecurityGroup("some-security-group", rules)
+		})
+
+		Context("when the security group is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(
+					ccv2.SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group", Rules: rules},
+					ccv2.Warnings{"create-warning"},
+					nil)
+			})
+
+			It("returns the security group and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(securityGroup).To(Equal(SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group", Rules: rules}))
+
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(1))
+				name, createdRules := fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)
+				Expect(name).To(Equal("some-security-group"))
+				Expect(createdRules).To(Equal(rules))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(
+					ccv2.SecurityGroup{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.SecurityGroupNameTakenError{Message: "taken"})
+			})
+
+			It("returns a SecurityGroupNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SecurityGroupNameTakenError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+
+		Context("when creating the security group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(
+					ccv2.SecurityGroup{},
+					ccv2.Warnings{"create-warning"},
+					errors.New("create-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetSecurityGroupRulesChangesByName", func() {
+		var (
+			rules      []ccv2.SecurityGroupRule
+			changes    SecurityGroupRulesChanges
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetSecurityGroupRulesChangesByName("some-security-group", rules)
+		})
+
+		Context("when the security group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{{
+						GUID: "some-security-group-guid",
+						Name: "some-security-group",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+							{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+							{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+						},
+					}},
+					ccv2.Warnings{"get-warning"},
+					nil)
+			})
+
+			Context("when the rules differ", func() {
+				BeforeEach(func() {
+					rules = []ccv2.SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+						{Protocol: "udp", Destination: "10.0.0.1", Ports: "53", Description: "dns"},
+					}
+				})
+
+				It("returns the added and removed rules", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning"))
+					Expect(changes.SecurityGroup.GUID).To(Equal("some-security-group-guid"))
+					Expect(changes.HasChanges()).To(BeTrue())
+					Expect(changes.Added).To(Equal([]ccv2.SecurityGroupRule{
+						{Protocol: "udp", Destination: "10.0.0.1", Ports: "53", Description: "dns"},
+					}))
+					Expect(changes.Removed).To(Equal([]ccv2.SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+						{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+					}))
+				})
+			})
+
+			Context("when the rules are the same", func() {
+				BeforeEach(func() {
+					rules = []ccv2.SecurityGroupRule{
+						{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443"},
+					}
+				})
+
+				It("returns no changes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(changes.HasChanges()).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SecurityGroupNotFoundError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSecurityGroupRules", func() {
+		var (
+			rules      []ccv2.SecurityGroupRule
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			rules = []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}}
+			fakeCloudControllerClient.UpdateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, errors.New("update-error"))
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateSecurityGroupRules("some-security-group-guid", rules)
+		})
+
+		It("replaces the rules and returns all warnings", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(1))
+			guid, updatedRules := fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)
+			Expect(guid).To(Equal("some-security-group-guid"))
+			Expect(updatedRules).To(Equal(rules))
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists and there are no errors", func() {
 			BeforeEach(func() {
@@ -41,6 +41,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSecurityGroupStub        func(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}
+	createSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateServiceBindingStub        func(appGUID string, serviceBindingGUID string, bindingName string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	createServiceBindingMutex       sync.RWMutex
 	createServiceBindingArgsForCall []struct {
@@ -804,6 +820,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateSecurityGroupStub        func(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	updateSecurityGroupMutex       sync.RWMutex
+	updateSecurityGroupArgsForCall []struct {
+		securityGroupGUID string
+		rules             []ccv2.SecurityGroupRule
+	}
+	updateSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	UpdateRouteApplicationStub        func(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	updateRouteApplicationMutex       sync.RWMutex
 	updateRouteApplicationArgsForCall []struct {
@@ -1059,6 +1091,61 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}{name, rules})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{name, rules})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2, fake.createSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].name, fake.createSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceBindingGUID string, bindingName string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.createServiceBindingMutex.Lock()
 	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
@@ -3803,6 +3890,61 @@ func (fake *FakeCloudControllerClient) UpdateResourceMatchReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSecurityGroup(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.updateSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
+	fake.updateSecurityGroupArgsForCall = append(fake.updateSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+		rules             []ccv2.SecurityGroupRule
+	}{securityGroupGUID, rules})
+	fake.recordInvocation("UpdateSecurityGroup", []interface{}{securityGroupGUID, rules})
+	fake.updateSecurityGroupMutex.Unlock()
+	if fake.UpdateSecurityGroupStub != nil {
+		return fake.UpdateSecurityGroupStub(securityGroupGUID, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSecurityGroupReturns.result1, fake.updateSecurityGroupReturns.result2, fake.updateSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupCallCount() int {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return len(fake.updateSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return fake.updateSecurityGroupArgsForCall[i].securityGroupGUID, fake.updateSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	fake.updateSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	if fake.updateSecurityGroupReturnsOnCall == nil {
+		fake.updateSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateRouteApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error) {
 	fake.updateRouteApplicationMutex.Lock()
 	ret, specificReturn := fake.updateRouteApplicationReturnsOnCall[len(fake.updateRouteApplicationArgsForCall)]
@@ -4367,6 +4509,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
 	fake.createUserMutex.RLock()
@@ -4469,6 +4613,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateResourceMatchMutex.RLock()
 	defer fake.updateResourceMatchMutex.RUnlock()
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
 	fake.updateRouteApplicationMutex.RLock()
 	defer fake.updateRouteApplicationMutex.RUnlock()
 	fake.updateSecurityGroupSpaceMutex.RLock()
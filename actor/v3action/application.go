@@ -16,6 +16,8 @@ type Application struct {
 	State               constant.ApplicationState
 	LifecycleType       constant.AppLifecycleType
 	LifecycleBuildpacks []string
+	Metadata            *Metadata
+	SpaceGUID           string
 }
 
 func (app Application) Started() bool {
@@ -77,6 +79,28 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsByGUIDs returns the applications with the given GUIDs that
+// the user can see. Applications that do not exist or are not visible are
+// omitted.
+func (actor Actor) GetApplicationsByGUIDs(appGUIDs ...string) ([]Application, Warnings, error) {
+	if len(appGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: appGUIDs},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		apps = append(apps, actor.convertCCToActorApplication(ccApp))
+	}
+	return apps, Warnings(warnings), nil
+}
+
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (Application, Warnings, error) {
@@ -168,7 +192,9 @@ func (Actor) convertCCToActorApplication(app ccv3.Application) Application {
 		GUID:                app.GUID,
 		LifecycleType:       app.LifecycleType,
 		LifecycleBuildpacks: app.LifecycleBuildpacks,
+		Metadata:            (*Metadata)(app.Metadata),
 		Name:                app.Name,
+		SpaceGUID:           app.Relationships[constant.RelationshipTypeSpace].GUID,
 		State:               app.State,
 	}
 }
@@ -224,6 +224,43 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsByGUIDs", func() {
+		It("returns the applications with their spaces and warnings", func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{
+						GUID: "some-app-guid-1",
+						Name: "some-app-1",
+						Relationships: ccv3.Relationships{
+							constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid"},
+						},
+					},
+				},
+				ccv3.Warnings{"warning-1"},
+				nil,
+			)
+
+			apps, warnings, err := actor.GetApplicationsByGUIDs("some-app-guid-1", "some-app-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]Application{
+				{GUID: "some-app-guid-1", Name: "some-app-1", SpaceGUID: "some-space-guid"},
+			}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-app-guid-1", "some-app-guid-2"}},
+			))
+		})
+
+		It("does not make a request when no GUIDs are given", func() {
+			apps, _, err := actor.GetApplicationsByGUIDs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("CreateApplicationInSpace", func() {
 		var (
 			application Application
@@ -91,7 +91,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns app summaries and warnings", func() {
-				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(summaries).To(ConsistOf(
 					ApplicationWithProcessSummary{
@@ -143,6 +143,25 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 		})
 
+		Context("when a label selector is provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("filters the applications by the label selector", func() {
+				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "team=payments,env!=prod")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"team=payments,env!=prod"}},
+				))
+			})
+		})
+
 		Context("when getting the app processes returns an error", func() {
 			var expectedErr error
 
@@ -168,7 +187,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning"}))
 			})
@@ -210,7 +229,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(Equal(Warnings{"some-warning", "some-process-warning", "some-process-stats-warning"}))
 			})
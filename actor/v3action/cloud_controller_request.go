@@ -0,0 +1,14 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// CloudControllerResponse is an undecoded response from the Cloud Controller.
+type CloudControllerResponse ccv3.RawResponse
+
+// MakeCloudControllerRequest sends an authenticated request to the given
+// Cloud Controller path and returns the undecoded response. Responses with an
+// error status code are returned as is so that the caller can inspect them.
+func (actor Actor) MakeCloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, Warnings, error) {
+	response, warnings, err := actor.CloudControllerClient.MakeRawRequest(method, path, body)
+	return CloudControllerResponse(response), Warnings(warnings), err
+}
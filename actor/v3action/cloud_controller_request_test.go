@@ -0,0 +1,74 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cloud Controller Request Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("MakeCloudControllerRequest", func() {
+		var (
+			response   CloudControllerResponse
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			response, warnings, executeErr = actor.MakeCloudControllerRequest(http.MethodPost, "/v3/apps", []byte(`{"name":"some-app"}`))
+		})
+
+		Context("when the request is made", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					ccv3.RawResponse{StatusCode: http.StatusUnprocessableEntity, Body: []byte(`{"errors":[]}`)},
+					ccv3.Warnings{"request-warning"},
+					nil,
+				)
+			})
+
+			It("returns the undecoded response and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("request-warning"))
+				Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+				Expect(response.Body).To(MatchJSON(`{"errors":[]}`))
+
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				method, path, body := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+				Expect(method).To(Equal(http.MethodPost))
+				Expect(path).To(Equal("/v3/apps"))
+				Expect(body).To(MatchJSON(`{"name":"some-app"}`))
+			})
+		})
+
+		Context("when the request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("request error")
+				fakeCloudControllerClient.MakeRawRequestReturns(ccv3.RawResponse{}, ccv3.Warnings{"request-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("request-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// Deployment represents a V3 actor Deployment.
+type Deployment ccv3.Deployment
+
+// CancelDeployment stops the given deployment and rolls the application back
+// to its previous droplet.
+func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
+
+// CreateDeployment starts a rolling deployment of the provided droplet. If
+// dropletGUID is empty, the application's current droplet is redeployed.
+func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+	return Deployment(deployment), Warnings(warnings), err
+}
+
+// PollDeployment waits for the deployment to finish, sending the current
+// process summaries of the application to progressChannel on every poll. It
+// returns a DeploymentCanceledError if the deployment was canceled and a
+// StartupTimeoutError if it does not finish within the startup timeout.
+func (actor Actor) PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- ProcessSummaries, warningsChannel chan<- Warnings) error {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		summaries, summaryWarnings, err := actor.getProcessSummariesForApp(appGUID)
+		warningsChannel <- summaryWarnings
+		if err != nil {
+			return err
+		}
+		progressChannel <- summaries
+
+		switch deployment.State {
+		case constant.DeploymentDeployed:
+			return nil
+		case constant.DeploymentCanceled:
+			return actionerror.DeploymentCanceledError{}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return actionerror.StartupTimeoutError{}
+}
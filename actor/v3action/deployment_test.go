@@ -0,0 +1,252 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+	})
+
+	Describe("CreateDeployment", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+		})
+
+		Context("when creating the deployment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid", State: constant.DeploymentDeploying},
+					ccv3.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(deployment).To(Equal(Deployment{GUID: "some-deployment-guid", State: constant.DeploymentDeploying}))
+
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+				appGUID, dropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		Context("when creating the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create error")
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"create-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CancelDeployment("some-deployment-guid")
+		})
+
+		Context("when canceling the deployment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the deployment and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+
+				Expect(fakeCloudControllerClient.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when canceling the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cancel error")
+				fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("cancel-warning"))
+			})
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			progressChannel chan ProcessSummaries
+			allWarnings     Warnings
+			allProgress     []string
+			funcDone        chan interface{}
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			warningsChannel = make(chan Warnings)
+			progressChannel = make(chan ProcessSummaries)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			allProgress = nil
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case summaries := <-progressChannel:
+						allProgress = append(allProgress, summaries.String())
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]ccv3.Process{{GUID: "web-guid", Type: constant.ProcessTypeWeb}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+				[]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning}, {State: constant.ProcessInstanceStarting}},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning}, {State: constant.ProcessInstanceRunning}},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.PollDeployment("some-deployment-guid", "some-app-guid", progressChannel, warningsChannel)
+			funcDone <- nil
+		})
+
+		Context("when the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+					ccv3.Deployment{State: constant.DeploymentDeploying},
+					ccv3.Warnings{"get-deployment-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+					ccv3.Deployment{State: constant.DeploymentDeployed},
+					ccv3.Warnings{"get-deployment-warning-2"},
+					nil,
+				)
+			})
+
+			It("sends the process progress on every poll and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(allProgress).To(Equal([]string{"web:1/2", "web:2/2"}))
+				Expect(allWarnings).To(ConsistOf(
+					"get-deployment-warning-1", "get-processes-warning", "get-instances-warning",
+					"get-deployment-warning-2", "get-processes-warning", "get-instances-warning",
+				))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{State: constant.DeploymentCanceled},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a DeploymentCanceledError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentCanceledError{}))
+			})
+		})
+
+		Context("when the deployment does not finish before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+				fakeConfig.PollingIntervalReturns(time.Millisecond * 2)
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{State: constant.DeploymentDeploying},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployment error")
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"get-deployment-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(allWarnings).To(ConsistOf("get-deployment-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
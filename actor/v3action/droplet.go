@@ -64,6 +64,24 @@ func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]D
 	return droplets, allWarnings, err
 }
 
+// GetDropletsByApplication returns all the droplets of the provided
+// application.
+func (actor Actor) GetDropletsByApplication(appGUID string) ([]Droplet, Warnings, error) {
+	ccv3Droplets, warnings, err := actor.CloudControllerClient.GetDroplets(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var droplets []Droplet
+	for _, ccv3Droplet := range ccv3Droplets {
+		droplets = append(droplets, actor.convertCCToActorDroplet(ccv3Droplet))
+	}
+
+	return droplets, Warnings(warnings), nil
+}
+
 func (actor Actor) GetCurrentDropletByApplication(appGUID string) (Droplet, Warnings, error) {
 	droplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(appGUID)
 	switch err.(type) {
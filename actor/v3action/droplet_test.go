@@ -330,4 +330,45 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDropletsByApplication", func() {
+		Context("when getting the droplets succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletsReturns(
+					[]ccv3.Droplet{
+						{GUID: "some-droplet-guid", State: constant.DropletStaged, Stack: "some-stack"},
+					},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+			})
+
+			It("returns the droplets and warnings", func() {
+				droplets, warnings, err := actor.GetDropletsByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+				Expect(droplets).To(ConsistOf(Droplet{GUID: "some-droplet-guid", State: constant.DropletStaged, Stack: "some-stack"}))
+
+				Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get droplets error")
+				fakeCloudControllerClient.GetDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetDropletsByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+			})
+		})
+	})
 })
@@ -196,3 +196,19 @@ func (actor Actor) ResetOrganizationDefaultIsolationSegment(orgGUID string) (War
 	_, apiWarnings, err := actor.CloudControllerClient.UpdateOrganizationDefaultIsolationSegmentRelationship(orgGUID, "")
 	return Warnings(apiWarnings), err
 }
+
+// GetIsolationSegments returns all the isolation segments visible to the
+// current user.
+func (actor Actor) GetIsolationSegments() ([]IsolationSegment, Warnings, error) {
+	ccv3IsolationSegments, warnings, err := actor.CloudControllerClient.GetIsolationSegments()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	isolationSegments := make([]IsolationSegment, len(ccv3IsolationSegments))
+	for i := range ccv3IsolationSegments {
+		isolationSegments[i] = IsolationSegment(ccv3IsolationSegments[i])
+	}
+
+	return isolationSegments, Warnings(warnings), nil
+}
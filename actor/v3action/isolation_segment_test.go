@@ -768,4 +768,45 @@ var _ = Describe("Isolation Segment Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetIsolationSegments", func() {
+		Context("when getting the isolation segments succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentsReturns(
+					[]ccv3.IsolationSegment{
+						{GUID: "iso-guid-1", Name: "iso-1"},
+						{GUID: "iso-guid-2", Name: "iso-2"},
+					},
+					ccv3.Warnings{"get-iso-warning"},
+					nil,
+				)
+			})
+
+			It("returns the isolation segments and warnings", func() {
+				isolationSegments, warnings, err := actor.GetIsolationSegments()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-iso-warning"))
+				Expect(isolationSegments).To(ConsistOf(
+					IsolationSegment{GUID: "iso-guid-1", Name: "iso-1"},
+					IsolationSegment{GUID: "iso-guid-2", Name: "iso-2"},
+				))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the isolation segments fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get isolation segments error")
+				fakeCloudControllerClient.GetIsolationSegmentsReturns(nil, ccv3.Warnings{"get-iso-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetIsolationSegments()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-iso-warning"))
+			})
+		})
+	})
 })
@@ -1,9 +1,12 @@
 package v3action
 
 import (
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 )
@@ -69,6 +72,52 @@ func (lm LogMessages) Swap(i, j int) {
 	lm[i], lm[j] = lm[j], lm[i]
 }
 
+// LogFilter selects log messages. The zero value selects every message.
+type LogFilter struct {
+	// Since selects messages sent at or after this time when it is not zero.
+	Since time.Time
+
+	// SourceTypes selects messages from these source types, such as APP or
+	// RTR, when it is not empty. A source type also selects its sub-types, so
+	// APP selects APP/PROC/WEB.
+	SourceTypes []string
+
+	// SourceInstance selects messages from this source instance when it is
+	// not empty.
+	SourceInstance string
+
+	// Pattern selects messages matching this regular expression when it is
+	// not nil.
+	Pattern *regexp.Regexp
+}
+
+// Matches returns true if the message is selected by the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if !filter.Since.IsZero() && message.timestamp.Before(filter.Since) {
+		return false
+	}
+
+	if filter.SourceInstance != "" && message.sourceInstance != filter.SourceInstance {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.message) {
+		return false
+	}
+
+	if len(filter.SourceTypes) == 0 {
+		return true
+	}
+
+	for _, sourceType := range filter.SourceTypes {
+		if strings.EqualFold(message.sourceType, sourceType) ||
+			strings.HasPrefix(strings.ToUpper(message.sourceType), strings.ToUpper(sourceType)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
@@ -135,3 +184,58 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetRecentLogsForApplicationByNameAndSpace returns the buffered recent logs
+// of the application, oldest first, that are selected by filter.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, filter LogFilter) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	noaaMessages, err := client.RecentLogs(app.GUID, "")
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	noaaMessages = noaa.SortRecent(noaaMessages)
+
+	var logMessages []LogMessage
+	for _, message := range noaaMessages {
+		logMessage := LogMessage{
+			message:        string(message.GetMessage()),
+			messageType:    message.GetMessageType(),
+			timestamp:      time.Unix(0, message.GetTimestamp()),
+			sourceType:     message.GetSourceType(),
+			sourceInstance: message.GetSourceInstance(),
+		}
+
+		if filter.Matches(logMessage) {
+			logMessages = append(logMessages, logMessage)
+		}
+	}
+
+	return logMessages, allWarnings, nil
+}
+
+// GetFilteredStreamingLogsForApplicationByNameAndSpace tails the logs of the
+// application, only sending the messages that are selected by filter.
+func (actor Actor) GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, filter LogFilter) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	allMessages, logErrs, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace(appName, spaceGUID, client)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	messages := make(chan *LogMessage)
+	go func() {
+		defer close(messages)
+
+		for message := range allMessages {
+			if filter.Matches(*message) {
+				messages <- message
+			}
+		}
+	}()
+
+	return messages, logErrs, warnings, nil
+}
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
@@ -10,6 +11,7 @@ import (
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -366,4 +368,229 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("LogFilter", func() {
+		var (
+			filter  LogFilter
+			message LogMessage
+		)
+
+		BeforeEach(func() {
+			filter = LogFilter{}
+			message = *NewLogMessage("some-message", 0, time.Unix(100, 0), "APP/PROC/WEB", "1")
+		})
+
+		It("matches every message when empty", func() {
+			Expect(filter.Matches(message)).To(BeTrue())
+		})
+
+		DescribeTable("Matches",
+			func(setFilter func(*LogFilter), matches bool) {
+				setFilter(&filter)
+				Expect(filter.Matches(message)).To(Equal(matches))
+			},
+
+			Entry("matches messages sent after Since", func(f *LogFilter) { f.Since = time.Unix(50, 0) }, true),
+			Entry("matches messages sent at Since", func(f *LogFilter) { f.Since = time.Unix(100, 0) }, true),
+			Entry("does not match messages sent before Since", func(f *LogFilter) { f.Since = time.Unix(150, 0) }, false),
+			Entry("matches the sub-types of a source type", func(f *LogFilter) { f.SourceTypes = []string{"RTR", "APP"} }, true),
+			Entry("matches source types regardless of case", func(f *LogFilter) { f.SourceTypes = []string{"app/proc/web"} }, true),
+			Entry("does not match other source types", func(f *LogFilter) { f.SourceTypes = []string{"RTR", "APPS"} }, false),
+			Entry("matches the source instance", func(f *LogFilter) { f.SourceInstance = "1" }, true),
+			Entry("does not match other source instances", func(f *LogFilter) { f.SourceInstance = "10" }, false),
+			Entry("matches messages matching the pattern", func(f *LogFilter) { f.Pattern = regexp.MustCompile("^some-m.ss") }, true),
+			Entry("does not match messages not matching the pattern", func(f *LogFilter) { f.Pattern = regexp.MustCompile("other") }, false),
+		)
+	})
+
+	Describe("GetRecentLogsForApplicationByNameAndSpace", func() {
+		var (
+			filter   LogFilter
+			messages []LogMessage
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = LogFilter{}
+		})
+
+		JustBeforeEach(func() {
+			messages, warnings, err = actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, filter)
+		})
+
+		Context("when the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+
+				outMessage := events.LogMessage_OUT
+				errMessage := events.LogMessage_ERR
+				ts1 := int64(20)
+				ts2 := int64(10)
+				appSource := "APP/PROC/WEB"
+				routerSource := "RTR"
+				instance := "0"
+
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					{
+						Message:        []byte("message-2"),
+						MessageType:    &errMessage,
+						Timestamp:      &ts1,
+						SourceType:     &routerSource,
+						SourceInstance: &instance,
+					},
+					{
+						Message:        []byte("message-1"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts2,
+						SourceType:     &appSource,
+						SourceInstance: &instance,
+					},
+				}, nil)
+			})
+
+			It("returns the sorted log messages and warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+				appGUID, authToken := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(authToken).To(BeEmpty())
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[0].Type()).To(Equal("OUT"))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+				Expect(messages[0].SourceType()).To(Equal("APP/PROC/WEB"))
+				Expect(messages[0].SourceInstance()).To(Equal("0"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+				Expect(messages[1].Type()).To(Equal("ERR"))
+			})
+
+			Context("when a filter is provided", func() {
+				BeforeEach(func() {
+					filter.SourceTypes = []string{"RTR"}
+				})
+
+				It("only returns the selected messages", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-2"))
+				})
+			})
+
+			Context("when getting the recent logs errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("ZOMG")
+					fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+				})
+
+				It("returns error and warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+				})
+			})
+		})
+
+		Context("when finding the application errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"some-app-warnings"},
+					expectedErr,
+				)
+			})
+
+			It("returns error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetFilteredStreamingLogsForApplicationByNameAndSpace", func() {
+		var (
+			eventStream chan *events.LogMessage
+			errStream   chan error
+
+			messages <-chan *LogMessage
+			logErrs  <-chan error
+		)
+
+		BeforeEach(func() {
+			eventStream = make(chan *events.LogMessage)
+			errStream = make(chan error)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"some-app-warnings"},
+				nil,
+			)
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+				go func() {
+					outMessage := events.LogMessage_OUT
+					ts1 := int64(10)
+					ts2 := int64(20)
+					sourceType := "APP/PROC/WEB"
+					firstInstance := "0"
+					secondInstance := "1"
+
+					eventStream <- &events.LogMessage{
+						Message:        []byte("message-1"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts1,
+						SourceType:     &sourceType,
+						SourceInstance: &firstInstance,
+					}
+
+					eventStream <- &events.LogMessage{
+						Message:        []byte("message-2"),
+						MessageType:    &outMessage,
+						Timestamp:      &ts2,
+						SourceType:     &sourceType,
+						SourceInstance: &secondInstance,
+					}
+				}()
+
+				return eventStream, errStream
+			}
+		})
+
+		// If tests panic due to this close, it is likely you have a failing
+		// expectation and the channels are being closed because the test has
+		// failed/short circuited and is going through teardown.
+		AfterEach(func() {
+			close(eventStream)
+			close(errStream)
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("only passes the selected messages through the messages channel", func() {
+			var (
+				warnings Warnings
+				err      error
+			)
+			messages, logErrs, warnings, err = actor.GetFilteredStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, LogFilter{SourceInstance: "1"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+			message := <-messages
+			Expect(message.Message()).To(Equal("message-2"))
+			Expect(message.SourceInstance()).To(Equal("1"))
+		})
+	})
 })
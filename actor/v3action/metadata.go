@@ -0,0 +1,124 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the labels and annotations of a V3 actor resource.
+type Metadata ccv3.Metadata
+
+// GetApplicationLabels returns the labels of the application with the given
+// name in the given space.
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsOf((*ccv3.Metadata)(app.Metadata)), warnings, nil
+}
+
+// GetOrganizationLabels returns the labels of the organization with the given
+// name.
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsOf(org.Metadata), warnings, nil
+}
+
+// GetSpaceLabels returns the labels of the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsOf(space.Metadata), warnings, nil
+}
+
+// GetServiceInstanceLabels returns the labels of the service instance with the
+// given name in the given space.
+func (actor Actor) GetServiceInstanceLabels(serviceInstanceName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsOf(serviceInstance.Metadata), warnings, nil
+}
+
+// UpdateApplicationLabelsByApplicationName merges the provided labels into
+// the labels of the application with the given name in the given space.
+// Labels set to an unset types.NullString are removed.
+func (actor Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateApplication(ccv3.Application{
+		GUID:     app.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+	return append(warnings, updateWarnings...), err
+}
+
+// UpdateOrganizationLabelsByOrganizationName merges the provided labels into
+// the labels of the organization with the given name. Labels set to an unset
+// types.NullString are removed.
+func (actor Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateOrganization(ccv3.Organization{
+		GUID:     org.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+	return append(warnings, updateWarnings...), err
+}
+
+// UpdateSpaceLabelsBySpaceName merges the provided labels into the labels of
+// the space with the given name in the given organization. Labels set to an
+// unset types.NullString are removed.
+func (actor Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateSpace(ccv3.Space{
+		GUID:     space.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+	return append(warnings, updateWarnings...), err
+}
+
+// UpdateServiceInstanceLabelsByServiceInstanceName merges the provided labels
+// into the labels of the service instance with the given name in the given
+// space. Labels set to an unset types.NullString are removed.
+func (actor Actor) UpdateServiceInstanceLabelsByServiceInstanceName(serviceInstanceName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateServiceInstance(ccv3.ServiceInstance{
+		GUID:     serviceInstance.GUID,
+		Metadata: &ccv3.Metadata{Labels: labels},
+	})
+	return append(warnings, updateWarnings...), err
+}
+
+func labelsOf(metadata *ccv3.Metadata) map[string]types.NullString {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.Labels
+}
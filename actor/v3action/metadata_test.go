@@ -0,0 +1,289 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metadata Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		labels                    map[string]types.NullString
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationLabels", func() {
+		JustBeforeEach(func() {
+			labels, warnings, executeErr = actor.GetApplicationLabels("some-app-name", "some-space-guid")
+		})
+
+		Context("when the application has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						GUID: "some-app-guid",
+						Metadata: &ccv3.Metadata{
+							Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+						},
+					}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(labels).To(Equal(map[string]types.NullString{"team": types.NewNullString("payments")}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		Context("when the application has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns no labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(labels).To(BeEmpty())
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{
+					GUID: "some-org-guid",
+					Metadata: &ccv3.Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				}},
+				ccv3.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels of the organization and warnings", func() {
+			labels, warnings, executeErr = actor.GetOrganizationLabels("some-org-name")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+		})
+	})
+
+	Describe("GetSpaceLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{
+					GUID: "some-space-guid",
+					Metadata: &ccv3.Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				}},
+				ccv3.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels of the space and warnings", func() {
+			labels, warnings, executeErr = actor.GetSpaceLabels("some-space-name", "some-org-guid")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space-name"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+		})
+	})
+
+	Describe("GetServiceInstanceLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{
+					GUID: "some-service-instance-guid",
+					Metadata: &ccv3.Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				}},
+				ccv3.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels of the service instance and warnings", func() {
+			labels, warnings, executeErr = actor.GetServiceInstanceLabels("some-service-instance-name", "some-space-guid")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+		})
+	})
+
+	Describe("UpdateApplicationLabelsByApplicationName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{
+				"team": types.NewNullString("payments"),
+				"env":  {},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationLabelsByApplicationName("some-app-name", "some-space-guid", labels)
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the labels of the application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv3.Application{
+					GUID:     "some-app-guid",
+					Metadata: &ccv3.Metadata{Labels: labels},
+				}))
+			})
+
+			Context("when updating the application fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("update-error"))
+					Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+				})
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and does not update anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationLabelsByOrganizationName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"env": {}}
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{GUID: "some-org-guid"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateOrganizationReturns(ccv3.Organization{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the organization", func() {
+			warnings, executeErr = actor.UpdateOrganizationLabelsByOrganizationName("some-org-name", labels)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateOrganizationArgsForCall(0)).To(Equal(ccv3.Organization{
+				GUID:     "some-org-guid",
+				Metadata: &ccv3.Metadata{Labels: labels},
+			}))
+		})
+	})
+
+	Describe("UpdateSpaceLabelsBySpaceName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"env": types.NewNullString("prod")}
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{GUID: "some-space-guid"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateSpaceReturns(ccv3.Space{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the space", func() {
+			warnings, executeErr = actor.UpdateSpaceLabelsBySpaceName("some-space-name", "some-org-guid", labels)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateSpaceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateSpaceArgsForCall(0)).To(Equal(ccv3.Space{
+				GUID:     "some-space-guid",
+				Metadata: &ccv3.Metadata{Labels: labels},
+			}))
+		})
+	})
+
+	Describe("UpdateServiceInstanceLabelsByServiceInstanceName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"env": types.NewNullString("prod")}
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{GUID: "some-service-instance-guid"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceReturns(ccv3.ServiceInstance{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the service instance", func() {
+			warnings, executeErr = actor.UpdateServiceInstanceLabelsByServiceInstanceName("some-service-instance-name", "some-space-guid", labels)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateServiceInstanceArgsForCall(0)).To(Equal(ccv3.ServiceInstance{
+				GUID:     "some-service-instance-guid",
+				Metadata: &ccv3.Metadata{Labels: labels},
+			}))
+		})
+	})
+})
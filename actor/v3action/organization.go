@@ -23,3 +23,24 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
+
+// GetOrganizationsByGUIDs returns the organizations with the given GUIDs that
+// the user can see.
+func (actor Actor) GetOrganizationsByGUIDs(orgGUIDs ...string) ([]Organization, Warnings, error) {
+	if len(orgGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: orgGUIDs},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var orgs []Organization
+	for _, ccOrg := range ccOrgs {
+		orgs = append(orgs, Organization(ccOrg))
+	}
+	return orgs, Warnings(warnings), nil
+}
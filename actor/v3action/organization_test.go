@@ -88,4 +88,30 @@ var _ = Describe("Organization Actions", func() {
 			Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org-name"}))
 		})
 	})
+
+	Describe("GetOrganizationsByGUIDs", func() {
+		It("returns the organizations with the given GUIDs and warnings", func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{Name: "org-1", GUID: "org-guid-1"}},
+				ccv3.Warnings{"some-warning"},
+				nil)
+
+			orgs, warnings, err := actor.GetOrganizationsByGUIDs("org-guid-1", "org-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(Equal([]Organization{{Name: "org-1", GUID: "org-guid-1"}}))
+			Expect(warnings).To(ConsistOf("some-warning"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"org-guid-1", "org-guid-2"}},
+			))
+		})
+
+		It("does not make a request when no GUIDs are given", func() {
+			orgs, _, err := actor.GetOrganizationsByGUIDs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -206,3 +206,21 @@ func (actor Actor) UploadBitsPackage(pkg Package, existingResources []sharedacti
 	appPkg, warnings, err := actor.CloudControllerClient.UploadBitsPackage(ccv3.Package(pkg), apiResources, newResources, newResourcesLength)
 	return Package(appPkg), Warnings(warnings), err
 }
+
+// GetPackagesByApplication returns all the packages of the provided
+// application.
+func (actor Actor) GetPackagesByApplication(appGUID string) ([]Package, Warnings, error) {
+	ccv3Packages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var packages []Package
+	for _, ccv3Package := range ccv3Packages {
+		packages = append(packages, Package(ccv3Package))
+	}
+
+	return packages, Warnings(warnings), nil
+}
@@ -825,4 +825,45 @@ var _ = Describe("Package Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetPackagesByApplication", func() {
+		Context("when getting the packages succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{GUID: "some-package-guid", State: constant.PackageReady},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns the packages and warnings", func() {
+				packages, warnings, err := actor.GetPackagesByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(packages).To(ConsistOf(Package{GUID: "some-package-guid", State: constant.PackageReady}))
+
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		Context("when getting the packages fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get packages error")
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetPackagesByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+	})
 })
@@ -21,3 +21,19 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// GetProcessesByApplication returns all the processes of the provided
+// application.
+func (actor Actor) GetProcessesByApplication(appGUID string) ([]Process, Warnings, error) {
+	ccv3Processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var processes []Process
+	for _, ccv3Process := range ccv3Processes {
+		processes = append(processes, Process(ccv3Process))
+	}
+
+	return processes, Warnings(warnings), nil
+}
@@ -98,4 +98,47 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessesByApplication", func() {
+		Context("when getting the processes succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "web-process-guid", Type: constant.ProcessTypeWeb},
+						{GUID: "worker-process-guid", Type: "worker"},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("returns the processes and warnings", func() {
+				processes, warnings, err := actor.GetProcessesByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+				Expect(processes).To(ConsistOf(
+					Process{GUID: "web-process-guid", Type: constant.ProcessTypeWeb},
+					Process{GUID: "worker-process-guid", Type: "worker"},
+				))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetProcessesByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
 })
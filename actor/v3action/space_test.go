@@ -220,4 +220,38 @@ var _ = Describe("Space", func() {
 		})
 
 	})
+
+	Describe("GetSpacesByGUIDs", func() {
+		It("returns the spaces with the given GUIDs and warnings", func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{Name: "space-1", GUID: "space-guid-1"}},
+				ccv3.Warnings{"some-space-warning"},
+				nil)
+
+			spaces, warnings, err := actor.GetSpacesByGUIDs("space-guid-1", "space-guid-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(Equal([]Space{{Name: "space-1", GUID: "space-guid-1"}}))
+			Expect(warnings).To(ConsistOf("some-space-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"space-guid-1", "space-guid-2"}},
+			))
+		})
+
+		It("does not make a request when no GUIDs are given", func() {
+			spaces, _, err := actor.GetSpacesByGUIDs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+		})
+
+		It("returns the error and warnings when the request fails", func() {
+			fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-space-warning"}, errors.New("cannot get spaces"))
+
+			_, warnings, err := actor.GetSpacesByGUIDs("space-guid-1")
+			Expect(err).To(MatchError("cannot get spaces"))
+			Expect(warnings).To(ConsistOf("some-space-warning"))
+		})
+	})
 })
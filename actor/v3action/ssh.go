@@ -33,26 +33,11 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		return SSHAuthentication{}, Warnings{}, err
 	}
 
-	appSummary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	processSummary, warnings, err := actor.getStartedProcessSummary(appName, spaceGUID, processType)
 	if err != nil {
 		return SSHAuthentication{}, warnings, err
 	}
 
-	var processSummary ProcessSummary
-	for _, appProcessSummary := range appSummary.ProcessSummaries {
-		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
-		}
-	}
-	if processSummary.GUID == "" {
-		return SSHAuthentication{}, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
-	}
-
-	if !appSummary.Application.Started() {
-		return SSHAuthentication{}, warnings, actionerror.ApplicationNotStartedError{Name: appName}
-	}
-
 	var processInstance ProcessInstance
 	for _, instance := range processSummary.InstanceDetails {
 		if uint(instance.Index) == processIndex {
@@ -77,3 +62,74 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex),
 	}, warnings, err
 }
+
+// GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType returns
+// back the SSH authentication information for every running instance of the
+// process, each with its own one-time passcode.
+func (actor Actor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(
+	appName string, spaceGUID string, processType string,
+) ([]SSHAuthentication, Warnings, error) {
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return nil, nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return nil, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	processSummary, warnings, err := actor.getStartedProcessSummary(appName, spaceGUID, processType)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var sshAuths []SSHAuthentication
+	for _, instance := range processSummary.InstanceDetails {
+		if !instance.Running() {
+			continue
+		}
+
+		passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+		if err != nil {
+			return nil, warnings, err
+		}
+
+		sshAuths = append(sshAuths, SSHAuthentication{
+			Endpoint:           endpoint,
+			HostKeyFingerprint: fingerprint,
+			Passcode:           passcode,
+			Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, instance.Index),
+		})
+	}
+
+	if len(sshAuths) == 0 {
+		return nil, warnings, actionerror.ProcessHasNoRunningInstancesError{ProcessType: processType}
+	}
+
+	return sshAuths, warnings, nil
+}
+
+func (actor Actor) getStartedProcessSummary(appName string, spaceGUID string, processType string) (ProcessSummary, Warnings, error) {
+	appSummary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ProcessSummary{}, warnings, err
+	}
+
+	var processSummary ProcessSummary
+	for _, appProcessSummary := range appSummary.ProcessSummaries {
+		if appProcessSummary.Type == processType {
+			processSummary = appProcessSummary
+			break
+		}
+	}
+	if processSummary.GUID == "" {
+		return ProcessSummary{}, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	}
+
+	if !appSummary.Application.Started() {
+		return ProcessSummary{}, warnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	return processSummary, warnings, nil
+}
@@ -177,4 +177,99 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType", func() {
+		var sshAuths []SSHAuthentication
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeUAAClient.GetSSHPasscodeReturnsOnCall(0, "some-ssh-passcode-1", nil)
+			fakeUAAClient.GetSSHPasscodeReturnsOnCall(1, "some-ssh-passcode-2", nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuths, warnings, executeErr = actor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type")
+		})
+
+		Context("when the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("creates an ssh-endpoint-not-set error", func() {
+				Expect(executeErr).To(MatchError("SSH endpoint not set"))
+			})
+		})
+
+		Context("when some of the process instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning, Index: 0},
+					{State: constant.ProcessInstanceDown, Index: 1},
+					{State: constant.ProcessInstanceRunning, Index: 2},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns the authentication information for each running instance with its own passcode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(sshAuths).To(Equal([]SSHAuthentication{
+					{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Passcode:           "some-ssh-passcode-1",
+						Username:           "cf:some-process-guid/0",
+					},
+					{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Passcode:           "some-ssh-passcode-2",
+						Username:           "cf:some-process-guid/2",
+					},
+				}))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(2))
+			})
+
+			Context("when getting a passcode errors", func() {
+				BeforeEach(func() {
+					fakeUAAClient.GetSSHPasscodeReturnsOnCall(1, "", errors.New("some-ssh-passcode-error"))
+				})
+
+				It("returns all warnings and the error", func() {
+					Expect(executeErr).To(MatchError("some-ssh-passcode-error"))
+					Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+				})
+			})
+		})
+
+		Context("when none of the process instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceDown, Index: 0},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a ProcessHasNoRunningInstancesError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessHasNoRunningInstancesError{ProcessType: "some-process-type"}))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+	})
 })
@@ -4,10 +4,12 @@ import (
 	"strconv"
 
 	"sort"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 // Task represents a V3 actor Task.
@@ -69,3 +71,43 @@ func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return Task(task), Warnings(warnings), err
 }
+
+// PollTask polls the task until it has succeeded or failed, returning a
+// TaskFailedError if it failed. If the task has not finished within the
+// timeout it is cancelled, and a TaskTimeoutError is returned. A zero timeout
+// waits forever.
+func (actor Actor) PollTask(task Task, timeout time.Duration) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		ccTask, warnings, err := actor.CloudControllerClient.GetTask(task.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+		task = Task(ccTask)
+
+		switch task.State {
+		case constant.TaskSucceeded:
+			return task, allWarnings, nil
+		case constant.TaskFailed:
+			return task, allWarnings, actionerror.TaskFailedError{Name: task.Name, FailureReason: task.FailureReason}
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			_, cancelWarnings, err := actor.TerminateTask(task.GUID)
+			allWarnings = append(allWarnings, cancelWarnings...)
+			if err != nil {
+				return task, allWarnings, err
+			}
+			return task, allWarnings, actionerror.TaskTimeoutError{Name: task.Name, Timeout: timeout}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
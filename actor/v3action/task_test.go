@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
@@ -304,4 +305,101 @@ var _ = Describe("Task Actions", func() {
 			})
 		})
 	})
+
+	Describe("PollTask", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+			timeout    time.Duration
+
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+			timeout = 0
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.PollTask(Task{GUID: "some-task-guid", Name: "some-task"}, timeout)
+		})
+
+		Context("when the task succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturnsOnCall(0, ccv3.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskPending}, ccv3.Warnings{"get-task-warning-1"}, nil)
+				fakeCloudControllerClient.GetTaskReturnsOnCall(1, ccv3.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskRunning}, ccv3.Warnings{"get-task-warning-2"}, nil)
+				fakeCloudControllerClient.GetTaskReturnsOnCall(2, ccv3.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskSucceeded}, ccv3.Warnings{"get-task-warning-3"}, nil)
+			})
+
+			It("polls until the task has succeeded and returns it with all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskSucceeded}))
+				Expect(warnings).To(ConsistOf("get-task-warning-1", "get-task-warning-2", "get-task-warning-3"))
+
+				Expect(fakeCloudControllerClient.GetTaskCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetTaskArgsForCall(0)).To(Equal("some-task-guid"))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(ccv3.Task{
+					GUID:          "some-task-guid",
+					Name:          "some-task",
+					State:         constant.TaskFailed,
+					FailureReason: "Exited with status 1",
+				}, ccv3.Warnings{"get-task-warning"}, nil)
+			})
+
+			It("returns a TaskFailedError with the failure reason", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Name: "some-task", FailureReason: "Exited with status 1"}))
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+			})
+		})
+
+		Context("when getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(ccv3.Task{}, ccv3.Warnings{"get-task-warning"}, errors.New("get-task-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-task-error"))
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+			})
+		})
+
+		Context("when the task does not finish within the timeout", func() {
+			BeforeEach(func() {
+				timeout = time.Nanosecond
+				fakeCloudControllerClient.GetTaskStub = func(string) (ccv3.Task, ccv3.Warnings, error) {
+					time.Sleep(time.Millisecond)
+					return ccv3.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskRunning}, ccv3.Warnings{"get-task-warning"}, nil
+				}
+				fakeCloudControllerClient.UpdateTaskCancelReturns(ccv3.Task{}, ccv3.Warnings{"cancel-task-warning"}, nil)
+			})
+
+			It("cancels the task and returns a TaskTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskTimeoutError{Name: "some-task", Timeout: time.Nanosecond}))
+				Expect(warnings).To(ConsistOf("get-task-warning", "cancel-task-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateTaskCancelCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelArgsForCall(0)).To(Equal("some-task-guid"))
+			})
+
+			Context("when cancelling the task fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateTaskCancelReturns(ccv3.Task{}, ccv3.Warnings{"cancel-task-warning"}, errors.New("cancel-task-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("cancel-task-error"))
+					Expect(warnings).To(ConsistOf("get-task-warning", "cancel-task-warning"))
+				})
+			})
+		})
+	})
 })
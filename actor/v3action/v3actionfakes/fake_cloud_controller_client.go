@@ -28,6 +28,19 @@ type FakeCloudControllerClient struct {
 	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CancelDeploymentStub        func(deploymentGUID string) (ccv3.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -52,6 +65,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -323,6 +352,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(guid string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		guid string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -518,6 +562,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		taskGUID string
+	}
+	getTaskReturns struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	MakeRawRequestStub        func(method string, path string, body []byte) (ccv3.RawResponse, ccv3.Warnings, error)
+	makeRawRequestMutex       sync.RWMutex
+	makeRawRequestArgsForCall []struct {
+		method string
+		path   string
+		body   []byte
+	}
+	makeRawRequestReturns struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
+	makeRawRequestReturnsOnCall map[int]struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
 	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
@@ -658,6 +734,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateOrganizationStub        func(org ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		org ccv3.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateOrganizationDefaultIsolationSegmentRelationshipStub        func(orgGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateOrganizationDefaultIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall []struct {
@@ -674,6 +765,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateServiceInstanceStub        func(serviceInstance ccv3.ServiceInstance) (ccv3.ServiceInstance, ccv3.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		serviceInstance ccv3.ServiceInstance
+	}
+	updateServiceInstanceReturns struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceStub        func(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
+	updateSpaceMutex       sync.RWMutex
+	updateSpaceArgsForCall []struct {
+		space ccv3.Space
+	}
+	updateSpaceReturns struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceIsolationSegmentRelationshipStub        func(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateSpaceIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateSpaceIsolationSegmentRelationshipArgsForCall []struct {
@@ -823,6 +944,57 @@ func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) CancelDeployment(deploymentGUID string) (ccv3.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -917,6 +1089,61 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID, fake.createApplicationDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -1891,6 +2118,60 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(guid string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetDeployment", []interface{}{guid})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -2593,6 +2874,121 @@ func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("GetTask", []interface{}{taskGUID})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(taskGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTaskReturns.result1, fake.getTaskReturns.result2, fake.getTaskReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTaskArgsForCall(i int) string {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return fake.getTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturnsOnCall(i int, result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequest(method string, path string, body []byte) (ccv3.RawResponse, ccv3.Warnings, error) {
+	var bodyCopy []byte
+	if body != nil {
+		bodyCopy = make([]byte, len(body))
+		copy(bodyCopy, body)
+	}
+	fake.makeRawRequestMutex.Lock()
+	ret, specificReturn := fake.makeRawRequestReturnsOnCall[len(fake.makeRawRequestArgsForCall)]
+	fake.makeRawRequestArgsForCall = append(fake.makeRawRequestArgsForCall, struct {
+		method string
+		path   string
+		body   []byte
+	}{method, path, bodyCopy})
+	fake.recordInvocation("MakeRawRequest", []interface{}{method, path, bodyCopy})
+	fake.makeRawRequestMutex.Unlock()
+	if fake.MakeRawRequestStub != nil {
+		return fake.MakeRawRequestStub(method, path, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeRawRequestReturns.result1, fake.makeRawRequestReturns.result2, fake.makeRawRequestReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestCallCount() int {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return len(fake.makeRawRequestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestArgsForCall(i int) (string, string, []byte) {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return fake.makeRawRequestArgsForCall[i].method, fake.makeRawRequestArgsForCall[i].path, fake.makeRawRequestArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturns(result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	fake.makeRawRequestReturns = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturnsOnCall(i int, result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	if fake.makeRawRequestReturnsOnCall == nil {
+		fake.makeRawRequestReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RawResponse
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.makeRawRequestReturnsOnCall[i] = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
@@ -3093,6 +3489,60 @@ func (fake *FakeCloudControllerClient) UpdateApplicationStopReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganization(org ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		org ccv3.Organization
+	}{org})
+	fake.recordInvocation("UpdateOrganization", []interface{}{org})
+	fake.updateOrganizationMutex.Unlock()
+	if fake.UpdateOrganizationStub != nil {
+		return fake.UpdateOrganizationStub(org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationReturns.result1, fake.updateOrganizationReturns.result2, fake.updateOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationArgsForCall(i int) ccv3.Organization {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return fake.updateOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturns(result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturnsOnCall(i int, result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Organization
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegmentRelationship(orgGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationDefaultIsolationSegmentRelationshipReturnsOnCall[len(fake.updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall)]
@@ -3148,6 +3598,114 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegment
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateServiceInstance(serviceInstance ccv3.ServiceInstance) (ccv3.ServiceInstance, ccv3.Warnings, error) {
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		serviceInstance ccv3.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{serviceInstance})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateServiceInstanceReturns.result1, fake.updateServiceInstanceReturns.result2, fake.updateServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceArgsForCall(i int) ccv3.ServiceInstance {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return fake.updateServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturns(result1 ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceReturnsOnCall(i int, result1 ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServiceInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpace(space ccv3.Space) (ccv3.Space, ccv3.Warnings, error) {
+	fake.updateSpaceMutex.Lock()
+	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
+	fake.updateSpaceArgsForCall = append(fake.updateSpaceArgsForCall, struct {
+		space ccv3.Space
+	}{space})
+	fake.recordInvocation("UpdateSpace", []interface{}{space})
+	fake.updateSpaceMutex.Unlock()
+	if fake.UpdateSpaceStub != nil {
+		return fake.UpdateSpaceStub(space)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceReturns.result1, fake.updateSpaceReturns.result2, fake.updateSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceCallCount() int {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return len(fake.updateSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceArgsForCall(i int) ccv3.Space {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return fake.updateSpaceArgsForCall[i].space
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturns(result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	fake.updateSpaceReturns = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturnsOnCall(i int, result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceStub = nil
+	if fake.updateSpaceReturnsOnCall == nil {
+		fake.updateSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpaceIsolationSegmentRelationship(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateSpaceIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateSpaceIsolationSegmentRelationshipReturnsOnCall[len(fake.updateSpaceIsolationSegmentRelationshipArgsForCall)]
@@ -3381,10 +3939,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.appSSHEndpointMutex.RUnlock()
 	fake.appSSHHostKeyFingerprintMutex.RLock()
 	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -3421,6 +3983,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
@@ -3447,6 +4011,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -3465,8 +4033,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStartMutex.RUnlock()
 	fake.updateApplicationStopMutex.RLock()
 	defer fake.updateApplicationStopMutex.RUnlock()
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
 	fake.updateSpaceIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateSpaceIsolationSegmentRelationshipMutex.RUnlock()
 	fake.updateTaskCancelMutex.RLock()
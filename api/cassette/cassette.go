@@ -0,0 +1,308 @@
+// Package cassette records the requests made to the Cloud Controller and UAA
+// along with their responses, and plays the responses back in place of the
+// servers. This allows commands and plugins to be run end to end, and
+// deterministically, without a Cloud Foundry deployment.
+//
+// Requests are matched to recorded interactions on their method, path and
+// query, ignoring the order of the query parameters. Each interaction is
+// played back at most once, in the order it was recorded, so a request that is
+// repeated, such as polling a job, gets the responses in the order the server
+// sent them.
+//
+// Credentials in the recorded responses are redacted, so that cassettes can be
+// committed. Each recorded interaction is added to the end of the file as soon
+// as its response is received, without rereading the file.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// Interaction is a recorded request and the response to it.
+type Interaction struct {
+	Method   string   `json:"method"`
+	Host     string   `json:"host"`
+	Path     string   `json:"path"`
+	Query    string   `json:"query,omitempty"`
+	Response Response `json:"response"`
+}
+
+// Response is a recorded response. Bodies that are not valid UTF-8 are stored
+// base64 encoded, which is recorded in BodyEncoding.
+type Response struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Cassette is a file of recorded interactions. It is safe for concurrent use.
+type Cassette struct {
+	path string
+
+	mutex        sync.Mutex
+	interactions []Interaction
+	played       []bool
+}
+
+var tokenSanitizer = regexp.MustCompile(ui.TokenRegexp)
+
+// redactedHeaders are the response headers whose values are never recorded.
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Set-Cookie":          true,
+}
+
+// interactionIndent is the indentation of the interactions in a cassette
+// file.
+const interactionIndent = "    "
+
+// cassetteFileStart and cassetteFileEnd surround the interactions of a
+// cassette file.
+var cassetteFileStart, cassetteFileEnd = cassetteFileBounds()
+
+// appendMutex serializes the interactions added to the files, since the Cloud
+// Controller and UAA clients of a command record to the same file.
+var appendMutex sync.Mutex
+
+// Open returns the cassette stored at path, which is empty if the file does
+// not exist. Cassettes opened from the same file play back its interactions
+// independently of each other.
+func Open(path string) (*Cassette, error) {
+	var file cassetteFile
+	raw, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		err = json.Unmarshal(raw, &file)
+		if err != nil {
+			return nil, InvalidCassetteError{Path: path, Err: err}
+		}
+	}
+
+	return &Cassette{
+		path:         path,
+		interactions: file.Interactions,
+		played:       make([]bool, len(file.Interactions)),
+	}, nil
+}
+
+// Record adds the request and its response to the end of the cassette file,
+// creating the file if it does not exist. Credentials in the response, such as
+// the tokens returned by UAA and the Authorization and Set-Cookie headers, are
+// redacted, since cassettes are meant to be committed. Recorded interactions
+// are not played back by the cassette.
+func (cassette *Cassette) Record(request *http.Request, response *http.Response, body []byte) error {
+	header, body := redactResponse(response.Header, body)
+	recorded := Response{
+		StatusCode: response.StatusCode,
+		Header:     header,
+		Body:       string(body),
+	}
+	if !utf8.Valid(body) {
+		recorded.Body = base64.StdEncoding.EncodeToString(body)
+		recorded.BodyEncoding = "base64"
+	}
+
+	raw, err := json.MarshalIndent(Interaction{
+		Method:   request.Method,
+		Host:     request.URL.Host,
+		Path:     request.URL.Path,
+		Query:    request.URL.RawQuery,
+		Response: recorded,
+	}, interactionIndent, "  ")
+	if err != nil {
+		return err
+	}
+
+	appendMutex.Lock()
+	defer appendMutex.Unlock()
+
+	return appendInteraction(cassette.path, raw)
+}
+
+// Play returns the response of the first interaction matching the request
+// that has not been played yet. It returns an UnmatchedRequestError if there
+// is none.
+func (cassette *Cassette) Play(request *http.Request) (Response, error) {
+	query := normalizeQuery(request.URL.RawQuery)
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	for i, interaction := range cassette.interactions {
+		if cassette.played[i] ||
+			interaction.Method != request.Method ||
+			interaction.Path != request.URL.Path ||
+			normalizeQuery(interaction.Query) != query {
+			continue
+		}
+
+		cassette.played[i] = true
+		return interaction.Response, nil
+	}
+
+	return Response{}, UnmatchedRequestError{
+		Path:   cassette.path,
+		Method: request.Method,
+		URL:    request.URL.String(),
+	}
+}
+
+// NewHTTPClient returns an HTTP client that answers every request with the
+// response, without contacting any server. Like the recorded response,
+// redirects are not followed.
+func NewHTTPClient(response Response) *http.Client {
+	return &http.Client{
+		Transport: responseTransport(response),
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type responseTransport Response
+
+func (transport responseTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		request.Body.Close()
+	}
+
+	body := []byte(transport.Body)
+	if transport.BodyEncoding == "base64" {
+		var err error
+		body, err = base64.StdEncoding.DecodeString(transport.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := http.Header{}
+	for name, values := range transport.Header {
+		header[name] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", transport.StatusCode, http.StatusText(transport.StatusCode)),
+		StatusCode:    transport.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+func cassetteFileBounds() (string, string) {
+	raw, _ := json.MarshalIndent(cassetteFile{Interactions: []Interaction{}}, "", "  ")
+
+	i := bytes.Index(raw, []byte("[]"))
+	return string(raw[:i+1]), "\n  " + string(raw[i+1:]) + "\n"
+}
+
+// appendInteraction adds a marshalled interaction to the cassette file at
+// path. The interaction is written over the end of the file, so that the file
+// stays valid without rereading its interactions.
+func appendInteraction(path string, interaction []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var prefix string
+	if info.Size() == 0 {
+		prefix = cassetteFileStart + "\n" + interactionIndent
+	} else {
+		offset := info.Size() - int64(len(cassetteFileEnd))
+		end := make([]byte, len(cassetteFileEnd))
+		if offset >= 0 {
+			_, err = file.ReadAt(end, offset)
+			if err != nil {
+				return err
+			}
+		}
+		if offset < 0 || string(end) != cassetteFileEnd {
+			return InvalidCassetteError{Path: path, Err: errors.New("it was not recorded by cf")}
+		}
+		_, err = file.Seek(offset, io.SeekStart)
+		if err != nil {
+			return err
+		}
+		prefix = ",\n" + interactionIndent
+	}
+
+	_, err = file.WriteString(prefix + string(interaction) + cassetteFileEnd)
+	return err
+}
+
+// normalizeQuery sorts the query parameters by name, so that queries that
+// only differ in the order of their parameters match.
+func normalizeQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	return values.Encode()
+}
+
+// redactResponse returns a copy of the header and body with their credentials
+// replaced by ui.RedactedValue. JSON bodies are redacted with ui.SanitizeJSON,
+// and any other body only has its bearer tokens redacted.
+func redactResponse(header http.Header, body []byte) (http.Header, []byte) {
+	var redactedHeader http.Header
+	if header != nil {
+		redactedHeader = http.Header{}
+		for name, values := range header {
+			redactedValues := make([]string, len(values))
+			for i, value := range values {
+				if redactedHeaders[http.CanonicalHeaderKey(name)] {
+					redactedValues[i] = ui.RedactedValue
+				} else {
+					redactedValues[i] = tokenSanitizer.ReplaceAllString(value, ui.RedactedValue)
+				}
+			}
+			redactedHeader[name] = redactedValues
+		}
+	}
+
+	if len(body) == 0 || !utf8.Valid(body) {
+		return redactedHeader, body
+	}
+
+	if sanitized, err := ui.SanitizeJSON(body); err == nil {
+		compacted := new(bytes.Buffer)
+		if json.Compact(compacted, sanitized) == nil {
+			return redactedHeader, compacted.Bytes()
+		}
+	}
+	return redactedHeader, tokenSanitizer.ReplaceAll(body, []byte(ui.RedactedValue))
+}
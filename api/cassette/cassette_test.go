@@ -0,0 +1,268 @@
+package cassette_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/cassette"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cassette")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	newRequest := func(method string, url string) *http.Request {
+		request, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return request
+	}
+
+	newResponse := func(statusCode int, header http.Header) *http.Response {
+		return &http.Response{StatusCode: statusCode, Header: header}
+	}
+
+	Describe("Open", func() {
+		Context("when the file is not a cassette", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(path, []byte("not json"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an InvalidCassetteError", func() {
+				_, err := Open(path)
+				Expect(err).To(BeAssignableToTypeOf(InvalidCassetteError{}))
+				Expect(err.Error()).To(HavePrefix(path + " is not a cassette"))
+			})
+		})
+	})
+
+	Describe("Record", func() {
+		var cassette *Cassette
+
+		BeforeEach(func() {
+			var err error
+			cassette, err = Open(path)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		readInteractions := func() []Interaction {
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			var file struct {
+				Interactions []Interaction `json:"interactions"`
+			}
+			Expect(json.Unmarshal(raw, &file)).To(Succeed())
+			return file.Interactions
+		}
+
+		It("adds each interaction to the end of the file", func() {
+			Expect(cassette.Record(
+				newRequest(http.MethodGet, "https://api.example.com/v2/apps?q=name:some-app"),
+				newResponse(http.StatusOK, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+				[]byte(`{"resources":[]}`),
+			)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			firstInteraction := Interaction{
+				Method: http.MethodGet,
+				Host:   "api.example.com",
+				Path:   "/v2/apps",
+				Query:  "q=name:some-app",
+				Response: Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"X-Cf-Warnings": {"some-warning"}},
+					Body:       `{"resources":[]}`,
+				},
+			}
+			Expect(readInteractions()).To(Equal([]Interaction{firstInteraction}))
+
+			otherCassette, err := Open(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherCassette.Record(
+				newRequest(http.MethodPost, "https://uaa.example.com/oauth/token"),
+				newResponse(http.StatusUnauthorized, nil),
+				[]byte{0xff, 0xfe},
+			)).To(Succeed())
+
+			Expect(readInteractions()).To(Equal([]Interaction{
+				firstInteraction,
+				{
+					Method: http.MethodPost,
+					Host:   "uaa.example.com",
+					Path:   "/oauth/token",
+					Response: Response{
+						StatusCode:   http.StatusUnauthorized,
+						Body:         "//4=",
+						BodyEncoding: "base64",
+					},
+				},
+			}))
+		})
+
+		It("redacts the credentials of a token exchange", func() {
+			Expect(cassette.Record(
+				newRequest(http.MethodPost, "https://uaa.example.com/oauth/token"),
+				newResponse(http.StatusOK, http.Header{
+					"Authorization": {"bearer some-access-token"},
+					"Set-Cookie":    {"JSESSIONID=some-session; Path=/"},
+					"X-Echo":        {"bearer some-access-token"},
+				}),
+				[]byte(`{"access_token":"some-access-token","refresh_token":"some-refresh-token","token_type":"bearer","expires_in":599}`),
+			)).To(Succeed())
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).ToNot(ContainSubstring("some-access-token"))
+			Expect(string(raw)).ToNot(ContainSubstring("some-refresh-token"))
+			Expect(string(raw)).ToNot(ContainSubstring("some-session"))
+
+			interactions := readInteractions()
+			Expect(interactions).To(HaveLen(1))
+			response := interactions[0].Response
+			Expect(response.Header).To(Equal(http.Header{
+				"Authorization": {"[PRIVATE DATA HIDDEN]"},
+				"Set-Cookie":    {"[PRIVATE DATA HIDDEN]"},
+				"X-Echo":        {"[PRIVATE DATA HIDDEN]"},
+			}))
+			Expect(response.Body).To(MatchJSON(`{"access_token":"[PRIVATE DATA HIDDEN]","refresh_token":"[PRIVATE DATA HIDDEN]","token_type":"[PRIVATE DATA HIDDEN]","expires_in":599}`))
+		})
+
+		It("does not play back interactions recorded by the same cassette", func() {
+			Expect(cassette.Record(newRequest(http.MethodGet, "https://api.example.com/v2/info"), newResponse(http.StatusOK, nil), nil)).To(Succeed())
+
+			_, err := cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/info"))
+			Expect(err).To(BeAssignableToTypeOf(UnmatchedRequestError{}))
+
+			reopened, err := Open(path)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = reopened.Play(newRequest(http.MethodGet, "https://api.example.com/v2/info"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the file was not recorded by cf", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(path, []byte(`{"interactions": []}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an InvalidCassetteError and leaves the file as it is", func() {
+				err := cassette.Record(newRequest(http.MethodGet, "https://api.example.com/v2/info"), newResponse(http.StatusOK, nil), nil)
+				Expect(err).To(MatchError(InvalidCassetteError{Path: path, Err: errors.New("it was not recorded by cf")}))
+
+				raw, err := ioutil.ReadFile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal(`{"interactions": []}`))
+			})
+		})
+	})
+
+	Describe("Play", func() {
+		var cassette *Cassette
+
+		BeforeEach(func() {
+			raw := `{
+				"interactions": [
+					{"method": "GET", "path": "/v2/jobs/some-job", "response": {"status_code": 200, "body": "queued"}},
+					{"method": "GET", "path": "/v2/apps", "query": "q=name:some-app&results-per-page=50", "response": {"status_code": 200, "body": "some-app"}},
+					{"method": "GET", "path": "/v2/jobs/some-job", "response": {"status_code": 200, "body": "finished"}},
+					{"method": "GET", "path": "/v2/droplet", "response": {"status_code": 200, "body": "//4=", "body_encoding": "base64"}}
+				]
+			}`
+			err := ioutil.WriteFile(path, []byte(raw), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			cassette, err = Open(path)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("matches the query regardless of the order of its parameters", func() {
+			response, err := cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/apps?results-per-page=50&q=name%3Asome-app"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body).To(Equal("some-app"))
+		})
+
+		It("plays back each interaction once, in the order they were recorded", func() {
+			response, err := cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/jobs/some-job"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body).To(Equal("queued"))
+
+			response, err = cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/jobs/some-job"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Body).To(Equal("finished"))
+
+			_, err = cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/jobs/some-job"))
+			Expect(err).To(MatchError(UnmatchedRequestError{
+				Path:   path,
+				Method: http.MethodGet,
+				URL:    "https://api.example.com/v2/jobs/some-job",
+			}))
+		})
+
+		It("does not match requests that differ in method, path or query", func() {
+			_, err := cassette.Play(newRequest(http.MethodDelete, "https://api.example.com/v2/apps?q=name:some-app&results-per-page=50"))
+			Expect(err).To(BeAssignableToTypeOf(UnmatchedRequestError{}))
+
+			_, err = cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v3/apps?q=name:some-app&results-per-page=50"))
+			Expect(err).To(BeAssignableToTypeOf(UnmatchedRequestError{}))
+
+			_, err = cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/apps?q=name:other-app&results-per-page=50"))
+			Expect(err).To(BeAssignableToTypeOf(UnmatchedRequestError{}))
+		})
+
+		Describe("NewHTTPClient", func() {
+			It("answers requests with the decoded response", func() {
+				response, err := cassette.Play(newRequest(http.MethodGet, "https://api.example.com/v2/droplet"))
+				Expect(err).ToNot(HaveOccurred())
+
+				httpResponse, err := NewHTTPClient(response).Do(newRequest(http.MethodGet, "https://api.example.com/v2/droplet"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusOK))
+				Expect(httpResponse.Status).To(Equal("200 OK"))
+
+				body, err := ioutil.ReadAll(httpResponse.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(body).To(Equal([]byte{0xff, 0xfe}))
+			})
+
+			It("does not follow redirects", func() {
+				client := NewHTTPClient(Response{
+					StatusCode: http.StatusFound,
+					Header:     http.Header{"Location": {"https://login.example.com/some-code"}},
+				})
+
+				request, err := http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/authorize", strings.NewReader("some-body"))
+				Expect(err).ToNot(HaveOccurred())
+
+				httpResponse, err := client.Do(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(httpResponse.StatusCode).To(Equal(http.StatusFound))
+				Expect(httpResponse.Header.Get("Location")).To(Equal("https://login.example.com/some-code"))
+			})
+		})
+	})
+})
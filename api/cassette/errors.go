@@ -0,0 +1,25 @@
+package cassette
+
+import "fmt"
+
+// InvalidCassetteError is returned when a cassette file cannot be parsed.
+type InvalidCassetteError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidCassetteError) Error() string {
+	return fmt.Sprintf("%s is not a cassette: %s", e.Path, e.Err)
+}
+
+// UnmatchedRequestError is returned when a request is made that does not
+// match any of the interactions remaining in the cassette being played back.
+type UnmatchedRequestError struct {
+	Path   string
+	Method string
+	URL    string
+}
+
+func (e UnmatchedRequestError) Error() string {
+	return fmt.Sprintf("No unplayed interaction in cassette %s matches %s %s", e.Path, e.Method, e.URL)
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// SecurityGroupNameTakenError is returned when creating a security group with
+// the name of an existing security group.
+type SecurityGroupNameTakenError struct {
+	Message string
+}
+
+func (e SecurityGroupNameTakenError) Error() string {
+	return e.Message
+}
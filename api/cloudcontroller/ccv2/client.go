@@ -112,6 +112,8 @@ type Client struct {
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
 
+	pageRequestConcurrency int
+
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
 	userAgent  string
@@ -132,6 +134,15 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PageRequestConcurrency is the maximum number of pages of a list that are
+	// requested at the same time. Pages are requested one at a time when it is
+	// less than 2.
+	PageRequestConcurrency int
+
+	// RawWrappers apply to the client connection before the errorWrapper, so
+	// they see 4xx and 5xx responses before they are converted to errors.
+	RawWrappers []ConnectionWrapper
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -139,10 +150,17 @@ type Config struct {
 // NewClient returns a new Cloud Controller Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	wrappers := append([]ConnectionWrapper{}, config.RawWrappers...)
+	wrappers = append(wrappers, newErrorWrapper())
+	wrappers = append(wrappers, config.Wrappers...)
+
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		wrappers:           wrappers,
+
+		pageRequestConcurrency: config.PageRequestConcurrency,
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"runtime"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/ccv2fakes"
 
@@ -39,6 +41,36 @@ var _ = Describe("Cloud Controller Client", func() {
 		})
 	})
 
+	Describe("RawWrappers", func() {
+		var rawErr error
+
+		BeforeEach(func() {
+			fakeRawWrapper := new(ccv2fakes.FakeConnectionWrapper)
+			fakeRawWrapper.WrapStub = func(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+				fakeRawWrapper.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					rawErr = innerconnection.Make(request, passedResponse)
+					return rawErr
+				}
+				return fakeRawWrapper
+			}
+
+			client = NewTestClient(Config{RawWrappers: []ConnectionWrapper{fakeRawWrapper}})
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/apps"),
+					RespondWith(http.StatusNotFound, `{"code":10000,"description":"Unknown request","error_code":"CF-NotFound"}`),
+				),
+			)
+		})
+
+		It("wraps the connection before the error responses are converted", func() {
+			_, _, err := client.GetApplications()
+			Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+			Expect(rawErr).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+		})
+	})
+
 	Describe("User Agent", func() {
 		BeforeEach(func() {
 			expectedUserAgent := fmt.Sprintf("CF CLI API V2 Test/Unknown (%s; %s %s)", runtime.Version(), runtime.GOARCH, runtime.GOOS)
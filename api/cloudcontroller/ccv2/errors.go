@@ -89,6 +89,8 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.InvalidRelationError{Message: errorResponse.Description}
 	case "CF-NotStaged":
 		return ccerror.NotStagedError{Message: errorResponse.Description}
+	case "CF-SecurityGroupNameTaken":
+		return ccerror.SecurityGroupNameTakenError{Message: errorResponse.Description}
 	case "CF-ServiceBindingAppServiceTaken":
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
 	default:
@@ -129,6 +129,23 @@ var _ = Describe("Error Wrapper", func() {
 						})
 					})
 
+					Context("when creating a security group with a taken name", func() {
+						BeforeEach(func() {
+							serverResponse = `{
+							"code": 300005,
+							"description": "The security group name is taken: some-group",
+							"error_code": "CF-SecurityGroupNameTaken"
+						}`
+						})
+
+						It("returns a SecurityGroupNameTakenError", func() {
+							_, _, err := client.GetApplications()
+							Expect(err).To(MatchError(ccerror.SecurityGroupNameTakenError{
+								Message: "The security group name is taken: some-group",
+							}))
+						})
+					})
+
 					Context("getting stats for a stopped app", func() {
 						BeforeEach(func() {
 							serverResponse = `{
@@ -3,7 +3,10 @@ package ccv2
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
@@ -12,6 +15,7 @@ import (
 // Controller.
 type PaginatedResources struct {
 	NextURL        string          `json:"next_url"`
+	TotalPages     int             `json:"total_pages"`
 	ResourcesBytes json.RawMessage `json:"resources"`
 	resourceType   reflect.Type
 }
@@ -38,31 +42,88 @@ func (pr PaginatedResources) Resources() ([]interface{}, error) {
 	return contents, err
 }
 
+// paginate requests every page of the list, appending the resources in page
+// order. When the client is configured with a PageRequestConcurrency of 2 or
+// more, it reads total_pages from the first page and requests the remaining
+// pages at the same time.
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
-	fullWarningsList := Warnings{}
+	if client.pageRequestConcurrency < 2 {
+		return client.paginateSequentially(request, obj, appendToExternalList)
+	}
 
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
+	firstPage, fullWarningsList, err := client.getPage(request, obj)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	err = appendPage(firstPage, appendToExternalList)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	pageURIs, ok := remainingPageURIs(firstPage.NextURL, firstPage.TotalPages)
+	if !ok {
+		return client.paginateFrom(firstPage, fullWarningsList, obj, appendToExternalList)
+	}
+
+	results := make([]pageResult, len(pageURIs))
+	pageIndexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < client.pageRequestConcurrency && worker < len(pageURIs); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pageIndexes {
+				pageRequest, err := client.newHTTPRequest(requestOptions{
+					URI:    pageURIs[i],
+					Method: http.MethodGet,
+				})
+				if err != nil {
+					results[i] = pageResult{err: err}
+					continue
+				}
+				results[i].wrapper, results[i].warnings, results[i].err = client.getPage(pageRequest, obj)
+			}
+		}()
+	}
+	for i := range pageURIs {
+		pageIndexes <- i
+	}
+	close(pageIndexes)
+	wg.Wait()
+
+	lastPage := firstPage
+	for _, result := range results {
+		fullWarningsList = append(fullWarningsList, result.warnings...)
+		if result.err != nil {
+			return fullWarningsList, result.err
 		}
 
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		err = appendPage(result.wrapper, appendToExternalList)
 		if err != nil {
 			return fullWarningsList, err
 		}
+		lastPage = result.wrapper
+	}
+
+	// Pages added to the list since the first page was requested are requested
+	// one at a time.
+	return client.paginateFrom(lastPage, fullWarningsList, obj, appendToExternalList)
+}
 
-		list, err := wrapper.Resources()
+func (client Client) paginateSequentially(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	fullWarningsList := Warnings{}
+
+	for {
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
+		err = appendPage(wrapper, appendToExternalList)
+		if err != nil {
+			return fullWarningsList, err
 		}
 
 		if wrapper.NextURL == "" {
@@ -80,3 +141,78 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+type pageResult struct {
+	wrapper  *PaginatedResources
+	warnings Warnings
+	err      error
+}
+
+// paginateFrom requests the pages following the given page one at a time.
+func (client Client) paginateFrom(page *PaginatedResources, warnings Warnings, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	if page.NextURL == "" {
+		return warnings, nil
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URI:    page.NextURL,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return warnings, err
+	}
+
+	remainingWarnings, err := client.paginateSequentially(request, obj, appendToExternalList)
+	return append(warnings, remainingWarnings...), err
+}
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+func appendPage(wrapper *PaginatedResources, appendToExternalList func(interface{}) error) error {
+	list, err := wrapper.Resources()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remainingPageURIs returns the URIs of the pages after the first page, built
+// from the URI of the second page. It returns false when the URI does not have
+// a page number to replace.
+func remainingPageURIs(nextURL string, totalPages int) ([]string, bool) {
+	if nextURL == "" || totalPages < 2 {
+		return nil, false
+	}
+
+	nextURI, err := url.Parse(nextURL)
+	if err != nil {
+		return nil, false
+	}
+	query := nextURI.Query()
+	if query.Get("page") != "2" {
+		return nil, false
+	}
+
+	var pageURIs []string
+	for page := 2; page <= totalPages; page++ {
+		query.Set("page", strconv.Itoa(page))
+		nextURI.RawQuery = query.Encode()
+		pageURIs = append(pageURIs, nextURI.String())
+	}
+	return pageURIs, true
+}
@@ -1,9 +1,13 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SecurityGroup represents a Cloud Controller Security Group.
@@ -30,10 +34,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 			GUID  string `json:"guid"`
 			Name  string `json:"name"`
 			Rules []struct {
-				Description string `json:"description"`
-				Destination string `json:"destination"`
-				Ports       string `json:"ports"`
-				Protocol    string `json:"protocol"`
+				Code        types.NullInt `json:"code"`
+				Description string        `json:"description"`
+				Destination string        `json:"destination"`
+				Log         bool          `json:"log"`
+				Ports       string        `json:"ports"`
+				Protocol    string        `json:"protocol"`
+				Type        types.NullInt `json:"type"`
 			} `json:"rules"`
 			RunningDefault bool `json:"running_default"`
 			StagingDefault bool `json:"staging_default"`
@@ -48,16 +55,32 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 	securityGroup.Name = ccSecurityGroup.Entity.Name
 	securityGroup.Rules = make([]SecurityGroupRule, len(ccSecurityGroup.Entity.Rules))
 	for i, ccRule := range ccSecurityGroup.Entity.Rules {
+		securityGroup.Rules[i].Code = ccRule.Code
 		securityGroup.Rules[i].Description = ccRule.Description
 		securityGroup.Rules[i].Destination = ccRule.Destination
+		securityGroup.Rules[i].Log = ccRule.Log
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		securityGroup.Rules[i].Type = ccRule.Type
 	}
 	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
 	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
 	return nil
 }
 
+type securityGroupRequestBody struct {
+	Name  string              `json:"name,omitempty"`
+	Rules []SecurityGroupRule `json:"rules"`
+}
+
+// CreateSecurityGroup creates a security group with the provided name and
+// rules.
+func (client *Client) CreateSecurityGroup(name string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	return client.makeSecurityGroupRequest(requestOptions{
+		RequestName: internal.PostSecurityGroupRequest,
+	}, securityGroupRequestBody{Name: name, Rules: rules})
+}
+
 // DeleteSecurityGroupSpace disassociates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -142,6 +165,15 @@ func (client *Client) GetSpaceStagingSecurityGroups(spaceGUID string, filters ..
 	return client.getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID, internal.GetSpaceStagingSecurityGroupsRequest, filters)
 }
 
+// UpdateSecurityGroup replaces all the rules of the security group, specified
+// by its GUID, with the provided rules.
+func (client *Client) UpdateSecurityGroup(securityGroupGUID string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	return client.makeSecurityGroupRequest(requestOptions{
+		RequestName: internal.PutSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": securityGroupGUID},
+	}, securityGroupRequestBody{Rules: rules})
+}
+
 // UpdateSecurityGroupSpace associates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -211,3 +243,32 @@ func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string
 
 	return securityGroupsList, warnings, err
 }
+
+func (client *Client) makeSecurityGroupRequest(options requestOptions, body securityGroupRequestBody) (SecurityGroup, Warnings, error) {
+	if body.Rules == nil {
+		body.Rules = []SecurityGroupRule{}
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	options.Body = bytes.NewReader(bodyBytes)
+	request, err := client.newHTTPRequest(options)
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return SecurityGroup{}, response.Warnings, err
+	}
+
+	return securityGroup, response.Warnings, nil
+}
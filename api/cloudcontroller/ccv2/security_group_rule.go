@@ -1,16 +1,61 @@
 package ccv2
 
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
 // SecurityGroupRule represents a Cloud Controller Security Group Role.
 type SecurityGroupRule struct {
+	// Code is the ICMP code, -1 allows all codes. Only valid for icmp rules.
+	Code types.NullInt
+
 	// Description is a short message discribing the rule.
 	Description string
 
 	// Destination is the destination CIDR or range of IPs.
 	Destination string
 
+	// Log enables logging of connections matching the rule. Only valid for
+	// tcp rules.
+	Log bool
+
 	// Ports is the port or port range.
 	Ports string
 
 	// Protocol can be tcp, icmp, udp, all.
 	Protocol string
+
+	// Type is the ICMP type, -1 allows all types. Only valid for icmp rules.
+	Type types.NullInt
+}
+
+// MarshalJSON converts a security group rule into a Cloud Controller security
+// group rule, omitting the fields that are not set.
+func (rule SecurityGroupRule) MarshalJSON() ([]byte, error) {
+	ccRule := struct {
+		Code        *int   `json:"code,omitempty"`
+		Description string `json:"description,omitempty"`
+		Destination string `json:"destination"`
+		Log         bool   `json:"log,omitempty"`
+		Ports       string `json:"ports,omitempty"`
+		Protocol    string `json:"protocol"`
+		Type        *int   `json:"type,omitempty"`
+	}{
+		Description: rule.Description,
+		Destination: rule.Destination,
+		Log:         rule.Log,
+		Ports:       rule.Ports,
+		Protocol:    rule.Protocol,
+	}
+
+	if rule.Code.IsSet {
+		ccRule.Code = &rule.Code.Value
+	}
+	if rule.Type.IsSet {
+		ccRule.Type = &rule.Type.Value
+	}
+
+	return json.Marshal(ccRule)
 }
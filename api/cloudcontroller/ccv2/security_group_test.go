@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -18,6 +19,86 @@ var _ = Describe("Security Groups", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, executeErr = client.CreateSecurityGroup("some-security-group", []SecurityGroupRule{
+				{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https", Log: true},
+				{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+			})
+		})
+
+		Context("when the security group is created", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"name": "some-security-group",
+					"rules": []map[string]interface{}{
+						{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "443", "description": "https", "log": true},
+						{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1},
+					},
+				}
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "443", "description": "https", "log": true},
+							{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the created security group and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "security-group-guid",
+					Name: "some-security-group",
+					Rules: []SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Description: "https", Log: true},
+						{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+					},
+				}))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 300005,
+  "description": "The security group name is taken: some-security-group",
+  "error_code": "CF-SecurityGroupNameTaken"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns a SecurityGroupNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.SecurityGroupNameTakenError{
+					Message: "The security group name is taken: some-security-group",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("DeleteSecurityGroupSpace", func() {
 		var (
 			warnings Warnings
@@ -615,6 +696,75 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("UpdateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, executeErr = client.UpdateSecurityGroup("security-group-guid", nil)
+		})
+
+		Context("when the rules are replaced", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": []
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						VerifyJSON(`{"rules": []}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the updated security group and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID:  "security-group-guid",
+					Name:  "some-security-group",
+					Rules: []SecurityGroupRule{},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("UpdateSecurityGroupSpace", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -171,6 +171,63 @@ var _ = Describe("Stack", func() {
 					}))
 				})
 			})
+
+			Context("when the client requests pages concurrently", func() {
+				BeforeEach(func() {
+					client = NewTestClient(Config{PageRequestConcurrency: 2})
+
+					page3Requested := make(chan bool)
+					server.RouteToHandler(http.MethodGet, "/v2/stacks", func(w http.ResponseWriter, req *http.Request) {
+						defer GinkgoRecover()
+
+						page := req.URL.Query().Get("page")
+						nextURL := "null"
+						switch page {
+						case "":
+							nextURL = `"/v2/stacks?page=2&q=some-query%3Asome-value"`
+						case "2":
+							// Page 2 is only returned once page 3 has been requested,
+							// which only happens when the pages are requested at the
+							// same time.
+							Eventually(page3Requested).Should(BeClosed())
+							nextURL = `"/v2/stacks?page=3&q=some-query%3Asome-value"`
+						case "3":
+							close(page3Requested)
+						}
+
+						Expect(req.URL.Query().Get("q")).To(Equal("some-query:some-value"))
+						w.Header().Set("X-Cf-Warnings", "warning-"+page)
+						w.WriteHeader(http.StatusOK)
+						_, err := w.Write([]byte(`{
+							"total_pages": 3,
+							"next_url": ` + nextURL + `,
+							"resources": [
+								{
+									"metadata": {"guid": "some-stack-guid` + page + `"},
+									"entity": {"name": "some-stack-name` + page + `"}
+								}
+							]
+						}`))
+						Expect(err).ToNot(HaveOccurred())
+					})
+				})
+
+				It("returns the results and warnings of every page in order", func() {
+					stacks, warnings, err := client.GetStacks(Filter{
+						Type:     "some-query",
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-value"},
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(Equal(Warnings{"warning-", "warning-2", "warning-3"}))
+					Expect(stacks).To(Equal([]Stack{
+						{GUID: "some-stack-guid", Name: "some-stack-name"},
+						{GUID: "some-stack-guid2", Name: "some-stack-name2"},
+						{GUID: "some-stack-guid3", Name: "some-stack-name3"},
+					}))
+				})
+			})
 		})
 
 		Context("when an error is encountered", func() {
@@ -18,6 +18,8 @@ type Application struct {
 	LifecycleBuildpacks []string `json:"-"`
 	// LifecycleType is the type of the lifecycle.
 	LifecycleType constant.AppLifecycleType `json:"-"`
+	// Metadata is the labels and annotations of the application.
+	Metadata *Metadata `json:"metadata,omitempty"`
 	// Name is the name given to the application.
 	Name string `json:"name,omitempty"`
 	// Relationships list the relationships to the application.
@@ -126,7 +128,7 @@ func (client *Client) GetApplications(query ...Query) ([]Application, Warnings,
 	}
 
 	var fullAppsList []Application
-	warnings, err := client.paginate(request, Application{}, func(item interface{}) error {
+	warnings, err := client.paginateConcurrently(request, Application{}, func(item interface{}) error {
 		if app, ok := item.(Application); ok {
 			fullAppsList = append(fullAppsList, app)
 		} else {
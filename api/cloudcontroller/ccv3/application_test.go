@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -405,6 +406,52 @@ var _ = Describe("Application", func() {
 			})
 		})
 
+		Context("when filtering applications by labels", func() {
+			BeforeEach(func() {
+				response := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "app-name-1",
+			"guid": "app-guid-1",
+			"metadata": {
+				"labels": {
+					"team": "payments"
+				}
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "label_selector=team%3Dpayments%2Cenv%21%3Dprod"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				filters = []Query{
+					{Key: LabelSelectorFilter, Values: []string{"team=payments,env!=prod"}},
+				}
+			})
+
+			It("sends the label selector and returns the applications with their labels", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name: "app-name-1",
+						GUID: "app-guid-1",
+						Metadata: &Metadata{
+							Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+						},
+					},
+				))
+			})
+		})
+
 		Context("when the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -524,6 +571,51 @@ var _ = Describe("Application", func() {
 			})
 		})
 
+		Context("when updating the application's labels", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-app-guid",
+					"name": "some-app-name",
+					"metadata": {
+						"labels": {
+							"team": "payments"
+						},
+						"annotations": {
+							"owner": "someone"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						VerifyJSON(`{"metadata": {"labels": {"team": "payments", "env": null}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				appToUpdate = Application{
+					GUID: "some-app-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"team": types.NewNullString("payments"),
+							"env":  {},
+						},
+					},
+				}
+			})
+
+			It("only sends the metadata and returns the updated labels and annotations", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(updatedApp.Metadata).To(Equal(&Metadata{
+					Labels:      map[string]types.NullString{"team": types.NewNullString("payments")},
+					Annotations: map[string]types.NullString{"owner": types.NewNullString("someone")},
+				}))
+			})
+		})
+
 		Context("when cc returns back an error or warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -97,6 +97,8 @@ type Client struct {
 
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
+
+	pageRequestConcurrency int
 }
 
 // Config allows the Client to be configured
@@ -113,6 +115,15 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PageRequestConcurrency is the maximum number of pages of a list that are
+	// requested at the same time. Pages are requested one at a time when it is
+	// less than 2.
+	PageRequestConcurrency int
+
+	// RawWrappers apply to the client connection before the errorWrapper, so
+	// they see 4xx and 5xx responses before they are converted to errors.
+	RawWrappers []ConnectionWrapper
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -120,10 +131,17 @@ type Config struct {
 // NewClient returns a new Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	wrappers := append([]ConnectionWrapper{}, config.RawWrappers...)
+	wrappers = append(wrappers, newErrorWrapper())
+	wrappers = append(wrappers, config.Wrappers...)
+
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		wrappers:           wrappers,
+
+		pageRequestConcurrency: config.PageRequestConcurrency,
 	}
 }
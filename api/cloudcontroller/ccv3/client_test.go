@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"runtime"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/ccv3fakes"
 
@@ -39,6 +41,36 @@ var _ = Describe("Cloud Controller Client", func() {
 		})
 	})
 
+	Describe("RawWrappers", func() {
+		var rawErr error
+
+		BeforeEach(func() {
+			fakeRawWrapper := new(ccv3fakes.FakeConnectionWrapper)
+			fakeRawWrapper.WrapStub = func(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+				fakeRawWrapper.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					rawErr = innerconnection.Make(request, passedResponse)
+					return rawErr
+				}
+				return fakeRawWrapper
+			}
+
+			client = NewTestClient(Config{RawWrappers: []ConnectionWrapper{fakeRawWrapper}})
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps"),
+					RespondWith(http.StatusNotFound, `{"errors":[{"code":10010,"detail":"Space not found","title":"CF-ResourceNotFound"}]}`),
+				),
+			)
+		})
+
+		It("wraps the connection before the error responses are converted", func() {
+			_, _, err := client.GetApplications()
+			Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space not found"}))
+			Expect(rawErr).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+		})
+	})
+
 	Describe("User Agent", func() {
 		BeforeEach(func() {
 			expectedUserAgent := fmt.Sprintf("CF CLI API V3 Test/Unknown (%s; %s %s)", runtime.Version(), runtime.GOARCH, runtime.GOOS)
@@ -0,0 +1,29 @@
+package constant
+
+// DeploymentState represents the current state of the deployment.
+type DeploymentState string
+
+const (
+	// DeploymentDeploying is when the deployment is replacing the old
+	// instances with new ones.
+	DeploymentDeploying DeploymentState = "DEPLOYING"
+	// DeploymentDeployed is when all of the old instances have been replaced.
+	DeploymentDeployed DeploymentState = "DEPLOYED"
+	// DeploymentCanceling is when the deployment is rolling back to the
+	// previous droplet.
+	DeploymentCanceling DeploymentState = "CANCELING"
+	// DeploymentCanceled is when the deployment has finished rolling back to
+	// the previous droplet.
+	DeploymentCanceled DeploymentState = "CANCELED"
+)
+
+// DeploymentStrategy represents how the Cloud Controller replaces the
+// instances of an application.
+type DeploymentStrategy string
+
+const (
+	// DeploymentStrategyDefault stops all instances before starting new ones.
+	DeploymentStrategyDefault DeploymentStrategy = ""
+	// DeploymentStrategyRolling replaces the instances one at a time.
+	DeploymentStrategyRolling DeploymentStrategy = "rolling"
+)
@@ -0,0 +1,167 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Deployment represents the replacement of an application's running
+// instances with instances of a new droplet.
+type Deployment struct {
+	// CreatedAt is the time with zone when the deployment was created.
+	CreatedAt string
+	// DropletGUID is the unique identifier of the droplet being deployed.
+	DropletGUID string
+	// GUID is the unique deployment identifier.
+	GUID string
+	// Relationships list the relationships to the deployment.
+	Relationships Relationships
+	// State is the current state of the deployment.
+	State constant.DeploymentState
+}
+
+// MarshalJSON converts a Deployment into a Cloud Controller Deployment.
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	type Droplet struct {
+		GUID string `json:"guid,omitempty"`
+	}
+
+	var ccDeployment struct {
+		Droplet       *Droplet      `json:"droplet,omitempty"`
+		Relationships Relationships `json:"relationships,omitempty"`
+	}
+
+	if d.DropletGUID != "" {
+		ccDeployment.Droplet = &Droplet{d.DropletGUID}
+	}
+
+	ccDeployment.Relationships = d.Relationships
+
+	return json.Marshal(ccDeployment)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		CreatedAt     string                   `json:"created_at,omitempty"`
+		GUID          string                   `json:"guid,omitempty"`
+		Relationships Relationships            `json:"relationships,omitempty"`
+		State         constant.DeploymentState `json:"state,omitempty"`
+		Droplet       struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
+	if err != nil {
+		return err
+	}
+
+	d.CreatedAt = ccDeployment.CreatedAt
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.GUID = ccDeployment.GUID
+	d.Relationships = ccDeployment.Relationships
+	d.State = ccDeployment.State
+
+	return nil
+}
+
+// CancelDeployment cancels the given deployment, rolling the application
+// back to its previous droplet.
+func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentActionCancelRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// CreateApplicationDeployment starts a rolling deployment of the given droplet
+// for the given application. When dropletGUID is empty, the application's
+// current droplet is deployed.
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	deployment := Deployment{
+		DropletGUID: dropletGUID,
+		Relationships: Relationships{
+			constant.RelationshipTypeApplication: Relationship{GUID: appGUID},
+		},
+	}
+
+	bodyBytes, err := json.Marshal(deployment)
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployments lists deployments with optional filters.
+func (client *Client) GetDeployments(query ...Query) ([]Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullDeploymentsList []Deployment
+	warnings, err := client.paginate(request, Deployment{}, func(item interface{}) error {
+		if deployment, ok := item.(Deployment); ok {
+			fullDeploymentsList = append(fullDeploymentsList, deployment)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Deployment{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDeploymentsList, warnings, err
+}
@@ -0,0 +1,337 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		var (
+			dropletGUID string
+			deployment  Deployment
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid", dropletGUID)
+		})
+
+		Context("when a droplet is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = "some-droplet-guid"
+
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYING",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"created_at": "some-time",
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"droplet": map[string]interface{}{
+						"guid": "some-droplet-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created deployment and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					CreatedAt:   "some-time",
+					DropletGUID: "some-droplet-guid",
+					GUID:        "some-deployment-guid",
+					Relationships: Relationships{
+						constant.RelationshipTypeApplication: Relationship{GUID: "some-app-guid"},
+					},
+					State: constant.DeploymentDeploying,
+				}))
+			})
+		})
+
+		Context("when no droplet is provided", func() {
+			BeforeEach(func() {
+				dropletGUID = ""
+
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "some-deployment-guid"}`),
+					),
+				)
+			})
+
+			It("omits the droplet from the request", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				dropletGUID = "some-droplet-guid"
+
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: command presence"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYED",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					DropletGUID: "some-droplet-guid",
+					GUID:        "some-deployment-guid",
+					State:       constant.DeploymentDeployed,
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Deployment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployments", func() {
+		var (
+			deployments []Deployment
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			deployments, warnings, executeErr = client.GetDeployments(
+				Query{Key: AppGUIDFilter, Values: []string{"some-app-guid"}},
+				Query{Key: StatesFilter, Values: []string{"DEPLOYING"}},
+			)
+		})
+
+		Context("when the CC returns back deployments", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/deployments?app_guids=some-app-guid&states=DEPLOYING&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "some-deployment-guid-1",
+							"state": "DEPLOYING"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "some-deployment-guid-2",
+							"state": "DEPLOYING"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&states=DEPLOYING"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&states=DEPLOYING&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the deployments and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(deployments).To(Equal([]Deployment{
+					{GUID: "some-deployment-guid-1", State: constant.DeploymentDeploying},
+					{GUID: "some-deployment-guid-2", State: constant.DeploymentDeploying},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Some resource not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Some resource not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		Context("when the deployment can be canceled", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("cancels the deployment and returns all warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Cannot cancel a DEPLOYED deployment",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.CancelDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Cannot cancel a DEPLOYED deployment"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
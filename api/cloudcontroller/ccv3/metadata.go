@@ -0,0 +1,14 @@
+package ccv3
+
+import "code.cloudfoundry.org/cli/types"
+
+// Metadata is the labels and annotations of a Cloud Controller V3 resource.
+// When updating a resource, keys set to an unset types.NullString are
+// removed from the resource; keys that are not provided are left untouched.
+type Metadata struct {
+	// Labels are identifying key/value pairs that can be used to select
+	// resources with the LabelSelectorFilter.
+	Labels map[string]types.NullString `json:"labels,omitempty"`
+	// Annotations are non-identifying key/value pairs.
+	Annotations map[string]types.NullString `json:"annotations,omitempty"`
+}
@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
@@ -11,6 +15,21 @@ type Organization struct {
 	GUID string `json:"guid"`
 	// Name is the name of the organization.
 	Name string `json:"name"`
+	// Metadata is the labels and annotations of the organization.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// MarshalJSON converts an Organization into a Cloud Controller Organization.
+func (org Organization) MarshalJSON() ([]byte, error) {
+	var ccOrg struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}
+
+	ccOrg.Name = org.Name
+	ccOrg.Metadata = org.Metadata
+
+	return json.Marshal(ccOrg)
 }
 
 // GetIsolationSegmentOrganizations lists organizations
@@ -51,7 +70,7 @@ func (client *Client) GetOrganizations(query ...Query) ([]Organization, Warnings
 	}
 
 	var fullOrgsList []Organization
-	warnings, err := client.paginate(request, Organization{}, func(item interface{}) error {
+	warnings, err := client.paginateConcurrently(request, Organization{}, func(item interface{}) error {
 		if app, ok := item.(Organization); ok {
 			fullOrgsList = append(fullOrgsList, app)
 		} else {
@@ -65,3 +84,29 @@ func (client *Client) GetOrganizations(query ...Query) ([]Organization, Warnings
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganization updates the name and metadata of the given
+// organization.
+func (client *Client) UpdateOrganization(org Organization) (Organization, Warnings, error) {
+	bodyBytes, err := json.Marshal(org)
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchOrganizationRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		URIParams:   map[string]string{"organization_guid": org.GUID},
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var responseOrg Organization
+	response := cloudcontroller.Response{
+		Result: &responseOrg,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseOrg, response.Warnings, err
+}
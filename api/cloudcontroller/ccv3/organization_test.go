@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -244,4 +245,91 @@ var _ = Describe("Organizations", func() {
 			})
 		})
 	})
+
+	Describe("UpdateOrganization", func() {
+		var (
+			orgToUpdate Organization
+
+			updatedOrganization Organization
+			warnings            Warnings
+			executeErr          error
+		)
+
+		JustBeforeEach(func() {
+			updatedOrganization, warnings, executeErr = client.UpdateOrganization(orgToUpdate)
+		})
+
+		Context("when the organization is successfully updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-org-guid",
+					"name": "some-org-name",
+					"metadata": {
+						"labels": {
+							"team": "payments"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid"),
+						VerifyJSON(`{"metadata": {"labels": {"team": "payments", "env": null}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				orgToUpdate = Organization{
+					GUID: "some-org-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"team": types.NewNullString("payments"),
+							"env":  {},
+						},
+					},
+				}
+			})
+
+			It("returns the updated organization and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(updatedOrganization).To(Equal(Organization{
+					GUID: "some-org-guid",
+					Name: "some-org-name",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Organization not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				orgToUpdate = Organization{GUID: "some-org-guid"}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Organization not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
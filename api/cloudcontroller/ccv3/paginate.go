@@ -2,6 +2,9 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
@@ -10,29 +13,17 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 	fullWarningsList := Warnings{}
 
 	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
-		}
-
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		list, err := wrapper.Resources()
+		err = appendPage(wrapper, appendToExternalList)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
-		}
-
 		if wrapper.NextPage() == "" {
 			break
 		}
@@ -48,3 +39,147 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+type pageResult struct {
+	wrapper  *PaginatedResources
+	warnings Warnings
+	err      error
+}
+
+// paginateConcurrently returns the same resources and warnings as paginate.
+// When the client is configured with a PageRequestConcurrency of 2 or more,
+// it reads total_pages from the first page and requests the remaining pages
+// at the same time, while still appending the resources in page order.
+func (client Client) paginateConcurrently(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	if client.pageRequestConcurrency < 2 {
+		return client.paginate(request, obj, appendToExternalList)
+	}
+
+	firstPage, fullWarningsList, err := client.getPage(request, obj)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	err = appendPage(firstPage, appendToExternalList)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	pageURLs, ok := remainingPageURLs(firstPage.NextPage(), firstPage.TotalPages())
+	if !ok {
+		return client.paginateFrom(firstPage, fullWarningsList, obj, appendToExternalList)
+	}
+
+	results := make([]pageResult, len(pageURLs))
+	pageIndexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < client.pageRequestConcurrency && worker < len(pageURLs); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pageIndexes {
+				pageRequest, err := client.newHTTPRequest(requestOptions{
+					URL:    pageURLs[i],
+					Method: http.MethodGet,
+				})
+				if err != nil {
+					results[i] = pageResult{err: err}
+					continue
+				}
+				results[i].wrapper, results[i].warnings, results[i].err = client.getPage(pageRequest, obj)
+			}
+		}()
+	}
+	for i := range pageURLs {
+		pageIndexes <- i
+	}
+	close(pageIndexes)
+	wg.Wait()
+
+	lastPage := firstPage
+	for _, result := range results {
+		fullWarningsList = append(fullWarningsList, result.warnings...)
+		if result.err != nil {
+			return fullWarningsList, result.err
+		}
+
+		err = appendPage(result.wrapper, appendToExternalList)
+		if err != nil {
+			return fullWarningsList, err
+		}
+		lastPage = result.wrapper
+	}
+
+	// Pages added to the list since the first page was requested are requested
+	// one at a time.
+	return client.paginateFrom(lastPage, fullWarningsList, obj, appendToExternalList)
+}
+
+// paginateFrom requests the pages following the given page one at a time.
+func (client Client) paginateFrom(page *PaginatedResources, warnings Warnings, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	if page.NextPage() == "" {
+		return warnings, nil
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    page.NextPage(),
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return warnings, err
+	}
+
+	remainingWarnings, err := client.paginate(request, obj, appendToExternalList)
+	return append(warnings, remainingWarnings...), err
+}
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+func appendPage(wrapper *PaginatedResources, appendToExternalList func(interface{}) error) error {
+	list, err := wrapper.Resources()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remainingPageURLs returns the URLs of the pages after the first page, built
+// from the link to the second page. It returns false when the link does not
+// have a page number to replace.
+func remainingPageURLs(nextPage string, totalPages int) ([]string, bool) {
+	if nextPage == "" || totalPages < 2 {
+		return nil, false
+	}
+
+	nextURL, err := url.Parse(nextPage)
+	if err != nil {
+		return nil, false
+	}
+	query := nextURL.Query()
+	if query.Get("page") != "2" {
+		return nil, false
+	}
+
+	var pageURLs []string
+	for page := 2; page <= totalPages; page++ {
+		query.Set("page", strconv.Itoa(page))
+		nextURL.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, nextURL.String())
+	}
+	return pageURLs, true
+}
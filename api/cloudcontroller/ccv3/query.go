@@ -13,6 +13,9 @@ const (
 	AppGUIDFilter QueryKey = "app_guids"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
+	// LabelSelectorFilter is a query parameter for listing objects by their
+	// labels, such as "team=payments,env!=prod".
+	LabelSelectorFilter QueryKey = "label_selector"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
@@ -21,6 +24,8 @@ const (
 	SequenceIDFilter QueryKey = "sequence_ids"
 	// SpaceGUIDFilter is a query parameter for listing objects by Space GUID.
 	SpaceGUIDFilter QueryKey = "space_guids"
+	// StatesFilter is a query parameter for listing objects by state.
+	StatesFilter QueryKey = "states"
 
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
@@ -0,0 +1,59 @@
+package ccv3
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RawResponse is a Cloud Controller response that has not been decoded.
+type RawResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header is the HTTP header of the response.
+	Header http.Header
+
+	// Body is the response body.
+	Body []byte
+}
+
+// MakeRawRequest sends a request with the given method and body to path,
+// which is relative to the Cloud Controller URL, and returns the response
+// without decoding it. Unlike other requests, a response with an error status
+// code is returned without an error, so that the caller can inspect it; an
+// error is only returned when no response was received.
+func (client *Client) MakeRawRequest(method string, path string, body []byte) (RawResponse, Warnings, error) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	var requestBody io.ReadSeeker
+	if len(body) > 0 {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: method,
+		URL:    client.cloudControllerURL + path,
+		Body:   requestBody,
+	})
+	if err != nil {
+		return RawResponse{}, nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if response.HTTPResponse == nil {
+		return RawResponse{}, response.Warnings, err
+	}
+
+	return RawResponse{
+		StatusCode: response.HTTPResponse.StatusCode,
+		Header:     response.HTTPResponse.Header,
+		Body:       response.RawResponse,
+	}, response.Warnings, nil
+}
@@ -0,0 +1,114 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Raw Request", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("MakeRawRequest", func() {
+		var (
+			method string
+			path   string
+			body   []byte
+
+			response   RawResponse
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			method = http.MethodGet
+			path = "/v3/apps?names=some-app"
+			body = nil
+		})
+
+		JustBeforeEach(func() {
+			response, warnings, executeErr = client.MakeRawRequest(method, path, body)
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+						RespondWith(http.StatusOK, `{"resources":[]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the undecoded response and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Body).To(MatchJSON(`{"resources":[]}`))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the path does not start with a slash", func() {
+			BeforeEach(func() {
+				path = "v3/apps"
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps"),
+						RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("sends the request relative to the Cloud Controller URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when a body is provided", func() {
+			BeforeEach(func() {
+				method = http.MethodPost
+				path = "/v3/apps"
+				body = []byte(`{"name":"some-app"}`)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps"),
+						VerifyJSON(`{"name":"some-app"}`),
+						RespondWith(http.StatusCreated, `{"guid":"some-app-guid"}`),
+					),
+				)
+			})
+
+			It("sends the body", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(response.Body).To(MatchJSON(`{"guid":"some-app-guid"}`))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error status", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+						RespondWith(http.StatusNotFound, `{"errors":[{"code":10010,"detail":"not found","title":"CF-ResourceNotFound"}]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the response without an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(response.Body).To(ContainSubstring("CF-ResourceNotFound"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
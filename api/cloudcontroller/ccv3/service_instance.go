@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
@@ -11,6 +15,21 @@ type ServiceInstance struct {
 	GUID string `json:"guid"`
 	// Name is the name of the service instance.
 	Name string `json:"name"`
+	// Metadata is the labels and annotations of the service instance.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// MarshalJSON converts a ServiceInstance into a Cloud Controller ServiceInstance.
+func (serviceInstance ServiceInstance) MarshalJSON() ([]byte, error) {
+	var ccServiceInstance struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}
+
+	ccServiceInstance.Name = serviceInstance.Name
+	ccServiceInstance.Metadata = serviceInstance.Metadata
+
+	return json.Marshal(ccServiceInstance)
 }
 
 // GetServiceInstances lists service instances with optional filters.
@@ -24,7 +43,7 @@ func (client *Client) GetServiceInstances(query ...Query) ([]ServiceInstance, Wa
 	}
 
 	var fullServiceInstanceList []ServiceInstance
-	warnings, err := client.paginate(request, ServiceInstance{}, func(item interface{}) error {
+	warnings, err := client.paginateConcurrently(request, ServiceInstance{}, func(item interface{}) error {
 		if serviceInstance, ok := item.(ServiceInstance); ok {
 			fullServiceInstanceList = append(fullServiceInstanceList, serviceInstance)
 		} else {
@@ -38,3 +57,28 @@ func (client *Client) GetServiceInstances(query ...Query) ([]ServiceInstance, Wa
 
 	return fullServiceInstanceList, warnings, err
 }
+
+// UpdateServiceInstance updates the name and metadata of the given service instance.
+func (client *Client) UpdateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstance)
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchServiceInstanceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		URIParams:   map[string]string{"service_instance_guid": serviceInstance.GUID},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var responseServiceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &responseServiceInstance,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseServiceInstance, response.Warnings, err
+}
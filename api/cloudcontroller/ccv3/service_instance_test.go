@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -150,4 +151,91 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("UpdateServiceInstance", func() {
+		var (
+			serviceInstanceToUpdate ServiceInstance
+
+			updatedServiceInstance ServiceInstance
+			warnings               Warnings
+			executeErr             error
+		)
+
+		JustBeforeEach(func() {
+			updatedServiceInstance, warnings, executeErr = client.UpdateServiceInstance(serviceInstanceToUpdate)
+		})
+
+		Context("when the service instance is successfully updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-service-instance-guid",
+					"name": "some-service-instance-name",
+					"metadata": {
+						"labels": {
+							"team": "payments"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/some-service-instance-guid"),
+						VerifyJSON(`{"metadata": {"labels": {"team": "payments", "env": null}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				serviceInstanceToUpdate = ServiceInstance{
+					GUID: "some-service-instance-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"team": types.NewNullString("payments"),
+							"env":  {},
+						},
+					},
+				}
+			})
+
+			It("returns the updated service instance and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(updatedServiceInstance).To(Equal(ServiceInstance{
+					GUID: "some-service-instance-guid",
+					Name: "some-service-instance-name",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Service instance not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/some-service-instance-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				serviceInstanceToUpdate = ServiceInstance{GUID: "some-service-instance-guid"}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Service instance not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
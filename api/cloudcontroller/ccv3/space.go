@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
@@ -11,6 +15,23 @@ type Space struct {
 	GUID string `json:"guid"`
 	// Name is the name of the space.
 	Name string `json:"name"`
+	// Metadata is the labels and annotations of the space.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// Relationships list the relationships to the space.
+	Relationships Relationships `json:"relationships,omitempty"`
+}
+
+// MarshalJSON converts a Space into a Cloud Controller Space.
+func (space Space) MarshalJSON() ([]byte, error) {
+	var ccSpace struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}
+
+	ccSpace.Name = space.Name
+	ccSpace.Metadata = space.Metadata
+
+	return json.Marshal(ccSpace)
 }
 
 // GetSpaces lists spaces with optional filters.
@@ -24,7 +45,7 @@ func (client *Client) GetSpaces(query ...Query) ([]Space, Warnings, error) {
 	}
 
 	var fullSpacesList []Space
-	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+	warnings, err := client.paginateConcurrently(request, Space{}, func(item interface{}) error {
 		if space, ok := item.(Space); ok {
 			fullSpacesList = append(fullSpacesList, space)
 		} else {
@@ -38,3 +59,28 @@ func (client *Client) GetSpaces(query ...Query) ([]Space, Warnings, error) {
 
 	return fullSpacesList, warnings, err
 }
+
+// UpdateSpace updates the name and metadata of the given space.
+func (client *Client) UpdateSpace(space Space) (Space, Warnings, error) {
+	bodyBytes, err := json.Marshal(space)
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchSpaceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+		URIParams:   map[string]string{"space_guid": space.GUID},
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var responseSpace Space
+	response := cloudcontroller.Response{
+		Result: &responseSpace,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseSpace, response.Warnings, err
+}
@@ -6,6 +6,8 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -57,7 +59,14 @@ var _ = Describe("Spaces", func() {
 	"resources": [
 	  {
       "name": "space-name-3",
-		  "guid": "space-guid-3"
+		  "guid": "space-guid-3",
+		  "relationships": {
+		    "organization": {
+		      "data": {
+		        "guid": "org-guid-3"
+		      }
+		    }
+		  }
 		}
 	]
 }`
@@ -87,12 +96,88 @@ var _ = Describe("Spaces", func() {
 				Expect(spaces).To(ConsistOf(
 					Space{Name: "space-name-1", GUID: "space-guid-1"},
 					Space{Name: "space-name-2", GUID: "space-guid-2"},
-					Space{Name: "space-name-3", GUID: "space-guid-3"},
+					Space{Name: "space-name-3", GUID: "space-guid-3", Relationships: Relationships{
+						constant.RelationshipTypeOrganization: Relationship{GUID: "org-guid-3"},
+					}},
 				))
 				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
 			})
 		})
 
+		Context("when the client requests pages concurrently", func() {
+			BeforeEach(func() {
+				client = NewTestClient(Config{
+					AppName:                "CF CLI API V3 Test",
+					AppVersion:             "Unknown",
+					PageRequestConcurrency: 2,
+				})
+
+				page3Requested := make(chan bool)
+				server.RouteToHandler(http.MethodGet, "/v3/spaces", func(w http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					var body string
+					switch page := req.URL.Query().Get("page"); page {
+					case "":
+						body = fmt.Sprintf(`{
+	"pagination": {
+		"total_pages": 3,
+		"next": {
+			"href": "%s/v3/spaces?names=some-space-name&page=2&per_page=1"
+		}
+	},
+	"resources": [{"name": "space-name-1", "guid": "space-guid-1"}]
+}`, server.URL())
+					case "2":
+						// Page 2 is only returned once page 3 has been requested,
+						// which only happens when the pages are requested at the
+						// same time.
+						Eventually(page3Requested).Should(BeClosed())
+						body = fmt.Sprintf(`{
+	"pagination": {
+		"total_pages": 3,
+		"next": {
+			"href": "%s/v3/spaces?names=some-space-name&page=3&per_page=1"
+		}
+	},
+	"resources": [{"name": "space-name-2", "guid": "space-guid-2"}]
+}`, server.URL())
+					case "3":
+						close(page3Requested)
+						body = `{
+	"pagination": {
+		"total_pages": 3,
+		"next": null
+	},
+	"resources": [{"name": "space-name-3", "guid": "space-guid-3"}]
+}`
+					}
+
+					Expect(req.URL.Query().Get("names")).To(Equal("some-space-name"))
+					w.Header().Set("X-Cf-Warnings", "warning-"+req.URL.Query().Get("page"))
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(body))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				query = Query{
+					Key:    NameFilter,
+					Values: []string{"some-space-name"},
+				}
+			})
+
+			It("returns the spaces and warnings of every page in order", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(spaces).To(Equal([]Space{
+					{Name: "space-name-1", GUID: "space-guid-1"},
+					{Name: "space-name-2", GUID: "space-guid-2"},
+					{Name: "space-name-3", GUID: "space-guid-3"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"warning-", "warning-2", "warning-3"}))
+			})
+		})
+
 		Context("when the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -137,4 +222,91 @@ var _ = Describe("Spaces", func() {
 			})
 		})
 	})
+
+	Describe("UpdateSpace", func() {
+		var (
+			spaceToUpdate Space
+
+			updatedSpace Space
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			updatedSpace, warnings, executeErr = client.UpdateSpace(spaceToUpdate)
+		})
+
+		Context("when the space is successfully updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-space-guid",
+					"name": "some-space-name",
+					"metadata": {
+						"labels": {
+							"team": "payments"
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/spaces/some-space-guid"),
+						VerifyJSON(`{"metadata": {"labels": {"team": "payments", "env": null}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				spaceToUpdate = Space{
+					GUID: "some-space-guid",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{
+							"team": types.NewNullString("payments"),
+							"env":  {},
+						},
+					},
+				}
+			})
+
+			It("returns the updated space and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(updatedSpace).To(Equal(Space{
+					GUID: "some-space-guid",
+					Name: "some-space-name",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Space not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				spaceToUpdate = Space{GUID: "some-space-guid"}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -19,6 +19,8 @@ type Task struct {
 	CreatedAt string `json:"created_at,omitempty"`
 	// DiskInMB represents the disk in MB allocated for the task.
 	DiskInMB uint64 `json:"disk_in_mb,omitempty"`
+	// FailureReason represents why the task failed, when it has failed.
+	FailureReason string `json:"-"`
 	// GUID represents the unique task identifier.
 	GUID string `json:"guid,omitempty"`
 	// MemoryInMB represents the memory in MB allocated for the task.
@@ -32,6 +34,27 @@ type Task struct {
 	State constant.TaskState `json:"state,omitempty"`
 }
 
+// UnmarshalJSON helps unmarshal a Cloud Controller Task response.
+func (task *Task) UnmarshalJSON(data []byte) error {
+	type alias Task
+	var ccTask struct {
+		alias
+		Result struct {
+			FailureReason string `json:"failure_reason"`
+		} `json:"result"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccTask)
+	if err != nil {
+		return err
+	}
+
+	*task = Task(ccTask.alias)
+	task.FailureReason = ccTask.Result.FailureReason
+
+	return nil
+}
+
 // CreateApplicationTask runs a command in the Application environment
 // associated with the provided Application GUID.
 func (client *Client) CreateApplicationTask(appGUID string, task Task) (Task, Warnings, error) {
@@ -90,6 +113,31 @@ func (client *Client) GetApplicationTasks(appGUID string, query ...Query) ([]Tas
 	return fullTasksList, warnings, err
 }
 
+// GetTask returns the task with the provided GUID.
+func (client *Client) GetTask(taskGUID string) (Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetTaskRequest,
+		URIParams: internal.Params{
+			"task_guid": taskGUID,
+		},
+	})
+	if err != nil {
+		return Task{}, nil, err
+	}
+
+	var task Task
+	response := cloudcontroller.Response{
+		Result: &task,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Task{}, response.Warnings, err
+	}
+
+	return task, response.Warnings, nil
+}
+
 // UpdateTaskCancel cancels a task.
 func (client *Client) UpdateTaskCancel(taskGUID string) (Task, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
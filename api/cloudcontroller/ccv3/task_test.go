@@ -354,6 +354,80 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetTask", func() {
+		var (
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = client.GetTask("some-task-guid")
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+          "guid": "task-3-guid",
+          "sequence_id": 3,
+          "name": "task-3",
+          "command": "some-command",
+          "state": "FAILED",
+          "result": {
+            "failure_reason": "Exited with status 1"
+          },
+          "created_at": "2016-11-07T07:59:01Z"
+        }`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the task with its failure reason and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(task).To(Equal(Task{
+					GUID:          "task-3-guid",
+					SequenceID:    3,
+					Name:          "task-3",
+					Command:       "some-command",
+					State:         constant.TaskFailed,
+					FailureReason: "Exited with status 1",
+					CreatedAt:     "2016-11-07T07:59:01Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Task not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Task not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTaskCancel", func() {
 		var (
 			task       Task
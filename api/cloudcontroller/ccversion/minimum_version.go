@@ -17,6 +17,7 @@ const (
 
 	MinVersionProvideNameForServiceBinding = "2.99.0"
 
+	MinVersionDeploymentsV3      = "3.57.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
 	MinVersionNetworkingV3       = "3.19.0"
 	MinVersionRoutingV3          = "3.16.0"
@@ -26,4 +27,5 @@ const (
 
 	MinVersionManifestBuildpacksV3        = "3.25.0"
 	MinVersionBuildpackStackAssociationV3 = "3.47.0"
+	MinVersionMetadataV3                  = "3.63.0"
 )
@@ -0,0 +1,135 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette Player", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		dir            string
+		path           string
+
+		wrapper  cloudcontroller.Connection
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		var err error
+		dir, err = ioutil.TempDir("", "cassette-player")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+
+		raw := `{
+			"interactions": [
+				{
+					"method": "POST",
+					"path": "/v2/apps",
+					"response": {
+						"status_code": 201,
+						"header": {"X-Cf-Warnings": ["some-warning"], "Location": ["/v2/apps/some-guid"]},
+						"body": "{\"metadata\":{\"guid\":\"some-guid\"}}"
+					}
+				},
+				{
+					"method": "GET",
+					"path": "/v2/apps/some-guid",
+					"response": {
+						"status_code": 404,
+						"header": {"X-Vcap-Request-Id": ["some-request-id"]},
+						"body": "{\"code\":100004}"
+					}
+				}
+			]
+		}`
+		Expect(ioutil.WriteFile(path, []byte(raw), 0600)).To(Succeed())
+
+		recording, err := cassette.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		wrapper = NewCassettePlayer(recording).Wrap(fakeConnection)
+
+		response = &cloudcontroller.Response{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	newRequest := func(method string, url string) *cloudcontroller.Request {
+		req, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return cloudcontroller.NewRequest(req, nil)
+	}
+
+	Context("when a recorded response matches the request", func() {
+		var result struct {
+			Metadata struct {
+				GUID string `json:"guid"`
+			} `json:"metadata"`
+		}
+
+		BeforeEach(func() {
+			request = newRequest(http.MethodPost, "https://api.example.com/v2/apps")
+			response.Result = &result
+		})
+
+		It("populates the response like the Cloud Controller connection", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.ResourceLocationURL).To(Equal("/v2/apps/some-guid"))
+			Expect(result.Metadata.GUID).To(Equal("some-guid"))
+		})
+	})
+
+	Context("when the recorded response is an error", func() {
+		BeforeEach(func() {
+			request = newRequest(http.MethodGet, "https://api.example.com/v2/apps/some-guid")
+		})
+
+		It("returns a RawHTTPStatusError", func() {
+			Expect(makeErr).To(MatchError(ccerror.RawHTTPStatusError{
+				StatusCode:  http.StatusNotFound,
+				RawResponse: []byte(`{"code":100004}`),
+				RequestIDs:  []string{"some-request-id"},
+			}))
+		})
+	})
+
+	Context("when no recorded response matches the request", func() {
+		BeforeEach(func() {
+			request = newRequest(http.MethodGet, "https://api.example.com/v2/spaces")
+		})
+
+		It("returns an UnmatchedRequestError", func() {
+			Expect(makeErr).To(MatchError(cassette.UnmatchedRequestError{
+				Path:   path,
+				Method: http.MethodGet,
+				URL:    "https://api.example.com/v2/spaces",
+			}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette Recorder", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		dir            string
+		path           string
+		recording      *cassette.Cassette
+
+		wrapper  cloudcontroller.Connection
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		var err error
+		dir, err = ioutil.TempDir("", "cassette-recorder")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+
+		recording, err = cassette.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		wrapper = NewCassetteRecorder(recording).Wrap(fakeConnection)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v2/apps?q=name:some-app", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when the Cloud Controller responds", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusNotFound,
+					Header:     http.Header{"X-Vcap-Request-Id": {"some-request-id"}},
+				}
+				passedResponse.RawResponse = []byte(`{"code":10000}`)
+				return ccerror.RawHTTPStatusError{StatusCode: http.StatusNotFound}
+			}
+		})
+
+		It("records the response and returns the connection's error", func() {
+			Expect(makeErr).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusNotFound}))
+
+			replayPath := filepath.Join(dir, "replay.json")
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.WriteFile(replayPath, raw, 0600)).To(Succeed())
+
+			replay, err := cassette.Open(replayPath)
+			Expect(err).ToNot(HaveOccurred())
+			played, err := replay.Play(request.Request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(played).To(Equal(cassette.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"X-Vcap-Request-Id": {"some-request-id"}},
+				Body:       `{"code":10000}`,
+			}))
+		})
+	})
+
+	Context("when the request fails without a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(errors.New("some-error"))
+		})
+
+		It("does not record the request", func() {
+			Expect(makeErr).To(MatchError("some-error"))
+
+			_, err := os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when the response cannot be recorded", func() {
+		BeforeEach(func() {
+			Expect(os.Mkdir(path, 0700)).To(Succeed())
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+				return nil
+			}
+		})
+
+		It("returns the error", func() {
+			Expect(makeErr).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	uuid "github.com/nu7hatch/gouuid"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -20,6 +21,7 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -43,7 +45,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	err := logger.displayRequest(request)
+	id := newRequestID()
+	err := logger.displayRequest(id, request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -51,7 +54,7 @@ func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedRespon
 	err = logger.connection.Make(request, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(id, passedResponse)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -66,7 +69,7 @@ func (logger *RequestLogger) Wrap(innerconnection cloudcontroller.Connection) cl
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) error {
+func (logger *RequestLogger) displayRequest(id string, request *cloudcontroller.Request) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -77,6 +80,10 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -118,7 +125,7 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
+func (logger *RequestLogger) displayResponse(id string, passedResponse *cloudcontroller.Response) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -129,6 +136,10 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayResponseHeader(passedResponse.HTTPResponse.Proto, passedResponse.HTTPResponse.Status)
 	if err != nil {
 		return err
@@ -164,3 +175,13 @@ func redactHeaders(key string, value string) string {
 	}
 	return value
 }
+
+// newRequestID returns an ID that pairs the display of a request with the
+// display of its response.
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
@@ -224,6 +224,13 @@ var _ = Describe("Request Logger", func() {
 				Expect(fakeOutput.DisplayJSONBodyCallCount()).To(BeNumerically(">=", 1))
 				Expect(fakeOutput.DisplayJSONBodyArgsForCall(0)).To(Equal([]byte("some-response-body")))
 			})
+
+			It("displays the same request ID with the request and its response", func() {
+				Expect(fakeOutput.DisplayRequestIDCallCount()).To(Equal(2))
+				requestID := fakeOutput.DisplayRequestIDArgsForCall(0)
+				Expect(requestID).ToNot(BeEmpty())
+				Expect(fakeOutput.DisplayRequestIDArgsForCall(1)).To(Equal(requestID))
+			})
 		})
 
 		Context("when the request is unsuccessful", func() {
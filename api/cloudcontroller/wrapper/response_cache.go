@@ -0,0 +1,256 @@
+package wrapper
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultResponseCacheTTLs are the lengths of time that a cached response is
+// used without asking the Cloud Controller whether it has changed, by the type
+// of resource requested. Only responses for these resource types are cached.
+var DefaultResponseCacheTTLs = map[string]time.Duration{
+	"domains":         5 * time.Minute,
+	"organizations":   time.Minute,
+	"private_domains": 5 * time.Minute,
+	"shared_domains":  5 * time.Minute,
+	"spaces":          time.Minute,
+	"stacks":          time.Hour,
+}
+
+var apiVersionSegment = regexp.MustCompile(`^v\d+$`)
+
+// ResponseCache is a wrapper that caches the responses to GET requests on
+// disk. A cached response is used as is until its resource type's TTL has
+// passed, after which it is revalidated with a conditional request. Any
+// request that is not a GET removes the cached responses of the resource types
+// in its path.
+type ResponseCache struct {
+	connection cloudcontroller.Connection
+	directory  string
+	scope      func() string
+	ttls       map[string]time.Duration
+}
+
+type responseCacheEntry struct {
+	URL          string    `json:"url"`
+	StatusCode   int       `json:"status_code"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	StoredAt     time.Time `json:"stored_at"`
+	Warnings     []string  `json:"warnings,omitempty"`
+	Body         []byte    `json:"body"`
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// responses in directory. Responses are only shared between requests made with
+// the same scope, which should identify the user making them. The scope is
+// called on every request, since the user can change while a command runs.
+func NewResponseCache(directory string, scope func() string, ttls map[string]time.Duration) *ResponseCache {
+	return &ResponseCache{
+		directory: directory,
+		scope:     scope,
+		ttls:      ttls,
+	}
+}
+
+// Make returns the cached response to a GET request if there is one, and
+// otherwise makes the request and caches the response.
+func (cache *ResponseCache) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	resourceTypes := resourceTypesInPath(request.URL.Path)
+
+	if request.Method != http.MethodGet {
+		err := cache.connection.Make(request, passedResponse)
+		cache.invalidate(resourceTypes)
+		return err
+	}
+
+	if len(resourceTypes) == 0 {
+		return cache.connection.Make(request, passedResponse)
+	}
+	resourceType := resourceTypes[len(resourceTypes)-1]
+	ttl, ok := cache.ttls[resourceType]
+	if !ok {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	entryPath := cache.entryPath(resourceType, request.URL.String())
+	entry, found := readResponseCacheEntry(entryPath)
+	if found && time.Since(entry.StoredAt) < ttl {
+		log.WithField("url", entry.URL).Debug("using cached response")
+		passedResponse.Warnings = entry.Warnings
+		return entry.populate(passedResponse)
+	}
+
+	if found {
+		if entry.ETag != "" {
+			request.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			request.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	// The body of a 304 response is empty, so the result is decoded here
+	// rather than by the connection.
+	result := passedResponse.Result
+	passedResponse.Result = nil
+	err := cache.connection.Make(request, passedResponse)
+	passedResponse.Result = result
+	if err != nil {
+		return err
+	}
+
+	httpResponse := passedResponse.HTTPResponse
+	switch {
+	case found && httpResponse.StatusCode == http.StatusNotModified:
+		entry.StoredAt = time.Now()
+		entry.Warnings = passedResponse.Warnings
+		cache.writeEntry(entryPath, entry)
+		return entry.populate(passedResponse)
+	case httpResponse.StatusCode == http.StatusOK:
+		cache.writeEntry(entryPath, responseCacheEntry{
+			URL:          request.URL.String(),
+			StatusCode:   httpResponse.StatusCode,
+			ETag:         httpResponse.Header.Get("ETag"),
+			LastModified: httpResponse.Header.Get("Last-Modified"),
+			StoredAt:     time.Now(),
+			Warnings:     passedResponse.Warnings,
+			Body:         passedResponse.RawResponse,
+		})
+	}
+
+	return decodeResult(passedResponse)
+}
+
+// Wrap sets the connection on the ResponseCache and returns itself
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+func (cache *ResponseCache) entryPath(resourceType string, url string) string {
+	hash := sha1.Sum([]byte(cache.scope() + " " + url))
+	return filepath.Join(cache.directory, fmt.Sprintf("%s-%x.json", resourceType, hash))
+}
+
+// invalidate removes the cached responses of the given resource types for all
+// scopes.
+func (cache *ResponseCache) invalidate(resourceTypes []string) {
+	for _, resourceType := range resourceTypes {
+		paths, err := filepath.Glob(filepath.Join(cache.directory, resourceType+"-*.json"))
+		if err != nil {
+			continue
+		}
+		for _, path := range paths {
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				log.Errorln("removing cached response:", err)
+			}
+		}
+	}
+}
+
+// writeEntry replaces the entry with a temp file, so that a concurrent reader
+// never sees a partially written response. Failing to write only means the
+// response is not cached, so errors are logged rather than returned.
+func (cache *ResponseCache) writeEntry(path string, entry responseCacheEntry) {
+	err := writeResponseCacheEntry(cache.directory, path, entry)
+	if err != nil {
+		log.Errorln("writing cached response:", err)
+	}
+}
+
+func writeResponseCacheEntry(directory string, path string, entry responseCacheEntry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(directory, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(directory, "temp-response")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+	}
+	return err
+}
+
+func (entry responseCacheEntry) populate(passedResponse *cloudcontroller.Response) error {
+	passedResponse.RawResponse = entry.Body
+	passedResponse.HTTPResponse = &http.Response{
+		StatusCode: entry.StatusCode,
+		Status:     fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		Header:     http.Header{},
+	}
+	return decodeResult(passedResponse)
+}
+
+func decodeResult(passedResponse *cloudcontroller.Response) error {
+	if passedResponse.Result == nil {
+		return nil
+	}
+	return cloudcontroller.DecodeJSON(passedResponse.RawResponse, passedResponse.Result)
+}
+
+func readResponseCacheEntry(path string) (responseCacheEntry, bool) {
+	var entry responseCacheEntry
+
+	raw, err := ioutil.ReadFile(path)
+	if err == nil {
+		err = json.Unmarshal(raw, &entry)
+	}
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorln("reading cached response:", err)
+		}
+		return responseCacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// resourceTypesInPath returns the resource collections named in an API path,
+// such as ["organizations", "spaces"] for /v2/organizations/some-guid/spaces.
+func resourceTypesInPath(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, segment := range segments {
+		if !apiVersionSegment.MatchString(segment) {
+			continue
+		}
+
+		var resourceTypes []string
+		for j := i + 1; j < len(segments); j += 2 {
+			resourceTypes = append(resourceTypes, segments[j])
+		}
+		return resourceTypes
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cacheDir       string
+		scope          string
+		ttls           map[string]time.Duration
+
+		responseBody string
+		statusCode   int
+		headers      http.Header
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+
+		var err error
+		cacheDir, err = ioutil.TempDir("", "response-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		scope = "some-user"
+		ttls = map[string]time.Duration{"spaces": time.Hour}
+
+		responseBody = `{"name":"some-space"}`
+		statusCode = http.StatusOK
+		headers = http.Header{}
+
+		fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+			response.HTTPResponse = &http.Response{StatusCode: statusCode, Header: headers}
+			response.Warnings = []string{"some-warning"}
+			if statusCode == http.StatusNotModified {
+				response.RawResponse = []byte{}
+			} else {
+				response.RawResponse = []byte(responseBody)
+			}
+			if statusCode >= 400 {
+				return ccerror.RawHTTPStatusError{StatusCode: statusCode, RawResponse: response.RawResponse}
+			}
+			if response.Result != nil {
+				return cloudcontroller.DecodeJSON(response.RawResponse, response.Result)
+			}
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(cacheDir)
+	})
+
+	makeRequest := func(method string, url string) (map[string]string, []string, error) {
+		connection := NewResponseCache(cacheDir, func() string { return scope }, ttls).Wrap(fakeConnection)
+
+		httpRequest, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		result := map[string]string{}
+		response := cloudcontroller.Response{Result: &result}
+		err = connection.Make(cloudcontroller.NewRequest(httpRequest, nil), &response)
+		return result, response.Warnings, err
+	}
+
+	Describe("Make", func() {
+		Context("when the resource type is cached", func() {
+			BeforeEach(func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+
+			It("returns the cached response with its warnings without making the request", func() {
+				result, warnings, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(map[string]string{"name": "some-space"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+
+			It("does not share responses between scopes", func() {
+				scope = "some-other-user"
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			It("does not share responses between URLs", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces?page=2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			Context("when a mutating request is made to the same resource type", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodDelete, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("makes the request again", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				})
+			})
+
+			Context("when a mutating request is made to a different resource type", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodPost, "https://api.example.com/v2/apps")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("still uses the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/organizations/some-org-guid/spaces")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		Context("when the resource type is not cached", func() {
+			It("always makes the request", func() {
+				for i := 0; i < 2; i++ {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/apps/some-app-guid")
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusNotFound
+			})
+
+			It("returns the error and does not cache the response", func() {
+				for i := 0; i < 2; i++ {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusNotFound, RawResponse: []byte(responseBody)}))
+				}
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the cached response has expired", func() {
+			BeforeEach(func() {
+				ttls["spaces"] = 0
+				headers = http.Header{
+					"Etag":          {`"some-etag"`},
+					"Last-Modified": {"Mon, 02 Jan 2006 15:04:05 GMT"},
+				}
+
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("makes a conditional request", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+				Expect(request.Header.Get("If-Modified-Since")).To(Equal("Mon, 02 Jan 2006 15:04:05 GMT"))
+			})
+
+			Context("when the resource has not been modified", func() {
+				BeforeEach(func() {
+					statusCode = http.StatusNotModified
+				})
+
+				It("returns the cached response", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "some-space"}))
+				})
+			})
+
+			Context("when the resource has been modified", func() {
+				BeforeEach(func() {
+					responseBody = `{"name":"some-renamed-space"}`
+				})
+
+				It("returns and caches the new response", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "some-renamed-space"}))
+
+					statusCode = http.StatusNotModified
+					result, _, err = makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "some-renamed-space"}))
+				})
+			})
+		})
+	})
+})
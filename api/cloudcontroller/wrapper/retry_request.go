@@ -1,38 +1,52 @@
 package wrapper
 
 import (
-	"net/http"
-
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests according to a
+// retry.Policy.
 type RetryRequest struct {
-	maxRetries int
+	policy     retry.Policy
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries up
+// to maxRetries times with the default backoff.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithPolicy(retry.NewPolicy(maxRetries))
+}
+
+// NewRetryRequestWithPolicy returns a pointer to a RetryRequest wrapper that
+// retries according to the provided policy.
+func NewRetryRequestWithPolicy(policy retry.Policy) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
-func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	var err error
+// Make retries the request if it comes back with a retryable status code or
+// network error, waiting between attempts as described by the policy.
+func (retryRequest *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	attempts := retryRequest.policy.Start()
 
-	for i := 0; i < retry.maxRetries+1; i++ {
-		err = retry.connection.Make(request, passedResponse)
+	for {
+		passedResponse.HTTPResponse = nil
+		err := retryRequest.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
-			break
+		requestErr := err
+		if e, ok := err.(ccerror.RequestError); ok {
+			requestErr = e.Err
+		}
+
+		if !retry.ShouldRetry(request.Method, passedResponse.HTTPResponse, requestErr) ||
+			!attempts.Wait(passedResponse.HTTPResponse) {
+			return err
 		}
 
 		// Reset the request body prior to the next retry
@@ -44,22 +58,10 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			return resetErr
 		}
 	}
-	return err
 }
 
 // Wrap sets the connection in the RetryRequest and returns itself.
-func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
-	retry.connection = innerconnection
-	return retry
-}
-
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+func (retryRequest *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
 }
@@ -3,19 +3,39 @@ package wrapper_test
 import (
 	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("Retry Request", func() {
+	var (
+		policy retry.Policy
+		sleeps []time.Duration
+	)
+
+	BeforeEach(func() {
+		sleeps = nil
+		policy = retry.NewPolicy(2)
+		policy.RandomizationFactor = 0
+		policy.Sleep = func(duration time.Duration) {
+			sleeps = append(sleeps, duration)
+		}
+	})
+
 	DescribeTable("number of retries",
 		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
 			rawRequestBody := "banana pants"
@@ -25,11 +45,7 @@ var _ = Describe("Retry Request", func() {
 			Expect(err).NotTo(HaveOccurred())
 			request := cloudcontroller.NewRequest(req, body)
 
-			response := &cloudcontroller.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &cloudcontroller.Response{}
 
 			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
 			expectedErr := ccerror.RawHTTPStatusError{
@@ -40,10 +56,14 @@ var _ = Describe("Retry Request", func() {
 				body, readErr := ioutil.ReadAll(request.Body)
 				Expect(readErr).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -59,9 +79,120 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	It("backs off exponentially between retries", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request := cloudcontroller.NewRequest(req, nil)
+		response := &cloudcontroller.Response{}
+
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+			}
+			return ccerror.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+		}
+
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+		Expect(wrapper.Make(request, response)).To(HaveOccurred())
+		Expect(sleeps).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+
+	Context("when the response has a Retry-After header", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{}
+
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": {"7"}},
+				}
+				return ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+		})
+
+		It("waits for the requested delay", func() {
+			wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+			Expect(wrapper.Make(request, response)).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			Expect(sleeps).To(Equal([]time.Duration{7 * time.Second, 7 * time.Second}))
+		})
+
+		Context("when waiting would exceed the max elapsed time", func() {
+			BeforeEach(func() {
+				policy.MaxElapsedTime = 10 * time.Second
+			})
+
+			It("stops retrying", func() {
+				wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+				Expect(wrapper.Make(request, response)).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(sleeps).To(Equal([]time.Duration{7 * time.Second}))
+			})
+		})
+	})
+
+	DescribeTable("network errors",
+		func(requestMethod string, networkErr error, expectedNumberOfRetries int) {
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request := cloudcontroller.NewRequest(req, nil)
+
+			expectedErr := ccerror.RequestError{Err: &url.Error{Op: requestMethod, URL: "https://foo.bar.com/banana", Err: networkErr}}
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturns(expectedErr)
+
+			wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+			err = wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Non-Post connection reset", http.MethodGet, &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, 3),
+		Entry("1 for Post connection reset", http.MethodPost, &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, 1),
+		Entry("maxRetries for Post TLS handshake timeout", http.MethodPost, errors.New("net/http: TLS handshake timeout"), 3),
+		Entry("maxRetries for Non-Post other network errors", http.MethodGet, errors.New("no such host"), 3),
+		Entry("1 for Post other network errors", http.MethodPost, errors.New("no such host"), 1),
+	)
+
+	It("does not take the response of an earlier attempt for that of a later one", func() {
+		req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request := cloudcontroller.NewRequest(req, nil)
+
+		expectedErr := ccerror.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: errors.New("no such host")}}
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests}
+				return ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+			return expectedErr
+		}
+
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+		response := &cloudcontroller.Response{}
+		Expect(wrapper.Make(request, response)).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(response.HTTPResponse).To(BeNil())
+	})
+
 	It("does not retry on success", func() {
 		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -73,7 +204,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
@@ -105,7 +236,7 @@ var _ = Describe("Retry Request", func() {
 			expectedErr = errors.New("oh noes")
 			fakeConnection.MakeReturns(expectedErr)
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -169,6 +170,27 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 
+			Context("when another request has already refreshed the token", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+						if fakeConnection.MakeCallCount() == 1 {
+							inMemoryCache.SetAccessToken("refreshed-by-another-request")
+							return ccerror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with the new token without refreshing again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+					requestArg, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(requestArg.Header.Get("Authorization")).To(Equal("refreshed-by-another-request"))
+				})
+			})
+
 			Context("when a PipeSeekError is returned from ResetBody", func() {
 				BeforeEach(func() {
 					body, writer := cloudcontroller.NewPipeBomb()
@@ -191,5 +213,65 @@ var _ = Describe("UAA Authentication", func() {
 				})
 			})
 		})
+
+		Context("when clients sharing the token cache make requests with an expired token at the same time", func() {
+			var (
+				sharedCache *lockingTokenCache
+				wrappers    []cloudcontroller.Connection
+			)
+
+			BeforeEach(func() {
+				sharedCache = &lockingTokenCache{InMemoryCache: util.NewInMemoryTokenCache()}
+				sharedCache.SetAccessToken("expired-token")
+
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "expired-token" {
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "refreshed-token",
+						RefreshToken: "some-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+
+				wrappers = nil
+				for i := 0; i < 2; i++ {
+					wrappers = append(wrappers, NewUAAAuthentication(fakeClient, sharedCache).Wrap(fakeConnection))
+				}
+			})
+
+			It("refreshes the token once and resends every request with it", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func(wrapper cloudcontroller.Connection) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						request := cloudcontroller.NewRequest(&http.Request{Header: http.Header{}}, nil)
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+						Expect(request.Header.Get("Authorization")).To(Equal("bearer refreshed-token"))
+					}(wrappers[i%len(wrappers)])
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+			})
+		})
 	})
 })
+
+type lockingTokenCache struct {
+	*util.InMemoryCache
+	lock sync.Mutex
+}
+
+func (cache *lockingTokenCache) TokenLock() sync.Locker {
+	return &cache.lock
+}
@@ -9,12 +9,18 @@ import (
 // PluginRepository represents a plugin repository
 type PluginRepository struct {
 	Plugins []Plugin `json:"plugins"`
+
+	// RawIndex is the repository index exactly as it was served, which is what
+	// the repository signature is computed over.
+	RawIndex []byte `json:"-"`
 }
 
 type PluginBinary struct {
 	Platform string `json:"platform"`
 	URL      string `json:"url"`
 	Checksum string `json:"checksum"`
+	// Signature is the base64 encoded ed25519 signature of the binary.
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -25,17 +31,12 @@ type Plugin struct {
 }
 
 func (client *Client) GetPluginRepository(repositoryURL string) (PluginRepository, error) {
-	parsedURL, err := url.Parse(repositoryURL)
+	listURL, err := repositoryListURL(repositoryURL)
 	if err != nil {
 		return PluginRepository{}, err
 	}
 
-	parsedURL.Path = strings.TrimSuffix(parsedURL.Path, "/")
-	if !strings.HasSuffix(parsedURL.Path, "/list") {
-		parsedURL.Path = path.Join(parsedURL.Path, "list")
-	}
-
-	request, err := client.newGETRequest(parsedURL.String())
+	request, err := client.newGETRequest(listURL)
 	if err != nil {
 		return PluginRepository{}, err
 	}
@@ -49,5 +50,32 @@ func (client *Client) GetPluginRepository(repositoryURL string) (PluginRepositor
 		return PluginRepository{}, err
 	}
 
+	pluginRepository.RawIndex = response.RawResponse
 	return pluginRepository, nil
 }
+
+// GetPluginRepositorySignature returns the detached signature of the
+// repository index, which is served next to the index at /list.sig. An empty
+// signature is returned when the repository does not serve one.
+func (client *Client) GetPluginRepositorySignature(repositoryURL string) (string, error) {
+	listURL, err := repositoryListURL(repositoryURL)
+	if err != nil {
+		return "", err
+	}
+
+	return client.GetPluginSignature(listURL + ".sig")
+}
+
+func repositoryListURL(repositoryURL string) (string, error) {
+	parsedURL, err := url.Parse(repositoryURL)
+	if err != nil {
+		return "", err
+	}
+
+	parsedURL.Path = strings.TrimSuffix(parsedURL.Path, "/")
+	if !strings.HasSuffix(parsedURL.Path, "/list") {
+		parsedURL.Path = path.Join(parsedURL.Path, "list")
+	}
+
+	return parsedURL.String(), nil
+}
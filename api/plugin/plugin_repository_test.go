@@ -30,7 +30,7 @@ var _ = Describe("PluginRepository", func() {
 							"name": "plugin-1",
 							"description": "useful plugin for useful things",
 							"version": "1.0.0",
-							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum"}]
+							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum","signature":"somesignature"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum"}]
 						},
 						{
 							"name": "plugin-2",
@@ -57,7 +57,7 @@ var _ = Describe("PluginRepository", func() {
 							Description: "useful plugin for useful things",
 							Version:     "1.0.0",
 							Binaries: []PluginBinary{
-								{Platform: "osx", URL: "http://some-url", Checksum: "somechecksum"},
+								{Platform: "osx", URL: "http://some-url", Checksum: "somechecksum", Signature: "somesignature"},
 								{Platform: "win64", URL: "http://another-url", Checksum: "anotherchecksum"},
 								{Platform: "linux64", URL: "http://last-url", Checksum: "lastchecksum"},
 							},
@@ -68,6 +68,7 @@ var _ = Describe("PluginRepository", func() {
 							Version:     "1.0.0",
 						},
 					},
+					RawIndex: []byte(response),
 				}))
 			})
 
@@ -132,4 +133,56 @@ var _ = Describe("PluginRepository", func() {
 			})
 		})
 	})
+
+	Describe("GetPluginRepositorySignature", func() {
+		Context("when the repository serves a signature", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/cli/list.sig"),
+						RespondWith(http.StatusOK, "some-signature\n"),
+					),
+				)
+			})
+
+			It("returns the signature of /list", func() {
+				signature, err := client.GetPluginRepositorySignature(fmt.Sprintf("%s/cli/", server.URL()))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(signature).To(Equal("some-signature"))
+			})
+		})
+
+		Context("when the repository does not serve a signature", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/list.sig"),
+						RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns an empty signature", func() {
+				signature, err := client.GetPluginRepositorySignature(server.URL())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(signature).To(BeEmpty())
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/list.sig"),
+						RespondWith(http.StatusTeapot, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetPluginRepositorySignature(server.URL())
+				Expect(err).To(MatchError(pluginerror.RawHTTPStatusError{Status: "418 I'm a teapot", RawResponse: []byte{}}))
+			})
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package plugin
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+)
+
+// GetPluginSignature returns the detached signature served at signatureURL,
+// with surrounding whitespace removed. An empty signature is returned when the
+// server responds with 404 Not Found.
+func (client *Client) GetPluginSignature(signatureURL string) (string, error) {
+	request, err := client.newGETRequest(signatureURL)
+	if err != nil {
+		return "", err
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response, nil)
+	if statusErr, ok := err.(pluginerror.RawHTTPStatusError); ok && strings.HasPrefix(statusErr.Status, strconv.Itoa(http.StatusNotFound)) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(response.RawResponse)), nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	uuid "github.com/nu7hatch/gouuid"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -19,6 +20,7 @@ type RequestLoggerOutput interface {
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -42,7 +44,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
-	err := logger.displayRequest(request)
+	id := newRequestID()
+	err := logger.displayRequest(id, request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -50,7 +53,7 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.
 	err = logger.connection.Make(request, passedResponse, proxyReader)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(id, passedResponse)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -65,7 +68,7 @@ func (logger *RequestLogger) Wrap(innerconnection plugin.Connection) plugin.Conn
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *http.Request) error {
+func (logger *RequestLogger) displayRequest(id string, request *http.Request) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -76,6 +79,10 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -106,7 +113,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) error {
+func (logger *RequestLogger) displayResponse(id string, passedResponse *plugin.Response) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -117,6 +124,10 @@ func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) er
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayResponseHeader(passedResponse.HTTPResponse.Proto, passedResponse.HTTPResponse.Status)
 	if err != nil {
 		return err
@@ -160,3 +171,13 @@ func redactHeaders(key string, value string) string {
 	}
 	return value
 }
+
+// newRequestID returns an ID that pairs the display of a request with the
+// display of its response.
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
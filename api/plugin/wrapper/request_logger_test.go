@@ -221,6 +221,13 @@ var _ = Describe("Request Logger", func() {
 
 					Expect(fakeOutput.DisplayDumpCallCount()).To(Equal(0))
 				})
+
+				It("displays the same request ID with the request and its response", func() {
+					Expect(fakeOutput.DisplayRequestIDCallCount()).To(Equal(2))
+					requestID := fakeOutput.DisplayRequestIDArgsForCall(0)
+					Expect(requestID).ToNot(BeEmpty())
+					Expect(fakeOutput.DisplayRequestIDArgsForCall(1)).To(Equal(requestID))
+				})
 			})
 
 			Context("when the response is not JSON", func() {
@@ -0,0 +1,30 @@
+package retry
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// isTLSHandshakeTimeout returns true if err was caused by the TLS handshake
+// with the server timing out.
+func isTLSHandshakeTimeout(err error) bool {
+	err = rootCause(err)
+	return err != nil && strings.Contains(err.Error(), "TLS handshake timeout")
+}
+
+func rootCause(err error) error {
+	for {
+		switch e := err.(type) {
+		case *url.Error:
+			err = e.Err
+		case *net.OpError:
+			err = e.Err
+		case *os.SyscallError:
+			err = e.Err
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Package retry provides the policy used by the API wrappers to decide when a
+// failed request should be retried and how long to wait before doing so.
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultInitialInterval is the default delay before the first retry.
+	DefaultInitialInterval = 500 * time.Millisecond
+
+	// DefaultMaxInterval is the default upper bound of a single delay.
+	DefaultMaxInterval = 10 * time.Second
+
+	// DefaultMaxElapsedTime is the default maximum time spent on a request,
+	// including all retries and delays.
+	DefaultMaxElapsedTime = time.Minute
+
+	// DefaultMultiplier is the default factor the delay grows by between
+	// retries.
+	DefaultMultiplier = 2.0
+
+	// DefaultRandomizationFactor is the default jitter applied to each delay;
+	// a factor of 0.5 spreads a delay of d over [0.5d, 1.5d].
+	DefaultRandomizationFactor = 0.5
+)
+
+// Policy describes how failed requests are retried. Delays grow
+// exponentially from InitialInterval by Multiplier up to MaxInterval, with
+// jitter, unless the server asks for a specific delay with a Retry-After
+// header. No retry is attempted when it would push the total time spent on
+// the request past MaxElapsedTime.
+type Policy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+	// MaxInterval caps each delay computed by the backoff.
+	MaxInterval time.Duration
+	// MaxElapsedTime caps the total time spent on a request. Zero means no
+	// limit.
+	MaxElapsedTime time.Duration
+	// Multiplier is the factor the delay grows by after each retry.
+	Multiplier float64
+	// RandomizationFactor is the jitter, between 0 and 1, applied to each
+	// delay.
+	RandomizationFactor float64
+
+	// Sleep waits for the given duration. Defaults to time.Sleep.
+	Sleep func(time.Duration)
+	// Random returns a number in [0, 1). Defaults to rand.Float64.
+	Random func() float64
+}
+
+// NewPolicy returns a Policy with the default backoff that retries up to
+// maxRetries times.
+func NewPolicy(maxRetries int) Policy {
+	return Policy{
+		MaxRetries:          maxRetries,
+		InitialInterval:     DefaultInitialInterval,
+		MaxInterval:         DefaultMaxInterval,
+		MaxElapsedTime:      DefaultMaxElapsedTime,
+		Multiplier:          DefaultMultiplier,
+		RandomizationFactor: DefaultRandomizationFactor,
+	}
+}
+
+// Backoff returns the jittered delay before the given retry, counting from
+// zero.
+func (policy Policy) Backoff(retry int) time.Duration {
+	interval := float64(policy.InitialInterval) * math.Pow(policy.Multiplier, float64(retry))
+	if policy.MaxInterval > 0 && interval > float64(policy.MaxInterval) {
+		interval = float64(policy.MaxInterval)
+	}
+
+	random := rand.Float64
+	if policy.Random != nil {
+		random = policy.Random
+	}
+
+	delta := policy.RandomizationFactor * interval
+	return time.Duration(interval - delta + random()*(2*delta))
+}
+
+// ShouldRetry returns true when a request made with the given method that
+// resulted in the given response or error is safe and worthwhile to retry:
+//   - 429 Too Many Requests, for every method, since the request was not
+//     processed
+//   - 500, 502, 503 and 504, for every method but POST
+//   - TLS handshake timeouts, for every method, since no request was sent
+//   - any other error without a response, for every method but POST
+func ShouldRetry(method string, response *http.Response, err error) bool {
+	if response != nil {
+		switch response.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return method != http.MethodPost
+		default:
+			return false
+		}
+	}
+
+	switch {
+	case err == nil:
+		return false
+	case isTLSHandshakeTimeout(err):
+		return true
+	default:
+		return method != http.MethodPost
+	}
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of the
+// response, which is either a number of seconds or an HTTP date.
+func RetryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// Start returns an Attempts that tracks the retries of a single request.
+func (policy Policy) Start() *Attempts {
+	return &Attempts{
+		policy:  policy,
+		started: time.Now(),
+	}
+}
+
+// Attempts tracks the retries of a single request against a Policy.
+type Attempts struct {
+	policy  Policy
+	retries int
+	started time.Time
+	slept   time.Duration
+}
+
+// Wait sleeps before the next retry and returns true, or returns false
+// without sleeping when the retries or the elapsed time of the policy are
+// exhausted. The delay honors the Retry-After header of response, if any.
+func (attempts *Attempts) Wait(response *http.Response) bool {
+	policy := attempts.policy
+	if attempts.retries >= policy.MaxRetries {
+		return false
+	}
+
+	now := time.Now()
+	delay, ok := RetryAfter(response, now)
+	if !ok {
+		delay = policy.Backoff(attempts.retries)
+	}
+
+	elapsed := now.Sub(attempts.started)
+	if elapsed < attempts.slept {
+		elapsed = attempts.slept
+	}
+	if policy.MaxElapsedTime > 0 && elapsed+delay > policy.MaxElapsedTime {
+		return false
+	}
+
+	sleep := time.Sleep
+	if policy.Sleep != nil {
+		sleep = policy.Sleep
+	}
+	sleep(delay)
+
+	attempts.retries++
+	attempts.slept += delay
+	return true
+}
@@ -0,0 +1,147 @@
+package retry_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var policy Policy
+
+	BeforeEach(func() {
+		policy = NewPolicy(3)
+	})
+
+	Describe("Backoff", func() {
+		Context("without jitter", func() {
+			BeforeEach(func() {
+				policy.RandomizationFactor = 0
+				policy.MaxInterval = 3 * time.Second
+			})
+
+			It("grows exponentially up to the max interval", func() {
+				Expect(policy.Backoff(0)).To(Equal(500 * time.Millisecond))
+				Expect(policy.Backoff(1)).To(Equal(time.Second))
+				Expect(policy.Backoff(2)).To(Equal(2 * time.Second))
+				Expect(policy.Backoff(3)).To(Equal(3 * time.Second))
+				Expect(policy.Backoff(10)).To(Equal(3 * time.Second))
+			})
+		})
+
+		Context("with jitter", func() {
+			It("spreads the delay around the interval", func() {
+				policy.Random = func() float64 { return 0 }
+				Expect(policy.Backoff(1)).To(Equal(500 * time.Millisecond))
+
+				policy.Random = func() float64 { return 0.5 }
+				Expect(policy.Backoff(1)).To(Equal(time.Second))
+
+				policy.Random = func() float64 { return 0.99 }
+				Expect(policy.Backoff(1)).To(BeNumerically("~", 1500*time.Millisecond, 20*time.Millisecond))
+			})
+		})
+	})
+
+	DescribeTable("ShouldRetry",
+		func(method string, statusCode int, err error, expected bool) {
+			var response *http.Response
+			if statusCode != 0 {
+				response = &http.Response{StatusCode: statusCode}
+			}
+			Expect(ShouldRetry(method, response, err)).To(Equal(expected))
+		},
+
+		Entry("GET 429", http.MethodGet, http.StatusTooManyRequests, nil, true),
+		Entry("POST 429", http.MethodPost, http.StatusTooManyRequests, nil, true),
+		Entry("GET 500", http.MethodGet, http.StatusInternalServerError, nil, true),
+		Entry("GET 502", http.MethodGet, http.StatusBadGateway, nil, true),
+		Entry("GET 503", http.MethodGet, http.StatusServiceUnavailable, nil, true),
+		Entry("GET 504", http.MethodGet, http.StatusGatewayTimeout, nil, true),
+		Entry("POST 503", http.MethodPost, http.StatusServiceUnavailable, nil, false),
+		Entry("GET 404", http.MethodGet, http.StatusNotFound, nil, false),
+		Entry("GET 501", http.MethodGet, http.StatusNotImplemented, nil, false),
+		Entry("GET connection reset", http.MethodGet, 0,
+			&url.Error{Op: "Get", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true),
+		Entry("PUT connection reset", http.MethodPut, 0, errors.New("read tcp 10.0.0.1:443: connection reset by peer"), true),
+		Entry("POST connection reset", http.MethodPost, 0,
+			&url.Error{Op: "Post", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, false),
+		Entry("POST TLS handshake timeout", http.MethodPost, 0,
+			&url.Error{Op: "Post", Err: errors.New("net/http: TLS handshake timeout")}, true),
+		Entry("GET other network errors", http.MethodGet, 0,
+			&url.Error{Op: "Get", Err: errors.New("dial tcp: lookup banana: no such host")}, true),
+		Entry("POST other network errors", http.MethodPost, 0,
+			&url.Error{Op: "Post", Err: errors.New("dial tcp: lookup banana: no such host")}, false),
+		Entry("GET without an error", http.MethodGet, 0, nil, false),
+	)
+
+	Describe("RetryAfter", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2018, time.May, 1, 12, 0, 0, 0, time.UTC)
+		})
+
+		It("parses a number of seconds", func() {
+			delay, ok := RetryAfter(&http.Response{Header: http.Header{"Retry-After": {"120"}}}, now)
+			Expect(ok).To(BeTrue())
+			Expect(delay).To(Equal(2 * time.Minute))
+		})
+
+		It("parses an HTTP date", func() {
+			delay, ok := RetryAfter(&http.Response{Header: http.Header{"Retry-After": {"Tue, 01 May 2018 12:00:30 GMT"}}}, now)
+			Expect(ok).To(BeTrue())
+			Expect(delay).To(Equal(30 * time.Second))
+		})
+
+		It("returns false when the header is missing or invalid", func() {
+			_, ok := RetryAfter(&http.Response{}, now)
+			Expect(ok).To(BeFalse())
+
+			_, ok = RetryAfter(&http.Response{Header: http.Header{"Retry-After": {"soon"}}}, now)
+			Expect(ok).To(BeFalse())
+
+			_, ok = RetryAfter(nil, now)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Attempts", func() {
+		var sleeps []time.Duration
+
+		BeforeEach(func() {
+			sleeps = nil
+			policy.RandomizationFactor = 0
+			policy.Sleep = func(duration time.Duration) {
+				sleeps = append(sleeps, duration)
+			}
+		})
+
+		It("waits until the retries are exhausted", func() {
+			attempts := policy.Start()
+			Expect(attempts.Wait(nil)).To(BeTrue())
+			Expect(attempts.Wait(nil)).To(BeTrue())
+			Expect(attempts.Wait(nil)).To(BeTrue())
+			Expect(attempts.Wait(nil)).To(BeFalse())
+			Expect(sleeps).To(Equal([]time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second}))
+		})
+
+		It("stops before exceeding the max elapsed time", func() {
+			policy.MaxElapsedTime = 2 * time.Second
+			attempts := policy.Start()
+			Expect(attempts.Wait(nil)).To(BeTrue())
+			Expect(attempts.Wait(nil)).To(BeTrue())
+			Expect(attempts.Wait(nil)).To(BeFalse())
+			Expect(sleeps).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+		})
+	})
+})
@@ -16,9 +16,10 @@ import (
 type Client struct {
 	config Config
 
-	connection Connection
-	router     *internal.Router
-	userAgent  string
+	connection    Connection
+	rawConnection Connection
+	router        *internal.Router
+	userAgent     string
 }
 
 // NewClient returns a new UAA Client with the provided configuration
@@ -34,10 +35,10 @@ func NewClient(config Config) *Client {
 	client := Client{
 		config: config,
 
-		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
-		userAgent:  userAgent,
+		rawConnection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
+		userAgent:     userAgent,
 	}
-	client.WrapConnection(NewErrorWrapper())
+	client.connection = NewErrorWrapper().Wrap(client.rawConnection)
 
 	return &client
 }
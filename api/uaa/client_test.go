@@ -26,6 +26,40 @@ var _ = Describe("UAA Client", func() {
 		client = NewTestUAAClientAndStore(fakeConfig)
 	})
 
+	Describe("WrapRawConnection", func() {
+		var rawErr error
+
+		BeforeEach(func() {
+			fakeRawWrapper := new(uaafakes.FakeConnectionWrapper)
+			fakeRawWrapper.WrapStub = func(innerconnection Connection) Connection {
+				fakeRawWrapper.MakeStub = func(request *http.Request, passedResponse *Response) error {
+					rawErr = innerconnection.Make(request, passedResponse)
+					return rawErr
+				}
+				return fakeRawWrapper
+			}
+
+			client = NewClient(fakeConfig)
+			client.WrapRawConnection(fakeRawWrapper)
+
+			SetupBootstrapResponse()
+			err := client.SetupResources(server.URL())
+			Expect(err).ToNot(HaveOccurred())
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/oauth/token"),
+					RespondWith(http.StatusUnauthorized, `{"error":"invalid_token","error_description":"some description"}`),
+				))
+		})
+
+		It("wraps the connection before the error responses are converted", func() {
+			_, err := client.RefreshAccessToken("")
+			Expect(err).To(MatchError(InvalidAuthTokenError{Message: "some description"}))
+			Expect(rawErr).To(BeAssignableToTypeOf(RawHTTPStatusError{}))
+		})
+	})
+
 	Describe("Request Headers", func() {
 		Describe("User-Agent", func() {
 			var userAgent string
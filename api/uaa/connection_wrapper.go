@@ -13,3 +13,12 @@ type ConnectionWrapper interface {
 func (client *Client) WrapConnection(wrapper ConnectionWrapper) {
 	client.connection = wrapper.Wrap(client.connection)
 }
+
+// WrapRawConnection wraps the Client connection in the wrapper before the
+// error wrapper, so that the wrapper sees 4xx and 5xx responses before they
+// are converted to errors. It must be called before WrapConnection, since it
+// replaces the wrappers applied so far.
+func (client *Client) WrapRawConnection(wrapper ConnectionWrapper) {
+	client.rawConnection = wrapper.Wrap(client.rawConnection)
+	client.connection = NewErrorWrapper().Wrap(client.rawConnection)
+}
@@ -2,7 +2,11 @@
 // TokenRefresher interface for noaa/consumer.
 package noaabridge
 
-import "code.cloudfoundry.org/cli/api/uaa"
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
 
 //go:generate counterfeiter . UAAClient
 
@@ -20,18 +24,31 @@ type TokenCache interface {
 	SetRefreshToken(token string)
 }
 
+// TokenLocker is implemented by token caches that are shared by several
+// clients. The refresher holds the lock while refreshing the tokens.
+type TokenLocker interface {
+	TokenLock() sync.Locker
+}
+
 // TokenRefresher implements the TokenRefresher interface. It requires a UAA
 // client and a token cache for storing the access and refresh tokens.
 type TokenRefresher struct {
 	uaaClient UAAClient
 	cache     TokenCache
+	tokenLock sync.Locker
 }
 
 // NewTokenRefresher returns back a pointer to a TokenRefresher.
 func NewTokenRefresher(uaaClient UAAClient, cache TokenCache) *TokenRefresher {
+	var tokenLock sync.Locker = new(sync.Mutex)
+	if locker, ok := cache.(TokenLocker); ok {
+		tokenLock = locker.TokenLock()
+	}
+
 	return &TokenRefresher{
 		uaaClient: uaaClient,
 		cache:     cache,
+		tokenLock: tokenLock,
 	}
 }
 
@@ -39,6 +56,9 @@ func NewTokenRefresher(uaaClient UAAClient, cache TokenCache) *TokenRefresher {
 // Access and Refresh token in it's cache. The returned Authorization Token
 // includes the type prefixed by a space.
 func (t *TokenRefresher) RefreshAuthToken() (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
 	tokens, err := t.uaaClient.RefreshAccessToken(t.cache.RefreshToken())
 	if err != nil {
 		return "", err
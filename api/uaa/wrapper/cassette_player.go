@@ -0,0 +1,44 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// CassettePlayer is a wrapper that answers requests with the responses
+// recorded in a cassette instead of sending them to UAA. It must wrap the
+// connection before the client's error handling, so that the recorded
+// responses are handled the same way as when they were recorded.
+type CassettePlayer struct {
+	cassette *cassette.Cassette
+}
+
+// NewCassettePlayer returns a pointer to a CassettePlayer wrapper that plays
+// back the cassette.
+func NewCassettePlayer(cassette *cassette.Cassette) *CassettePlayer {
+	return &CassettePlayer{
+		cassette: cassette,
+	}
+}
+
+// Make populates the response from the recorded response matching the
+// request. It returns a cassette.UnmatchedRequestError if no recorded
+// response matches.
+func (player *CassettePlayer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	response, err := player.cassette.Play(request)
+	if err != nil {
+		return err
+	}
+
+	connection := uaa.UAAConnection{
+		HTTPClient: cassette.NewHTTPClient(response),
+	}
+	return connection.Make(request, passedResponse)
+}
+
+// Wrap returns the CassettePlayer, which never uses the inner connection.
+func (player *CassettePlayer) Wrap(_ uaa.Connection) uaa.Connection {
+	return player
+}
@@ -0,0 +1,121 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette Player", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		dir            string
+		path           string
+
+		wrapper  uaa.Connection
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+
+		var err error
+		dir, err = ioutil.TempDir("", "cassette-player")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+
+		raw := `{
+			"interactions": [
+				{
+					"method": "POST",
+					"path": "/oauth/token",
+					"response": {"status_code": 200, "body": "{\"access_token\":\"some-access-token\"}"}
+				},
+				{
+					"method": "GET",
+					"path": "/oauth/authorize",
+					"query": "client_id=ssh-proxy&response_type=code",
+					"response": {"status_code": 302, "header": {"Location": ["https://uaa.example.com/login?code=some-code"]}}
+				}
+			]
+		}`
+		Expect(ioutil.WriteFile(path, []byte(raw), 0600)).To(Succeed())
+
+		recording, err := cassette.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		wrapper = NewCassettePlayer(recording).Wrap(fakeConnection)
+
+		response = &uaa.Response{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when a recorded response matches the request", func() {
+		var result struct {
+			AccessToken string `json:"access_token"`
+		}
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", nil)
+			Expect(err).ToNot(HaveOccurred())
+			response.Result = &result
+		})
+
+		It("populates the response like the UAA connection", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(result.AccessToken).To(Equal("some-access-token"))
+		})
+	})
+
+	Context("when the recorded response is a redirect", func() {
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodGet, "https://uaa.example.com/oauth/authorize?response_type=code&client_id=ssh-proxy", nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not follow it", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusFound))
+			Expect(response.HTTPResponse.Header.Get("Location")).To(Equal("https://uaa.example.com/login?code=some-code"))
+		})
+	})
+
+	Context("when no recorded response matches the request", func() {
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodGet, "https://uaa.example.com/Users", nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an UnmatchedRequestError", func() {
+			Expect(makeErr).To(MatchError(cassette.UnmatchedRequestError{
+				Path:   path,
+				Method: http.MethodGet,
+				URL:    "https://uaa.example.com/Users",
+			}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// CassetteRecorder is a wrapper that records requests to and responses from
+// UAA to a cassette. It must wrap the connection before the client's error
+// handling, so that it records responses as they were sent.
+type CassetteRecorder struct {
+	connection uaa.Connection
+	cassette   *cassette.Cassette
+}
+
+// NewCassetteRecorder returns a pointer to a CassetteRecorder wrapper that
+// records to the cassette.
+func NewCassetteRecorder(cassette *cassette.Cassette) *CassetteRecorder {
+	return &CassetteRecorder{
+		cassette: cassette,
+	}
+}
+
+// Make makes the request and records it along with the response. Requests
+// that fail without a response are not recorded. An error recording a request
+// that succeeded is returned, since the cassette would be incomplete.
+func (recorder *CassetteRecorder) Make(request *http.Request, passedResponse *uaa.Response) error {
+	err := recorder.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		recordErr := recorder.cassette.Record(request, passedResponse.HTTPResponse, passedResponse.RawResponse)
+		if recordErr != nil && err == nil {
+			return recordErr
+		}
+	}
+
+	return err
+}
+
+// Wrap sets the connection on the CassetteRecorder and returns itself
+func (recorder *CassetteRecorder) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
@@ -0,0 +1,104 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette Recorder", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		dir            string
+		path           string
+		recording      *cassette.Cassette
+
+		wrapper  uaa.Connection
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+
+		var err error
+		dir, err = ioutil.TempDir("", "cassette-recorder")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+
+		recording, err = cassette.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		wrapper = NewCassetteRecorder(recording).Wrap(fakeConnection)
+
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response = &uaa.Response{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Context("when UAA responds", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusUnauthorized}
+				passedResponse.RawResponse = []byte(`{"error":"unauthorized"}`)
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusUnauthorized}
+			}
+		})
+
+		It("records the response and returns the connection's error", func() {
+			Expect(makeErr).To(MatchError(uaa.RawHTTPStatusError{StatusCode: http.StatusUnauthorized}))
+
+			raw, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(ContainSubstring(`"path": "/oauth/token"`))
+			Expect(string(raw)).To(ContainSubstring(`"status_code": 401`))
+			Expect(string(raw)).To(ContainSubstring(`"body": "{\"error\":\"unauthorized\"}"`))
+		})
+	})
+
+	Context("when the request fails without a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(errors.New("some-error"))
+		})
+
+		It("does not record the request", func() {
+			Expect(makeErr).To(MatchError("some-error"))
+
+			_, err := os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when the response cannot be recorded", func() {
+		BeforeEach(func() {
+			Expect(os.Mkdir(path, 0700)).To(Succeed())
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+				return nil
+			}
+		})
+
+		It("returns the error", func() {
+			Expect(makeErr).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+})
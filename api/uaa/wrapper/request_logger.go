@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	uuid "github.com/nu7hatch/gouuid"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -19,6 +20,7 @@ type RequestLoggerOutput interface {
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -42,7 +44,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *http.Request, passedResponse *uaa.Response) error {
-	err := logger.displayRequest(request)
+	id := newRequestID()
+	err := logger.displayRequest(id, request)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -50,7 +53,7 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *uaa.Res
 	err = logger.connection.Make(request, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(id, passedResponse)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -65,7 +68,7 @@ func (logger *RequestLogger) Wrap(innerconnection uaa.Connection) uaa.Connection
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *http.Request) error {
+func (logger *RequestLogger) displayRequest(id string, request *http.Request) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -76,6 +79,10 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -110,7 +117,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error {
+func (logger *RequestLogger) displayResponse(id string, passedResponse *uaa.Response) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -121,6 +128,10 @@ func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error
 	if err != nil {
 		return err
 	}
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayResponseHeader(passedResponse.HTTPResponse.Proto, passedResponse.HTTPResponse.Status)
 	if err != nil {
 		return err
@@ -156,3 +167,13 @@ func redactHeaders(key string, value string) string {
 	}
 	return value
 }
+
+// newRequestID returns an ID that pairs the display of a request with the
+// display of its response.
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
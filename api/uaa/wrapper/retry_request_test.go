@@ -2,9 +2,15 @@ package wrapper_test
 
 import (
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"syscall"
+	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
@@ -14,6 +20,20 @@ import (
 )
 
 var _ = Describe("Retry Request", func() {
+	var (
+		policy retry.Policy
+		sleeps []time.Duration
+	)
+
+	BeforeEach(func() {
+		sleeps = nil
+		policy = retry.NewPolicy(2)
+		policy.RandomizationFactor = 0
+		policy.Sleep = func(duration time.Duration) {
+			sleeps = append(sleeps, duration)
+		}
+	})
+
 	DescribeTable("number of retries",
 		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
 			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
@@ -22,11 +42,7 @@ var _ = Describe("Retry Request", func() {
 			rawRequestBody := "banana pants"
 			request.Body = ioutil.NopCloser(strings.NewReader(rawRequestBody))
 
-			response := &uaa.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &uaa.Response{}
 
 			fakeConnection := new(uaafakes.FakeConnection)
 			expectedErr := uaa.RawHTTPStatusError{
@@ -37,10 +53,14 @@ var _ = Describe("Retry Request", func() {
 				body, readErr := ioutil.ReadAll(request.Body)
 				Expect(readErr).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -56,9 +76,66 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	It("honors the Retry-After header", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response := &uaa.Response{}
+
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": {"3"}},
+			}
+			return uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+		}
+
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+		Expect(wrapper.Make(request, response)).To(HaveOccurred())
+		Expect(sleeps).To(Equal([]time.Duration{3 * time.Second, 3 * time.Second}))
+	})
+
+	It("retries connection resets", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedErr := uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}}
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeReturns(expectedErr)
+
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+		Expect(wrapper.Make(request, &uaa.Response{})).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+		Expect(sleeps).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+
+	It("does not take the response of an earlier attempt for that of a later one", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedErr := uaa.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}}
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests}
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+			return expectedErr
+		}
+
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
+		response := &uaa.Response{}
+		Expect(wrapper.Make(request, response)).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(response.HTTPResponse).To(BeNil())
+	})
+
 	It("does not retry on success", func() {
 		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -69,7 +146,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequestWithPolicy(policy).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
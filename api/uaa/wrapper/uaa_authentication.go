@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 )
@@ -26,20 +27,38 @@ type TokenCache interface {
 	SetRefreshToken(token string)
 }
 
+// TokenLocker is implemented by token caches that are shared by several
+// clients. Every wrapper using the cache holds the same lock while reading or
+// refreshing the tokens.
+type TokenLocker interface {
+	TokenLock() sync.Locker
+}
+
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock prevents requests made at the same time from refreshing the
+	// token more than once. It is shared with the other clients using the cache
+	// when the cache is a TokenLocker.
+	tokenLock sync.Locker
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
 // the client and token cache.
 func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+	var tokenLock sync.Locker = new(sync.Mutex)
+	if locker, ok := cache.(TokenLocker); ok {
+		tokenLock = locker.TokenLock()
+	}
+
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:    client,
+		cache:     cache,
+		tokenLock: tokenLock,
 	}
 }
 
@@ -67,22 +86,20 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		refreshErr := t.refreshToken(accessToken)
 		if refreshErr != nil {
 			return refreshErr
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", t.accessToken())
 		return t.connection.Make(request, passedResponse)
 	}
 
@@ -94,6 +111,32 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
 }
 
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+	return t.cache.AccessToken()
+}
+
+// refreshToken refreshes the access token, unless another request has already
+// replaced the rejected token.
+func (t *UAAAuthentication) refreshToken(rejectedToken string) error {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if t.cache.AccessToken() != rejectedToken {
+		return nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return nil
+}
+
 // Wrap sets the connection on the UAAAuthentication and returns itself
 func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection {
 	t.connection = innerconnection
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -162,6 +163,58 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when clients sharing the token cache make requests with an expired token at the same time", func() {
+			var (
+				sharedCache *lockingTokenCache
+				wrappers    []uaa.Connection
+			)
+
+			BeforeEach(func() {
+				sharedCache = &lockingTokenCache{InMemoryCache: util.NewInMemoryTokenCache()}
+				sharedCache.SetAccessToken("expired-token")
+
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					if request.Header.Get("Authorization") == "expired-token" {
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "refreshed-token",
+						RefreshToken: "some-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+
+				wrappers = nil
+				for i := 0; i < 2; i++ {
+					wrappers = append(wrappers, NewUAAAuthentication(fakeClient, sharedCache).Wrap(fakeConnection))
+				}
+			})
+
+			It("refreshes the token once and resends every request with it", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func(wrapper uaa.Connection) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						request, err := http.NewRequest(http.MethodGet, server.URL(), nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+						Expect(request.Header.Get("Authorization")).To(Equal("bearer refreshed-token"))
+					}(wrappers[i%len(wrappers)])
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -242,3 +295,12 @@ var _ = Describe("UAA Authentication", func() {
 		})
 	})
 })
+
+type lockingTokenCache struct {
+	*util.InMemoryCache
+	lock sync.Mutex
+}
+
+func (cache *lockingTokenCache) TokenLock() sync.Locker {
+	return &cache.lock
+}
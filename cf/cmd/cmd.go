@@ -22,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/cf/util/spellcheck"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
 
 	netrpc "net/rpc"
 )
@@ -141,6 +142,12 @@ func Main(traceEnv string, args []string) {
 		os.Exit(1)
 	}
 
+	cfConfig, err := configv3.LoadConfig()
+	if err != nil {
+		errFunc(err)
+	}
+	rpcService.RpcCmdV2.Config = cfConfig
+
 	pluginPath := filepath.Join(confighelpers.PluginRepoDir(), ".cf", "plugins")
 	pluginConfig := pluginconfig.NewPluginConfig(
 		func(err error) {
@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/filelock"
 )
 
 const (
@@ -28,11 +33,16 @@ type DataInterface interface {
 
 type DiskPersistor struct {
 	filePath string
+
+	// loaded holds the top level JSON fields as they were last read from or
+	// written to disk.
+	loaded *map[string]json.RawMessage
 }
 
 func NewDiskPersistor(path string) DiskPersistor {
 	return DiskPersistor{
 		filePath: path,
+		loaded:   new(map[string]json.RawMessage),
 	}
 }
 
@@ -76,15 +86,119 @@ func (dp DiskPersistor) read(data DataInterface) error {
 	}
 
 	err = data.JSONUnmarshalV3(jsonBytes)
-	return err
+	if err != nil {
+		return err
+	}
+
+	dp.setLoaded(jsonBytes)
+	return nil
 }
 
+// write persists data while holding an advisory lock on the file. Fields
+// changed by other processes since the file was loaded are kept; only the
+// fields that differ from what was loaded are replaced. The file is replaced
+// atomically by renaming a temporary file over it.
 func (dp DiskPersistor) write(data DataInterface) error {
-	bytes, err := data.JSONMarshalV3()
+	jsonBytes, err := data.JSONMarshalV3()
 	if err != nil {
 		return err
 	}
 
-	err = ioutil.WriteFile(dp.filePath, bytes, filePermissions)
-	return err
+	err = dp.makeDirectory()
+	if err != nil {
+		return err
+	}
+
+	lock, err := filelock.Acquire(dp.filePath + ".lock")
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	mergedBytes, err := dp.merge(jsonBytes)
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomically(dp.filePath, mergedBytes)
+	if err != nil {
+		return err
+	}
+
+	dp.setLoaded(jsonBytes)
+	return nil
+}
+
+func (dp DiskPersistor) merge(jsonBytes []byte) ([]byte, error) {
+	if dp.loaded == nil || *dp.loaded == nil {
+		return jsonBytes, nil
+	}
+
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(jsonBytes, &fields)
+	if err != nil {
+		return jsonBytes, nil
+	}
+
+	diskBytes, err := ioutil.ReadFile(dp.filePath)
+	if err != nil {
+		return jsonBytes, nil
+	}
+
+	var merged map[string]json.RawMessage
+	err = json.Unmarshal(diskBytes, &merged)
+	if err != nil || merged == nil {
+		return jsonBytes, nil
+	}
+
+	loaded := *dp.loaded
+	for key, value := range fields {
+		if !jsonEqual(loaded[key], value) {
+			merged[key] = value
+		}
+	}
+
+	return json.MarshalIndent(merged, "", "  ")
+}
+
+func (dp DiskPersistor) setLoaded(jsonBytes []byte) {
+	if dp.loaded == nil {
+		return
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(jsonBytes, &fields) == nil {
+		*dp.loaded = fields
+	}
+}
+
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return false
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+func writeFileAtomically(path string, contents []byte) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "temp-"+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	tempFileName := tempFile.Name()
+
+	_, err = tempFile.Write(contents)
+	tempFile.Close()
+	if err != nil {
+		_ = os.Remove(tempFileName)
+		return err
+	}
+
+	err = os.Chmod(tempFileName, filePermissions)
+	if err != nil {
+		_ = os.Remove(tempFileName)
+		return err
+	}
+
+	return os.Rename(tempFileName, path)
 }
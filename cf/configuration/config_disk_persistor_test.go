@@ -30,6 +30,7 @@ var _ = Describe("DiskPersistor", func() {
 
 	AfterEach(func() {
 		os.Remove(tmpFile.Name())
+		os.Remove(tmpFile.Name() + ".lock")
 	})
 
 	Describe(".Delete", func() {
@@ -54,6 +55,36 @@ var _ = Describe("DiskPersistor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(string(dataBytes)).To(ContainSubstring(d.Info))
 		})
+
+		Context("when another process changed the file after it was loaded", func() {
+			var d *sessionData
+
+			BeforeEach(func() {
+				err := ioutil.WriteFile(tmpFile.Name(), []byte(`{"AccessToken":"some-token","Target":"some-target"}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+
+				d = &sessionData{}
+				err = diskPersistor.Load(d)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = ioutil.WriteFile(tmpFile.Name(), []byte(`{"AccessToken":"some-token","Target":"other-target"}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only writes the fields that were changed since loading", func() {
+				d.AccessToken = "refreshed-token"
+
+				err := diskPersistor.Save(d)
+				Expect(err).ToNot(HaveOccurred())
+
+				dataBytes, err := ioutil.ReadFile(tmpFile.Name())
+				Expect(err).ToNot(HaveOccurred())
+
+				var written sessionData
+				Expect(json.Unmarshal(dataBytes, &written)).To(Succeed())
+				Expect(written).To(Equal(sessionData{AccessToken: "refreshed-token", Target: "other-target"}))
+			})
+		})
 	})
 
 	Describe(".Load", func() {
@@ -82,6 +113,19 @@ type data struct {
 	Info string
 }
 
+type sessionData struct {
+	AccessToken string
+	Target      string
+}
+
+func (d *sessionData) JSONMarshalV3() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+func (d *sessionData) JSONUnmarshalV3(data []byte) error {
+	return json.Unmarshal(data, d)
+}
+
 func (d *data) JSONMarshalV3() ([]byte, error) {
 	return json.MarshalIndent(d, "", "  ")
 }
@@ -25,6 +25,8 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	Contexts                 json.RawMessage `json:",omitempty"`
+	CurrentContext           string          `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	MinCLIVersion            string
@@ -216,5 +216,23 @@ var _ = Describe("V3 Config files", func() {
 
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
+
+		It("preserves the contexts written by newer commands", func() {
+			contextsJSON := `{
+				"ConfigVersion": 3,
+				"CurrentContext": "staging",
+				"Contexts": [{"Name": "staging", "Target": "api.staging.com"}]
+			}`
+
+			data := coreconfig.NewData()
+			err := data.JSONUnmarshalV3([]byte(contextsJSON))
+			Expect(err).NotTo(HaveOccurred())
+
+			rawData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rawData).To(ContainSubstring(`"CurrentContext": "staging"`))
+			Expect(rawData).To(ContainSubstring(`"Name": "staging"`))
+			Expect(rawData).To(ContainSubstring(`"Target": "api.staging.com"`))
+		})
 	})
 })
@@ -5,8 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
@@ -17,6 +19,13 @@ func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
 	stdoutLogger := NewWriterPrinter(writer, true)
 
 	for _, path := range boolsOrPaths {
+		// HAR files are only written by the request loggers in util/ui. The
+		// legacy commands that trace through this package do not record
+		// their requests in HAR files.
+		if strings.HasPrefix(path, configv3.TraceHARPrefix) {
+			continue
+		}
+
 		b, err := strconv.ParseBool(path)
 		LoggingToStdout = LoggingToStdout || b
 
@@ -127,6 +127,20 @@ var _ = Describe("NewLogger", func() {
 		})
 	})
 
+	It("does not write to HAR file paths", func() {
+		fileutils.TempDir("trace_test", func(tmpDir string, err error) {
+			Expect(err).ToNot(HaveOccurred())
+
+			fileName := path.Join(tmpDir, "trace.har")
+			logger := NewLogger(buffer, false, "har:"+fileName, "")
+			logger.Print("Hello World")
+
+			Expect(buffer).NotTo(gbytes.Say("Hello World"))
+			_, err = os.Stat(fileName)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
 	It("returns a logger that writes to a file when CF_TRACE is a path", func() {
 		fileutils.TempFile("trace_test", func(file *os.File, err error) {
 			logger := NewLogger(buffer, false, file.Name(), "")
@@ -48,6 +48,23 @@ type Handlers interface {
 	GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error
 }
 
+//go:generate counterfeiter . HandlersV2
+
+type HandlersV2 interface {
+	PluginAPIVersion(args string, retVal *int) error
+	GetV3Applications(spaceGUID string, retVal *[]plugin_models.V3Application) error
+	GetV3Application(args []string, retVal *plugin_models.V3Application) error
+	GetV3Processes(appGUID string, retVal *[]plugin_models.V3Process) error
+	GetV3Tasks(appGUID string, retVal *[]plugin_models.V3Task) error
+	GetV3Droplets(appGUID string, retVal *[]plugin_models.V3Droplet) error
+	GetV3Packages(appGUID string, retVal *[]plugin_models.V3Package) error
+	GetV3IsolationSegments(args string, retVal *[]plugin_models.V3IsolationSegment) error
+	CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error
+	StartAppLogStream(appGUID string, retVal *int) error
+	ReadAppLogs(streamID int, retVal *plugin_models.LogMessageBatch) error
+	StopAppLogStream(streamID int, retVal *bool) error
+}
+
 type TestServer struct {
 	listener net.Listener
 	Handlers Handlers
@@ -74,6 +91,14 @@ func NewTestRPCServer(handlers Handlers) (*TestServer, error) {
 	return ts, nil
 }
 
+// RegisterV2 serves version 2 of the plugin API with the given handlers.
+func (ts *TestServer) RegisterV2(handlers HandlersV2) error {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	return ts.server.RegisterName("CliRpcCmdV2", handlers)
+}
+
 func (ts *TestServer) Stop() {
 	close(ts.stopCh)
 	ts.listener.Close()
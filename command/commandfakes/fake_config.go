@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -30,6 +31,12 @@ type FakeConfig struct {
 		name string
 		url  string
 	}
+	AddTrustedPluginKeyStub        func(name string, publicKey string)
+	addTrustedPluginKeyMutex       sync.RWMutex
+	addTrustedPluginKeyArgsForCall []struct {
+		name      string
+		publicKey string
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -57,6 +64,17 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CassetteStub        func() (string, bool)
+	cassetteMutex       sync.RWMutex
+	cassetteArgsForCall []struct{}
+	cassetteReturns     struct {
+		result1 string
+		result2 bool
+	}
+	cassetteReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct{}
@@ -84,6 +102,35 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextsStub        func() []configv3.NamedContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct{}
+	contextsReturns     struct {
+		result1 []configv3.NamedContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 []configv3.NamedContext
+	}
+	CreateContextStub        func(name string) error
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		name string
+	}
+	createContextReturns struct {
+		result1 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CurrentContextStub        func() string
+	currentContextMutex       sync.RWMutex
+	currentContextArgsForCall []struct{}
+	currentContextReturns     struct {
+		result1 string
+	}
+	currentContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -95,6 +142,17 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	DeleteContextStub        func(name string) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		name string
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -202,6 +260,15 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PageRequestConcurrencyStub        func() int
+	pageRequestConcurrencyMutex       sync.RWMutex
+	pageRequestConcurrencyArgsForCall []struct{}
+	pageRequestConcurrencyReturns     struct {
+		result1 int
+	}
+	pageRequestConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct{}
@@ -252,6 +319,23 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveTrustedPluginKeyStub        func(name string)
+	removeTrustedPluginKeyMutex       sync.RWMutex
+	removeTrustedPluginKeyArgsForCall []struct {
+		name string
+	}
+	RenameContextStub        func(oldName string, newName string) error
+	renameContextMutex       sync.RWMutex
+	renameContextArgsForCall []struct {
+		oldName string
+		newName string
+	}
+	renameContextReturns struct {
+		result1 error
+	}
+	renameContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct{}
@@ -261,6 +345,42 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RequestRetryPolicyStub        func() retry.Policy
+	requestRetryPolicyMutex       sync.RWMutex
+	requestRetryPolicyArgsForCall []struct{}
+	requestRetryPolicyReturns     struct {
+		result1 retry.Policy
+	}
+	requestRetryPolicyReturnsOnCall map[int]struct {
+		result1 retry.Policy
+	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct{}
+	resourceCacheFilePathReturns     struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ResponseCacheDirectoryStub        func() string
+	responseCacheDirectoryMutex       sync.RWMutex
+	responseCacheDirectoryArgsForCall []struct{}
+	responseCacheDirectoryReturns     struct {
+		result1 string
+	}
+	responseCacheDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ResponseCacheEnabledStub        func() bool
+	responseCacheEnabledMutex       sync.RWMutex
+	responseCacheEnabledArgsForCall []struct{}
+	responseCacheEnabledReturns     struct {
+		result1 bool
+	}
+	responseCacheEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -336,6 +456,17 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SwitchContextStub        func(name string) error
+	switchContextMutex       sync.RWMutex
+	switchContextArgsForCall []struct {
+		name string
+	}
+	switchContextReturns struct {
+		result1 error
+	}
+	switchContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StagingTimeoutStub        func() time.Duration
 	stagingTimeoutMutex       sync.RWMutex
 	stagingTimeoutArgsForCall []struct{}
@@ -381,6 +512,15 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TrustedPluginKeysStub        func() []configv3.TrustedPluginKey
+	trustedPluginKeysMutex       sync.RWMutex
+	trustedPluginKeysArgsForCall []struct{}
+	trustedPluginKeysReturns     struct {
+		result1 []configv3.TrustedPluginKey
+	}
+	trustedPluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.TrustedPluginKey
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct{}
@@ -539,6 +679,31 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url
 }
 
+func (fake *FakeConfig) AddTrustedPluginKey(name string, publicKey string) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	fake.addTrustedPluginKeyArgsForCall = append(fake.addTrustedPluginKeyArgsForCall, struct {
+		name      string
+		publicKey string
+	}{name, publicKey})
+	fake.recordInvocation("AddTrustedPluginKey", []interface{}{name, publicKey})
+	fake.addTrustedPluginKeyMutex.Unlock()
+	if fake.AddTrustedPluginKeyStub != nil {
+		fake.AddTrustedPluginKeyStub(name, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCallCount() int {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return len(fake.addTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyArgsForCall(i int) (string, string) {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return fake.addTrustedPluginKeyArgsForCall[i].name, fake.addTrustedPluginKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -659,6 +824,49 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Cassette() (string, bool) {
+	fake.cassetteMutex.Lock()
+	ret, specificReturn := fake.cassetteReturnsOnCall[len(fake.cassetteArgsForCall)]
+	fake.cassetteArgsForCall = append(fake.cassetteArgsForCall, struct{}{})
+	fake.recordInvocation("Cassette", []interface{}{})
+	fake.cassetteMutex.Unlock()
+	if fake.CassetteStub != nil {
+		return fake.CassetteStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cassetteReturns.result1, fake.cassetteReturns.result2
+}
+
+func (fake *FakeConfig) CassetteCallCount() int {
+	fake.cassetteMutex.RLock()
+	defer fake.cassetteMutex.RUnlock()
+	return len(fake.cassetteArgsForCall)
+}
+
+func (fake *FakeConfig) CassetteReturns(result1 string, result2 bool) {
+	fake.CassetteStub = nil
+	fake.cassetteReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) CassetteReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.CassetteStub = nil
+	if fake.cassetteReturnsOnCall == nil {
+		fake.cassetteReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.cassetteReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -779,6 +987,134 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Contexts() []configv3.NamedContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct{}{})
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if fake.ContextsStub != nil {
+		return fake.ContextsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.contextsReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 []configv3.NamedContext) {
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 []configv3.NamedContext) {
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.NamedContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContext(name string) error {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("CreateContext", []interface{}{name})
+	fake.createContextMutex.Unlock()
+	if fake.CreateContextStub != nil {
+		return fake.CreateContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.createContextReturns.result1
+}
+
+func (fake *FakeConfig) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeConfig) CreateContextArgsForCall(i int) string {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return fake.createContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) CreateContextReturns(result1 error) {
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContextReturnsOnCall(i int, result1 error) {
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContext() string {
+	fake.currentContextMutex.Lock()
+	ret, specificReturn := fake.currentContextReturnsOnCall[len(fake.currentContextArgsForCall)]
+	fake.currentContextArgsForCall = append(fake.currentContextArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentContext", []interface{}{})
+	fake.currentContextMutex.Unlock()
+	if fake.CurrentContextStub != nil {
+		return fake.CurrentContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.currentContextReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextCallCount() int {
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
+	return len(fake.currentContextArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextReturns(result1 string) {
+	fake.CurrentContextStub = nil
+	fake.currentContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextReturnsOnCall(i int, result1 string) {
+	fake.CurrentContextStub = nil
+	if fake.currentContextReturnsOnCall == nil {
+		fake.currentContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -822,6 +1158,54 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteContext(name string) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteContext", []interface{}{name})
+	fake.deleteContextMutex.Unlock()
+	if fake.DeleteContextStub != nil {
+		return fake.DeleteContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteContextReturns.result1
+}
+
+func (fake *FakeConfig) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteContextArgsForCall(i int) string {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return fake.deleteContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) DeleteContextReturns(result1 error) {
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1284,6 +1668,46 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PageRequestConcurrency() int {
+	fake.pageRequestConcurrencyMutex.Lock()
+	ret, specificReturn := fake.pageRequestConcurrencyReturnsOnCall[len(fake.pageRequestConcurrencyArgsForCall)]
+	fake.pageRequestConcurrencyArgsForCall = append(fake.pageRequestConcurrencyArgsForCall, struct{}{})
+	fake.recordInvocation("PageRequestConcurrency", []interface{}{})
+	fake.pageRequestConcurrencyMutex.Unlock()
+	if fake.PageRequestConcurrencyStub != nil {
+		return fake.PageRequestConcurrencyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pageRequestConcurrencyReturns.result1
+}
+
+func (fake *FakeConfig) PageRequestConcurrencyCallCount() int {
+	fake.pageRequestConcurrencyMutex.RLock()
+	defer fake.pageRequestConcurrencyMutex.RUnlock()
+	return len(fake.pageRequestConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) PageRequestConcurrencyReturns(result1 int) {
+	fake.PageRequestConcurrencyStub = nil
+	fake.pageRequestConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PageRequestConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.PageRequestConcurrencyStub = nil
+	if fake.pageRequestConcurrencyReturnsOnCall == nil {
+		fake.pageRequestConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.pageRequestConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -1508,6 +1932,79 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemoveTrustedPluginKey(name string) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	fake.removeTrustedPluginKeyArgsForCall = append(fake.removeTrustedPluginKeyArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemoveTrustedPluginKey", []interface{}{name})
+	fake.removeTrustedPluginKeyMutex.Unlock()
+	if fake.RemoveTrustedPluginKeyStub != nil {
+		fake.RemoveTrustedPluginKeyStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCallCount() int {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return len(fake.removeTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyArgsForCall(i int) string {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return fake.removeTrustedPluginKeyArgsForCall[i].name
+}
+
+func (fake *FakeConfig) RenameContext(oldName string, newName string) error {
+	fake.renameContextMutex.Lock()
+	ret, specificReturn := fake.renameContextReturnsOnCall[len(fake.renameContextArgsForCall)]
+	fake.renameContextArgsForCall = append(fake.renameContextArgsForCall, struct {
+		oldName string
+		newName string
+	}{oldName, newName})
+	fake.recordInvocation("RenameContext", []interface{}{oldName, newName})
+	fake.renameContextMutex.Unlock()
+	if fake.RenameContextStub != nil {
+		return fake.RenameContextStub(oldName, newName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.renameContextReturns.result1
+}
+
+func (fake *FakeConfig) RenameContextCallCount() int {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return len(fake.renameContextArgsForCall)
+}
+
+func (fake *FakeConfig) RenameContextArgsForCall(i int) (string, string) {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return fake.renameContextArgsForCall[i].oldName, fake.renameContextArgsForCall[i].newName
+}
+
+func (fake *FakeConfig) RenameContextReturns(result1 error) {
+	fake.RenameContextStub = nil
+	fake.renameContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RenameContextReturnsOnCall(i int, result1 error) {
+	fake.RenameContextStub = nil
+	if fake.renameContextReturnsOnCall == nil {
+		fake.renameContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.renameContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1548,6 +2045,166 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RequestRetryPolicy() retry.Policy {
+	fake.requestRetryPolicyMutex.Lock()
+	ret, specificReturn := fake.requestRetryPolicyReturnsOnCall[len(fake.requestRetryPolicyArgsForCall)]
+	fake.requestRetryPolicyArgsForCall = append(fake.requestRetryPolicyArgsForCall, struct{}{})
+	fake.recordInvocation("RequestRetryPolicy", []interface{}{})
+	fake.requestRetryPolicyMutex.Unlock()
+	if fake.RequestRetryPolicyStub != nil {
+		return fake.RequestRetryPolicyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestRetryPolicyReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryPolicyCallCount() int {
+	fake.requestRetryPolicyMutex.RLock()
+	defer fake.requestRetryPolicyMutex.RUnlock()
+	return len(fake.requestRetryPolicyArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryPolicyReturns(result1 retry.Policy) {
+	fake.RequestRetryPolicyStub = nil
+	fake.requestRetryPolicyReturns = struct {
+		result1 retry.Policy
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryPolicyReturnsOnCall(i int, result1 retry.Policy) {
+	fake.RequestRetryPolicyStub = nil
+	if fake.requestRetryPolicyReturnsOnCall == nil {
+		fake.requestRetryPolicyReturnsOnCall = make(map[int]struct {
+			result1 retry.Policy
+		})
+	}
+	fake.requestRetryPolicyReturnsOnCall[i] = struct {
+		result1 retry.Policy
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resourceCacheFilePathReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirectory() string {
+	fake.responseCacheDirectoryMutex.Lock()
+	ret, specificReturn := fake.responseCacheDirectoryReturnsOnCall[len(fake.responseCacheDirectoryArgsForCall)]
+	fake.responseCacheDirectoryArgsForCall = append(fake.responseCacheDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheDirectory", []interface{}{})
+	fake.responseCacheDirectoryMutex.Unlock()
+	if fake.ResponseCacheDirectoryStub != nil {
+		return fake.ResponseCacheDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryCallCount() int {
+	fake.responseCacheDirectoryMutex.RLock()
+	defer fake.responseCacheDirectoryMutex.RUnlock()
+	return len(fake.responseCacheDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryReturns(result1 string) {
+	fake.ResponseCacheDirectoryStub = nil
+	fake.responseCacheDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryReturnsOnCall(i int, result1 string) {
+	fake.ResponseCacheDirectoryStub = nil
+	if fake.responseCacheDirectoryReturnsOnCall == nil {
+		fake.responseCacheDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.responseCacheDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheEnabled() bool {
+	fake.responseCacheEnabledMutex.Lock()
+	ret, specificReturn := fake.responseCacheEnabledReturnsOnCall[len(fake.responseCacheEnabledArgsForCall)]
+	fake.responseCacheEnabledArgsForCall = append(fake.responseCacheEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheEnabled", []interface{}{})
+	fake.responseCacheEnabledMutex.Unlock()
+	if fake.ResponseCacheEnabledStub != nil {
+		return fake.ResponseCacheEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheEnabledReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheEnabledCallCount() int {
+	fake.responseCacheEnabledMutex.RLock()
+	defer fake.responseCacheEnabledMutex.RUnlock()
+	return len(fake.responseCacheEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheEnabledReturns(result1 bool) {
+	fake.ResponseCacheEnabledStub = nil
+	fake.responseCacheEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheEnabledReturnsOnCall(i int, result1 bool) {
+	fake.ResponseCacheEnabledStub = nil
+	if fake.responseCacheEnabledReturnsOnCall == nil {
+		fake.responseCacheEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.responseCacheEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1856,6 +2513,54 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchContext(name string) error {
+	fake.switchContextMutex.Lock()
+	ret, specificReturn := fake.switchContextReturnsOnCall[len(fake.switchContextArgsForCall)]
+	fake.switchContextArgsForCall = append(fake.switchContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("SwitchContext", []interface{}{name})
+	fake.switchContextMutex.Unlock()
+	if fake.SwitchContextStub != nil {
+		return fake.SwitchContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.switchContextReturns.result1
+}
+
+func (fake *FakeConfig) SwitchContextCallCount() int {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	return len(fake.switchContextArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchContextArgsForCall(i int) string {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	return fake.switchContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) SwitchContextReturns(result1 error) {
+	fake.SwitchContextStub = nil
+	fake.switchContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchContextReturnsOnCall(i int, result1 error) {
+	fake.SwitchContextStub = nil
+	if fake.switchContextReturnsOnCall == nil {
+		fake.switchContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) StagingTimeout() time.Duration {
 	fake.stagingTimeoutMutex.Lock()
 	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
@@ -2056,6 +2761,46 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TrustedPluginKeys() []configv3.TrustedPluginKey {
+	fake.trustedPluginKeysMutex.Lock()
+	ret, specificReturn := fake.trustedPluginKeysReturnsOnCall[len(fake.trustedPluginKeysArgsForCall)]
+	fake.trustedPluginKeysArgsForCall = append(fake.trustedPluginKeysArgsForCall, struct{}{})
+	fake.recordInvocation("TrustedPluginKeys", []interface{}{})
+	fake.trustedPluginKeysMutex.Unlock()
+	if fake.TrustedPluginKeysStub != nil {
+		return fake.TrustedPluginKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.trustedPluginKeysReturns.result1
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCallCount() int {
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
+	return len(fake.trustedPluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturns(result1 []configv3.TrustedPluginKey) {
+	fake.TrustedPluginKeysStub = nil
+	fake.trustedPluginKeysReturns = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturnsOnCall(i int, result1 []configv3.TrustedPluginKey) {
+	fake.TrustedPluginKeysStub = nil
+	if fake.trustedPluginKeysReturnsOnCall == nil {
+		fake.trustedPluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TrustedPluginKey
+		})
+	}
+	fake.trustedPluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -2356,20 +3101,32 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cassetteMutex.RLock()
+	defer fake.cassetteMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -2392,6 +3149,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.pageRequestConcurrencyMutex.RLock()
+	defer fake.pageRequestConcurrencyMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -2404,8 +3163,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.requestRetryPolicyMutex.RLock()
+	defer fake.requestRetryPolicyMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	fake.responseCacheDirectoryMutex.RLock()
+	defer fake.responseCacheDirectoryMutex.RUnlock()
+	fake.responseCacheEnabledMutex.RLock()
+	defer fake.responseCacheEnabledMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -2428,6 +3199,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
@@ -2438,6 +3211,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
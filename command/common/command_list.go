@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -11,7 +12,9 @@ import (
 var Commands commandList
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	Output           flag.OutputFormat `long:"output" description:"Display results as json or yaml"`
+	Context          string            `long:"context" description:"Use the named context for this command only"`
 
 	V3App                v3.V3AppCommand                `command:"v3-app" description:"Display health and status for an app"`
 	V3Apps               v3.V3AppsCommand               `command:"v3-apps" description:"List all apps in the target space"`
@@ -22,9 +25,10 @@ type commandList struct {
 	V3Droplets           v3.V3DropletsCommand           `command:"v3-droplets" description:"List droplets of an app"`
 	V3Env                v3.V3EnvCommand                `command:"v3-env" description:"Show all env variables for an app"`
 	V3GetHealthCheck     v3.V3GetHealthCheckCommand     `command:"v3-get-health-check" description:"Show the type of health check performed on an app"`
+	V3Logs               v3.V3LogsCommand               `command:"v3-logs" description:"Tail or show recent logs for an app"`
 	V3Packages           v3.V3PackagesCommand           `command:"v3-packages" description:"List packages of an app"`
 	V3Push               v3.V3PushCommand               `command:"v3-push" description:"Push a new app or sync changes to an existing app"`
-	V3Restart            v3.V3RestartCommand            `command:"v3-restart" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	V3Restart            v3.V3RestartCommand            `command:"v3-restart" description:"Stop all instances of the app, then start them again. This causes downtime unless --strategy rolling is used."`
 	V3RestartAppInstance v3.V3RestartAppInstanceCommand `command:"v3-restart-app-instance" description:"Terminate, then instantiate an app instance"`
 	V3Scale              v3.V3ScaleCommand              `command:"v3-scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
 	V3SetDroplet         v3.V3SetDropletCommand         `command:"v3-set-droplet" description:"Set the droplet used to run an app"`
@@ -34,10 +38,15 @@ type commandList struct {
 	V3Start              v3.V3StartCommand              `command:"v3-start" description:"Start an app"`
 	V3Stop               v3.V3StopCommand               `command:"v3-stop" description:"Stop an app"`
 	V3UnsetEnv           v3.V3UnsetEnvCommand           `command:"v3-unset-env" description:"Remove an env variable from an app"`
+	V3ValidateManifest   v3.V3ValidateManifestCommand   `command:"v3-validate-manifest" description:"Validate a manifest without contacting the Cloud Controller"`
+	V3SCP                v3.V3SCPCommand                `command:"v3-scp" description:"Copy files and directories to or from an application container instance"`
+	V3SFTP               v3.V3SFTPCommand               `command:"v3-sftp" description:"Browse and copy files on an application container instance interactively"`
 	V3SSH                v3.V3SSHCommand                `command:"v3-ssh" description:"SSH to an application container instance"`
 
+	AddPluginKey                       plugin.AddPluginKeyCommand                   `command:"add-plugin-key" description:"Trust an ed25519 public key for verifying plugin signatures"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
+	ApplyNetworkPolicies               v3.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Make the network policies in the target space match a network policies file"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
@@ -50,10 +59,13 @@ type commandList struct {
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	ClearResourceCache                 v2.ClearResourceCacheCommand                 `command:"clear-resource-cache" description:"Clear the cached hashes of app files used by push"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v2.ContextsCommand                           `command:"contexts" description:"List all named contexts"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v2.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
+	CreateContext                      v2.CreateContextCommand                      `command:"create-context" description:"Save the current target and session as a named context"`
 	CreateDomain                       v2.CreateDomainCommand                       `command:"create-domain" description:"Create a domain in an org for later use"`
 	CreateIsolationSegment             v3.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v2.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
@@ -71,6 +83,7 @@ type commandList struct {
 	CreateUser                         v2.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
 	Curl                               v2.CurlCommand                               `command:"curl" description:"Executes a request to the targeted API endpoint"`
 	DeleteBuildpack                    v2.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
+	DeleteContext                      v2.DeleteContextCommand                      `command:"delete-context" description:"Delete a named context"`
 	DeleteDomain                       v2.DeleteDomainCommand                       `command:"delete-domain" description:"Delete a domain"`
 	DeleteIsolationSegment             v3.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v2.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
@@ -99,6 +112,7 @@ type commandList struct {
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportNetworkPolicies              v3.ExportNetworkPoliciesCommand              `command:"export-network-policies" description:"Write the network policies in the target space to a file"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
@@ -106,6 +120,7 @@ type commandList struct {
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Labels                             v3.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
@@ -119,6 +134,7 @@ type commandList struct {
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
+	PluginKeys                         plugin.PluginKeysCommand                     `command:"plugin-keys" description:"List the trusted plugin keys"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
@@ -126,8 +142,10 @@ type commandList struct {
 	Quotas                             v2.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v2.QuotaCommand                              `command:"quota" description:"Show quota info"`
 	RemoveNetworkPolicy                v3.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
+	RemovePluginKey                    plugin.RemovePluginKeyCommand                `command:"remove-plugin-key" description:"Stop trusting a plugin key"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	RenameBuildpack                    v2.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
+	RenameContext                      v2.RenameContextCommand                      `command:"rename-context" description:"Rename a named context"`
 	RenameOrg                          v2.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameServiceBroker                v2.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
 	RenameService                      v2.RenameServiceCommand                      `command:"rename-service" description:"Rename a service instance"`
@@ -147,6 +165,7 @@ type commandList struct {
 	Scale                              v2.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
 	SecurityGroups                     v2.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	SecurityGroup                      v2.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
+	SecurityGroupAudit                 v2.SecurityGroupAuditCommand                 `command:"security-group-audit" description:"Show the effective egress rules of a space and problems with them"`
 	ServiceAccess                      v2.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
 	ServiceAuthTokens                  v2.ServiceAuthTokensCommand                  `command:"service-auth-tokens" description:"List service auth tokens"`
 	ServiceBrokers                     v2.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
@@ -156,6 +175,7 @@ type commandList struct {
 	Service                            v2.ServiceCommand                            `command:"service" description:"Show service instance info"`
 	SetEnv                             v2.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
+	SetLabel                           v3.SetLabelCommand                           `command:"set-label" description:"Set a label (key-value pairs) for an API resource"`
 	SetOrgDefaultIsolationSegment      v3.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v2.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
 	SetQuota                           v2.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
@@ -181,6 +201,7 @@ type commandList struct {
 	StagingSecurityGroups              v2.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups in the staging set for applications"`
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v2.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
+	SwitchContext                      v2.SwitchContextCommand                      `command:"switch-context" description:"Switch to a named context"`
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
@@ -192,6 +213,7 @@ type commandList struct {
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
+	UnsetLabel                         v3.UnsetLabelCommand                         `command:"unset-label" description:"Unset a label (key-value pairs) for an API resource"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	UnsetSpaceQuota                    v2.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
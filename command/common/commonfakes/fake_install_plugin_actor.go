@@ -51,6 +51,19 @@ type FakeInstallPluginActor struct {
 	fileExistsReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	GetDetachedPluginSignatureStub        func(pluginLocation string) (string, error)
+	getDetachedPluginSignatureMutex       sync.RWMutex
+	getDetachedPluginSignatureArgsForCall []struct {
+		pluginLocation string
+	}
+	getDetachedPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	getDetachedPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetAndValidatePluginStub        func(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
 	getAndValidatePluginMutex       sync.RWMutex
 	getAndValidatePluginArgsForCall []struct {
@@ -144,6 +157,21 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	VerifyPluginSignatureStub        func(path string, signature string, requireSignature bool) (string, error)
+	verifyPluginSignatureMutex       sync.RWMutex
+	verifyPluginSignatureArgsForCall []struct {
+		path             string
+		signature        string
+		requireSignature bool
+	}
+	verifyPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -301,6 +329,57 @@ func (fake *FakeInstallPluginActor) FileExistsReturnsOnCall(i int, result1 bool)
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignature(pluginLocation string) (string, error) {
+	fake.getDetachedPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.getDetachedPluginSignatureReturnsOnCall[len(fake.getDetachedPluginSignatureArgsForCall)]
+	fake.getDetachedPluginSignatureArgsForCall = append(fake.getDetachedPluginSignatureArgsForCall, struct {
+		pluginLocation string
+	}{pluginLocation})
+	fake.recordInvocation("GetDetachedPluginSignature", []interface{}{pluginLocation})
+	fake.getDetachedPluginSignatureMutex.Unlock()
+	if fake.GetDetachedPluginSignatureStub != nil {
+		return fake.GetDetachedPluginSignatureStub(pluginLocation)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getDetachedPluginSignatureReturns.result1, fake.getDetachedPluginSignatureReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureCallCount() int {
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
+	return len(fake.getDetachedPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureArgsForCall(i int) string {
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
+	return fake.getDetachedPluginSignatureArgsForCall[i].pluginLocation
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureReturns(result1 string, result2 error) {
+	fake.GetDetachedPluginSignatureStub = nil
+	fake.getDetachedPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetDetachedPluginSignatureStub = nil
+	if fake.getDetachedPluginSignatureReturnsOnCall == nil {
+		fake.getDetachedPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getDetachedPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error) {
 	fake.getAndValidatePluginMutex.Lock()
 	ret, specificReturn := fake.getAndValidatePluginReturnsOnCall[len(fake.getAndValidatePluginArgsForCall)]
@@ -662,6 +741,59 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) VerifyPluginSignature(path string, signature string, requireSignature bool) (string, error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyPluginSignatureReturnsOnCall[len(fake.verifyPluginSignatureArgsForCall)]
+	fake.verifyPluginSignatureArgsForCall = append(fake.verifyPluginSignatureArgsForCall, struct {
+		path             string
+		signature        string
+		requireSignature bool
+	}{path, signature, requireSignature})
+	fake.recordInvocation("VerifyPluginSignature", []interface{}{path, signature, requireSignature})
+	fake.verifyPluginSignatureMutex.Unlock()
+	if fake.VerifyPluginSignatureStub != nil {
+		return fake.VerifyPluginSignatureStub(path, signature, requireSignature)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.verifyPluginSignatureReturns.result1, fake.verifyPluginSignatureReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCallCount() int {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return len(fake.verifyPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureArgsForCall(i int) (string, string, bool) {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return fake.verifyPluginSignatureArgsForCall[i].path, fake.verifyPluginSignatureArgsForCall[i].signature, fake.verifyPluginSignatureArgsForCall[i].requireSignature
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturns(result1 string, result2 error) {
+	fake.VerifyPluginSignatureStub = nil
+	fake.verifyPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.VerifyPluginSignatureStub = nil
+	if fake.verifyPluginSignatureReturnsOnCall == nil {
+		fake.verifyPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -671,6 +803,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
 	fake.fileExistsMutex.RLock()
 	defer fake.fileExistsMutex.RUnlock()
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
 	fake.getAndValidatePluginMutex.RLock()
 	defer fake.getAndValidatePluginMutex.RUnlock()
 	fake.getPlatformStringMutex.RLock()
@@ -685,6 +819,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
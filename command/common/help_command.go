@@ -314,6 +314,7 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE=har:path/to/trace.har", cmd.UI.TranslateText("Append API requests and responses to a HAR file (not supported by legacy commands)")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable proxying for HTTP requests")},
 	}
@@ -323,6 +324,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output", cmd.UI.TranslateText("Display results as json or yaml (supported commands only)")},
+		{"--context", cmd.UI.TranslateText("Use the named context for this command only")},
 	}
 }
 
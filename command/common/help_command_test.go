@@ -414,6 +414,8 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say("  --output                           Display results as json or yaml \\(supported commands only\\)"))
+			Expect(testUI.Out).To(Say("  --context                          Use the named context for this command only"))
 
 			Expect(testUI.Out).To(Say("Use 'cf help -a' to see all commands\\."))
 		})
@@ -507,6 +509,10 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   help\\s+Show help"))
 				Expect(testUI.Out).To(Say("   api\\s+Set or view target api url"))
 				Expect(testUI.Out).To(Say(""))
+				Expect(testUI.Out).To(Say("CONTEXTS:"))
+				Expect(testUI.Out).To(Say("   contexts\\s+List all named contexts"))
+				Expect(testUI.Out).To(Say("   delete-context\\s+Delete a named context"))
+				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("APPS:"))
 				Expect(testUI.Out).To(Say("   apps\\s+List all apps in the target space"))
 				Expect(testUI.Out).To(Say("   restart-app-instance\\s+Terminate, then restart an app instance"))
@@ -583,6 +589,7 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("ADVANCED:"))
 				Expect(testUI.Out).To(Say("   curl\\s+Executes a request to the targeted API endpoint"))
 				Expect(testUI.Out).To(Say("   ssh-code\\s+Get a one time password for ssh clients"))
+				Expect(testUI.Out).To(Say("   clear-resource-cache\\s+Clear the cached hashes of app files used by push"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("ADD/REMOVE PLUGIN REPOSITORY:"))
 				Expect(testUI.Out).To(Say("   add-plugin-repo\\s+Add a new plugin repository"))
@@ -602,12 +609,15 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE=har:path/to/trace.har     Append API requests and responses to a HAR file \\(not supported by legacy commands\\)"))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable proxying for HTTP requests"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --output                           Display results as json or yaml \\(supported commands only\\)"))
+				Expect(testUI.Out).To(Say("   --context                          Use the named context for this command only"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("APPS \\(experimental\\):"))
 				Expect(testUI.Out).To(Say("   v3-apps\\s+List all apps in the target space"))
@@ -622,6 +632,7 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   v3-stage\\s+Create a new droplet for an app"))
 				Expect(testUI.Out).To(Say("   v3-restart-app-instance\\s+Terminate, then instantiate an app instance"))
 				Expect(testUI.Out).To(Say("   v3-apply-manifest\\s+Applies manifest properties to an application"))
+				Expect(testUI.Out).To(Say("   v3-validate-manifest\\s+Validate a manifest without contacting the Cloud Controller"))
 				Expect(testUI.Out).To(Say("   v3-droplets\\s+List droplets of an app"))
 				Expect(testUI.Out).To(Say("   v3-set-droplet\\s+Set the droplet used to run an app"))
 				Expect(testUI.Out).To(Say("   v3-env\\s+Show all env variables for an app"))
@@ -632,10 +643,17 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   v3-packages\\s+List packages of an app"))
 				Expect(testUI.Out).To(Say("   v3-create-package\\s+Uploads a V3 Package"))
 				Expect(testUI.Out).To(Say("   v3-ssh\\s+SSH to an application container instance"))
+				Expect(testUI.Out).To(Say("   v3-scp\\s+Copy files and directories to or from an application container instance"))
+				Expect(testUI.Out).To(Say("   v3-sftp\\s+Browse and copy files on an application container instance interactively"))
 				Expect(testUI.Out).To(Say("SERVICES \\(experimental\\):"))
 				Expect(testUI.Out).To(Say("   share-service\\s+Share a service instance with another space"))
 				Expect(testUI.Out).To(Say("   unshare-service\\s+Unshare a shared service instance from a space"))
 
+				Expect(testUI.Out).To(Say("METADATA \\(experimental\\):"))
+				Expect(testUI.Out).To(Say("   labels\\s+List all labels \\(key-value pairs\\) for an API resource"))
+				Expect(testUI.Out).To(Say("   set-label\\s+Set a label \\(key-value pairs\\) for an API resource"))
+				Expect(testUI.Out).To(Say("   unset-label\\s+Unset a label \\(key-value pairs\\) for an API resource"))
+
 			})
 
 			Context("when there are multiple installed plugins", func() {
@@ -25,6 +25,7 @@ type InstallPluginActor interface {
 	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
 	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
 	FileExists(path string) bool
+	GetDetachedPluginSignature(pluginLocation string) (string, error)
 	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
 	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
 	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
@@ -32,6 +33,7 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string, requireSignature bool) (string, error)
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -56,8 +58,9 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	RequireSignature     bool                   `long:"require-signature" description:"Fail unless the plugin binary, and the index of the repository it is installed from, are signed by a trusted plugin key"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--require-signature]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f] [--require-signature]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nSIGNATURES:\n   Signatures are verified against the keys added with add-plugin-key. A plugin\n   binary installed from a file or URL is signed by a base64 encoded ed25519\n   signature in a file of the same name with .sig appended.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo --require-signature"`
+	relatedCommands      interface{}            `related_commands:"add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
 	Actor                InstallPluginActor
@@ -276,6 +279,16 @@ func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (s
 		return "", 0, err
 	}
 
+	signature, err := cmd.Actor.GetDetachedPluginSignature(pluginLocation)
+	if err != nil {
+		return "", 0, err
+	}
+
+	err = cmd.verifyPluginSignature(pluginLocation, signature)
+	if err != nil {
+		return "", 0, err
+	}
+
 	return pluginLocation, PluginFromLocalFile, err
 }
 
@@ -294,6 +307,16 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 		return "", 0, err
 	}
 
+	signature, err := cmd.Actor.GetDetachedPluginSignature(pluginLocation)
+	if err != nil {
+		return "", 0, err
+	}
+
+	err = cmd.verifyPluginSignature(tempPath, signature)
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromURL, err
 }
 
@@ -354,9 +377,34 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, translatableerror.InvalidChecksumError{}
 	}
 
+	err = cmd.verifyPluginSignature(tempPath, pluginInfo.Signature)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if cmd.RequireSignature && !pluginInfo.RepositoryVerified {
+		return "", 0, translatableerror.PluginRepositorySignatureMissingError{RepositoryName: repoList[0]}
+	}
+
 	return tempPath, PluginFromRepository, err
 }
 
+// verifyPluginSignature verifies the signature of the plugin binary at path
+// against the trusted plugin keys, as required by --require-signature.
+func (cmd InstallPluginCommand) verifyPluginSignature(path string, signature string) error {
+	keyName, err := cmd.Actor.VerifyPluginSignature(path, signature, cmd.RequireSignature)
+	if err != nil {
+		return err
+	}
+
+	if keyName != "" {
+		cmd.UI.DisplayText("Plugin signature verified with trusted key {{.KeyName}}.", map[string]interface{}{
+			"KeyName": keyName,
+		})
+	}
+	return nil
+}
+
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
 	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
 	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
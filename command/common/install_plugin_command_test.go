@@ -280,6 +280,75 @@ var _ = Describe("install-plugin command", func() {
 						})
 					})
 				})
+
+				Context("when the plugin has a detached signature", func() {
+					BeforeEach(func() {
+						fakeActor.GetDetachedPluginSignatureReturns("some-signature", nil)
+						fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+					})
+
+					Context("when the signature is verified", func() {
+						BeforeEach(func() {
+							fakeActor.VerifyPluginSignatureReturns("some-key", nil)
+						})
+
+						It("verifies the local file and installs the plugin", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Plugin signature verified with trusted key some-key\\."))
+							Expect(testUI.Out).To(Say("Installing plugin some-plugin\\.\\.\\."))
+
+							Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(1))
+							Expect(fakeActor.GetDetachedPluginSignatureArgsForCall(0)).To(Equal("some-path"))
+
+							Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+							path, signature, requireSignature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+							Expect(path).To(Equal("some-path"))
+							Expect(signature).To(Equal("some-signature"))
+							Expect(requireSignature).To(BeFalse())
+						})
+					})
+
+					Context("when the signature is not verified", func() {
+						BeforeEach(func() {
+							fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureInvalidError{})
+						})
+
+						It("returns the error and does not install the plugin", func() {
+							Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+
+							Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when getting the detached signature errors", func() {
+					BeforeEach(func() {
+						expectedErr = errors.New("some-error")
+						fakeActor.GetDetachedPluginSignatureReturns("", expectedErr)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+
+						Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when --require-signature is given", func() {
+					BeforeEach(func() {
+						cmd.RequireSignature = true
+						fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureMissingError{})
+					})
+
+					It("requires the signature", func() {
+						Expect(executeErr).To(MatchError(actionerror.PluginSignatureMissingError{}))
+
+						_, _, requireSignature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+						Expect(requireSignature).To(BeTrue())
+					})
+				})
 			})
 
 			Context("when the -f argument is not given (user is prompted for confirmation)", func() {
@@ -613,6 +682,42 @@ var _ = Describe("install-plugin command", func() {
 					})
 				})
 			})
+
+			Context("when the plugin has a detached signature", func() {
+				BeforeEach(func() {
+					fakeActor.DownloadExecutableBinaryFromURLReturns("some-path", nil)
+					fakeActor.GetDetachedPluginSignatureReturns("some-signature", nil)
+					fakeActor.VerifyPluginSignatureReturns("some-key", nil)
+					fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: pluginName}, nil)
+				})
+
+				It("verifies the downloaded binary against the signature next to the URL", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Starting download of plugin binary from URL\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Plugin signature verified with trusted key some-key\\."))
+
+					Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(1))
+					Expect(fakeActor.GetDetachedPluginSignatureArgsForCall(0)).To(Equal("http://some-url"))
+
+					Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+					path, signature, _ := fakeActor.VerifyPluginSignatureArgsForCall(0)
+					Expect(path).To(Equal("some-path"))
+					Expect(signature).To(Equal("some-signature"))
+				})
+
+				Context("when the signature is not verified", func() {
+					BeforeEach(func() {
+						fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureInvalidError{})
+					})
+
+					It("returns the error and does not install the plugin", func() {
+						Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+
+						Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 
 		Context("when the -f argument is not given (user is prompted for confirmation)", func() {
@@ -291,6 +291,74 @@ var _ = Describe("install-plugin command", func() {
 									fakeActor.ValidateFileChecksumReturns(true)
 								})
 
+								Context("when the plugin binary is signed by a trusted key", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature"}, []string{repoName}, nil)
+										fakeActor.VerifyPluginSignatureReturns("some-key", nil)
+										fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+										fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: pluginName}, nil)
+									})
+
+									It("verifies the signature from the repository", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+
+										Expect(testUI.Out).To(Say("Starting download of plugin binary from repository %s\\.\\.\\.", repoName))
+										Expect(testUI.Out).To(Say("Plugin signature verified with trusted key some-key\\."))
+										Expect(testUI.Out).To(Say("Installing plugin %s\\.\\.\\.", pluginName))
+
+										Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+										pathArg, signatureArg, requireSignatureArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+										Expect(pathArg).To(Equal(execPath))
+										Expect(signatureArg).To(Equal("some-signature"))
+										Expect(requireSignatureArg).To(BeFalse())
+
+										Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(0))
+									})
+								})
+
+								Context("when verifying the plugin signature errors", func() {
+									BeforeEach(func() {
+										fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureInvalidError{})
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+										Expect(testUI.Out).ToNot(Say("Installing plugin"))
+
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+									})
+								})
+
+								Context("when --require-signature is given", func() {
+									BeforeEach(func() {
+										cmd.RequireSignature = true
+										fakeActor.VerifyPluginSignatureReturns("some-key", nil)
+									})
+
+									Context("when the repository index is not verified", func() {
+										It("returns a PluginRepositorySignatureMissingError", func() {
+											Expect(executeErr).To(MatchError(translatableerror.PluginRepositorySignatureMissingError{RepositoryName: repoName}))
+											Expect(testUI.Out).ToNot(Say("Installing plugin"))
+
+											_, _, requireSignatureArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+											Expect(requireSignatureArg).To(BeTrue())
+										})
+									})
+
+									Context("when the repository index is verified", func() {
+										BeforeEach(func() {
+											fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature", RepositoryVerified: true}, []string{repoName}, nil)
+											fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+											fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: pluginName}, nil)
+										})
+
+										It("installs the plugin", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(testUI.Out).To(Say("Installing plugin %s\\.\\.\\.", pluginName))
+										})
+									})
+								})
+
 								Context("when creating an executable copy errors", func() {
 									BeforeEach(func() {
 										fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
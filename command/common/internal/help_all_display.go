@@ -8,6 +8,12 @@ var HelpCategoryList = []HelpCategory{
 			{"api", "auth"},
 		},
 	},
+	{
+		CategoryName: "CONTEXTS:",
+		CommandList: [][]string{
+			{"contexts", "create-context", "switch-context", "rename-context", "delete-context"},
+		},
+	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
@@ -65,6 +71,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"export-network-policies", "apply-network-policies"},
 		},
 	},
 	{
@@ -109,7 +116,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SECURITY GROUP:",
 		CommandList: [][]string{
-			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
+			{"security-group", "security-groups", "security-group-audit", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
 		},
@@ -135,7 +142,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "ssh-code", "clear-resource-cache"},
 		},
 	},
 	{
@@ -148,6 +155,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
 			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"add-plugin-key", "remove-plugin-key", "plugin-keys"},
 		},
 	},
 }
@@ -158,12 +166,12 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"v3-apps", "v3-app", "v3-create-app"},
 			{"v3-push", "v3-scale", "v3-delete"},
-			{"v3-start", "v3-stop", "v3-restart", "v3-stage", "v3-restart-app-instance", "v3-apply-manifest"},
+			{"v3-start", "v3-stop", "v3-restart", "v3-stage", "v3-restart-app-instance", "v3-apply-manifest", "v3-validate-manifest"},
 			{"v3-droplets", "v3-set-droplet"},
 			{"v3-env", "v3-set-env", "v3-unset-env"},
 			{"v3-get-health-check", "v3-set-health-check"},
 			{"v3-packages", "v3-create-package"},
-			{"v3-ssh"},
+			{"v3-logs", "v3-ssh", "v3-scp", "v3-sftp"},
 		},
 	},
 	{
@@ -172,4 +180,10 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 			{"share-service", "unshare-service"},
 		},
 	},
+	{
+		CategoryName: "METADATA (experimental):",
+		CommandList: [][]string{
+			{"labels", "set-label", "unset-label"},
+		},
+	},
 }
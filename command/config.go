@@ -3,6 +3,7 @@ package command
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -13,13 +14,19 @@ type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddTrustedPluginKey(name string, publicKey string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	Cassette() (string, bool)
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() []configv3.NamedContext
+	CreateContext(name string) error
+	CurrentContext() string
 	CurrentUser() (configv3.User, error)
+	DeleteContext(name string) error
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
@@ -31,13 +38,20 @@ type Config interface {
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	OverallPollingTimeout() time.Duration
+	PageRequestConcurrency() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RemoveTrustedPluginKey(name string)
+	RenameContext(oldName string, newName string) error
 	RequestRetryCount() int
+	RequestRetryPolicy() retry.Policy
+	ResourceCacheFilePath() string
+	ResponseCacheDirectory() string
+	ResponseCacheEnabled() bool
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
@@ -49,11 +63,13 @@ type Config interface {
 	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
+	SwitchContext(name string) error
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TrustedPluginKeys() []configv3.TrustedPluginKey
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
@@ -9,3 +9,12 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredOutputCommander is implemented by commands that can display their
+// results with UI.DisplayStructuredOutput when the global --output flag is
+// provided. Commands that do not implement it fail with
+// StructuredOutputNotSupportedError when --output is used.
+type StructuredOutputCommander interface {
+	ExtendedCommander
+	SupportsStructuredOutput() bool
+}
@@ -16,6 +16,10 @@ type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
 
+type ContextName struct {
+	ContextName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
 type Domain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
@@ -48,6 +52,10 @@ type PluginRepoName struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 }
 
+type PluginKeyName struct {
+	PluginKeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+}
+
 type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
@@ -259,6 +267,11 @@ type ServiceBrokerArgs struct {
 	URL           string `positional-arg-name:"URL" required:"true" description:"The URL of the service broker"`
 }
 
+type RenameContextArgs struct {
+	OldContextName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The old context name"`
+	NewContextName string `positional-arg-name:"NEW_CONTEXT_NAME" required:"true" description:"The new context name"`
+}
+
 type RenameServiceBrokerArgs struct {
 	OldServiceBrokerName string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The old service broker name"`
 	NewServiceBrokerName string `positional-arg-name:"NEW_SERVICE_BROKER" required:"true" description:"The new service broker name"`
@@ -282,6 +295,11 @@ type AddPluginRepoArgs struct {
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
 }
 
+type AddPluginKeyArgs struct {
+	PluginKeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+	PublicKey     string `positional-arg-name:"PUBLIC_KEY" required:"true" description:"The base64 encoded ed25519 public key"`
+}
+
 type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
@@ -325,3 +343,29 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type ApplyNetworkPoliciesArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"PATH" required:"true" description:"Path to a network policies file"`
+}
+
+type LabelsArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The resource type"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The resource name"`
+}
+
+type SetLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The resource type"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The resource name"`
+	Labels       []Label       `positional-arg-name:"KEY=VALUE" required:"true" description:"The labels to set"`
+}
+
+type UnsetLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The resource type"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The resource name"`
+	LabelKeys    []string      `positional-arg-name:"KEY" required:"true" description:"The keys of the labels to remove"`
+}
+
+type CopyFileArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path, or APP_NAME:PATH on an app instance, to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path, or APP_NAME:PATH on an app instance, to copy to"`
+}
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type DeploymentStrategy struct {
+	Name constant.DeploymentStrategy
+}
+
+func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(constant.DeploymentStrategyRolling)}, prefix, false)
+}
+
+func (h *DeploymentStrategy) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch constant.DeploymentStrategy(valLower) {
+	case constant.DeploymentStrategyRolling:
+		h.Name = constant.DeploymentStrategy(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "rolling"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentStrategy", func() {
+	var strategy DeploymentStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'rolling' when passed 'r'", "r",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'rolling' when passed 'ROL'", "ROL",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'rolling' when passed nothing", "",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			strategy = DeploymentStrategy{}
+		})
+
+		DescribeTable("downcases and sets name",
+			func(input string, expected constant.DeploymentStrategy) {
+				err := strategy.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(strategy.Name).To(Equal(expected))
+			},
+			Entry("sets 'rolling' when passed 'rolling'", "rolling", constant.DeploymentStrategyRolling),
+			Entry("sets 'rolling' when passed 'RoLLing'", "RoLLing", constant.DeploymentStrategyRolling),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := strategy.UnmarshalFlag("blue-green")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STRATEGY must be "rolling"`,
+				}))
+				Expect(strategy.Name).To(BeEmpty())
+			})
+		})
+	})
+})
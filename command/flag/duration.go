@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a positive duration such as 30s, 5m or 2h.
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalFlag(val string) error {
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Duration must be a positive number followed by a unit, such as 30s, 5m or 2h.`,
+		}
+	}
+
+	d.Duration = duration
+	return nil
+}
@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	BeforeEach(func() {
+		duration = Duration{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("parses the duration", func() {
+			err := duration.UnmarshalFlag("1h30m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(duration.Duration).To(Equal(90 * time.Minute))
+		})
+
+		DescribeTable("returns an error for invalid durations",
+			func(input string) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Duration must be a positive number followed by a unit, such as 30s, 5m or 2h.`,
+				}))
+				Expect(duration.Duration).To(BeZero())
+			},
+
+			Entry("empty", ""),
+			Entry("no unit", "10"),
+			Entry("zero", "0s"),
+			Entry("negative", "-5m"),
+		)
+	})
+})
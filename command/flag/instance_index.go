@@ -0,0 +1,24 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndex is the index of an app instance. It is not set when the flag
+// is not provided.
+type InstanceIndex struct {
+	types.NullInt
+}
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	err := i.ParseStringValue(val)
+	if err != nil || !i.IsSet || i.Value < 0 {
+		i.NullInt = types.NullInt{}
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid argument for instance index flag (expected int >= 0)",
+		}
+	}
+	return nil
+}
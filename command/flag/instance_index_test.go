@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceIndex", func() {
+	var instanceIndex InstanceIndex
+
+	BeforeEach(func() {
+		instanceIndex = InstanceIndex{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when zero is provided", func() {
+			It("sets the index", func() {
+				err := instanceIndex.UnmarshalFlag("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instanceIndex).To(Equal(InstanceIndex{NullInt: types.NullInt{Value: 0, IsSet: true}}))
+			})
+		})
+
+		Context("when a positive integer is provided", func() {
+			It("sets the index", func() {
+				err := instanceIndex.UnmarshalFlag("3")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instanceIndex).To(Equal(InstanceIndex{NullInt: types.NullInt{Value: 3, IsSet: true}}))
+			})
+		})
+
+		Context("when a negative integer is provided", func() {
+			It("returns an error", func() {
+				err := instanceIndex.UnmarshalFlag("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid argument for instance index flag (expected int >= 0)",
+				}))
+				Expect(instanceIndex).To(Equal(InstanceIndex{}))
+			})
+		})
+
+		Context("when a non-integer is provided", func() {
+			It("returns an error", func() {
+				err := instanceIndex.UnmarshalFlag("abc")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid argument for instance index flag (expected int >= 0)",
+				}))
+				Expect(instanceIndex).To(Equal(InstanceIndex{}))
+			})
+		})
+	})
+})
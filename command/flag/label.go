@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Label is a label provided as KEY=VALUE.
+type Label struct {
+	Key   string
+	Value string
+}
+
+func (l *Label) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Labels must be provided as KEY=VALUE",
+		}
+	}
+
+	l.Key = parts[0]
+	l.Value = parts[1]
+	return nil
+}
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LabelResource struct {
+	Type string
+}
+
+func (LabelResource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"app", "org", "service-instance", "space"}, prefix, false)
+}
+
+func (l *LabelResource) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "app", "org", "service-instance", "space":
+		l.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `RESOURCE must be "app", "org", "space", or "service-instance"`,
+		}
+	}
+	return nil
+}
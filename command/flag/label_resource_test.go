@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelResource", func() {
+	var resource LabelResource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := resource.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'app' when passed 'a'", "a",
+				[]flags.Completion{{Item: "app"}}),
+			Entry("returns 'service-instance' and 'space' when passed 'S'", "S",
+				[]flags.Completion{{Item: "service-instance"}, {Item: "space"}}),
+			Entry("returns all resources when passed ''", "",
+				[]flags.Completion{{Item: "app"}, {Item: "org"}, {Item: "service-instance"}, {Item: "space"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			resource = LabelResource{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(input string, expectedType string) {
+				err := resource.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resource.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'app' when passed 'App'", "App", "app"),
+			Entry("sets 'org' when passed 'org'", "org", "org"),
+			Entry("sets 'space' when passed 'SPACE'", "SPACE", "space"),
+			Entry("sets 'service-instance' when passed 'service-instance'", "service-instance", "service-instance"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := resource.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `RESOURCE must be "app", "org", "space", or "service-instance"`,
+				}))
+				Expect(resource.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Label", func() {
+	var label Label
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			label = Label{}
+		})
+
+		DescribeTable("splits the key from the value",
+			func(input string, expectedKey string, expectedValue string) {
+				err := label.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(label).To(Equal(Label{Key: expectedKey, Value: expectedValue}))
+			},
+			Entry("when passed KEY=VALUE", "team=payments", "team", "payments"),
+			Entry("when the value contains '='", "query=a=b", "query", "a=b"),
+			Entry("when the value is empty", "team=", "team", ""),
+		)
+
+		DescribeTable("returns an error",
+			func(input string) {
+				err := label.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Labels must be provided as KEY=VALUE",
+				}))
+			},
+			Entry("when there is no '='", "team"),
+			Entry("when the key is empty", "=payments"),
+		)
+	})
+})
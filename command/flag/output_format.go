@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format configv3.OutputFormat
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "yaml"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	switch configv3.OutputFormat(strings.ToLower(val)) {
+	case configv3.OutputFormatJSON:
+		o.Format = configv3.OutputFormatJSON
+	case configv3.OutputFormatYAML:
+		o.Format = configv3.OutputFormatYAML
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT must be "json" or "yaml"`,
+		}
+	}
+
+	return nil
+}
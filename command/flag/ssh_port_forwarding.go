@@ -10,36 +10,129 @@ import (
 
 const DefaultLocalAddress = "localhost"
 
+// SSHPortForwarding is a local port forwarding specification of the form
+// [BIND_ADDRESS:]PORT:HOST:HOST_PORT. Either side can instead be a UNIX domain
+// socket path, which must contain a '/'.
 type SSHPortForwarding struct {
+	LocalNetwork  string
 	LocalAddress  string
+	RemoteNetwork string
 	RemoteAddress string
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
+	listen, target, ok := parseForwardingSpec(val)
+	if !ok {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
 		}
 	}
 
-	re := regexp.MustCompile("^\\d+$")
-	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
-	default:
+	s.LocalNetwork, s.LocalAddress = listen.network, listen.address
+	s.RemoteNetwork, s.RemoteAddress = target.network, target.address
+	return nil
+}
+
+// SSHRemotePortForwarding is a remote port forwarding specification of the
+// form [BIND_ADDRESS:]PORT:HOST:HOST_PORT, where PORT is listened on by the
+// app instance and HOST:HOST_PORT is connected to locally. Either side can
+// instead be a UNIX domain socket path, which must contain a '/'.
+type SSHRemotePortForwarding struct {
+	RemoteNetwork string
+	RemoteAddress string
+	LocalNetwork  string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	listen, target, ok := parseForwardingSpec(val)
+	if !ok {
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad remote forwarding specification '%s'", val),
+		}
+	}
+
+	s.RemoteNetwork, s.RemoteAddress = listen.network, listen.address
+	s.LocalNetwork, s.LocalAddress = target.network, target.address
+	return nil
+}
+
+// SSHDynamicPortForwarding is a dynamic port forwarding specification of the
+// form [BIND_ADDRESS:]PORT, or a UNIX domain socket path which must contain a
+// '/'.
+type SSHDynamicPortForwarding struct {
+	LocalNetwork string
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	listen, ok := parseListenEndpoint(strings.Split(val, ":"))
+	if !ok {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
+	s.LocalNetwork, s.LocalAddress = listen.network, listen.address
 	return nil
 }
+
+type forwardingEndpoint struct {
+	network string
+	address string
+}
+
+var portRegexp = regexp.MustCompile(`^\d+$`)
+
+func isSocketPath(piece string) bool {
+	return strings.Contains(piece, "/")
+}
+
+func parseForwardingSpec(val string) (forwardingEndpoint, forwardingEndpoint, bool) {
+	pieces := strings.Split(val, ":")
+	for _, piece := range pieces {
+		if len(piece) == 0 {
+			return forwardingEndpoint{}, forwardingEndpoint{}, false
+		}
+	}
+
+	var (
+		target   forwardingEndpoint
+		listenAt []string
+	)
+	last := len(pieces) - 1
+	switch {
+	case last >= 1 && isSocketPath(pieces[last]):
+		target = forwardingEndpoint{network: "unix", address: pieces[last]}
+		listenAt = pieces[:last]
+	case last >= 2 && !isSocketPath(pieces[last-1]) && portRegexp.MatchString(pieces[last]):
+		target = forwardingEndpoint{network: "tcp", address: fmt.Sprintf("%s:%s", pieces[last-1], pieces[last])}
+		listenAt = pieces[:last-1]
+	default:
+		return forwardingEndpoint{}, forwardingEndpoint{}, false
+	}
+
+	listen, ok := parseListenEndpoint(listenAt)
+	if !ok {
+		return forwardingEndpoint{}, forwardingEndpoint{}, false
+	}
+
+	return listen, target, true
+}
+
+// parseListenEndpoint parses SOCKET, PORT or BIND_ADDRESS:PORT.
+func parseListenEndpoint(pieces []string) (forwardingEndpoint, bool) {
+	switch {
+	case len(pieces) == 1 && isSocketPath(pieces[0]):
+		return forwardingEndpoint{network: "unix", address: pieces[0]}, true
+	case len(pieces) == 1 && portRegexp.MatchString(pieces[0]):
+		return forwardingEndpoint{network: "tcp", address: fmt.Sprintf("%s:%s", DefaultLocalAddress, pieces[0])}, true
+	case len(pieces) == 2 && len(pieces[0]) > 0 && !isSocketPath(pieces[0]) && portRegexp.MatchString(pieces[1]):
+		return forwardingEndpoint{network: "tcp", address: fmt.Sprintf("%s:%s", pieces[0], pieces[1])}, true
+	default:
+		return forwardingEndpoint{}, false
+	}
+}
@@ -23,7 +23,9 @@ var _ = Describe("SpaceRole", func() {
 				err := forward.UnmarshalFlag("8888:remote:8080")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(forward).To(Equal(SSHPortForwarding{
+					LocalNetwork:  "tcp",
 					LocalAddress:  "localhost:8888",
+					RemoteNetwork: "tcp",
 					RemoteAddress: "remote:8080",
 				}))
 			})
@@ -34,7 +36,35 @@ var _ = Describe("SpaceRole", func() {
 				err := forward.UnmarshalFlag("local:8888:remote:8080")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(forward).To(Equal(SSHPortForwarding{
+					LocalNetwork:  "tcp",
 					LocalAddress:  "local:8888",
+					RemoteNetwork: "tcp",
+					RemoteAddress: "remote:8080",
+				}))
+			})
+		})
+
+		Context("when passed local_port:remote_socket", func() {
+			It("extracts the local address and remote socket", func() {
+				err := forward.UnmarshalFlag("8888:/var/run/remote.sock")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHPortForwarding{
+					LocalNetwork:  "tcp",
+					LocalAddress:  "localhost:8888",
+					RemoteNetwork: "unix",
+					RemoteAddress: "/var/run/remote.sock",
+				}))
+			})
+		})
+
+		Context("when passed local_socket:remote:remote_port", func() {
+			It("extracts the local socket and remote address", func() {
+				err := forward.UnmarshalFlag("./local.sock:remote:8080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHPortForwarding{
+					LocalNetwork:  "unix",
+					LocalAddress:  "./local.sock",
+					RemoteNetwork: "tcp",
 					RemoteAddress: "remote:8080",
 				}))
 			})
@@ -57,6 +87,88 @@ var _ = Describe("SpaceRole", func() {
 			Entry("[implicit localhost] incorrect port numbers for third value", "8888:AM:potato"),
 			Entry("[explicit localhost] incorrect port numbers for second value", "localhost:foo:AM:8888"),
 			Entry("[explicit localhost] incorrect port numbers for fourth value", "localhost:8080:AM:bar"),
+			Entry("socket as the bind address", "/tmp/local.sock:8888:remote:8080"),
+			Entry("socket followed by a port", "8888:/tmp/remote.sock:8080"),
+		)
+	})
+})
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		Context("when passed remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("8888:local:8080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteNetwork: "tcp",
+					RemoteAddress: "localhost:8888",
+					LocalNetwork:  "tcp",
+					LocalAddress:  "local:8080",
+				}))
+			})
+		})
+
+		Context("when passed remote_socket:local_socket", func() {
+			It("extracts the remote and local sockets", func() {
+				err := forward.UnmarshalFlag("/tmp/remote.sock:/tmp/local.sock")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteNetwork: "unix",
+					RemoteAddress: "/tmp/remote.sock",
+					LocalNetwork:  "unix",
+					LocalAddress:  "/tmp/local.sock",
+				}))
+			})
+		})
+
+		It("returns an error for a bad specification", func() {
+			err := forward.UnmarshalFlag("8888:local")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Bad remote forwarding specification '8888:local'",
+			}))
+		})
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		DescribeTable("valid specifications",
+			func(input string, expected SSHDynamicPortForwarding) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(expected))
+			},
+
+			Entry("port", "1080", SSHDynamicPortForwarding{LocalNetwork: "tcp", LocalAddress: "localhost:1080"}),
+			Entry("bind address and port", "0.0.0.0:1080", SSHDynamicPortForwarding{LocalNetwork: "tcp", LocalAddress: "0.0.0.0:1080"}),
+			Entry("socket", "/tmp/socks.sock", SSHDynamicPortForwarding{LocalNetwork: "unix", LocalAddress: "/tmp/socks.sock"}),
+		)
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("empty", ""),
+			Entry("not a port", "banana"),
+			Entry("too many colons", "localhost:1080:80"),
 		)
 	})
 })
@@ -0,0 +1,43 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+// AppsKind is the kind of the document displayed by apps.
+const AppsKind = "apps"
+
+// App is a single application listed by apps.
+type App struct {
+	Name             string   `json:"name" yaml:"name"`
+	GUID             string   `json:"guid" yaml:"guid"`
+	State            string   `json:"state" yaml:"state"`
+	RunningInstances int      `json:"running_instances" yaml:"running_instances"`
+	TotalInstances   int      `json:"total_instances" yaml:"total_instances"`
+	MemoryInMB       uint64   `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64   `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Routes           []string `json:"routes" yaml:"routes"`
+}
+
+// NewApp converts an application, its instances and its routes into an App.
+func NewApp(application v2action.Application, instances map[int]v2action.ApplicationInstance, routes v2action.Routes) App {
+	app := App{
+		Name:           application.Name,
+		GUID:           application.GUID,
+		State:          string(application.State),
+		TotalInstances: application.Instances.Value,
+		MemoryInMB:     application.Memory.Value,
+		DiskInMB:       application.DiskQuota.Value,
+		Routes:         []string{},
+	}
+
+	for _, instance := range instances {
+		if instance.Running() {
+			app.RunningInstances++
+		}
+	}
+
+	for _, route := range routes {
+		app.Routes = append(app.Routes, route.String())
+	}
+
+	return app
+}
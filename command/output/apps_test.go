@@ -0,0 +1,55 @@
+package output_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "code.cloudfoundry.org/cli/command/output"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App", func() {
+	Describe("NewApp", func() {
+		It("converts the application, its instances and routes", func() {
+			app := NewApp(
+				v2action.Application{
+					Name:      "some-app",
+					GUID:      "some-app-guid",
+					State:     constant.ApplicationStarted,
+					Instances: types.NullInt{Value: 3, IsSet: true},
+					Memory:    types.NullByteSizeInMb{Value: 256, IsSet: true},
+					DiskQuota: types.NullByteSizeInMb{Value: 1024, IsSet: true},
+				},
+				map[int]v2action.ApplicationInstance{
+					0: {State: constant.ApplicationInstanceRunning},
+					1: {State: constant.ApplicationInstanceRunning},
+					2: {State: constant.ApplicationInstanceCrashed},
+				},
+				v2action.Routes{{Host: "some-host", Domain: v2action.Domain{Name: "some-domain.com"}}},
+			)
+
+			Expect(app).To(Equal(App{
+				Name:             "some-app",
+				GUID:             "some-app-guid",
+				State:            "STARTED",
+				RunningInstances: 2,
+				TotalInstances:   3,
+				MemoryInMB:       256,
+				DiskInMB:         1024,
+				Routes:           []string{"some-host.some-domain.com"},
+			}))
+		})
+
+		It("marshals missing routes as an empty list", func() {
+			app := NewApp(v2action.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStopped}, nil, nil)
+
+			raw, err := json.Marshal(app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{"name": "some-app", "guid": "some-app-guid", "state": "STOPPED", "running_instances": 0, "total_instances": 0, "memory_in_mb": 0, "disk_in_mb": 0, "routes": []}`))
+		})
+	})
+})
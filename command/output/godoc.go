@@ -0,0 +1,11 @@
+// Package output defines the documents displayed by commands when the global
+// --output flag is provided. Each document is built from the actor return
+// types of a command and is wrapped in a versioned ui.StructuredOutput
+// envelope.
+//
+// Fields may be added to these documents at any time. Removing a field or
+// changing its meaning requires incrementing ui.StructuredOutputVersion.
+//
+// Package output should not be imported by external consumers. It was not
+// designed for external use.
+package output
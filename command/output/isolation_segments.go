@@ -0,0 +1,29 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+// IsolationSegmentsKind is the kind of the document displayed by
+// isolation-segments.
+const IsolationSegmentsKind = "isolation-segments"
+
+// IsolationSegment is a single isolation segment listed by isolation-segments.
+type IsolationSegment struct {
+	Name string   `json:"name" yaml:"name"`
+	Orgs []string `json:"orgs" yaml:"orgs"`
+}
+
+// NewIsolationSegments converts isolation segment summaries into
+// IsolationSegments.
+func NewIsolationSegments(summaries []v3action.IsolationSegmentSummary) []IsolationSegment {
+	converted := []IsolationSegment{}
+	for _, summary := range summaries {
+		orgs := []string{}
+		orgs = append(orgs, summary.EntitledOrgs...)
+
+		converted = append(converted, IsolationSegment{
+			Name: summary.Name,
+			Orgs: orgs,
+		})
+	}
+	return converted
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// LogKind is the kind of the documents displayed by v3-logs, one per log
+// message.
+const LogKind = "log"
+
+// LogMessage is a single log message displayed by v3-logs.
+type LogMessage struct {
+	Timestamp      string `json:"timestamp" yaml:"timestamp"`
+	SourceType     string `json:"source_type" yaml:"source_type"`
+	SourceInstance string `json:"source_instance" yaml:"source_instance"`
+	Type           string `json:"type" yaml:"type"`
+	Message        string `json:"message" yaml:"message"`
+}
+
+// NewLogMessage converts message into a LogMessage with an RFC3339 UTC
+// timestamp.
+func NewLogMessage(message v3action.LogMessage) LogMessage {
+	return LogMessage{
+		Timestamp:      message.Timestamp().UTC().Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        message.Message(),
+	}
+}
@@ -0,0 +1,45 @@
+package output_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	. "code.cloudfoundry.org/cli/command/output"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogMessage", func() {
+	Describe("NewLogMessage", func() {
+		var message LogMessage
+
+		BeforeEach(func() {
+			timestamp := time.Date(2018, time.May, 3, 10, 15, 30, 123000000, time.FixedZone("some-zone", 3600))
+			message = NewLogMessage(*v3action.NewLogMessage("some-message", 1, timestamp, "APP/PROC/WEB", "2"))
+		})
+
+		It("converts the log message with a UTC timestamp", func() {
+			Expect(message).To(Equal(LogMessage{
+				Timestamp:      "2018-05-03T09:15:30.123Z",
+				SourceType:     "APP/PROC/WEB",
+				SourceInstance: "2",
+				Type:           "OUT",
+				Message:        "some-message",
+			}))
+		})
+
+		It("marshals to json with snake case keys", func() {
+			raw, err := json.Marshal(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{
+				"timestamp": "2018-05-03T09:15:30.123Z",
+				"source_type": "APP/PROC/WEB",
+				"source_instance": "2",
+				"type": "OUT",
+				"message": "some-message"
+			}`))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+
+// NetworkPoliciesKind is the kind of the document displayed by
+// network-policies.
+const NetworkPoliciesKind = "network-policies"
+
+// NetworkPolicy is a single policy listed by network-policies.
+type NetworkPolicy struct {
+	Source           string `json:"source" yaml:"source"`
+	Destination      string `json:"destination" yaml:"destination"`
+	Protocol         string `json:"protocol" yaml:"protocol"`
+	StartPort        int    `json:"start_port" yaml:"start_port"`
+	EndPort          int    `json:"end_port" yaml:"end_port"`
+	DestinationSpace string `json:"destination_space" yaml:"destination_space"`
+	DestinationOrg   string `json:"destination_org" yaml:"destination_org"`
+}
+
+// NewNetworkPolicies converts policies into NetworkPolicies.
+func NewNetworkPolicies(policies []cfnetworkingaction.Policy) []NetworkPolicy {
+	converted := []NetworkPolicy{}
+	for _, policy := range policies {
+		converted = append(converted, NetworkPolicy{
+			Source:           policy.SourceName,
+			Destination:      policy.DestinationName,
+			Protocol:         policy.Protocol,
+			StartPort:        policy.StartPort,
+			EndPort:          policy.EndPort,
+			DestinationSpace: policy.DestinationSpaceName,
+			DestinationOrg:   policy.DestinationOrgName,
+		})
+	}
+	return converted
+}
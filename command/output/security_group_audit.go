@@ -0,0 +1,101 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+// SecurityGroupAuditKind is the kind of the document displayed by
+// security-group-audit.
+const SecurityGroupAuditKind = "security-group-audit"
+
+// SecurityGroupAudit is the effective egress rules of a space and the findings
+// about them. Staging is null when the staging rules were not audited.
+type SecurityGroupAudit struct {
+	Space    string                 `json:"space" yaml:"space"`
+	Running  []SecurityGroupRule    `json:"running" yaml:"running"`
+	Staging  []SecurityGroupRule    `json:"staging" yaml:"staging"`
+	Findings []SecurityGroupFinding `json:"findings" yaml:"findings"`
+}
+
+// SecurityGroupRule is an effective egress rule and the security groups that
+// define it. Type and Code are only set for icmp rules.
+type SecurityGroupRule struct {
+	Lifecycle      string                    `json:"lifecycle" yaml:"lifecycle"`
+	Protocol       string                    `json:"protocol" yaml:"protocol"`
+	Destination    string                    `json:"destination" yaml:"destination"`
+	Ports          string                    `json:"ports" yaml:"ports"`
+	Type           *int                      `json:"type,omitempty" yaml:"type,omitempty"`
+	Code           *int                      `json:"code,omitempty" yaml:"code,omitempty"`
+	SecurityGroups []SecurityGroupRuleOrigin `json:"security_groups" yaml:"security_groups"`
+}
+
+// SecurityGroupRuleOrigin is a security group defining a rule. Binding is
+// "global" for running and staging defaults and "space" otherwise.
+type SecurityGroupRuleOrigin struct {
+	Name    string `json:"name" yaml:"name"`
+	Binding string `json:"binding" yaml:"binding"`
+}
+
+// SecurityGroupFinding is a problem found with Rule. Type is one of "broad",
+// "duplicate", "shadowed", "overlapping" or "unrecognized". RelatedRule is the
+// rule shadowing or overlapping Rule, and is null for other types.
+type SecurityGroupFinding struct {
+	Type        string             `json:"type" yaml:"type"`
+	Rule        SecurityGroupRule  `json:"rule" yaml:"rule"`
+	RelatedRule *SecurityGroupRule `json:"related_rule" yaml:"related_rule"`
+}
+
+// NewSecurityGroupAudit converts audit into a SecurityGroupAudit.
+func NewSecurityGroupAudit(audit v2action.SecurityGroupAudit) SecurityGroupAudit {
+	converted := SecurityGroupAudit{
+		Space:    audit.SpaceName,
+		Running:  newSecurityGroupRules(audit.Running),
+		Findings: []SecurityGroupFinding{},
+	}
+	if audit.StagingIncluded {
+		converted.Staging = newSecurityGroupRules(audit.Staging)
+	}
+
+	for _, finding := range audit.Findings {
+		convertedFinding := SecurityGroupFinding{
+			Type: string(finding.Type),
+			Rule: newSecurityGroupRule(finding.Rule),
+		}
+		if finding.Related != nil {
+			related := newSecurityGroupRule(*finding.Related)
+			convertedFinding.RelatedRule = &related
+		}
+		converted.Findings = append(converted.Findings, convertedFinding)
+	}
+
+	return converted
+}
+
+func newSecurityGroupRules(rules []v2action.EffectiveSecurityGroupRule) []SecurityGroupRule {
+	converted := []SecurityGroupRule{}
+	for _, rule := range rules {
+		converted = append(converted, newSecurityGroupRule(rule))
+	}
+	return converted
+}
+
+func newSecurityGroupRule(rule v2action.EffectiveSecurityGroupRule) SecurityGroupRule {
+	converted := SecurityGroupRule{
+		Lifecycle:      string(rule.Lifecycle),
+		Protocol:       rule.Protocol,
+		Destination:    rule.Destination,
+		Ports:          rule.Ports,
+		SecurityGroups: []SecurityGroupRuleOrigin{},
+	}
+	if rule.Type.IsSet {
+		converted.Type = &rule.Type.Value
+	}
+	if rule.Code.IsSet {
+		converted.Code = &rule.Code.Value
+	}
+	for _, origin := range rule.Origins {
+		converted.SecurityGroups = append(converted.SecurityGroups, SecurityGroupRuleOrigin{
+			Name:    origin.SecurityGroupName,
+			Binding: string(origin.Binding),
+		})
+	}
+	return converted
+}
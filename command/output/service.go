@@ -0,0 +1,104 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+// ServiceKind is the kind of the document displayed by service.
+const ServiceKind = "service"
+
+// Service is the summary of a single service instance displayed by service.
+// Fields that only apply to managed service instances are omitted for
+// user-provided service instances.
+type Service struct {
+	Name             string         `json:"name" yaml:"name"`
+	GUID             string         `json:"guid" yaml:"guid"`
+	Type             string         `json:"type" yaml:"type"`
+	Service          string         `json:"service,omitempty" yaml:"service,omitempty"`
+	Plan             string         `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Description      string         `json:"description,omitempty" yaml:"description,omitempty"`
+	DocumentationURL string         `json:"documentation_url,omitempty" yaml:"documentation_url,omitempty"`
+	DashboardURL     string         `json:"dashboard_url,omitempty" yaml:"dashboard_url,omitempty"`
+	Tags             []string       `json:"tags" yaml:"tags"`
+	SharedFrom       *ServiceSpace  `json:"shared_from,omitempty" yaml:"shared_from,omitempty"`
+	SharedTo         []ServiceSpace `json:"shared_to,omitempty" yaml:"shared_to,omitempty"`
+	BoundApps        []BoundApp     `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation    *LastOperation `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+}
+
+// ServiceSpace is a space a service instance is shared from or to.
+// BoundAppCount is only set for spaces the service instance is shared to.
+type ServiceSpace struct {
+	Org           string `json:"org" yaml:"org"`
+	Space         string `json:"space" yaml:"space"`
+	BoundAppCount int    `json:"bound_app_count,omitempty" yaml:"bound_app_count,omitempty"`
+}
+
+// BoundApp is an application bound to a service instance.
+type BoundApp struct {
+	Name        string `json:"name" yaml:"name"`
+	BindingName string `json:"binding_name" yaml:"binding_name"`
+}
+
+// LastOperation is the last operation performed on a managed service
+// instance.
+type LastOperation struct {
+	Type        string `json:"type" yaml:"type"`
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description" yaml:"description"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+	UpdatedAt   string `json:"updated_at" yaml:"updated_at"`
+}
+
+// NewService converts a service instance summary into a Service.
+func NewService(summary v2action.ServiceInstanceSummary) Service {
+	service := Service{
+		Name:      summary.Name,
+		GUID:      summary.GUID,
+		Type:      string(summary.ServiceInstance.Type),
+		Tags:      []string{},
+		BoundApps: []BoundApp{},
+	}
+	service.Tags = append(service.Tags, summary.Tags...)
+
+	for _, boundApp := range summary.BoundApplications {
+		service.BoundApps = append(service.BoundApps, BoundApp{
+			Name:        boundApp.AppName,
+			BindingName: boundApp.ServiceBindingName,
+		})
+	}
+
+	if !summary.IsManaged() {
+		return service
+	}
+
+	service.Service = summary.Service.Label
+	service.Plan = summary.ServicePlan.Name
+	service.Description = summary.Service.Description
+	service.DocumentationURL = summary.Service.DocumentationURL
+	service.DashboardURL = summary.DashboardURL
+
+	if summary.IsSharedFrom() {
+		service.SharedFrom = &ServiceSpace{
+			Org:   summary.ServiceInstanceSharedFrom.OrganizationName,
+			Space: summary.ServiceInstanceSharedFrom.SpaceName,
+		}
+	}
+
+	for _, sharedTo := range summary.ServiceInstanceSharedTos {
+		service.SharedTo = append(service.SharedTo, ServiceSpace{
+			Org:           sharedTo.OrganizationName,
+			Space:         sharedTo.SpaceName,
+			BoundAppCount: sharedTo.BoundAppCount,
+		})
+	}
+
+	lastOperation := summary.ServiceInstance.LastOperation
+	service.LastOperation = &LastOperation{
+		Type:        lastOperation.Type,
+		State:       lastOperation.State,
+		Description: lastOperation.Description,
+		CreatedAt:   lastOperation.CreatedAt,
+		UpdatedAt:   lastOperation.UpdatedAt,
+	}
+
+	return service
+}
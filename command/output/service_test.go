@@ -0,0 +1,74 @@
+package output_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "code.cloudfoundry.org/cli/command/output"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service", func() {
+	Describe("NewService", func() {
+		var summary v2action.ServiceInstanceSummary
+
+		BeforeEach(func() {
+			summary = v2action.ServiceInstanceSummary{
+				ServiceInstance: v2action.ServiceInstance{
+					Name:         "some-service-instance",
+					GUID:         "some-service-instance-guid",
+					Tags:         []string{"tag-1"},
+					DashboardURL: "some-dashboard",
+				},
+				BoundApplications: []v2action.BoundApplication{
+					{AppName: "some-app", ServiceBindingName: "some-binding"},
+				},
+			}
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				summary.ServiceInstance.Type = constant.ServiceInstanceTypeUserProvidedService
+			})
+
+			It("only converts the common fields", func() {
+				Expect(NewService(summary)).To(Equal(Service{
+					Name:      "some-service-instance",
+					GUID:      "some-service-instance-guid",
+					Type:      "user_provided_service_instance",
+					Tags:      []string{"tag-1"},
+					BoundApps: []BoundApp{{Name: "some-app", BindingName: "some-binding"}},
+				}))
+			})
+		})
+
+		Context("when the service instance is managed", func() {
+			BeforeEach(func() {
+				summary.ServiceInstance.Type = constant.ServiceInstanceTypeManagedService
+				summary.ServiceInstance.LastOperation = ccv2.LastOperation{
+					Type:  "create",
+					State: "succeeded",
+				}
+				summary.Service = v2action.Service{Label: "some-service", Description: "some-description"}
+				summary.ServicePlan = v2action.ServicePlan{Name: "some-plan"}
+				summary.ServiceInstanceShareType = v2action.ServiceInstanceIsSharedFrom
+				summary.ServiceInstanceSharedFrom = v2action.ServiceInstanceSharedFrom{
+					OrganizationName: "some-org",
+					SpaceName:        "some-space",
+				}
+			})
+
+			It("converts the service, plan, sharing information and last operation", func() {
+				service := NewService(summary)
+				Expect(service.Service).To(Equal("some-service"))
+				Expect(service.Plan).To(Equal("some-plan"))
+				Expect(service.Description).To(Equal("some-description"))
+				Expect(service.DashboardURL).To(Equal("some-dashboard"))
+				Expect(service.SharedFrom).To(Equal(&ServiceSpace{Org: "some-org", Space: "some-space"}))
+				Expect(service.LastOperation).To(Equal(&LastOperation{Type: "create", State: "succeeded"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package output
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+// TasksKind is the kind of the document displayed by tasks.
+const TasksKind = "tasks"
+
+// Task is a single task listed by tasks. Command is empty when the user is
+// not allowed to see it.
+type Task struct {
+	ID        int    `json:"id" yaml:"id"`
+	GUID      string `json:"guid" yaml:"guid"`
+	Name      string `json:"name" yaml:"name"`
+	State     string `json:"state" yaml:"state"`
+	CreatedAt string `json:"created_at" yaml:"created_at"`
+	Command   string `json:"command" yaml:"command"`
+}
+
+// NewTasks converts tasks into Tasks, preserving their order.
+func NewTasks(tasks []v3action.Task) []Task {
+	converted := []Task{}
+	for _, task := range tasks {
+		converted = append(converted, Task{
+			ID:        task.SequenceID,
+			GUID:      task.GUID,
+			Name:      task.Name,
+			State:     string(task.State),
+			CreatedAt: task.CreatedAt,
+			Command:   task.Command,
+		})
+	}
+	return converted
+}
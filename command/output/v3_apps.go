@@ -0,0 +1,58 @@
+package output
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// V3AppsKind is the kind of the document displayed by v3-apps.
+const V3AppsKind = "v3-apps"
+
+// V3App is a single application listed by v3-apps.
+type V3App struct {
+	Name      string    `json:"name" yaml:"name"`
+	GUID      string    `json:"guid" yaml:"guid"`
+	State     string    `json:"state" yaml:"state"`
+	Processes []Process `json:"processes" yaml:"processes"`
+	Routes    []string  `json:"routes" yaml:"routes"`
+}
+
+// Process is the instance summary of a single process of an application.
+type Process struct {
+	Type             string `json:"type" yaml:"type"`
+	RunningInstances int    `json:"running_instances" yaml:"running_instances"`
+	TotalInstances   int    `json:"total_instances" yaml:"total_instances"`
+}
+
+// NewV3App converts an application and its routes into a V3App.
+func NewV3App(summary v3action.ApplicationWithProcessSummary, routes v2action.Routes) V3App {
+	app := V3App{
+		Name:      summary.Name,
+		GUID:      summary.GUID,
+		State:     string(summary.State),
+		Processes: NewProcesses(summary.ProcessSummaries),
+		Routes:    []string{},
+	}
+
+	for _, route := range routes {
+		app.Routes = append(app.Routes, route.String())
+	}
+
+	return app
+}
+
+// NewProcesses converts process summaries into Processes, web process first.
+func NewProcesses(summaries v3action.ProcessSummaries) []Process {
+	summaries.Sort()
+
+	processes := []Process{}
+	for _, summary := range summaries {
+		processes = append(processes, Process{
+			Type:             summary.Type,
+			RunningInstances: summary.HealthyInstanceCount(),
+			TotalInstances:   summary.TotalInstanceCount(),
+		})
+	}
+
+	return processes
+}
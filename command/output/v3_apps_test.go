@@ -0,0 +1,60 @@
+package output_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/output"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3App", func() {
+	Describe("NewV3App", func() {
+		It("converts the application, its processes and routes", func() {
+			app := NewV3App(
+				v3action.ApplicationWithProcessSummary{
+					Application: v3action.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStarted},
+					ProcessSummaries: v3action.ProcessSummaries{
+						{
+							Process:         v3action.Process{Type: "worker"},
+							InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+						},
+						{
+							Process: v3action.Process{Type: constant.ProcessTypeWeb},
+							InstanceDetails: []v3action.ProcessInstance{
+								{State: constant.ProcessInstanceRunning},
+								{State: constant.ProcessInstanceStarting},
+							},
+						},
+					},
+				},
+				v2action.Routes{{Host: "some-host", Domain: v2action.Domain{Name: "some-domain.com"}}},
+			)
+
+			Expect(app).To(Equal(V3App{
+				Name:  "some-app",
+				GUID:  "some-app-guid",
+				State: "STARTED",
+				Processes: []Process{
+					{Type: "web", RunningInstances: 1, TotalInstances: 2},
+					{Type: "worker", RunningInstances: 1, TotalInstances: 1},
+				},
+				Routes: []string{"some-host.some-domain.com"},
+			}))
+		})
+
+		It("marshals missing processes and routes as empty lists", func() {
+			app := NewV3App(v3action.ApplicationWithProcessSummary{
+				Application: v3action.Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStopped},
+			}, nil)
+
+			raw, err := json.Marshal(app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(raw).To(MatchJSON(`{"name": "some-app", "guid": "some-app-guid", "state": "STOPPED", "processes": [], "routes": []}`))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+//go:generate counterfeiter . AddPluginKeyActor
+
+type AddPluginKeyActor interface {
+	AddTrustedPluginKey(keyName string, publicKey string) error
+}
+
+type AddPluginKeyCommand struct {
+	RequiredArgs    flag.AddPluginKeyArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME add-plugin-key KEY_NAME PUBLIC_KEY\n\nEXAMPLES:\n   CF_NAME add-plugin-key ExampleAuthor 11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="`
+	relatedCommands interface{}           `related_commands:"install-plugin, plugin-keys, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           AddPluginKeyActor
+}
+
+func (cmd *AddPluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, false))
+	return nil
+}
+
+func (cmd AddPluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.AddTrustedPluginKey(cmd.RequiredArgs.PluginKeyName, cmd.RequiredArgs.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("{{.KeyName}} added as a trusted plugin key",
+		map[string]interface{}{
+			"KeyName": cmd.RequiredArgs.PluginKeyName,
+		})
+	return nil
+}
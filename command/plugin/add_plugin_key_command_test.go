@@ -0,0 +1,60 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-plugin-key command", func() {
+	var (
+		cmd        AddPluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeAddPluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeAddPluginKeyActor)
+		cmd = AddPluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+
+		cmd.RequiredArgs.PluginKeyName = "some-key"
+		cmd.RequiredArgs.PublicKey = "some-public-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when adding the key errors", func() {
+		BeforeEach(func() {
+			fakeActor.AddTrustedPluginKeyReturns(actionerror.TrustedPluginKeyNameTakenError{Name: "some-key"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.TrustedPluginKeyNameTakenError{Name: "some-key"}))
+			Expect(testUI.Out).ToNot(Say("added"))
+		})
+	})
+
+	Context("when adding the key succeeds", func() {
+		It("adds the key and displays a success message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("some-key added as a trusted plugin key"))
+
+			Expect(fakeActor.AddTrustedPluginKeyCallCount()).To(Equal(1))
+			keyName, publicKey := fakeActor.AddTrustedPluginKeyArgsForCall(0)
+			Expect(keyName).To(Equal("some-key"))
+			Expect(publicKey).To(Equal("some-public-key"))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . PluginKeysActor
+
+type PluginKeysActor interface {
+	GetTrustedPluginKeys() []configv3.TrustedPluginKey
+}
+
+type PluginKeysCommand struct {
+	usage           interface{} `usage:"CF_NAME plugin-keys"`
+	relatedCommands interface{} `related_commands:"add-plugin-key, install-plugin, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           PluginKeysActor
+}
+
+func (cmd *PluginKeysCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, false))
+	return nil
+}
+
+func (cmd PluginKeysCommand) Execute([]string) error {
+	cmd.UI.DisplayText("Listing trusted plugin keys...")
+	cmd.UI.DisplayNewline()
+
+	keys := cmd.Actor.GetTrustedPluginKeys()
+	if len(keys) == 0 {
+		cmd.UI.DisplayText("No trusted plugin keys found.")
+		return nil
+	}
+
+	table := [][]string{{"name", "public key"}}
+	for _, key := range keys {
+		table = append(table, []string{key.Name, key.PublicKey})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,60 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("plugin-keys command", func() {
+	var (
+		cmd        PluginKeysCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakePluginKeysActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakePluginKeysActor)
+		cmd = PluginKeysCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no trusted keys", func() {
+		It("displays a message that there are no keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Listing trusted plugin keys\\.\\.\\."))
+			Expect(testUI.Out).To(Say("No trusted plugin keys found\\."))
+		})
+	})
+
+	Context("when there are trusted keys", func() {
+		BeforeEach(func() {
+			fakeActor.GetTrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+			})
+		})
+
+		It("displays the keys in a table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Listing trusted plugin keys\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+public key"))
+			Expect(testUI.Out).To(Say("key-1\\s+public-key-1"))
+			Expect(testUI.Out).To(Say("key-2\\s+public-key-2"))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+//go:generate counterfeiter . RemovePluginKeyActor
+
+type RemovePluginKeyActor interface {
+	RemoveTrustedPluginKey(keyName string) error
+}
+
+type RemovePluginKeyCommand struct {
+	RequiredArgs    flag.PluginKeyName `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME remove-plugin-key KEY_NAME\n\nEXAMPLES:\n   CF_NAME remove-plugin-key ExampleAuthor"`
+	relatedCommands interface{}        `related_commands:"add-plugin-key, plugin-keys"`
+	UI              command.UI
+	Config          command.Config
+	Actor           RemovePluginKeyActor
+}
+
+func (cmd *RemovePluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, false))
+	return nil
+}
+
+func (cmd RemovePluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.RemoveTrustedPluginKey(cmd.RequiredArgs.PluginKeyName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("{{.KeyName}} removed from the trusted plugin keys",
+		map[string]interface{}{
+			"KeyName": cmd.RequiredArgs.PluginKeyName,
+		})
+	return nil
+}
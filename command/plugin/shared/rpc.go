@@ -43,6 +43,12 @@ func NewRPCService(config Config, ui UI) (*RPCService, error) {
 		return nil, err
 	}
 
+	// Version 2 of the plugin API is served with the configuration the CLI is
+	// running with.
+	if cfConfig, ok := config.(*configv3.Config); ok {
+		rpcService.RpcCmdV2.Config = cfConfig
+	}
+
 	return &RPCService{
 		config:     config,
 		ui:         ui,
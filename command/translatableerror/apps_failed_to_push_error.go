@@ -0,0 +1,21 @@
+package translatableerror
+
+import (
+	"strings"
+)
+
+// AppsFailedToPushError is returned when one or more of the apps pushed in
+// parallel failed to push.
+type AppsFailedToPushError struct {
+	AppNames []string
+}
+
+func (e AppsFailedToPushError) Error() string {
+	return "Failed to push apps: {{.AppNames}}"
+}
+
+func (e AppsFailedToPushError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ContextAlreadyExistsError is returned when creating or renaming a context
+// would replace an existing context.
+type ContextAlreadyExistsError struct {
+	Name string
+}
+
+func (ContextAlreadyExistsError) Error() string {
+	return "Context '{{.Name}}' already exists."
+}
+
+func (e ContextAlreadyExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ContextNotFoundError is returned when a named context does not exist in the
+// config.
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (ContextNotFoundError) Error() string {
+	return "Context '{{.Name}}' not found."
+}
+
+func (e ContextNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
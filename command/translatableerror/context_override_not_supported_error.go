@@ -0,0 +1,13 @@
+package translatableerror
+
+// ContextOverrideNotSupportedError is returned when the global --context flag
+// is used with a command that still reads the config directly from disk.
+type ContextOverrideNotSupportedError struct{}
+
+func (ContextOverrideNotSupportedError) Error() string {
+	return "This command does not support the --context flag. Use 'cf switch-context' instead."
+}
+
+func (e ContextOverrideNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
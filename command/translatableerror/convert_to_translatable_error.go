@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -31,6 +32,10 @@ func ConvertToTranslatableError(err error) error {
 		return AssignDropletError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
+	case actionerror.CopySourceIsDirectoryError:
+		return CopySourceIsDirectoryError(e)
+	case actionerror.DependencyCycleError:
+		return DependencyCycleError(e)
 	case actionerror.DockerPasswordNotSetError:
 		return DockerPasswordNotSetError{}
 	case actionerror.DomainNotFoundError:
@@ -49,12 +54,22 @@ func ConvertToTranslatableError(err error) error {
 		return HTTPHealthCheckInvalidError{}
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidNetworkPoliciesFileError:
+		return InvalidNetworkPoliciesFileError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
+	case actionerror.InvalidSecurityGroupRulesError:
+		var messages []string
+		for _, ruleErr := range e.Errors {
+			messages = append(messages, ruleErr.Error())
+		}
+		return InvalidSecurityGroupRulesError{Messages: messages}
 	case actionerror.InvalidTCPRouteSettings:
 		return HostAndPathNotAllowedWithTCPDomainError(e)
+	case actionerror.InvalidTrustedPluginKeyError:
+		return InvalidTrustedPluginKeyError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
 	case actionerror.MissingNameError:
@@ -73,6 +88,8 @@ func ConvertToTranslatableError(err error) error {
 		return NoOrganizationTargetedError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
+	case actionerror.NoTrustedPluginKeysError:
+		return NoTrustedPluginKeysError{}
 	case actionerror.NotLoggedInError:
 		return NotLoggedInError(e)
 	case actionerror.OrganizationNotFoundError:
@@ -85,6 +102,16 @@ func ConvertToTranslatableError(err error) error {
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginRepositorySignatureInvalidError:
+		return PluginRepositorySignatureInvalidError(e)
+	case actionerror.PluginRepositorySignatureMissingError:
+		return PluginRepositorySignatureMissingError(e)
+	case actionerror.PluginSignatureInvalidError:
+		return PluginSignatureInvalidError{}
+	case actionerror.PluginSignatureMissingError:
+		return PluginSignatureMissingError{}
+	case actionerror.ProcessHasNoRunningInstancesError:
+		return ProcessHasNoRunningInstancesError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -120,12 +147,20 @@ func ConvertToTranslatableError(err error) error {
 		return StackNotFoundError(e)
 	case actionerror.StagingTimeoutError:
 		return StagingTimeoutError(e)
+	case actionerror.TaskFailedError:
+		return TaskFailedError(e)
+	case actionerror.TaskTimeoutError:
+		return TaskTimeoutError(e)
 	case actionerror.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
 	case actionerror.TCPRouteOptionsNotProvidedError:
 		return TCPRouteOptionsNotProvidedError{}
 	case actionerror.TriggerLegacyPushError:
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
+	case actionerror.TrustedPluginKeyNameTakenError:
+		return TrustedPluginKeyNameTakenError(e)
+	case actionerror.TrustedPluginKeyNotFoundError:
+		return TrustedPluginKeyNotFoundError(e)
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
 	case actionerror.CommandLineOptionsAndManifestConflictError:
@@ -169,6 +204,12 @@ func ConvertToTranslatableError(err error) error {
 		return TriggerLegacyPushError{GlobalRelated: e.Fields}
 	case manifest.InterpolationError:
 		return InterpolationError(e)
+	case manifestparser.InvalidManifestError:
+		var messages []string
+		for _, validationErr := range e.Errors {
+			messages = append(messages, validationErr.Error())
+		}
+		return InvalidManifestError{Messages: messages}
 
 	// Plugin Execution Errors
 	case pluginerror.RawHTTPStatusError:
@@ -12,6 +12,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -54,6 +55,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
 
+		Entry("actionerror.CopySourceIsDirectoryError -> CopySourceIsDirectoryError",
+			actionerror.CopySourceIsDirectoryError{Path: "some-path"},
+			CopySourceIsDirectoryError{Path: "some-path"}),
+
+		Entry("actionerror.DependencyCycleError -> DependencyCycleError",
+			actionerror.DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}},
+			DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}}),
+
 		Entry("actionerror.DockerPasswordNotSetError -> DockerPasswordNotSetError",
 			actionerror.DockerPasswordNotSetError{},
 			DockerPasswordNotSetError{}),
@@ -95,6 +104,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidBuildpacksError{},
 			InvalidBuildpacksError{}),
 
+		Entry("actionerror.InvalidNetworkPoliciesFileError -> InvalidNetworkPoliciesFileError",
+			actionerror.InvalidNetworkPoliciesFileError{Path: "some-path", Message: "some-message"},
+			InvalidNetworkPoliciesFileError{Path: "some-path", Message: "some-message"}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
@@ -103,10 +116,20 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
 
+		Entry("actionerror.InvalidSecurityGroupRulesError -> InvalidSecurityGroupRulesError",
+			actionerror.InvalidSecurityGroupRulesError{Errors: []actionerror.SecurityGroupRuleError{
+				{File: "rules.json", Line: 2, Column: 15, Key: "[0].protocol", Message: "is required"},
+			}},
+			InvalidSecurityGroupRulesError{Messages: []string{"rules.json:2:15: [0].protocol: is required"}}),
+
 		Entry("actionerror.InvalidTCPRouteSettings -> HostAndPathNotAllowedWithTCPDomainError",
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.InvalidTrustedPluginKeyError -> InvalidTrustedPluginKeyError",
+			actionerror.InvalidTrustedPluginKeyError{Name: "some-key"},
+			InvalidTrustedPluginKeyError{Name: "some-key"}),
+
 		Entry("actionerror.MissingNameError -> RequiredNameForPushError",
 			actionerror.MissingNameError{},
 			RequiredNameForPushError{}),
@@ -139,6 +162,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoTrustedPluginKeysError -> NoTrustedPluginKeysError",
+			actionerror.NoTrustedPluginKeysError{},
+			NoTrustedPluginKeysError{}),
+
 		Entry("actionerror.NotLoggedInError -> NotLoggedInError",
 			actionerror.NotLoggedInError{BinaryName: "faceman"},
 			NotLoggedInError{BinaryName: "faceman"}),
@@ -177,6 +204,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginRepositorySignatureInvalidError -> PluginRepositorySignatureInvalidError",
+			actionerror.PluginRepositorySignatureInvalidError{RepositoryName: "some-repo"},
+			PluginRepositorySignatureInvalidError{RepositoryName: "some-repo"}),
+
+		Entry("actionerror.PluginRepositorySignatureMissingError -> PluginRepositorySignatureMissingError",
+			actionerror.PluginRepositorySignatureMissingError{RepositoryName: "some-repo"},
+			PluginRepositorySignatureMissingError{RepositoryName: "some-repo"}),
+
+		Entry("actionerror.PluginSignatureInvalidError -> PluginSignatureInvalidError",
+			actionerror.PluginSignatureInvalidError{},
+			PluginSignatureInvalidError{}),
+
+		Entry("actionerror.PluginSignatureMissingError -> PluginSignatureMissingError",
+			actionerror.PluginSignatureMissingError{},
+			PluginSignatureMissingError{}),
+
+		Entry("actionerror.ProcessHasNoRunningInstancesError -> ProcessHasNoRunningInstancesError",
+			actionerror.ProcessHasNoRunningInstancesError{ProcessType: "some-process-type"},
+			ProcessHasNoRunningInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -237,6 +284,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("actionerror.TaskFailedError -> TaskFailedError",
+			actionerror.TaskFailedError{Name: "some-task", FailureReason: "some-reason"},
+			TaskFailedError{Name: "some-task", FailureReason: "some-reason"}),
+
+		Entry("actionerror.TaskTimeoutError -> TaskTimeoutError",
+			actionerror.TaskTimeoutError{Name: "some-task", Timeout: time.Minute},
+			TaskTimeoutError{Name: "some-task", Timeout: time.Minute}),
+
 		Entry("actionerror.TaskWorkersUnavailableError -> RunTaskError",
 			actionerror.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
@@ -249,6 +304,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}},
 			TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}),
 
+		Entry("actionerror.TrustedPluginKeyNameTakenError -> TrustedPluginKeyNameTakenError",
+			actionerror.TrustedPluginKeyNameTakenError{Name: "some-key"},
+			TrustedPluginKeyNameTakenError{Name: "some-key"}),
+
+		Entry("actionerror.TrustedPluginKeyNotFoundError -> TrustedPluginKeyNotFoundError",
+			actionerror.TrustedPluginKeyNotFoundError{Name: "some-key"},
+			TrustedPluginKeyNotFoundError{Name: "some-key"}),
+
 		Entry("actionerror.UploadFailedError -> UploadFailedError",
 			actionerror.UploadFailedError{Err: actionerror.NoDomainsFoundError{}},
 			UploadFailedError{Err: NoDomainsFoundError{}}),
@@ -347,6 +410,12 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			manifest.InterpolationError{Err: errors.New("an-error")},
 			InterpolationError{Err: errors.New("an-error")}),
 
+		Entry("manifestparser.InvalidManifestError -> InvalidManifestError",
+			manifestparser.InvalidManifestError{Errors: []manifestparser.ValidationError{
+				{File: "manifest.yml", Line: 3, Column: 5, Key: "applications[0].instnces", Message: "unknown key"},
+			}},
+			InvalidManifestError{Messages: []string{"manifest.yml:3:5: applications[0].instnces: unknown key"}}),
+
 		// Plugin Errors
 		Entry("pluginerror.RawHTTPStatusError -> DownloadPluginHTTPError",
 			pluginerror.RawHTTPStatusError{Status: "some status"},
@@ -0,0 +1,17 @@
+package translatableerror
+
+// CopySourceIsDirectoryError is returned when copying a directory without
+// requesting a recursive copy.
+type CopySourceIsDirectoryError struct {
+	Path string
+}
+
+func (CopySourceIsDirectoryError) Error() string {
+	return "{{.Path}} is a directory. Use -r to copy directories."
+}
+
+func (e CopySourceIsDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
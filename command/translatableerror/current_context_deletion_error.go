@@ -0,0 +1,16 @@
+package translatableerror
+
+// CurrentContextDeletionError is returned when deleting the current context.
+type CurrentContextDeletionError struct {
+	Name string
+}
+
+func (CurrentContextDeletionError) Error() string {
+	return "Context '{{.Name}}' is the current context and cannot be deleted. Switch to another context first."
+}
+
+func (e CurrentContextDeletionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import (
+	"strings"
+)
+
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return "Apps in the manifest depend on each other in a cycle: {{.AppNames}}"
+}
+
+func (e DependencyCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, " -> "),
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type DeploymentCanceledError struct {
+	AppName string
+}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment of app {{.AppName}} was canceled. The app has been rolled back to its previous droplet."
+}
+
+func (e DeploymentCanceledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// InvalidCopyPathsError is returned when neither or both of the paths given
+// to a copy command are on an app instance.
+type InvalidCopyPathsError struct{}
+
+func (InvalidCopyPathsError) Error() string {
+	return "Exactly one of SOURCE and DESTINATION must be a path on an app instance, in the form APP_NAME:PATH."
+}
+
+func (e InvalidCopyPathsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
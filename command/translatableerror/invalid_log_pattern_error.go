@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidLogPatternError is returned when the pattern passed to '--grep' is
+// not a valid regular expression.
+type InvalidLogPatternError struct {
+	Pattern string
+	Err     error
+}
+
+func (InvalidLogPatternError) Error() string {
+	return "Invalid pattern '{{.Pattern}}' for '--grep': {{.Err}}"
+}
+
+func (e InvalidLogPatternError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Pattern": e.Pattern,
+		"Err":     e.Err,
+	})
+}
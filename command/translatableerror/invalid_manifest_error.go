@@ -0,0 +1,24 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+type InvalidManifestError struct {
+	Messages []string
+}
+
+func (InvalidManifestError) Error() string {
+	return "The manifest is invalid:\n{{.Errors}}"
+}
+
+func (e InvalidManifestError) Translate(translate func(string, ...interface{}) string) string {
+	var formattedErrs []string
+	for _, err := range e.Messages {
+		formattedErrs = append(formattedErrs, fmt.Sprintf("- %s", err))
+	}
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": strings.Join(formattedErrs, "\n"),
+	})
+}
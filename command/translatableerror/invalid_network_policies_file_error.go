@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidNetworkPoliciesFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidNetworkPoliciesFileError) Error() string {
+	return "Invalid network policies file {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidNetworkPoliciesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
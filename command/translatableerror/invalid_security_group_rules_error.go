@@ -0,0 +1,24 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+type InvalidSecurityGroupRulesError struct {
+	Messages []string
+}
+
+func (InvalidSecurityGroupRulesError) Error() string {
+	return "The security group rules file is invalid:\n{{.Errors}}"
+}
+
+func (e InvalidSecurityGroupRulesError) Translate(translate func(string, ...interface{}) string) string {
+	var formattedErrs []string
+	for _, err := range e.Messages {
+		formattedErrs = append(formattedErrs, fmt.Sprintf("- %s", err))
+	}
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": strings.Join(formattedErrs, "\n"),
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// InvalidTrustedPluginKeyError is returned when adding a trusted plugin
+// key that is not a base64 encoded ed25519 public key.
+type InvalidTrustedPluginKeyError struct {
+	Name string
+}
+
+func (InvalidTrustedPluginKeyError) Error() string {
+	return "Plugin key {{.KeyName}} is not a base64 encoded ed25519 public key."
+}
+
+func (e InvalidTrustedPluginKeyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+type NetworkPolicyDestinationOrgWithoutSpaceError struct{}
+
+func (NetworkPolicyDestinationOrgWithoutSpaceError) DisplayUsage() {}
+
+func (NetworkPolicyDestinationOrgWithoutSpaceError) Error() string {
+	return "Incorrect Usage: --destination-org can only be used with --destination-space"
+}
+
+func (e NetworkPolicyDestinationOrgWithoutSpaceError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// NoTrustedPluginKeysError is returned when --require-signature is used
+// and no trusted plugin keys have been added.
+type NoTrustedPluginKeysError struct{}
+
+func (NoTrustedPluginKeysError) Error() string {
+	return "No trusted plugin keys have been added, so plugin signatures cannot be verified.\nAdd the plugin author's public key with add-plugin-key, or install without --require-signature."
+}
+
+func (e NoTrustedPluginKeysError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
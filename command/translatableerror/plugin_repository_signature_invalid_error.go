@@ -0,0 +1,15 @@
+package translatableerror
+
+// PluginRepositorySignatureInvalidError is returned when the signature of
+// the plugin repository index does not match any trusted plugin key.
+type PluginRepositorySignatureInvalidError struct {
+	RepositoryName string
+}
+
+func (PluginRepositorySignatureInvalidError) Error() string {
+	return "Plugin repository {{.RepositoryName}} signature verification failed: the signature does not match any trusted plugin key.\nThe repository may have been tampered with. Contact the repository owner."
+}
+
+func (e PluginRepositorySignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.RepositoryName})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// PluginRepositorySignatureMissingError is returned when --require-signature
+// is used and the plugin repository index is not signed by a trusted key.
+type PluginRepositorySignatureMissingError struct {
+	RepositoryName string
+}
+
+func (PluginRepositorySignatureMissingError) Error() string {
+	return "Plugin repository {{.RepositoryName}} is not signed by a trusted plugin key and --require-signature was specified."
+}
+
+func (e PluginRepositorySignatureMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.RepositoryName})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginSignatureInvalidError is returned when the signature of the plugin
+// binary does not match any trusted plugin key.
+type PluginSignatureInvalidError struct{}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Plugin binary signature verification failed: the signature does not match any trusted plugin key.\nThe plugin binary may have been tampered with. Do not install it, and contact the plugin author."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginSignatureMissingError is returned when --require-signature is
+// used and the plugin binary is not signed.
+type PluginSignatureMissingError struct{}
+
+func (PluginSignatureMissingError) Error() string {
+	return "Plugin binary is not signed and --require-signature was specified."
+}
+
+func (e PluginSignatureMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ProcessHasNoRunningInstancesError is returned when trying to perform an
+// action on every running instance of a process that has none.
+type ProcessHasNoRunningInstancesError struct {
+	ProcessType string
+}
+
+func (ProcessHasNoRunningInstancesError) Error() string {
+	return "Process {{.ProcessType}} has no running instances"
+}
+
+func (e ProcessHasNoRunningInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
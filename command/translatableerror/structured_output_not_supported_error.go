@@ -0,0 +1,13 @@
+package translatableerror
+
+// StructuredOutputNotSupportedError is returned when the global --output flag
+// is used with a command that can only display human readable output.
+type StructuredOutputNotSupportedError struct{}
+
+func (StructuredOutputNotSupportedError) Error() string {
+	return "This command does not support the --output flag."
+}
+
+func (e StructuredOutputNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
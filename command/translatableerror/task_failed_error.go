@@ -0,0 +1,25 @@
+package translatableerror
+
+// TaskFailedExitStatus is the exit status of the CLI when a task it waited for
+// fails.
+const TaskFailedExitStatus = 3
+
+type TaskFailedError struct {
+	Name          string
+	FailureReason string
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.TaskName}} failed: {{.FailureReason}}"
+}
+
+func (TaskFailedError) ExitStatus() int {
+	return TaskFailedExitStatus
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":      e.Name,
+		"FailureReason": e.FailureReason,
+	})
+}
@@ -0,0 +1,27 @@
+package translatableerror
+
+import "time"
+
+// TaskTimeoutExitStatus is the exit status of the CLI when a task it waited
+// for does not finish in time.
+const TaskTimeoutExitStatus = 4
+
+type TaskTimeoutError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (TaskTimeoutError) Error() string {
+	return "Task {{.TaskName}} did not finish within {{.Timeout}} and has been cancelled"
+}
+
+func (TaskTimeoutError) ExitStatus() int {
+	return TaskTimeoutExitStatus
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName": e.Name,
+		"Timeout":  e.Timeout,
+	})
+}
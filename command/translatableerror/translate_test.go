@@ -47,6 +47,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
+		Entry("ContextAlreadyExistsError", ContextAlreadyExistsError{}),
+		Entry("ContextNotFoundError", ContextNotFoundError{}),
+		Entry("ContextOverrideNotSupportedError", ContextOverrideNotSupportedError{}),
+		Entry("CopySourceIsDirectoryError", CopySourceIsDirectoryError{}),
+		Entry("CurrentContextDeletionError", CurrentContextDeletionError{}),
+		Entry("DeploymentCanceledError", DeploymentCanceledError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
@@ -60,7 +66,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HostnameWithTCPDomainError", HostnameWithTCPDomainError{}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidCopyPathsError", InvalidCopyPathsError{}),
+		Entry("InvalidLogPatternError", InvalidLogPatternError{Err: errors.New("some-error")}),
+		Entry("InvalidManifestError", InvalidManifestError{Messages: []string{"some-error"}}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
+		Entry("InvalidSecurityGroupRulesError", InvalidSecurityGroupRulesError{Messages: []string{"some-error"}}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
@@ -96,6 +106,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
+		Entry("ProcessHasNoRunningInstancesError", ProcessHasNoRunningInstancesError{ProcessType: "some-process"}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
@@ -118,6 +129,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("StructuredOutputNotSupportedError", StructuredOutputNotSupportedError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
@@ -0,0 +1,15 @@
+package translatableerror
+
+// TrustedPluginKeyNameTakenError is returned when adding a trusted plugin
+// key with the name of an existing key.
+type TrustedPluginKeyNameTakenError struct {
+	Name string
+}
+
+func (TrustedPluginKeyNameTakenError) Error() string {
+	return "Plugin key named '{{.KeyName}}' already exists, please use another name."
+}
+
+func (e TrustedPluginKeyNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
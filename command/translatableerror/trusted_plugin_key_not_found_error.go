@@ -0,0 +1,15 @@
+package translatableerror
+
+// TrustedPluginKeyNotFoundError is returned when removing a trusted plugin
+// key that does not exist.
+type TrustedPluginKeyNotFoundError struct {
+	Name string
+}
+
+func (TrustedPluginKeyNotFoundError) Error() string {
+	return "Plugin key {{.KeyName}} does not exist."
+}
+
+func (e TrustedPluginKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
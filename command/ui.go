@@ -22,6 +22,8 @@ type UI interface {
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayStructuredOutput(kind string, data interface{}) error
+	DisplayStructuredOutputLine(kind string, data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
@@ -31,9 +33,19 @@ type UI interface {
 	GetIn() io.Reader
 	GetOut() io.Writer
 	GetErr() io.Writer
+	IsStructuredOutput() bool
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
 }
+
+// WithAppPrefix returns a UI that prefixes every line written to its output
+// with the app name. UIs that cannot prefix their output are returned as is.
+func WithAppPrefix(commandUI UI, appName string) UI {
+	if terminalUI, ok := commandUI.(*ui.UI); ok {
+		return terminalUI.WithAppPrefix(appName)
+	}
+	return commandUI
+}
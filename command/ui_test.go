@@ -0,0 +1,26 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("WithAppPrefix", func() {
+	var testUI *ui.UI
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	It("returns a UI that prefixes its output with the app name", func() {
+		prefixedUI := WithAppPrefix(testUI, "some-app")
+		prefixedUI.DisplayText("some-text")
+		prefixedUI.DisplayWarning("some-warning")
+
+		Expect(testUI.Out).To(Say(`\[some-app\] some-text`))
+		Expect(testUI.Err).To(Say(`\[some-app\] some-warning`))
+	})
+})
@@ -1,19 +1,93 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesByApplication(guid string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error)
+	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
+}
+
 type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only builds the actors for structured output, which is the only part
+// of apps that has been refactored.
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (AppsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd AppsCommand) Execute(args []string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	applications, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	apps := []output.App{}
+	for _, application := range applications {
+		var instances map[int]v2action.ApplicationInstance
+		if application.Started() {
+			instances, warnings, err = cmd.Actor.GetApplicationInstancesByApplication(application.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if _, ok := err.(actionerror.ApplicationInstancesNotFoundError); err != nil && !ok {
+				return err
+			}
+		}
+
+		routes, warnings, err := cmd.Actor.GetApplicationRoutes(application.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		apps = append(apps, output.NewApp(application, instances, routes))
+	}
+
+	return cmd.UI.DisplayStructuredOutput(output.AppsKind, apps)
 }
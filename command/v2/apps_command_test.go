@@ -0,0 +1,195 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("supports structured output", func() {
+		Expect(cmd.SupportsStructuredOutput()).To(BeTrue())
+	})
+
+	Context("when structured output is not requested", func() {
+		It("runs the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when structured output is requested", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		Context("when an error is encountered checking if the environment is setup correctly", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrgArg, checkTargetedSpaceArg := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrgArg).To(BeTrue())
+				Expect(checkTargetedSpaceArg).To(BeTrue())
+			})
+		})
+
+		Context("when the user is logged in and an org and space are targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+			})
+
+			Context("when getting the apps fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError("get-apps-error"))
+					Expect(testUI.Err).To(Say("get-apps-warning"))
+				})
+			})
+
+			Context("when the space has apps", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(
+						[]v2action.Application{
+							{
+								Name:      "some-app",
+								GUID:      "some-app-guid",
+								State:     constant.ApplicationStarted,
+								Instances: types.NullInt{Value: 2, IsSet: true},
+								Memory:    types.NullByteSizeInMb{Value: 256, IsSet: true},
+								DiskQuota: types.NullByteSizeInMb{Value: 512, IsSet: true},
+							},
+							{
+								Name:  "stopped-app",
+								GUID:  "stopped-app-guid",
+								State: constant.ApplicationStopped,
+							},
+						},
+						v2action.Warnings{"get-apps-warning"},
+						nil,
+					)
+					fakeActor.GetApplicationInstancesByApplicationReturns(
+						map[int]v2action.ApplicationInstance{
+							0: {State: constant.ApplicationInstanceRunning},
+							1: {State: constant.ApplicationInstanceStarting},
+						},
+						v2action.Warnings{"get-instances-warning"},
+						nil,
+					)
+					fakeActor.GetApplicationRoutesStub = func(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+						if appGUID == "some-app-guid" {
+							return v2action.Routes{{Host: "some-host", Domain: v2action.Domain{Name: "some-domain.com"}}}, v2action.Warnings{"get-routes-warning"}, nil
+						}
+						return nil, nil, nil
+					}
+				})
+
+				It("displays the apps as a json document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Getting apps"))
+					Expect(testUI.Out).To(Say(`"kind": "apps"`))
+					Expect(testUI.Out).To(Say(`"name": "some-app"`))
+					Expect(testUI.Out).To(Say(`"state": "STARTED"`))
+					Expect(testUI.Out).To(Say(`"running_instances": 1`))
+					Expect(testUI.Out).To(Say(`"total_instances": 2`))
+					Expect(testUI.Out).To(Say(`"memory_in_mb": 256`))
+					Expect(testUI.Out).To(Say(`"disk_in_mb": 512`))
+					Expect(testUI.Out).To(Say(`"routes": \[\s+"some-host.some-domain.com"\s+\]`))
+					Expect(testUI.Out).To(Say(`"name": "stopped-app"`))
+					Expect(testUI.Out).To(Say(`"routes": \[\]`))
+
+					Expect(testUI.Err).To(Say(`"get-apps-warning"`))
+					Expect(testUI.Err).To(Say(`"get-instances-warning"`))
+					Expect(testUI.Err).To(Say(`"get-routes-warning"`))
+
+					Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(fakeActor.GetApplicationInstancesByApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				})
+
+				Context("when a started app has no instances", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationInstancesByApplicationReturns(nil, nil, actionerror.ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"})
+					})
+
+					It("displays no running instances", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`"running_instances": 0`))
+					})
+				})
+
+				Context("when getting the instances fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationInstancesByApplicationReturns(nil, nil, errors.New("get-instances-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("get-instances-error"))
+					})
+				})
+
+				Context("when getting the routes fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationRoutesStub = nil
+						fakeActor.GetApplicationRoutesReturns(nil, nil, errors.New("get-routes-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("get-routes-error"))
+					})
+				})
+			})
+		})
+	})
+})
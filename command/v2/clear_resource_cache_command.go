@@ -0,0 +1,40 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate counterfeiter . ClearResourceCacheActor
+
+type ClearResourceCacheActor interface {
+	ClearResourceCache() error
+}
+
+type ClearResourceCacheCommand struct {
+	usage           interface{} `usage:"CF_NAME clear-resource-cache\n\n   The hashes of app files are cached between pushes, and reused for files whose size, modification time and inode have not changed."`
+	relatedCommands interface{} `related_commands:"push"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ClearResourceCacheActor
+}
+
+func (cmd *ClearResourceCacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd ClearResourceCacheCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Clearing cached hashes of app files...")
+
+	err := cmd.Actor.ClearResourceCache()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,57 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clear-resource-cache command", func() {
+	var (
+		cmd        ClearResourceCacheCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeClearResourceCacheActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeClearResourceCacheActor)
+		cmd = ClearResourceCacheCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("clears the resource cache", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.ClearResourceCacheCallCount()).To(Equal(1))
+		Expect(testUI.Out).To(Say("Clearing cached hashes of app files..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when clearing the resource cache fails", func() {
+		BeforeEach(func() {
+			fakeActor.ClearResourceCacheReturns(errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ContextsCommand struct {
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"create-context, switch-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	contexts := cmd.Config.Contexts()
+	if len(contexts) == 0 {
+		cmd.UI.DisplayText("No contexts found.")
+		return nil
+	}
+
+	current := cmd.Config.CurrentContext()
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("current"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, context := range contexts {
+		var marker string
+		if context.Name == current {
+			marker = "*"
+		}
+		table = append(table, []string{
+			marker,
+			context.Name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no contexts", func() {
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found."))
+		})
+	})
+
+	Context("when there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextsReturns([]configv3.NamedContext{
+				{
+					Name:                 "production",
+					Target:               "https://api.production.com",
+					TargetedOrganization: configv3.Organization{Name: "production-org"},
+					TargetedSpace:        configv3.Space{Name: "production-space"},
+				},
+				{
+					Name:   "staging",
+					Target: "https://api.staging.com",
+				},
+			})
+			fakeConfig.CurrentContextReturns("staging")
+		})
+
+		It("displays the contexts and marks the current context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say(`current\s+name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`\s+production\s+https://api.production.com\s+production-org\s+production-space`))
+			Expect(testUI.Out).To(Say(`\*\s+staging\s+https://api.staging.com`))
+		})
+	})
+})
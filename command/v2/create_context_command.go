@@ -0,0 +1,35 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type CreateContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME create-context CONTEXT_NAME\n\n   Saves the current API endpoint, tokens and targeted org and space as a new context and switches to it."`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, switch-context, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *CreateContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd CreateContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Creating context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	err := cmd.Config.CreateContext(cmd.RequiredArgs.ContextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
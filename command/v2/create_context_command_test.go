@@ -0,0 +1,54 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-context command", func() {
+	var (
+		cmd        CreateContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = CreateContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "staging"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("creates the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.CreateContextCallCount()).To(Equal(1))
+		Expect(fakeConfig.CreateContextArgsForCall(0)).To(Equal("staging"))
+		Expect(testUI.Out).To(Say("Creating context staging..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when the context already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.CreateContextReturns(translatableerror.ContextAlreadyExistsError{Name: "staging"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "staging"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -1,21 +1,82 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateSecurityGroupActor
+
+type CreateSecurityGroupActor interface {
+	CreateSecurityGroup(securityGroupName string, rules []ccv2.SecurityGroupRule) (v2action.SecurityGroup, v2action.Warnings, error)
+	ReadSecurityGroupRulesFile(path string) ([]ccv2.SecurityGroupRule, error)
+}
+
 type CreateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME create-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.  The file should have\n   a single array with JSON objects inside describing the rules.  The JSON Base Object is\n   omitted and only the square brackets and associated child object are required in the file.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]"`
+	usage           interface{}            `usage:"CF_NAME create-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.  The file should have\n   a single array with JSON objects inside describing the rules.  The JSON Base Object is\n   omitted and only the square brackets and associated child object are required in the file.\n\n   Each rule requires a protocol (tcp, udp, icmp or all) and a destination. tcp and udp rules\n   require ports, icmp rules require a type and a code, and only tcp rules can set log.\n   The rules are checked before the security group is created.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]"`
 	relatedCommands interface{}            `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSecurityGroupActor
 }
 
-func (CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateSecurityGroupCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd CreateSecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rules, err := cmd.Actor.ReadSecurityGroupRulesFile(string(cmd.RequiredArgs.PathToJsonRules))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating security group {{.SecurityGroup}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+		"Username":      user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CreateSecurityGroup(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.SecurityGroupNameTakenError); ok {
+			cmd.UI.DisplayWarning("Security group {{.SecurityGroup}} already exists", map[string]interface{}{
+				"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
 }
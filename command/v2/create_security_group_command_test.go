@@ -0,0 +1,135 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-security-group Command", func() {
+	var (
+		cmd             CreateSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSecurityGroupActor
+		binaryName      string
+		rules           []ccv2.SecurityGroupRule
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSecurityGroupActor)
+
+		cmd = CreateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.SecurityGroup = "some-security-group"
+		cmd.RequiredArgs.PathToJsonRules = "some-path"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		rules = []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.0/8"}}
+		fakeActor.ReadSecurityGroupRulesFileReturns(rules, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the rules file is invalid", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = actionerror.InvalidSecurityGroupRulesError{Errors: []actionerror.SecurityGroupRuleError{
+				{File: "some-path", Line: 1, Column: 1, Message: "the file must contain a list of rules"},
+			}}
+			fakeActor.ReadSecurityGroupRulesFileReturns(nil, expectedErr)
+		})
+
+		It("returns the error without creating the security group", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+
+			Expect(fakeActor.ReadSecurityGroupRulesFileCallCount()).To(Equal(1))
+			Expect(fakeActor.ReadSecurityGroupRulesFileArgsForCall(0)).To(Equal("some-path"))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the security group is created", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{}, v2action.Warnings{"warning-1"}, nil)
+		})
+
+		It("creates the security group with the rules from the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating security group some-security-group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(1))
+			name, createdRules := fakeActor.CreateSecurityGroupArgsForCall(0)
+			Expect(name).To(Equal("some-security-group"))
+			Expect(createdRules).To(Equal(rules))
+		})
+	})
+
+	Context("when the security group already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{}, v2action.Warnings{"warning-1"}, actionerror.SecurityGroupNameTakenError{Name: "some-security-group"})
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("Security group some-security-group already exists"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when creating the security group fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{}, v2action.Warnings{"warning-1"}, errors.New("create-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
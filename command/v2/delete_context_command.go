@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DeleteContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-context CONTEXT_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"contexts"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DeleteContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd DeleteContextCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteContext, err := cmd.UI.DisplayBoolPrompt(false, "Really delete the context {{.ContextName}}?", map[string]interface{}{
+			"ContextName": cmd.RequiredArgs.ContextName,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !deleteContext {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	err := cmd.Config.DeleteContext(cmd.RequiredArgs.ContextName)
+	switch err.(type) {
+	case translatableerror.ContextNotFoundError:
+		cmd.UI.DisplayText("Context {{.ContextName}} does not exist.", map[string]interface{}{
+			"ContextName": cmd.RequiredArgs.ContextName,
+		})
+	case nil:
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
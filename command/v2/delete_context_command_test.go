@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-context command", func() {
+	var (
+		cmd        DeleteContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = DeleteContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "production"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the context", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Really delete the context production\?`))
+				Expect(testUI.Out).To(Say("Deleting context production..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+				Expect(fakeConfig.DeleteContextArgsForCall(0)).To(Equal("production"))
+			})
+		})
+
+		Context("when the user does not confirm", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the context", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeConfig.DeleteContextCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the context without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+		})
+
+		Context("when the context does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(translatableerror.ContextNotFoundError{Name: "production"})
+			})
+
+			It("displays that the context does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Context production does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the context is the current context", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(translatableerror.CurrentContextDeletionError{Name: "production"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CurrentContextDeletionError{Name: "production"}))
+			})
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package v2
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -39,6 +41,13 @@ type V2PushActor interface {
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
+	PlanApplication(config pushaction.ApplicationConfig) (pushaction.Plan, pushaction.Warnings)
+}
+
+//go:generate counterfeiter . ResourceCacheStatsActor
+
+type ResourceCacheStatsActor interface {
+	ResourceCacheStats() sharedaction.ResourceCacheStats
 }
 
 type V2PushCommand struct {
@@ -49,6 +58,7 @@ type V2PushCommand struct {
 	DockerImage         flag.DockerImage              `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
 	DockerUsername      string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath         flag.PathWithExistenceCheck   `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun              bool                          `long:"dry-run" description:"Display the changes push would make to each app without making them"`
 	PathToManifest      flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
 	HealthCheckType     flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
 	Hostname            string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
@@ -59,6 +69,7 @@ type V2PushCommand struct {
 	NoManifest          bool                          `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute             bool                          `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
 	NoStart             bool                          `long:"no-start" description:"Do not start an app after pushing"`
+	Parallel            flag.PositiveInteger          `long:"parallel" description:"Maximum number of apps from a manifest to push at the same time (Default: 1)"`
 	AppPath             flag.PathWithExistenceCheck   `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute         bool                          `long:"random-route" description:"Create a random route for this app"`
 	RoutePath           flag.RoutePath                `long:"route-path" description:"Path for the route"`
@@ -70,7 +81,7 @@ type V2PushCommand struct {
 	envCFStartupTimeout interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	dockerPassword      interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage           interface{} `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage           interface{} `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--parallel NUM_APPS]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
@@ -79,6 +90,8 @@ type V2PushCommand struct {
 	Actor       V2PushActor
 	ProgressBar ProgressBar
 
+	ResourceCacheActor ResourceCacheStatsActor
+
 	RestartActor RestartActor
 	NOAAClient   *consumer.Consumer
 }
@@ -105,6 +118,7 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = pushaction.NewActor(v2Actor, v3Actor, sharedActor)
 
 	cmd.SharedActor = sharedActor
+	cmd.ResourceCacheActor = sharedActor
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
@@ -178,6 +192,14 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if verbose, _ := cmd.Config.Verbose(); verbose {
+		stats := cmd.ResourceCacheActor.ResourceCacheStats()
+		cmd.UI.DisplayText("Reused cached hashes of {{.Hits}} files, hashed {{.Misses}} files.", map[string]interface{}{
+			"Hits":   stats.Hits,
+			"Misses": stats.Misses,
+		})
+	}
+
 	for _, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayText("Creating app with these attributes...")
@@ -194,46 +216,240 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.DryRun {
+		return cmd.displayDryRunPlan(appConfigs)
+	}
+
+	if cmd.Parallel.Value > 1 && len(appConfigs) > 1 {
+		return cmd.pushAppsInParallel(user, appConfigs)
+	}
+
+	appConfigs = orderByDependencies(appConfigs)
 	for appNumber, appConfig := range appConfigs {
-		if appConfig.CreatingApplication() {
-			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
-		} else {
-			cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
-				"AppName": appConfig.DesiredApplication.Name,
-			})
+		updatedConfig, err := cmd.applyApp(user, appConfig)
+		if err != nil {
+			return err
 		}
 
-		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
-		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+		err = cmd.startApp(appConfig, updatedConfig)
 		if err != nil {
-			log.Errorln("process apply stream:", err)
 			return err
 		}
 
-		if !cmd.NoStart {
-			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient)
-			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
-			if err != nil {
-				return err
-			}
+		if appNumber+1 <= len(appConfigs) {
+			cmd.UI.DisplayNewline()
 		}
+	}
 
-		cmd.UI.DisplayNewline()
-		appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
-		cmd.UI.DisplayWarnings(warnings)
+	return nil
+}
+
+// applyApp creates or updates the app and uploads its files.
+func (cmd V2PushCommand) applyApp(user configv3.User, appConfig pushaction.ApplicationConfig) (pushaction.ApplicationConfig, error) {
+	if appConfig.CreatingApplication() {
+		cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	}
+
+	configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
+	updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
+	if err != nil {
+		log.Errorln("process apply stream:", err)
+		return pushaction.ApplicationConfig{}, err
+	}
+	return updatedConfig, nil
+}
+
+// startApp starts the app, unless --no-start was provided, and displays its
+// summary.
+func (cmd V2PushCommand) startApp(appConfig pushaction.ApplicationConfig, updatedConfig pushaction.ApplicationConfig) error {
+	if !cmd.NoStart {
+		messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient)
+		err := shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 		if err != nil {
 			return err
 		}
+	}
 
-		shared.DisplayAppSummary(cmd.UI, appSummary, true)
+	cmd.UI.DisplayNewline()
+	appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(appConfig.DesiredApplication.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
 
-		if appNumber+1 <= len(appConfigs) {
-			cmd.UI.DisplayNewline()
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+	return nil
+}
+
+type parallelPushResult struct {
+	err              error
+	failedDependency string
+}
+
+// pushAppsInParallel pushes up to --parallel apps at the same time. The files
+// of every app are uploaded as soon as there is room, but an app is only
+// started once the apps it depends on have been started. The output of each
+// app is prefixed with its name, and a summary of the apps that succeeded and
+// failed is displayed at the end.
+func (cmd V2PushCommand) pushAppsInParallel(user configv3.User, appConfigs []pushaction.ApplicationConfig) error {
+	appIndexes := map[string]int{}
+	for i := len(appConfigs) - 1; i >= 0; i-- {
+		appIndexes[appConfigs[i].DesiredApplication.Name] = i
+	}
+
+	slots := make(chan bool, cmd.Parallel.Value)
+	finished := make([]chan bool, len(appConfigs))
+	results := make([]parallelPushResult, len(appConfigs))
+	for i := range appConfigs {
+		finished[i] = make(chan bool)
+	}
+
+	var wg sync.WaitGroup
+	for i, appConfig := range appConfigs {
+		wg.Add(1)
+		go func(i int, appConfig pushaction.ApplicationConfig) {
+			defer wg.Done()
+			defer close(finished[i])
+
+			appCmd := cmd
+			appCmd.UI = command.WithAppPrefix(cmd.UI, appConfig.DesiredApplication.Name)
+			appCmd.ProgressBar = silentProgressBar{}
+
+			slots <- true
+			updatedConfig, err := appCmd.applyApp(user, appConfig)
+			<-slots
+			if err != nil {
+				appCmd.UI.DisplayError(translatableerror.ConvertToTranslatableError(err))
+				results[i] = parallelPushResult{err: err}
+				return
+			}
+
+			for _, dependency := range appConfig.DependsOn {
+				dependencyIndex, ok := appIndexes[dependency]
+				if !ok || dependencyIndex == i {
+					continue
+				}
+
+				<-finished[dependencyIndex]
+				dependencyResult := results[dependencyIndex]
+				if dependencyResult.err != nil || dependencyResult.failedDependency != "" {
+					appCmd.UI.DisplayWarning("Not starting app because {{.Dependency}} failed to push.", map[string]interface{}{
+						"Dependency": dependency,
+					})
+					results[i] = parallelPushResult{failedDependency: dependency}
+					return
+				}
+			}
+
+			slots <- true
+			err = appCmd.startApp(appConfig, updatedConfig)
+			<-slots
+			if err != nil {
+				appCmd.UI.DisplayError(translatableerror.ConvertToTranslatableError(err))
+				results[i] = parallelPushResult{err: err}
+				return
+			}
+			appCmd.UI.DisplayNewline()
+		}(i, appConfig)
+	}
+	wg.Wait()
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Push summary:")
+	table := [][]string{
+		{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("result")},
+	}
+	var failedApps []string
+	for i, appConfig := range appConfigs {
+		var result string
+		switch {
+		case results[i].err != nil:
+			result = cmd.UI.TranslateText("failed")
+		case results[i].failedDependency != "":
+			result = cmd.UI.TranslateText("not started, {{.Dependency}} failed", map[string]interface{}{
+				"Dependency": results[i].failedDependency,
+			})
+		default:
+			result = cmd.UI.TranslateText("succeeded")
+		}
+		if results[i].err != nil || results[i].failedDependency != "" {
+			failedApps = append(failedApps, appConfig.DesiredApplication.Name)
 		}
+		table = append(table, []string{appConfig.DesiredApplication.Name, result})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if len(failedApps) > 0 {
+		return translatableerror.AppsFailedToPushError{AppNames: failedApps}
+	}
+	return nil
+}
+
+// orderByDependencies orders the app configs so that every app comes after the
+// apps it depends on, keeping the order of the manifest otherwise.
+// Dependencies on apps that are not being pushed are ignored.
+func orderByDependencies(appConfigs []pushaction.ApplicationConfig) []pushaction.ApplicationConfig {
+	appIndexes := map[string]int{}
+	for i := len(appConfigs) - 1; i >= 0; i-- {
+		appIndexes[appConfigs[i].DesiredApplication.Name] = i
+	}
+
+	var ordered []pushaction.ApplicationConfig
+	added := make([]bool, len(appConfigs))
+	var add func(i int)
+	add = func(i int) {
+		if added[i] {
+			return
+		}
+		added[i] = true
+		for _, dependency := range appConfigs[i].DependsOn {
+			if dependencyIndex, ok := appIndexes[dependency]; ok {
+				add(dependencyIndex)
+			}
+		}
+		ordered = append(ordered, appConfigs[i])
+	}
+
+	for i := range appConfigs {
+		add(i)
+	}
+	return ordered
+}
+
+// silentProgressBar is used when pushing apps in parallel, since the progress
+// bars of several uploads cannot be drawn on the terminal at the same time.
+type silentProgressBar struct{}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return reader
+}
+
+func (silentProgressBar) Ready() {}
+
+func (silentProgressBar) Complete() {}
+
+// displayDryRunPlan displays the routes, service bindings and files that
+// pushing would create, map, bind and upload for each app, and a summary of
+// the apps that would be created and updated. Resource matching does not
+// change anything on the Cloud Controller, so it is the only request made.
+func (cmd V2PushCommand) displayDryRunPlan(appConfigs []pushaction.ApplicationConfig) error {
+	var plans []pushaction.Plan
+	for _, appConfig := range appConfigs {
+		log.Infoln("planning dry run:", appConfig.DesiredApplication.Name)
+		plan, warnings := cmd.Actor.PlanApplication(appConfig)
+		cmd.UI.DisplayWarnings(warnings)
+
+		shared.DisplayPushPlan(cmd.UI, plan)
+		plans = append(plans, plan)
 	}
 
+	shared.DisplayPushPlanSummary(cmd.UI, plans)
 	return nil
 }
 
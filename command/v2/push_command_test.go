@@ -6,10 +6,12 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
@@ -722,6 +724,22 @@ var _ = Describe("push Command", func() {
 							}
 						})
 
+						Context("when verbose output is enabled", func() {
+							var fakeResourceCacheActor *v2fakes.FakeResourceCacheStatsActor
+
+							BeforeEach(func() {
+								fakeConfig.VerboseReturns(true, nil)
+								fakeResourceCacheActor = new(v2fakes.FakeResourceCacheStatsActor)
+								fakeResourceCacheActor.ResourceCacheStatsReturns(sharedaction.ResourceCacheStats{Hits: 7, Misses: 3})
+								cmd.ResourceCacheActor = fakeResourceCacheActor
+							})
+
+							It("displays the resource cache stats", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Reused cached hashes of 7 files, hashed 3 files\\."))
+							})
+						})
+
 						Context("when the app starts", func() {
 							It("displays app events and warnings", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
@@ -871,6 +889,198 @@ var _ = Describe("push Command", func() {
 						})
 					})
 
+					Context("when --dry-run is set", func() {
+						BeforeEach(func() {
+							cmd.DryRun = true
+
+							appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+							appConfigs[0].AllResources = []v2action.Resource{
+								{Filename: "some-dir", Mode: os.ModeDir | 0755},
+								{Filename: "some-dir/file-1", Mode: 0644, Size: 2048},
+								{Filename: "file-2", Mode: 0644, Size: 1024},
+								{Filename: "file-3", Mode: 0644, Size: 10},
+							}
+							appConfigs = append(appConfigs, pushaction.ApplicationConfig{
+								DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "some-docker-app", DockerImage: "some-image"}},
+							})
+							fakeActor.ConvertToApplicationConfigsReturns(appConfigs, pushaction.Warnings{"some-config-warnings"}, nil)
+
+							fakeActor.PlanApplicationStub = func(config pushaction.ApplicationConfig) (pushaction.Plan, pushaction.Warnings) {
+								if config.DesiredApplication.DockerImage != "" {
+									return pushaction.Plan{Config: config}, nil
+								}
+								config.MatchedResources = config.AllResources[3:]
+								config.UnmatchedResources = config.AllResources[:3]
+								return pushaction.Plan{
+									Config: config,
+									RoutesToCreate: []v2action.Route{
+										{Host: "new-host", Domain: v2action.Domain{Name: "some-domain.com"}},
+									},
+									RoutesToMap: []v2action.Route{
+										{GUID: "existing-route-guid", Host: "existing-host", Domain: v2action.Domain{Name: "some-domain.com"}},
+									},
+									ServicesToBind: []string{"service-1", "service-2"},
+								}, pushaction.Warnings{"some-resource-match-warning"}
+							}
+						})
+
+						It("displays the changes and the plan without applying them", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Updating app with these attributes\\.\\.\\."))
+							Expect(testUI.Out).To(Say("\\s+name:\\s+%s", appName))
+							Expect(testUI.Out).To(Say("Creating app with these attributes\\.\\.\\."))
+							Expect(testUI.Out).To(Say("\\+\\s+name:\\s+some-docker-app"))
+							Expect(testUI.Out).To(Say("Plan for app %s:", appName))
+							Expect(testUI.Out).To(Say("routes to create and map:\\s+new-host\\.some-domain\\.com"))
+							Expect(testUI.Out).To(Say("routes to map:\\s+existing-host\\.some-domain\\.com"))
+							Expect(testUI.Out).To(Say("services to bind:\\s+service-1, service-2"))
+							Expect(testUI.Out).To(Say("files to upload:\\s+2 \\(3K\\)"))
+							Expect(testUI.Out).To(Say("files already on server:\\s+1"))
+							Expect(testUI.Out).To(Say("Plan: 1 app\\(s\\) to create, 1 app\\(s\\) to update\\."))
+							Expect(testUI.Out).To(Say("Dry run: no changes have been made\\."))
+							Expect(testUI.Out).ToNot(Say("Plan for app some-docker-app"))
+							Expect(testUI.Err).To(Say("some-resource-match-warning"))
+
+							Expect(fakeActor.PlanApplicationCallCount()).To(Equal(2))
+							Expect(fakeActor.PlanApplicationArgsForCall(0)).To(Equal(appConfigs[0]))
+
+							Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+							Expect(fakeRestartActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when --parallel is set and there are multiple apps", func() {
+						var (
+							restartMutex   sync.Mutex
+							restartedApps  []string
+							failingAppName string
+						)
+
+						BeforeEach(func() {
+							cmd.Parallel = flag.PositiveInteger{Value: 2}
+							restartedApps = nil
+							failingAppName = "app-3"
+
+							appConfigs = []pushaction.ApplicationConfig{
+								{
+									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "app-1"}},
+									DependsOn:          []string{"app-2", "some-app-not-being-pushed"},
+								},
+								{
+									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "app-2"}},
+								},
+								{
+									DesiredApplication: pushaction.Application{Application: v2action.Application{Name: "app-3"}},
+								},
+							}
+							fakeActor.ConvertToApplicationConfigsReturns(appConfigs, nil, nil)
+
+							fakeActor.ApplyStub = func(config pushaction.ApplicationConfig, _ pushaction.ProgressBar) (<-chan pushaction.ApplicationConfig, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+								configStream := make(chan pushaction.ApplicationConfig, 1)
+								eventStream := make(chan pushaction.Event, 3)
+								warningsStream := make(chan pushaction.Warnings)
+								errorStream := make(chan error, 1)
+
+								if config.DesiredApplication.Name == failingAppName {
+									errorStream <- errors.New("some-apply-error")
+								} else {
+									config.CurrentApplication = config.DesiredApplication
+									configStream <- config
+									eventStream <- pushaction.UploadingApplicationWithArchive
+									eventStream <- pushaction.UploadWithArchiveComplete
+									eventStream <- pushaction.Complete
+									close(errorStream)
+								}
+								close(configStream)
+								close(eventStream)
+								close(warningsStream)
+
+								return configStream, eventStream, warningsStream, errorStream
+							}
+
+							fakeRestartActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+								restartMutex.Lock()
+								restartedApps = append(restartedApps, app.Name)
+								restartMutex.Unlock()
+
+								messages := make(chan *v2action.LogMessage)
+								logErrs := make(chan error)
+								appState := make(chan v2action.ApplicationStateChange)
+								warnings := make(chan string)
+								errs := make(chan error)
+								close(messages)
+								close(logErrs)
+								close(appState)
+								close(warnings)
+								close(errs)
+
+								return messages, logErrs, appState, warnings, errs
+							}
+
+							fakeRestartActor.GetApplicationSummaryByNameAndSpaceStub = func(name string, _ string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+								return v2action.ApplicationSummary{Application: v2action.Application{Name: name, State: "STARTED"}}, nil, nil
+							}
+						})
+
+						It("pushes the apps with output prefixed by app name, starting dependencies first", func() {
+							Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{AppNames: []string{"app-3"}}))
+
+							Expect(fakeActor.ApplyCallCount()).To(Equal(3))
+							for i := 0; i < 3; i++ {
+								_, progressBar := fakeActor.ApplyArgsForCall(i)
+								Expect(progressBar).ToNot(Equal(fakeProgressBar))
+							}
+							Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+
+							Expect(restartedApps).To(Equal([]string{"app-2", "app-1"}))
+
+							Expect(testUI.Out).To(Say(`(?m)^\[app-1\] Creating app app-1\.\.\.$`))
+							Expect(testUI.Out).To(Say(`(?m)^\[app-1\] name:\s+app-1$`))
+							Expect(testUI.Err).To(Say(`(?m)^\[app-3\] some-apply-error$`))
+
+							Expect(testUI.Out).To(Say("Push summary:"))
+							Expect(testUI.Out).To(Say(`name\s+result`))
+							Expect(testUI.Out).To(Say(`app-1\s+succeeded`))
+							Expect(testUI.Out).To(Say(`app-2\s+succeeded`))
+							Expect(testUI.Out).To(Say(`app-3\s+failed`))
+						})
+
+						Context("when a dependency fails to push", func() {
+							BeforeEach(func() {
+								failingAppName = "app-2"
+							})
+
+							It("does not start the apps that depend on it", func() {
+								Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{AppNames: []string{"app-1", "app-2"}}))
+
+								Expect(restartedApps).To(Equal([]string{"app-3"}))
+								Expect(testUI.Err).To(Say(`\[app-1\] Not starting app because app-2 failed to push\.`))
+
+								Expect(testUI.Out).To(Say("Push summary:"))
+								Expect(testUI.Out).To(Say(`app-1\s+not started, app-2 failed`))
+								Expect(testUI.Out).To(Say(`app-2\s+failed`))
+								Expect(testUI.Out).To(Say(`app-3\s+succeeded`))
+							})
+						})
+
+						Context("when --parallel is not set", func() {
+							BeforeEach(func() {
+								cmd.Parallel = flag.PositiveInteger{}
+								failingAppName = ""
+							})
+
+							It("pushes the apps one at a time, dependencies first", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(restartedApps).To(Equal([]string{"app-2", "app-1", "app-3"}))
+								Expect(testUI.Out).ToNot(Say(`\[app-1\]`))
+								Expect(testUI.Out).ToNot(Say("Push summary:"))
+							})
+						})
+					})
+
 					Context("when the apply errors", func() {
 						var expectedErr error
 
@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RenameContextCommand struct {
+	RequiredArgs    flag.RenameContextArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-context CONTEXT_NAME NEW_CONTEXT_NAME"`
+	relatedCommands interface{}            `related_commands:"contexts"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *RenameContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd RenameContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Renaming context {{.OldContextName}} to {{.NewContextName}}...", map[string]interface{}{
+		"OldContextName": cmd.RequiredArgs.OldContextName,
+		"NewContextName": cmd.RequiredArgs.NewContextName,
+	})
+
+	err := cmd.Config.RenameContext(cmd.RequiredArgs.OldContextName, cmd.RequiredArgs.NewContextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
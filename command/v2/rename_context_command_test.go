@@ -0,0 +1,56 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-context command", func() {
+	var (
+		cmd        RenameContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = RenameContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.OldContextName = "staging"
+		cmd.RequiredArgs.NewContextName = "stage"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("renames the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.RenameContextCallCount()).To(Equal(1))
+		oldName, newName := fakeConfig.RenameContextArgsForCall(0)
+		Expect(oldName).To(Equal("staging"))
+		Expect(newName).To(Equal("stage"))
+		Expect(testUI.Out).To(Say("Renaming context staging to stage..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when renaming fails", func() {
+		BeforeEach(func() {
+			fakeConfig.RenameContextReturns(translatableerror.ContextAlreadyExistsError{Name: "stage"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "stage"}))
+		})
+	})
+})
@@ -0,0 +1,191 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/output"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . SecurityGroupAuditActor
+
+type SecurityGroupAuditActor interface {
+	CloudControllerAPIVersion() string
+	GetSecurityGroupAuditByOrganizationAndSpaceName(orgGUID string, spaceName string, includeStaging bool) (v2action.SecurityGroupAudit, v2action.Warnings, error)
+}
+
+type SecurityGroupAuditCommand struct {
+	RequiredArgs    flag.Space  `positional-args:"yes"`
+	usage           interface{} `usage:"CF_NAME security-group-audit SPACE\n\n   Effective rules are the rules of the running and staging default security groups merged with those of the security groups bound to the space. Rules allowing all traffic to 0.0.0.0/0, rules defined by several groups, and rules shadowed by or overlapping other rules are reported as findings.\n\nEXAMPLES:\n   CF_NAME security-group-audit my-space\n   CF_NAME security-group-audit my-space --output json"`
+	relatedCommands interface{} `related_commands:"bind-security-group, running-security-groups, security-groups, staging-security-groups"`
+
+	SharedActor command.SharedActor
+	Config      command.Config
+	UI          command.UI
+	Actor       SecurityGroupAuditActor
+}
+
+func (cmd *SecurityGroupAuditCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (SecurityGroupAuditCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd SecurityGroupAuditCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	includeStaging := true
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionLifecyleStagingV2)
+	if err != nil {
+		switch err.(type) {
+		case translatableerror.MinimumAPIVersionNotMetError:
+			includeStaging = false
+
+		default:
+			return err
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Auditing security groups of space {{.SpaceName}} in org {{.OrgName}} as {{.UserName}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.Space,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"UserName":  user.Name,
+	})
+
+	audit, warnings, err := cmd.Actor.GetSecurityGroupAuditByOrganizationAndSpaceName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.Space, includeStaging)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(output.SecurityGroupAuditKind, output.NewSecurityGroupAudit(audit))
+	}
+
+	cmd.UI.DisplayOK()
+
+	cmd.displayRules("running rules:", audit.Running)
+	if audit.StagingIncluded {
+		cmd.displayRules("staging rules:", audit.Staging)
+	}
+	cmd.displayFindings(audit.Findings)
+
+	return nil
+}
+
+func (cmd SecurityGroupAuditCommand) displayRules(title string, rules []v2action.EffectiveSecurityGroupRule) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText(title)
+
+	if len(rules) == 0 {
+		cmd.UI.DisplayText("No rules found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("security groups"),
+		},
+	}
+
+	for _, rule := range rules {
+		var origins []string
+		for _, origin := range rule.Origins {
+			origins = append(origins, fmt.Sprintf("%s (%s)", origin.SecurityGroupName, cmd.UI.TranslateText(string(origin.Binding))))
+		}
+		ports := rule.Ports
+		if icmp := formatICMPTypeAndCode(rule); icmp != "" {
+			ports = icmp
+		}
+		table = append(table, []string{rule.Protocol, rule.Destination, ports, strings.Join(origins, ", ")})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd SecurityGroupAuditCommand) displayFindings(findings []v2action.SecurityGroupFinding) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("findings:")
+
+	if len(findings) == 0 {
+		cmd.UI.DisplayText("No findings.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("finding"),
+			cmd.UI.TranslateText("lifecycle"),
+			cmd.UI.TranslateText("rule"),
+			cmd.UI.TranslateText("related rule"),
+		},
+	}
+
+	for _, finding := range findings {
+		var related string
+		if finding.Related != nil {
+			related = formatSecurityGroupRule(*finding.Related)
+		}
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(finding.Type)),
+			string(finding.Rule.Lifecycle),
+			formatSecurityGroupRule(finding.Rule),
+			related,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func formatSecurityGroupRule(rule v2action.EffectiveSecurityGroupRule) string {
+	if icmp := formatICMPTypeAndCode(rule); icmp != "" {
+		return fmt.Sprintf("%s %s (%s)", rule.Protocol, rule.Destination, icmp)
+	}
+	if rule.Ports == "" {
+		return fmt.Sprintf("%s %s", rule.Protocol, rule.Destination)
+	}
+	return fmt.Sprintf("%s %s:%s", rule.Protocol, rule.Destination, rule.Ports)
+}
+
+func formatICMPTypeAndCode(rule v2action.EffectiveSecurityGroupRule) string {
+	var parts []string
+	if rule.Type.IsSet {
+		parts = append(parts, fmt.Sprintf("type %d", rule.Type.Value))
+	}
+	if rule.Code.IsSet {
+		parts = append(parts, fmt.Sprintf("code %d", rule.Code.Value))
+	}
+	return strings.Join(parts, ", ")
+}
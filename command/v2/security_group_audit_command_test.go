@@ -0,0 +1,207 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("security-group-audit Command", func() {
+	var (
+		cmd             SecurityGroupAuditCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSecurityGroupAuditActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSecurityGroupAuditActor)
+
+		cmd = SecurityGroupAuditCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLifecyleStagingV2)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the API does not support staging security groups", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("2.34.0")
+		})
+
+		It("audits only the running rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameCallCount()).To(Equal(1))
+			_, _, includeStaging := fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameArgsForCall(0)
+			Expect(includeStaging).To(BeFalse())
+			Expect(testUI.Out).ToNot(Say("staging rules:"))
+		})
+	})
+
+	Context("when getting the audit fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameReturns(
+				v2action.SecurityGroupAudit{},
+				v2action.Warnings{"warning-1"},
+				actionerror.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when getting the audit succeeds", func() {
+		BeforeEach(func() {
+			broad := v2action.EffectiveSecurityGroupRule{
+				Lifecycle:   constant.SecurityGroupLifecycleRunning,
+				Protocol:    "all",
+				Destination: "0.0.0.0/0",
+				Origins: []v2action.SecurityGroupRuleOrigin{
+					{SecurityGroupName: "public-networks", Binding: v2action.SecurityGroupBindingGlobal},
+				},
+			}
+			shadowed := v2action.EffectiveSecurityGroupRule{
+				Lifecycle:   constant.SecurityGroupLifecycleRunning,
+				Protocol:    "tcp",
+				Destination: "10.0.0.0/8",
+				Ports:       "443",
+				Origins: []v2action.SecurityGroupRuleOrigin{
+					{SecurityGroupName: "web", Binding: v2action.SecurityGroupBindingSpace},
+					{SecurityGroupName: "api", Binding: v2action.SecurityGroupBindingSpace},
+				},
+			}
+
+			fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameReturns(
+				v2action.SecurityGroupAudit{
+					SpaceName:       "some-space",
+					Running:         []v2action.EffectiveSecurityGroupRule{broad, shadowed},
+					StagingIncluded: true,
+					Findings: []v2action.SecurityGroupFinding{
+						{Type: v2action.SecurityGroupFindingBroad, Rule: broad},
+						{Type: v2action.SecurityGroupFindingShadowed, Rule: shadowed, Related: &broad},
+					},
+				},
+				v2action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("displays the effective rules and findings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameCallCount()).To(Equal(1))
+			orgGUID, spaceName, includeStaging := fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(includeStaging).To(BeTrue())
+
+			Expect(testUI.Out).To(Say("Auditing security groups of space some-space in org some-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("running rules:"))
+			Expect(testUI.Out).To(Say(`protocol\s+destination\s+ports\s+security groups`))
+			Expect(testUI.Out).To(Say(`all\s+0\.0\.0\.0/0\s+public-networks \(global\)`))
+			Expect(testUI.Out).To(Say(`tcp\s+10\.0\.0\.0/8\s+443\s+web \(space\), api \(space\)`))
+			Expect(testUI.Out).To(Say("staging rules:"))
+			Expect(testUI.Out).To(Say("No rules found."))
+			Expect(testUI.Out).To(Say("findings:"))
+			Expect(testUI.Out).To(Say(`finding\s+lifecycle\s+rule\s+related rule`))
+			Expect(testUI.Out).To(Say(`broad\s+running\s+all 0\.0\.0\.0/0`))
+			Expect(testUI.Out).To(Say(`shadowed\s+running\s+tcp 10\.0\.0\.0/8:443\s+all 0\.0\.0\.0/0`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		Context("when the rules include icmp rules", func() {
+			BeforeEach(func() {
+				ping := v2action.EffectiveSecurityGroupRule{
+					Lifecycle:   constant.SecurityGroupLifecycleRunning,
+					Protocol:    "icmp",
+					Destination: "10.0.0.0/8",
+					Type:        types.NullInt{IsSet: true, Value: 8},
+					Code:        types.NullInt{IsSet: true, Value: 0},
+					Origins: []v2action.SecurityGroupRuleOrigin{
+						{SecurityGroupName: "ping", Binding: v2action.SecurityGroupBindingSpace},
+						{SecurityGroupName: "ping-copy", Binding: v2action.SecurityGroupBindingSpace},
+					},
+				}
+
+				fakeActor.GetSecurityGroupAuditByOrganizationAndSpaceNameReturns(
+					v2action.SecurityGroupAudit{
+						SpaceName: "some-space",
+						Running:   []v2action.EffectiveSecurityGroupRule{ping},
+						Findings: []v2action.SecurityGroupFinding{
+							{Type: v2action.SecurityGroupFindingDuplicate, Rule: ping},
+						},
+					},
+					nil,
+					nil)
+			})
+
+			It("displays their ICMP types and codes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`icmp\s+10\.0\.0\.0/8\s+type 8, code 0\s+ping \(space\), ping-copy \(space\)`))
+				Expect(testUI.Out).To(Say(`duplicate\s+running\s+icmp 10\.0\.0\.0/8 \(type 8, code 0\)`))
+			})
+		})
+
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the audit as a json document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Auditing security groups"))
+				Expect(testUI.Out).To(Say(`"kind": "security-group-audit"`))
+				Expect(testUI.Out).To(Say(`"space": "some-space"`))
+				Expect(testUI.Out).To(Say(`"findings": \[\s+{\s+"type": "broad"`))
+				Expect(testUI.Err).To(Say(`"warning-1"`))
+			})
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
@@ -45,6 +46,10 @@ func (cmd *ServiceCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd ServiceCommand) SupportsStructuredOutput() bool {
+	return !cmd.GUID
+}
+
 func (cmd ServiceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -89,6 +94,10 @@ func (cmd ServiceCommand) displayServiceInstanceSummary() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(output.ServiceKind, output.NewService(serviceInstanceSummary))
+	}
+
 	if serviceInstanceSummary.IsManaged() {
 		cmd.displayManagedServiceInstanceSummary(serviceInstanceSummary)
 		cmd.displayBoundApplicationsIfExists(serviceInstanceSummary)
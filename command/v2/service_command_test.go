@@ -51,6 +51,14 @@ var _ = Describe("service Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Describe("SupportsStructuredOutput", func() {
+		It("is supported unless the '--guid' flag is provided", func() {
+			Expect(cmd.SupportsStructuredOutput()).To(BeTrue())
+			cmd.GUID = true
+			Expect(cmd.SupportsStructuredOutput()).To(BeFalse())
+		})
+	})
+
 	Context("when an error is encountered checking if the environment is setup correctly", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -340,6 +348,25 @@ var _ = Describe("service Command", func() {
 							})
 						})
 
+						Context("when structured output is requested", func() {
+							BeforeEach(func() {
+								testUI.OutputFormat = configv3.OutputFormatJSON
+							})
+
+							It("displays the service instance summary as a json document", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Showing info of service"))
+								Expect(testUI.Out).To(Say(`"kind": "service"`))
+								Expect(testUI.Out).To(Say(`"name": "some-service-instance"`))
+								Expect(testUI.Out).To(Say(`"type": "managed_service_instance"`))
+								Expect(testUI.Out).To(Say(`"service": "some-service"`))
+								Expect(testUI.Out).To(Say(`"plan": "some-plan"`))
+								Expect(testUI.Out).To(Say(`"last_operation": {\s+"type": "some-type",\s+"state": "some-state"`))
+								Expect(testUI.Err).To(Say(`"get-service-instance-summary-warning-1"`))
+							})
+						})
+
 						Context("when the service instance is not shared and is shareable", func() {
 							BeforeEach(func() {
 								returnedSummary.ServiceInstanceShareType = v2action.ServiceInstanceIsNotShared
@@ -0,0 +1,91 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// DisplayPushPlan displays the routes, service bindings and files that
+// pushing would create, map, unmap, bind and upload for the plan's app.
+func DisplayPushPlan(ui command.UI, plan pushaction.Plan) {
+	var table [][]string
+	if len(plan.RoutesToCreate) > 0 {
+		table = append(table, []string{ui.TranslateText("routes to create and map:"), routeList(plan.RoutesToCreate)})
+	}
+	if len(plan.RoutesToMap) > 0 {
+		table = append(table, []string{ui.TranslateText("routes to map:"), routeList(plan.RoutesToMap)})
+	}
+	if len(plan.RoutesToUnmap) > 0 {
+		table = append(table, []string{ui.TranslateText("routes to unmap:"), routeList(plan.RoutesToUnmap)})
+	}
+	if len(plan.ServicesToBind) > 0 {
+		table = append(table, []string{ui.TranslateText("services to bind:"), strings.Join(plan.ServicesToBind, ", ")})
+	}
+
+	if len(plan.Config.AllResources) > 0 {
+		uploadCount, uploadSize := countFiles(plan.Config.UnmatchedResources)
+		matchedCount, _ := countFiles(plan.Config.MatchedResources)
+		table = append(table,
+			[]string{ui.TranslateText("files to upload:"), ui.TranslateText("{{.Count}} ({{.Size}})", map[string]interface{}{
+				"Count": uploadCount,
+				"Size":  bytefmt.ByteSize(uint64(uploadSize)),
+			})},
+			[]string{ui.TranslateText("files already on server:"), fmt.Sprint(matchedCount)},
+		)
+	}
+
+	if len(table) == 0 {
+		return
+	}
+
+	ui.DisplayTextWithFlavor("Plan for app {{.AppName}}:", map[string]interface{}{
+		"AppName": plan.Config.DesiredApplication.Name,
+	})
+	ui.DisplayKeyValueTable("  ", table, 3)
+	ui.DisplayNewline()
+}
+
+// DisplayPushPlanSummary displays how many apps pushing would create and
+// update, and that nothing has been changed.
+func DisplayPushPlanSummary(ui command.UI, plans []pushaction.Plan) {
+	var toCreate, toUpdate int
+	for _, plan := range plans {
+		if plan.Config.CreatingApplication() {
+			toCreate++
+		} else {
+			toUpdate++
+		}
+	}
+
+	ui.DisplayText("Plan: {{.CreateCount}} app(s) to create, {{.UpdateCount}} app(s) to update.", map[string]interface{}{
+		"CreateCount": toCreate,
+		"UpdateCount": toUpdate,
+	})
+	ui.DisplayText("Dry run: no changes have been made.")
+}
+
+func routeList(routes []v2action.Route) string {
+	names := make([]string, 0, len(routes))
+	for _, route := range routes {
+		names = append(names, route.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+func countFiles(resources []v2action.Resource) (int, int64) {
+	var count int
+	var size int64
+	for _, resource := range resources {
+		if resource.Mode.IsDir() {
+			continue
+		}
+		count++
+		size += resource.Size
+	}
+	return count, size
+}
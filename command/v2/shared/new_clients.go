@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"code.cloudfoundry.org/cli/api/cassette"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -23,17 +24,42 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
+	var ccRawWrappers []ccv2.ConnectionWrapper
+	var uaaRawWrapper uaa.ConnectionWrapper
+	if cassettePath, replay := config.Cassette(); cassettePath != "" {
+		tape, err := cassette.Open(cassettePath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if replay {
+			ccRawWrappers = append(ccRawWrappers, ccWrapper.NewCassettePlayer(tape))
+			uaaRawWrapper = uaaWrapper.NewCassettePlayer(tape)
+		} else {
+			ccRawWrappers = append(ccRawWrappers, ccWrapper.NewCassetteRecorder(tape))
+			uaaRawWrapper = uaaWrapper.NewCassetteRecorder(tape)
+		}
+	}
+
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequestWithPolicy(config.RequestRetryPolicy()))
+	if config.ResponseCacheEnabled() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.ResponseCacheDirectory(), func() string {
+			user, _ := config.CurrentUser()
+			return user.Name
+		}, ccWrapper.DefaultResponseCacheTTLs))
+	}
 
 	ccClient := ccv2.NewClient(ccv2.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:                config.BinaryName(),
+		AppVersion:             config.BinaryVersion(),
+		JobPollingTimeout:      config.OverallPollingTimeout(),
+		JobPollingInterval:     config.PollingInterval(),
+		PageRequestConcurrency: config.PageRequestConcurrency(),
+		RawWrappers:            ccRawWrappers,
+		Wrappers:               ccWrappers,
 	})
 
 	if !targetCF {
@@ -64,6 +90,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	}
 
 	uaaClient := uaa.NewClient(config)
+	if uaaRawWrapper != nil {
+		uaaClient.WrapRawConnection(uaaRawWrapper)
+	}
 
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -74,7 +103,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequestWithPolicy(config.RequestRetryPolicy()))
 
 	err = uaaClient.SetupResources(ccClient.AuthorizationEndpoint())
 	if err != nil {
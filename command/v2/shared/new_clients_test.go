@@ -1,7 +1,10 @@
 package shared_test
 
 import (
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -93,6 +96,50 @@ var _ = Describe("New Clients", func() {
 		})
 	})
 
+	Context("when a cassette is played back", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "new-clients")
+			Expect(err).ToNot(HaveOccurred())
+
+			path := filepath.Join(dir, "cassette.json")
+			raw := `{
+				"interactions": [
+					{
+						"method": "GET",
+						"path": "/v2/info",
+						"response": {"status_code": 200, "body": "{\"api_version\":\"2.100.0\",\"authorization_endpoint\":\"https://login.example.com\"}"}
+					},
+					{
+						"method": "GET",
+						"path": "/login",
+						"response": {"status_code": 200, "body": "{\"links\":{\"uaa\":\"https://uaa.example.com\"}}"}
+					}
+				]
+			}`
+			Expect(ioutil.WriteFile(path, []byte(raw), 0600)).To(Succeed())
+
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.CassetteReturns(path, true)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("targets the Cloud Controller and UAA without contacting them", func() {
+			ccClient, uaaClient, err := NewClients(fakeConfig, testUI, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ccClient.APIVersion()).To(Equal("2.100.0"))
+			Expect(uaaClient).ToNot(BeNil())
+
+			Expect(fakeConfig.SetUAAEndpointCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUAAEndpointArgsForCall(0)).To(Equal("https://uaa.example.com"))
+		})
+	})
+
 	Context("when not targetting", func() {
 		It("does not target and returns no UAA client", func() {
 			ccClient, uaaClient, err := NewClients(fakeConfig, testUI, false)
@@ -0,0 +1,35 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SwitchContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME switch-context CONTEXT_NAME\n\nTIP:\n   Use the '--context' global option to run a single command against another context without switching."`
+	relatedCommands interface{}      `related_commands:"contexts, create-context, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SwitchContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd SwitchContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Switching to context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	err := cmd.Config.SwitchContext(cmd.RequiredArgs.ContextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,54 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-context command", func() {
+	var (
+		cmd        SwitchContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = SwitchContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "production"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("switches to the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.SwitchContextCallCount()).To(Equal(1))
+		Expect(fakeConfig.SwitchContextArgsForCall(0)).To(Equal("production"))
+		Expect(testUI.Out).To(Say("Switching to context production..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	Context("when the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.SwitchContextReturns(translatableerror.ContextNotFoundError{Name: "production"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "production"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
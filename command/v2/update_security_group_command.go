@@ -1,21 +1,156 @@
 package v2
 
 import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+//go:generate counterfeiter . UpdateSecurityGroupActor
+
+type UpdateSecurityGroupActor interface {
+	GetSecurityGroupRulesChangesByName(securityGroupName string, rules []ccv2.SecurityGroupRule) (v2action.SecurityGroupRulesChanges, v2action.Warnings, error)
+	ReadSecurityGroupRulesFile(path string) ([]ccv2.SecurityGroupRule, error)
+	UpdateSecurityGroupRules(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (v2action.Warnings, error)
+}
+
 type UpdateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
+	Force           bool                   `short:"f" description:"Force update without confirmation"`
+	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE [-f]\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n   The rules in the file replace all the rules of the security group. The rules that\n   are added and removed are displayed before asking for confirmation.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
 	relatedCommands interface{}            `related_commands:"restage, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateSecurityGroupActor
 }
 
-func (UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd UpdateSecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rules, err := cmd.Actor.ReadSecurityGroupRulesFile(string(cmd.RequiredArgs.PathToJsonRules))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting rules of security group {{.SecurityGroup}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+		"Username":      user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.GetSecurityGroupRulesChangesByName(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if !changes.HasChanges() {
+		cmd.UI.DisplayText("The rules of security group {{.SecurityGroup}} are already up to date.", map[string]interface{}{
+			"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+		})
+		return nil
+	}
+
+	err = cmd.UI.DisplayChangesForPush([]ui.Change{
+		{
+			Header:       "rules:",
+			CurrentValue: formatSecurityGroupRules(changes.SecurityGroup.Rules),
+			NewValue:     formatSecurityGroupRules(rules),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.AddedCount}} rule(s) to add, {{.RemovedCount}} rule(s) to remove.", map[string]interface{}{
+		"AddedCount":   len(changes.Added),
+		"RemovedCount": len(changes.Removed),
+	})
+
+	if !cmd.Force {
+		updateSecurityGroup, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really update the rules of security group {{.SecurityGroup}}?", map[string]interface{}{
+			"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !updateSecurityGroup {
+			cmd.UI.DisplayText("Update cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating security group {{.SecurityGroup}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroup": cmd.RequiredArgs.SecurityGroup,
+		"Username":      user.Name,
+	})
+
+	warnings, err = cmd.Actor.UpdateSecurityGroupRules(changes.SecurityGroup.GUID, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+
 	return nil
 }
 
-func (UpdateSecurityGroupCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+// formatSecurityGroupRules formats each rule on a single line, including every
+// field, so that rules which differ in any field are displayed differently.
+func formatSecurityGroupRules(rules []ccv2.SecurityGroupRule) []string {
+	formatted := []string{}
+	for _, rule := range rules {
+		line := fmt.Sprintf("%s %s", rule.Protocol, rule.Destination)
+		if rule.Ports != "" {
+			line += ":" + rule.Ports
+		}
+		if rule.Type.IsSet {
+			line += fmt.Sprintf(" type %d", rule.Type.Value)
+		}
+		if rule.Code.IsSet {
+			line += fmt.Sprintf(" code %d", rule.Code.Value)
+		}
+		if rule.Log {
+			line += " log"
+		}
+		if rule.Description != "" {
+			line += fmt.Sprintf(" %q", rule.Description)
+		}
+		formatted = append(formatted, line)
+	}
+	return formatted
 }
@@ -0,0 +1,219 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-security-group Command", func() {
+	var (
+		cmd             UpdateSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateSecurityGroupActor
+		input           *Buffer
+		binaryName      string
+		rules           []ccv2.SecurityGroupRule
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateSecurityGroupActor)
+
+		cmd = UpdateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.SecurityGroup = "some-security-group"
+		cmd.RequiredArgs.PathToJsonRules = "some-path"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		rules = []ccv2.SecurityGroupRule{
+			{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Log: true},
+			{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}, Description: "ping"},
+		}
+		fakeActor.ReadSecurityGroupRulesFileReturns(rules, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the rules file is invalid", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = actionerror.InvalidSecurityGroupRulesError{Errors: []actionerror.SecurityGroupRuleError{
+				{File: "some-path", Line: 1, Column: 1, Message: "the file must contain a list of rules"},
+			}}
+			fakeActor.ReadSecurityGroupRulesFileReturns(nil, expectedErr)
+		})
+
+		It("returns the error without getting the security group", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+
+			Expect(fakeActor.ReadSecurityGroupRulesFileArgsForCall(0)).To(Equal("some-path"))
+			Expect(fakeActor.GetSecurityGroupRulesChangesByNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the security group fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesChangesByNameReturns(
+				v2action.SecurityGroupRulesChanges{},
+				v2action.Warnings{"get-warning"},
+				actionerror.SecurityGroupNotFoundError{Name: "some-security-group"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.SecurityGroupNotFoundError{Name: "some-security-group"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the rules are unchanged", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesChangesByNameReturns(
+				v2action.SecurityGroupRulesChanges{
+					SecurityGroup: v2action.SecurityGroup{GUID: "some-security-group-guid", Rules: rules},
+				},
+				nil,
+				nil)
+		})
+
+		It("does not update the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("The rules of security group some-security-group are already up to date."))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the rules change", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesChangesByNameReturns(
+				v2action.SecurityGroupRulesChanges{
+					SecurityGroup: v2action.SecurityGroup{
+						GUID: "some-security-group-guid",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "443", Log: true},
+							{Protocol: "all", Destination: "0.0.0.0/0"},
+						},
+					},
+					Added:   rules[1:],
+					Removed: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+				},
+				v2action.Warnings{"get-warning"},
+				nil)
+			fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"update-warning"}, nil)
+		})
+
+		It("displays the added and removed rules", func() {
+			Expect(testUI.Out).To(Say("Getting rules of security group some-security-group as some-user..."))
+			Expect(testUI.Out).To(Say(`rules:`))
+			Expect(testUI.Out).To(Say(`-\s+all 0\.0\.0\.0/0`))
+			Expect(testUI.Out).To(Say(`\+\s+icmp 10\.0\.0\.1 type 0 code -1 "ping"`))
+			Expect(testUI.Out).To(Say(`\s+tcp 10\.0\.0\.0/8:443 log`))
+			Expect(testUI.Out).To(Say(`1 rule\(s\) to add, 1 rule\(s\) to remove\.`))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("updates the rules without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Really update"))
+				Expect(testUI.Out).To(Say("Updating security group some-security-group as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Changes will not apply to existing running applications until they are restarted."))
+				Expect(testUI.Err).To(Say("update-warning"))
+
+				Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+				guid, updatedRules := fakeActor.UpdateSecurityGroupRulesArgsForCall(0)
+				Expect(guid).To(Equal("some-security-group-guid"))
+				Expect(updatedRules).To(Equal(rules))
+			})
+
+			Context("when updating the rules fails", func() {
+				BeforeEach(func() {
+					fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"update-warning"}, errors.New("update-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("update-error"))
+					Expect(testUI.Err).To(Say("update-warning"))
+				})
+			})
+		})
+
+		Context("when the -f flag is not provided", func() {
+			Context("when the user inputs yes", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("updates the rules", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Really update the rules of security group some-security-group\?`))
+					Expect(testUI.Out).To(Say("Updating security group some-security-group as some-user..."))
+					Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user chooses the default", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not update the rules", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Update cancelled"))
+					Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
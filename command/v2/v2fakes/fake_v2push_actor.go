@@ -96,6 +96,19 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	PlanApplicationStub        func(config pushaction.ApplicationConfig) (pushaction.Plan, pushaction.Warnings)
+	planApplicationMutex       sync.RWMutex
+	planApplicationArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	planApplicationReturns struct {
+		result1 pushaction.Plan
+		result2 pushaction.Warnings
+	}
+	planApplicationReturnsOnCall map[int]struct {
+		result1 pushaction.Plan
+		result2 pushaction.Warnings
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -423,6 +436,57 @@ func (fake *FakeV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) PlanApplication(config pushaction.ApplicationConfig) (pushaction.Plan, pushaction.Warnings) {
+	fake.planApplicationMutex.Lock()
+	ret, specificReturn := fake.planApplicationReturnsOnCall[len(fake.planApplicationArgsForCall)]
+	fake.planApplicationArgsForCall = append(fake.planApplicationArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("PlanApplication", []interface{}{config})
+	fake.planApplicationMutex.Unlock()
+	if fake.PlanApplicationStub != nil {
+		return fake.PlanApplicationStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.planApplicationReturns.result1, fake.planApplicationReturns.result2
+}
+
+func (fake *FakeV2PushActor) PlanApplicationCallCount() int {
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
+	return len(fake.planApplicationArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanApplicationArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
+	return fake.planApplicationArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PlanApplicationReturns(result1 pushaction.Plan, result2 pushaction.Warnings) {
+	fake.PlanApplicationStub = nil
+	fake.planApplicationReturns = struct {
+		result1 pushaction.Plan
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) PlanApplicationReturnsOnCall(i int, result1 pushaction.Plan, result2 pushaction.Warnings) {
+	fake.PlanApplicationStub = nil
+	if fake.planApplicationReturnsOnCall == nil {
+		fake.planApplicationReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Plan
+			result2 pushaction.Warnings
+		})
+	}
+	fake.planApplicationReturnsOnCall[i] = struct {
+		result1 pushaction.Plan
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -438,6 +502,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -16,16 +16,19 @@ import (
 //go:generate counterfeiter . AddNetworkPolicyActor
 
 type AddNetworkPolicyActor interface {
-	AddNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
 }
 
 type AddNetworkPolicyCommand struct {
-	RequiredArgs   flag.AddNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
-	Protocol       flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
-
-	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+	RequiredArgs     flag.AddNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
+	DestinationSpace string                    `long:"destination-space" description:"Space of the destination app (Default: targeted space)"`
+	DestinationOrg   string                    `long:"destination-org" description:"Org of the destination app, used with --destination-space (Default: targeted org)"`
+	Port             flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
+	Protocol         flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+
+	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space DESTINATION_SPACE [--destination-org DESTINATION_ORG]] [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME add-network-policy frontend --destination-app backend --destination-space backend-space --destination-org backend-org"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
 	UI          command.UI
@@ -59,6 +62,10 @@ func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI)
 }
 
 func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}
+	}
+
 	switch {
 	case cmd.Protocol.Protocol != "" && cmd.Port.StartPort == 0 && cmd.Port.EndPort == 0:
 		return translatableerror.NetworkPolicyProtocolOrPortNotProvidedError{}
@@ -79,14 +86,32 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-		"SrcAppName": cmd.RequiredArgs.SourceApp,
-		"Org":        cmd.Config.TargetedOrganization().Name,
-		"Space":      cmd.Config.TargetedSpace().Name,
-		"User":       user.Name,
-	})
-
-	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+
+	if cmd.DestinationSpace == "" {
+		cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"User":       user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DestAppName}} in org {{.DestOrg}} / space {{.DestSpace}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName":  cmd.RequiredArgs.SourceApp,
+			"Org":         cmd.Config.TargetedOrganization().Name,
+			"Space":       cmd.Config.TargetedSpace().Name,
+			"DestAppName": cmd.DestinationApp,
+			"DestOrg":     destinationOrgName(cmd.Config, cmd.DestinationOrg),
+			"DestSpace":   cmd.DestinationSpace,
+			"User":        user.Name,
+		})
+	}
+
+	destSpaceGUID, err := destinationSpaceGUID(cmd.Actor, cmd.Config, cmd.UI, cmd.DestinationSpace, cmd.DestinationOrg)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -95,3 +120,29 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 
 	return nil
 }
+
+type destinationSpaceActor interface {
+	GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
+}
+
+// destinationSpaceGUID returns the GUID of the space given by
+// --destination-space and --destination-org, or of the targeted space if no
+// destination space is given.
+func destinationSpaceGUID(actor destinationSpaceActor, config command.Config, ui command.UI, spaceName string, orgName string) (string, error) {
+	if spaceName == "" {
+		return config.TargetedSpace().GUID, nil
+	}
+
+	spaceGUID, warnings, err := actor.GetSpaceGUIDByNameAndOrganizationName(spaceName, destinationOrgName(config, orgName))
+	ui.DisplayWarnings(warnings)
+	return spaceGUID, err
+}
+
+// destinationOrgName returns the org given by --destination-org, or the
+// targeted org if none is given.
+func destinationOrgName(config command.Config, orgName string) string {
+	if orgName == "" {
+		return config.TargetedOrganization().Name
+	}
+	return orgName
+}
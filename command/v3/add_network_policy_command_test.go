@@ -114,9 +114,10 @@ var _ = Describe("add-network-policy Command", func() {
 				It("displays OK when no error occurs", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-					passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+					passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 					Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedSrcAppName).To(Equal("some-app"))
+					Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedDestAppName).To(Equal("some-other-app"))
 					Expect(passedProtocol).To(Equal("tcp"))
 					Expect(passedStartPort).To(Equal(8080))
@@ -145,12 +146,73 @@ var _ = Describe("add-network-policy Command", func() {
 			})
 		})
 
+		Context("when a destination space is specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("other-space-guid", cfnetworkingaction.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("adds a policy to the app in that space of the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetSpaceGUIDByNameAndOrganizationNameCallCount()).To(Equal(1))
+				spaceName, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgName).To(Equal("some-org"))
+
+				passedSpaceGuid, _, passedDestSpaceGuid, passedDestAppName, _, _, _ := fakeActor.AddNetworkPolicyArgsForCall(0)
+				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+				Expect(passedDestAppName).To(Equal("some-other-app"))
+
+				Expect(testUI.Out).To(Say(`Adding network policy from app %s in org some-org / space some-space to app some-other-app in org some-org / space other-space as some-user\.\.\.`, srcApp))
+				Expect(testUI.Err).To(Say("get-space-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+
+			Context("when a destination org is specified", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "other-org"
+				})
+
+				It("looks up the space in that org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+					Expect(orgName).To(Equal("other-org"))
+					Expect(testUI.Out).To(Say(`to app some-other-app in org other-org / space other-space`))
+				})
+			})
+
+			Context("when the destination space cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("", cfnetworkingaction.Warnings{"get-space-warning"}, actionerror.SpaceNotFoundError{Name: "other-space"})
+				})
+
+				It("returns the error without adding a policy", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "other-space"}))
+					Expect(testUI.Err).To(Say("get-space-warning"))
+					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}))
+				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when both protocol and port are not specified", func() {
 			It("defaults protocol to 'tcp' and port to '8080'", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-				_, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+				_, _, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
 				Expect(passedEndPort).To(Equal(8080))
@@ -0,0 +1,156 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	PlanNetworkPoliciesBySpace(spaceGUID string, path string) (cfnetworkingaction.PolicyPlan, cfnetworkingaction.Warnings, error)
+	ApplyNetworkPolicyPlan(spaceGUID string, plan cfnetworkingaction.PolicyPlan) (cfnetworkingaction.Warnings, error)
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	RequiredArgs    flag.ApplyNetworkPoliciesArgs `positional-args:"yes"`
+	DryRun          bool                          `long:"dry-run" description:"Display the policies that would be added and removed without changing them"`
+	Force           bool                          `short:"f" description:"Force removal of policies without confirmation"`
+	usage           interface{}                   `usage:"CF_NAME apply-network-policies PATH [--dry-run] [-f]\n\n   Policies between apps in the target space that are not in the file are removed, after the policies to add and remove are displayed and confirmed. Policies to or from apps in other spaces are not changed.\n\nFILE FORMAT:\n   policies:\n   - source: frontend\n     destination: backend\n     protocol: tcp\n     ports: 8080-8090"`
+	relatedCommands interface{}                   `related_commands:"add-network-policy, export-network-policies, network-policies, remove-network-policy"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyNetworkPoliciesActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, uaa, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.CFNetworkingEndpointNotFoundError{}
+		}
+
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} to org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Path":  cmd.RequiredArgs.Path,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	plan, warnings, err := cmd.Actor.PlanNetworkPoliciesBySpace(spaceGUID, string(cmd.RequiredArgs.Path))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if plan.HasChanges() {
+		cmd.displayPlan(plan)
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("Plan: {{.AddCount}} policies to add, {{.RemoveCount}} policies to remove.", map[string]interface{}{
+		"AddCount":    len(plan.Additions),
+		"RemoveCount": len(plan.Removals),
+	})
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run: no changes have been made.")
+		return nil
+	}
+
+	if len(plan.Removals) > 0 && !cmd.Force {
+		applyPlan, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really remove {{.RemoveCount}} network policies?", map[string]interface{}{
+			"RemoveCount": len(plan.Removals),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !applyPlan {
+			cmd.UI.DisplayText("Apply cancelled")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.ApplyNetworkPolicyPlan(spaceGUID, plan)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) displayPlan(plan cfnetworkingaction.PolicyPlan) {
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+		},
+	}
+
+	for _, policy := range plan.Additions {
+		table = append(table, policyPlanRow("+", policy))
+	}
+	for _, policy := range plan.Removals {
+		table = append(table, policyPlanRow("-", policy))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func policyPlanRow(change string, policy cfnetworkingaction.Policy) []string {
+	ports := strconv.Itoa(policy.StartPort)
+	if policy.StartPort != policy.EndPort {
+		ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+	}
+
+	return []string{change, policy.SourceName, policy.DestinationName, policy.Protocol, ports}
+}
@@ -0,0 +1,212 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd             ApplyNetworkPoliciesCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyNetworkPoliciesActor
+		binaryName      string
+		executeErr      error
+		plan            cfnetworkingaction.PolicyPlan
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyNetworkPoliciesActor)
+
+		cmd = ApplyNetworkPoliciesCommand{
+			RequiredArgs: flag.ApplyNetworkPoliciesArgs{Path: "some-policies.yml"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+			plan = cfnetworkingaction.PolicyPlan{
+				Additions: []cfnetworkingaction.Policy{
+					{SourceName: "app1", DestinationName: "app2", Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+				},
+				Removals: []cfnetworkingaction.Policy{
+					{SourceName: "app2", DestinationName: "app1", Protocol: "udp", StartPort: 53, EndPort: 53},
+				},
+			}
+			fakeActor.PlanNetworkPoliciesBySpaceReturns(plan, cfnetworkingaction.Warnings{"plan-warning"}, nil)
+			fakeActor.ApplyNetworkPolicyPlanReturns(cfnetworkingaction.Warnings{"apply-warning"}, nil)
+		})
+
+		Context("when the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("displays the plan and applies it without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PlanNetworkPoliciesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, path := fakeActor.PlanNetworkPoliciesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(path).To(Equal("some-policies.yml"))
+
+				Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(1))
+				spaceGUID, appliedPlan := fakeActor.ApplyNetworkPolicyPlanArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(appliedPlan).To(Equal(plan))
+
+				Expect(testUI.Out).To(Say(`Applying network policies from some-policies\.yml to org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`source\s+destination\s+protocol\s+ports`))
+				Expect(testUI.Out).To(Say(`\+\s+app1\s+app2\s+tcp\s+8080-8090`))
+				Expect(testUI.Out).To(Say(`-\s+app2\s+app1\s+udp\s+53`))
+				Expect(testUI.Out).To(Say(`Plan: 1 policies to add, 1 policies to remove\.`))
+				Expect(testUI.Out).ToNot(Say("Really remove"))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("plan-warning"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+
+			Context("when applying fails", func() {
+				BeforeEach(func() {
+					fakeActor.ApplyNetworkPolicyPlanReturns(cfnetworkingaction.Warnings{"apply-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(testUI.Err).To(Say("apply-warning"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+
+		Context("when the -f flag is not provided", func() {
+			Context("when the user inputs yes", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("displays the removals before applying the plan", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`-\s+app2\s+app1\s+udp\s+53`))
+					Expect(testUI.Out).To(Say(`Really remove 1 network policies\?`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user chooses the default", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not apply the plan", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Apply cancelled"))
+					Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the plan does not remove any policies", func() {
+				BeforeEach(func() {
+					plan.Removals = nil
+					fakeActor.PlanNetworkPoliciesBySpaceReturns(plan, nil, nil)
+				})
+
+				It("applies the plan without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Really remove"))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the plan without applying it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plan: 1 policies to add, 1 policies to remove\.`))
+				Expect(testUI.Out).To(Say("Dry run: no changes have been made."))
+				Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plan has no changes", func() {
+			BeforeEach(func() {
+				fakeActor.PlanNetworkPoliciesBySpaceReturns(cfnetworkingaction.PolicyPlan{}, nil, nil)
+			})
+
+			It("does not display a table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("source"))
+				Expect(testUI.Out).To(Say(`Plan: 0 policies to add, 0 policies to remove\.`))
+			})
+		})
+
+		Context("when planning fails", func() {
+			BeforeEach(func() {
+				fakeActor.PlanNetworkPoliciesBySpaceReturns(cfnetworkingaction.PolicyPlan{}, cfnetworkingaction.Warnings{"plan-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error without applying anything", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("plan-warning"))
+				Expect(fakeActor.ApplyNetworkPolicyPlanCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ExportNetworkPoliciesActor
+
+type ExportNetworkPoliciesActor interface {
+	ExportNetworkPoliciesBySpace(spaceGUID string, path string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+type ExportNetworkPoliciesCommand struct {
+	FilePath        flag.Path   `short:"p" description:"Specify a path for file creation. If path not specified, the file is created in current working directory."`
+	usage           interface{} `usage:"CF_NAME export-network-policies [-p /path/to/<space-name>_network_policies.yml]"`
+	relatedCommands interface{} `related_commands:"apply-network-policies, network-policies"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportNetworkPoliciesActor
+}
+
+func (cmd *ExportNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, uaa, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.CFNetworkingEndpointNotFoundError{}
+		}
+
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	return nil
+}
+
+func (cmd ExportNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+
+	filePath := cmd.FilePath.String()
+	if filePath == "" {
+		filePath = fmt.Sprintf(".%s%s_network_policies.yml", string(os.PathSeparator), cmd.Config.TargetedSpace().Name)
+	}
+
+	policies, warnings, err := cmd.Actor.ExportNetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID, filePath)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Wrote {{.Count}} network policies to {{.FilePath}}", map[string]interface{}{
+		"Count":    len(policies),
+		"FilePath": filePath,
+	})
+
+	return nil
+}
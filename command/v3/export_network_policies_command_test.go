@@ -0,0 +1,117 @@
+package v3_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-network-policies Command", func() {
+	var (
+		cmd             ExportNetworkPoliciesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeExportNetworkPoliciesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeExportNetworkPoliciesActor)
+
+		cmd = ExportNetworkPoliciesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+			fakeActor.ExportNetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{SourceName: "app1", DestinationName: "app2", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			}, cfnetworkingaction.Warnings{"some-warning"}, nil)
+		})
+
+		It("writes the policies to a file named after the space in the current directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ExportNetworkPoliciesBySpaceCallCount()).To(Equal(1))
+			spaceGUID, path := fakeActor.ExportNetworkPoliciesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(path).To(Equal(fmt.Sprintf(".%ssome-space_network_policies.yml", string(os.PathSeparator))))
+
+			Expect(testUI.Out).To(Say(`Exporting network policies in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Wrote 1 network policies to \.\Wsome-space_network_policies\.yml`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		Context("when a path is provided", func() {
+			BeforeEach(func() {
+				cmd.FilePath = flag.Path("some-dir/policies.yml")
+			})
+
+			It("writes the policies to that path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, path := fakeActor.ExportNetworkPoliciesBySpaceArgsForCall(0)
+				Expect(path).To(Equal("some-dir/policies.yml"))
+			})
+		})
+
+		Context("when exporting the policies fails", func() {
+			BeforeEach(func() {
+				fakeActor.ExportNetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -50,6 +51,10 @@ func (cmd *IsolationSegmentsCommand) Setup(config command.Config, ui command.UI)
 	return nil
 }
 
+func (IsolationSegmentsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
 	if err != nil {
@@ -75,6 +80,11 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(output.IsolationSegmentsKind, output.NewIsolationSegments(summaries))
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
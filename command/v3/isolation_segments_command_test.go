@@ -121,6 +121,22 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatYAML
+					})
+
+					It("displays the isolation segment summaries as a yaml document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting isolation segments"))
+						Expect(testUI.Out).To(Say("kind: isolation-segments"))
+						Expect(testUI.Out).To(Say(`- name: some-iso-1\n\s+orgs: \[\]`))
+						Expect(testUI.Out).To(Say(`- name: some-iso-3\n\s+orgs:\n\s+- some-org-1\n\s+- some-org-2`))
+						Expect(testUI.Err).To(Say("- warning-1"))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
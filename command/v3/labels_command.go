@@ -0,0 +1,125 @@
+package v3
+
+import (
+	"net/http"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . LabelsActor
+
+type LabelsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v3action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v3action.Warnings, error)
+	GetServiceInstanceLabels(serviceInstanceName string, spaceGUID string) (map[string]types.NullString, v3action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v3action.Warnings, error)
+}
+
+type LabelsCommand struct {
+	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nRESOURCES:\n   app\n   org\n   service-instance\n   space\n\nEXAMPLES:\n   cf labels app dora"`
+	relatedCommands interface{}     `related_commands:"set-label, unset-label, v3-apps"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LabelsActor
+}
+
+func (cmd *LabelsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd LabelsCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	resourceName := cmd.RequiredArgs.ResourceName
+
+	err = checkLabelTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	displayLabelFlavorText(cmd.UI, cmd.Config, "Getting labels for", resourceType, resourceName, user.Name)
+	cmd.UI.DisplayNewline()
+
+	var (
+		labels   map[string]types.NullString
+		warnings v3action.Warnings
+	)
+	switch resourceType {
+	case "app":
+		labels, warnings, err = cmd.Actor.GetApplicationLabels(resourceName, cmd.Config.TargetedSpace().GUID)
+	case "org":
+		labels, warnings, err = cmd.Actor.GetOrganizationLabels(resourceName)
+	case "service-instance":
+		labels, warnings, err = cmd.Actor.GetServiceInstanceLabels(resourceName, cmd.Config.TargetedSpace().GUID)
+	case "space":
+		labels, warnings, err = cmd.Actor.GetSpaceLabels(resourceName, cmd.Config.TargetedOrganization().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(labels) == 0 {
+		cmd.UI.DisplayText("No labels found.")
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
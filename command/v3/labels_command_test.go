@@ -0,0 +1,158 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("labels Command", func() {
+	var (
+		cmd             v3.LabelsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeLabelsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeLabelsActor)
+
+		cmd = v3.LabelsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "app"}
+		cmd.RequiredArgs.ResourceName = "some-app"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+
+		It("displays the experimental warning", func() {
+			Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the app has labels", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationLabelsReturns(
+				map[string]types.NullString{
+					"team": types.NewNullString("payments"),
+					"env":  types.NewNullString("prod"),
+				},
+				v3action.Warnings{"get-warning"},
+				nil,
+			)
+		})
+
+		It("displays the labels sorted by key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting labels for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Out).To(Say("key\\s+value"))
+			Expect(testUI.Out).To(Say("env\\s+prod"))
+			Expect(testUI.Out).To(Say("team\\s+payments"))
+
+			Expect(fakeActor.GetApplicationLabelsCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationLabelsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the app has no labels", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationLabelsReturns(nil, nil, nil)
+		})
+
+		It("says that no labels were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No labels found\\."))
+		})
+	})
+
+	Context("when getting the labels fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationLabelsReturns(nil, v3action.Warnings{"get-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	Context("when getting the labels of a space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "space"}
+			cmd.RequiredArgs.ResourceName = "some-other-space"
+			fakeActor.GetSpaceLabelsReturns(map[string]types.NullString{"env": types.NewNullString("prod")}, nil, nil)
+		})
+
+		It("gets the labels of the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Getting labels for space some-other-space in org some-org as banana\\.\\.\\."))
+			Expect(testUI.Out).To(Say("env\\s+prod"))
+
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -58,6 +59,10 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 	return nil
 }
 
+func (NetworkPoliciesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -94,6 +99,10 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(output.NetworkPoliciesKind, output.NewNetworkPolicies(policies))
+	}
+
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
@@ -102,6 +111,8 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("destination"),
 			cmd.UI.TranslateText("protocol"),
 			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
 		},
 	}
 
@@ -117,6 +128,8 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 			policy.DestinationName,
 			policy.Protocol,
 			portEntry,
+			policy.DestinationSpaceName,
+			policy.DestinationOrgName,
 		})
 	}
 
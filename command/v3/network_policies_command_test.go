@@ -102,6 +102,14 @@ var _ = Describe("network-policies Command", func() {
 						Protocol:        "udp",
 						StartPort:       1234,
 						EndPort:         2345,
+					}, {
+						SourceName:           "app1",
+						DestinationName:      "app3",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+						DestinationSpaceName: "other-space",
+						DestinationOrgName:   "other-org",
 					},
 				}, cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, nil)
 			})
@@ -114,14 +122,31 @@ var _ = Describe("network-policies Command", func() {
 
 				Expect(testUI.Out).To(Say(`Listing network policies in org some-org / space some-space as some-user\.\.\.`))
 				Expect(testUI.Out).To(Say("\n\n"))
-				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports"))
+				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
 				Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080[^-]"))
 				Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345"))
+				Expect(testUI.Out).To(Say("app1\\s+app3\\s+tcp\\s+8080\\s+other-space\\s+other-org"))
 
 				Expect(testUI.Err).To(Say("some-warning-1"))
 				Expect(testUI.Err).To(Say("some-warning-2"))
 			})
 
+			Context("when structured output is requested", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays the policies as a json document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Listing network policies"))
+					Expect(testUI.Out).To(Say(`"kind": "network-policies"`))
+					Expect(testUI.Out).To(Say(`"source": "app1",\s+"destination": "app2",\s+"protocol": "tcp",\s+"start_port": 8080,\s+"end_port": 8080`))
+					Expect(testUI.Out).To(Say(`"source": "app2",\s+"destination": "app1",\s+"protocol": "udp",\s+"start_port": 1234,\s+"end_port": 2345`))
+					Expect(testUI.Err).To(Say(`"some-warning-1"`))
+				})
+			})
+
 			Context("when a source app name is passed", func() {
 				BeforeEach(func() {
 					cmd.SourceApp = "some-app"
@@ -17,16 +17,19 @@ import (
 //go:generate counterfeiter . RemoveNetworkPolicyActor
 
 type RemoveNetworkPolicyActor interface {
-	RemoveNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
 }
 
 type RemoveNetworkPolicyCommand struct {
-	RequiredArgs   flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
-	Protocol       flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
-
-	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+	RequiredArgs     flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
+	DestinationSpace string                       `long:"destination-space" description:"Space of the destination app (Default: targeted space)"`
+	DestinationOrg   string                       `long:"destination-org" description:"Org of the destination app, used with --destination-space (Default: targeted org)"`
+	Port             flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
+	Protocol         flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
+
+	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space DESTINATION_SPACE [--destination-org DESTINATION_ORG]] --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME remove-network-policy frontend --destination-app backend --destination-space backend-space --destination-org backend-org --protocol tcp --port 8080"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
 	UI          command.UI
@@ -60,6 +63,10 @@ func (cmd *RemoveNetworkPolicyCommand) Setup(config command.Config, ui command.U
 }
 
 func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -69,14 +76,32 @@ func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	cmd.UI.DisplayTextWithFlavor("Removing network policy for app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-		"SrcAppName": cmd.RequiredArgs.SourceApp,
-		"Org":        cmd.Config.TargetedOrganization().Name,
-		"Space":      cmd.Config.TargetedSpace().Name,
-		"User":       user.Name,
-	})
-
-	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+
+	if cmd.DestinationSpace == "" {
+		cmd.UI.DisplayTextWithFlavor("Removing network policy for app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"User":       user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Removing network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DestAppName}} in org {{.DestOrg}} / space {{.DestSpace}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName":  cmd.RequiredArgs.SourceApp,
+			"Org":         cmd.Config.TargetedOrganization().Name,
+			"Space":       cmd.Config.TargetedSpace().Name,
+			"DestAppName": cmd.DestinationApp,
+			"DestOrg":     destinationOrgName(cmd.Config, cmd.DestinationOrg),
+			"DestSpace":   cmd.DestinationSpace,
+			"User":        user.Name,
+		})
+	}
+
+	destSpaceGUID, err := destinationSpaceGUID(cmd.Actor, cmd.Config, cmd.UI, cmd.DestinationSpace, cmd.DestinationOrg)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -5,6 +5,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -83,6 +84,39 @@ var _ = Describe("remove-network-policy Command", func() {
 			Expect(testUI.Out).To(Say(`Removing network policy for app %s in org some-org / space some-space as some-user\.\.\.`, srcApp))
 		})
 
+		Context("when a destination space and org are specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				cmd.DestinationOrg = "other-org"
+				fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("other-space-guid", cfnetworkingaction.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("removes the policy to the app in that space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				spaceName, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgName).To(Equal("other-org"))
+
+				_, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+
+				Expect(testUI.Out).To(Say(`Removing network policy from app %s in org some-org / space some-space to app some-other-app in org other-org / space other-space as some-user\.\.\.`, srcApp))
+				Expect(testUI.Err).To(Say("get-space-warning"))
+			})
+		})
+
+		Context("when a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}))
+				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the policy deletion is successful", func() {
 			BeforeEach(func() {
 				fakeActor.RemoveNetworkPolicyReturns(cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, nil)
@@ -91,9 +125,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -114,9 +149,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -3,6 +3,7 @@ package v3
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -14,10 +15,16 @@ import (
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+// taskLogFlushTimeout is how long logs are displayed for after the task has
+// finished.
+const taskLogFlushTimeout = 2 * time.Second
+
 //go:generate counterfeiter . RunTaskActor
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	PollTask(task v3action.Task, timeout time.Duration) (v3action.Task, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
@@ -27,11 +34,14 @@ type RunTaskCommand struct {
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Timeout         flag.Duration    `long:"timeout" description:"With --wait, cancel the task if it has not finished within a duration such as 30s, 5m or 2h"`
+	Wait            bool             `long:"wait" description:"Wait for the task to finish, displaying its logs, and exit with a non-zero status if it fails"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait [--timeout DURATION]]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\n   With --wait, the exit status is 3 if the task fails and 4 if it is cancelled after the timeout.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait --timeout 30m"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
+	NOAAClient  v3action.NOAAClient
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
 }
@@ -41,7 +51,7 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor(config)
 
-	client, _, err := shared.NewClients(config, ui, true)
+	client, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
 		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
 			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRunTaskV3}
@@ -50,6 +60,7 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+	cmd.NOAAClient = shared.NewNOAAClient(client.Info.Logging(), config, uaaClient, ui)
 
 	return nil
 }
@@ -60,6 +71,13 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Timeout.Duration != 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--timeout",
+			Arg2: "--wait",
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -99,9 +117,20 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		inputTask.MemoryInMB = cmd.Memory.Value
 	}
 
+	// Start streaming logs before the task is created so that none of its
+	// output is missed.
+	var logStream <-chan *v3action.LogMessage
+	var logErrStream <-chan error
+	if cmd.Wait {
+		logStream, logErrStream = cmd.Actor.GetStreamingLogs(application.GUID, cmd.NOAAClient)
+	}
+
 	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		if cmd.Wait {
+			cmd.stopLogStream(logStream, logErrStream)
+		}
 		return err
 	}
 
@@ -113,5 +142,94 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(task, logStream, logErrStream)
+}
+
+// waitForTask displays the logs of the task until it has finished.
+func (cmd RunTaskCommand) waitForTask(task v3action.Task, logStream <-chan *v3action.LogMessage, logErrStream <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to finish...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	var (
+		finishedTask v3action.Task
+		warnings     v3action.Warnings
+		pollErr      error
+	)
+	done := make(chan struct{})
+	go func() {
+		finishedTask, warnings, pollErr = cmd.Actor.PollTask(task, cmd.Timeout.Duration)
+		close(done)
+	}()
+
+	// Logs can arrive shortly after the task has finished, so keep displaying
+	// them for a little while afterwards. Diego runs a task as a single
+	// instance, so its logs always come from source instance 0.
+	filter := v3action.LogFilter{
+		SourceTypes:    []string{"APP/TASK/" + task.Name},
+		SourceInstance: "0",
+	}
+	var flushed <-chan time.Time
+	for done != nil || (flushed != nil && logStream != nil) {
+		select {
+		case message, ok := <-logStream:
+			if !ok {
+				logStream = nil
+				break
+			}
+			if filter.Matches(*message) {
+				cmd.UI.DisplayLogMessage(message, true)
+			}
+		case logErr, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+				break
+			}
+			cmd.UI.DisplayWarning(logErr.Error())
+		case <-done:
+			done = nil
+			flushed = time.After(taskLogFlushTimeout)
+		case <-flushed:
+			flushed = nil
+		}
+	}
+	cmd.stopLogStream(logStream, logErrStream)
+
+	cmd.UI.DisplayWarnings(warnings)
+	if pollErr != nil {
+		return pollErr
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Task {{.TaskName}} succeeded.", map[string]interface{}{
+		"TaskName": finishedTask.Name,
+	})
+
 	return nil
 }
+
+// stopLogStream closes the NOAA client and discards the rest of the logs
+// until the streams have been closed, so that nothing is left sending to
+// them.
+func (cmd RunTaskCommand) stopLogStream(logStream <-chan *v3action.LogMessage, logErrStream <-chan error) {
+	cmd.NOAAClient.Close()
+
+	for logStream != nil || logErrStream != nil {
+		select {
+		case _, ok := <-logStream:
+			if !ok {
+				logStream = nil
+			}
+		case _, ok := <-logErrStream:
+			if !ok {
+				logErrStream = nil
+			}
+		}
+	}
+}
@@ -2,9 +2,11 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -209,6 +211,154 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Err).To(Say("get-application-warning-3"))
 					})
 				})
+
+				Context("when --wait is provided", func() {
+					var (
+						fakeNOAAClient *v3actionfakes.FakeNOAAClient
+						logStream      chan *v3action.LogMessage
+						logErrStream   chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+						cmd.Timeout = flag.Duration{Duration: 5 * time.Minute}
+						fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+
+						logStream = make(chan *v3action.LogMessage, 3)
+						logErrStream = make(chan error)
+						logStream <- v3action.NewLogMessage("some-task-log", 1, time.Now(), "APP/TASK/some-task-name", "0")
+						logStream <- v3action.NewLogMessage("some-app-log", 1, time.Now(), "APP/PROC/WEB", "0")
+						logStream <- v3action.NewLogMessage("other-instance-log", 1, time.Now(), "APP/TASK/some-task-name", "1")
+						close(logStream)
+						close(logErrStream)
+						fakeActor.GetStreamingLogsReturns(logStream, logErrStream)
+
+						fakeActor.RunTaskReturns(
+							v3action.Task{GUID: "some-task-guid", Name: "some-task-name", SequenceID: 3},
+							v3action.Warnings{"run-task-warning"},
+							nil)
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{GUID: "some-task-guid", Name: "some-task-name", SequenceID: 3, State: "SUCCEEDED"},
+								v3action.Warnings{"poll-task-warning"},
+								nil)
+						})
+
+						It("displays the logs of the task until it has finished", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+							appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+							task, timeout := fakeActor.PollTaskArgsForCall(0)
+							Expect(task).To(Equal(v3action.Task{GUID: "some-task-guid", Name: "some-task-name", SequenceID: 3}))
+							Expect(timeout).To(Equal(5 * time.Minute))
+
+							Expect(testUI.Out).To(Say("task name:\\s+some-task-name"))
+							Expect(testUI.Out).To(Say(`Waiting for task some-task-name to finish\.\.\.`))
+							Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT some-task-log`))
+							Expect(testUI.Out).ToNot(Say("some-app-log"))
+							Expect(testUI.Out).ToNot(Say("other-instance-log"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+
+							Expect(testUI.Err).To(Say("run-task-warning"))
+							Expect(testUI.Err).To(Say("poll-task-warning"))
+
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when logs are still streaming after the task has finished", func() {
+						var streamEnded chan bool
+
+						BeforeEach(func() {
+							logStream = make(chan *v3action.LogMessage)
+							logErrStream = make(chan error)
+							fakeActor.GetStreamingLogsReturns(logStream, logErrStream)
+
+							stopped := make(chan bool)
+							streamEnded = make(chan bool)
+							go func(logStream chan *v3action.LogMessage, logErrStream chan error, streamEnded chan bool) {
+								defer close(streamEnded)
+								defer close(logStream)
+								defer close(logErrStream)
+								for {
+									select {
+									case logStream <- v3action.NewLogMessage("some-task-log", 1, time.Now(), "APP/TASK/some-task-name", "0"):
+										time.Sleep(100 * time.Millisecond)
+									case <-stopped:
+										// Like the streaming actor, send the logs received
+										// before the client was closed.
+										logStream <- v3action.NewLogMessage("last-task-log", 1, time.Now(), "APP/TASK/some-task-name", "0")
+										return
+									}
+								}
+							}(logStream, logErrStream, streamEnded)
+							fakeNOAAClient.CloseStub = func() error {
+								close(stopped)
+								return nil
+							}
+
+							fakeActor.PollTaskReturns(
+								v3action.Task{GUID: "some-task-guid", Name: "some-task-name", SequenceID: 3, State: "SUCCEEDED"},
+								nil,
+								nil)
+						})
+
+						It("stops the log stream after displaying the logs for a little while", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("some-task-log"))
+							Expect(testUI.Out).To(Say("Task some-task-name succeeded."))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							Eventually(streamEnded).Should(BeClosed())
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{},
+								v3action.Warnings{"poll-task-warning"},
+								actionerror.TaskFailedError{Name: "some-task-name", FailureReason: "Exited with status 1"})
+						})
+
+						It("returns the error and all warnings", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Name: "some-task-name", FailureReason: "Exited with status 1"}))
+							Expect(testUI.Out).ToNot(Say("succeeded"))
+							Expect(testUI.Err).To(Say("poll-task-warning"))
+						})
+					})
+
+					Context("when running the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.RunTaskReturns(v3action.Task{}, nil, errors.New("run-task-error"))
+						})
+
+						It("stops streaming logs and returns the error", func() {
+							Expect(executeErr).To(MatchError("run-task-error"))
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(0))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+				})
+
+				Context("when --timeout is provided without --wait", func() {
+					BeforeEach(func() {
+						cmd.Timeout = flag.Duration{Duration: time.Minute}
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}))
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			Context("when there are errors", func() {
@@ -0,0 +1,139 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . SetLabelActor
+
+type SetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateServiceInstanceLabelsByServiceInstanceName(serviceInstanceName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type SetLabelCommand struct {
+	RequiredArgs    flag.SetLabelArgs `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...\n\nRESOURCES:\n   app\n   org\n   service-instance\n   space\n\nEXAMPLES:\n   cf set-label app dora team=payments env=prod"`
+	relatedCommands interface{}       `related_commands:"labels, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetLabelActor
+}
+
+func (cmd *SetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd SetLabelCommand) Execute(args []string) error {
+	labels := map[string]types.NullString{}
+	for _, label := range cmd.RequiredArgs.Labels {
+		labels[label.Key] = types.NewNullString(label.Value)
+	}
+
+	return updateLabels(cmd.UI, cmd.Config, cmd.SharedActor, cmd.Actor, "Setting label(s) for", cmd.RequiredArgs.ResourceType.Type, cmd.RequiredArgs.ResourceName, labels)
+}
+
+// updateLabels displays which resource is being labeled, prefixed by action,
+// and then updates the labels of the resource.
+func updateLabels(ui command.UI, config command.Config, sharedActor command.SharedActor, actor SetLabelActor, action string, resourceType string, resourceName string, labels map[string]types.NullString) error {
+	ui.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	err = checkLabelTarget(sharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	displayLabelFlavorText(ui, config, action, resourceType, resourceName, user.Name)
+
+	var warnings v3action.Warnings
+	switch resourceType {
+	case "app":
+		warnings, err = actor.UpdateApplicationLabelsByApplicationName(resourceName, config.TargetedSpace().GUID, labels)
+	case "org":
+		warnings, err = actor.UpdateOrganizationLabelsByOrganizationName(resourceName, labels)
+	case "service-instance":
+		warnings, err = actor.UpdateServiceInstanceLabelsByServiceInstanceName(resourceName, config.TargetedSpace().GUID, labels)
+	case "space":
+		warnings, err = actor.UpdateSpaceLabelsBySpaceName(resourceName, config.TargetedOrganization().GUID, labels)
+	}
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayOK()
+	return nil
+}
+
+// checkLabelTarget checks the target needed to find a resource of the given
+// type: orgs need no target, spaces need a targeted org and everything else
+// needs a targeted space.
+func checkLabelTarget(sharedActor command.SharedActor, resourceType string) error {
+	switch resourceType {
+	case "org":
+		return sharedActor.CheckTarget(false, false)
+	case "space":
+		return sharedActor.CheckTarget(true, false)
+	default:
+		return sharedActor.CheckTarget(true, true)
+	}
+}
+
+func displayLabelFlavorText(ui command.UI, config command.Config, action string, resourceType string, resourceName string, username string) {
+	values := map[string]interface{}{
+		"ResourceName": resourceName,
+		"OrgName":      config.TargetedOrganization().Name,
+		"SpaceName":    config.TargetedSpace().Name,
+		"Username":     username,
+	}
+
+	switch resourceType {
+	case "app":
+		ui.DisplayTextWithFlavor(action+" app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", values)
+	case "org":
+		ui.DisplayTextWithFlavor(action+" org {{.ResourceName}} as {{.Username}}...", values)
+	case "service-instance":
+		ui.DisplayTextWithFlavor(action+" service instance {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", values)
+	case "space":
+		ui.DisplayTextWithFlavor(action+" space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", values)
+	}
+}
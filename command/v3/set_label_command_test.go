@@ -0,0 +1,210 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-label Command", func() {
+	var (
+		cmd             v3.SetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSetLabelActor
+		binaryName      string
+		executeErr      error
+		expectedLabels  map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSetLabelActor)
+
+		cmd = v3.SetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "app"}
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.RequiredArgs.Labels = []flag.Label{
+			{Key: "team", Value: "payments"},
+			{Key: "env", Value: "prod"},
+		}
+		expectedLabels = map[string]types.NullString{
+			"team": types.NewNullString("payments"),
+			"env":  types.NewNullString("prod"),
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when getting the current user returns an error", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	Context("when labeling an app", func() {
+		Context("when setting the labels succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"set-warning-1", "set-warning-2"}, nil)
+			})
+
+			It("sets the labels of the app in the targeted space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+
+				Expect(testUI.Out).To(Say("Setting label\\(s\\) for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+				Expect(testUI.Err).To(Say("set-warning-1"))
+				Expect(testUI.Err).To(Say("set-warning-2"))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+				appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(expectedLabels))
+			})
+		})
+
+		Context("when setting the labels fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"set-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("set-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when labeling an org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "org"}
+			cmd.RequiredArgs.ResourceName = "some-other-org"
+		})
+
+		It("sets the labels of the org without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Setting label\\(s\\) for org some-other-org as banana\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.UpdateOrganizationLabelsByOrganizationNameCallCount()).To(Equal(1))
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-other-org"))
+			Expect(labels).To(Equal(expectedLabels))
+		})
+	})
+
+	Context("when labeling a space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "space"}
+			cmd.RequiredArgs.ResourceName = "some-other-space"
+		})
+
+		It("sets the labels of the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Setting label\\(s\\) for space some-other-space in org some-org as banana\\.\\.\\."))
+
+			Expect(fakeActor.UpdateSpaceLabelsBySpaceNameCallCount()).To(Equal(1))
+			spaceName, orgGUID, labels := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labels).To(Equal(expectedLabels))
+		})
+	})
+
+	Context("when labeling a service instance", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "service-instance"}
+			cmd.RequiredArgs.ResourceName = "some-service-instance"
+		})
+
+		It("sets the labels of the service instance in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting label\\(s\\) for service instance some-service-instance in org some-org / space some-space as banana\\.\\.\\."))
+
+			Expect(fakeActor.UpdateServiceInstanceLabelsByServiceInstanceNameCallCount()).To(Equal(1))
+			serviceInstanceName, spaceGUID, labels := fakeActor.UpdateServiceInstanceLabelsByServiceInstanceNameArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(expectedLabels))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate counterfeiter . V3DeploymentActor
+
+type V3DeploymentActor interface {
+	CancelDeployment(deploymentGUID string) (v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error
+}
+
+// PollDeployment waits for the given deployment to finish, displaying the
+// running instance count of each of the application's processes whenever it
+// changes. Receiving on interrupt cancels the deployment; polling continues
+// until the Cloud Controller reports the deployment as canceled. If canceling
+// fails, the error is returned and the rest of the poll is discarded.
+func PollDeployment(actor V3DeploymentActor, deploymentGUID string, appGUID string, interrupt <-chan os.Signal, ui command.UI) error {
+	progressStream := make(chan v3action.ProcessSummaries)
+	warningsStream := make(chan v3action.Warnings)
+	errStream := make(chan error, 1)
+
+	go func() {
+		errStream <- actor.PollDeployment(deploymentGUID, appGUID, progressStream, warningsStream)
+	}()
+
+	var (
+		lastProgress string
+		canceling    bool
+	)
+
+	for {
+		select {
+		case summaries := <-progressStream:
+			progress := summaries.String()
+			if progress != lastProgress && progress != "" {
+				ui.DisplayText("   {{.Progress}}", map[string]interface{}{
+					"Progress": progress,
+				})
+				lastProgress = progress
+			}
+		case warnings := <-warningsStream:
+			ui.DisplayWarnings(warnings)
+		case <-interrupt:
+			if canceling {
+				break
+			}
+			canceling = true
+			ui.DisplayText("Canceling deployment...")
+			warnings, err := actor.CancelDeployment(deploymentGUID)
+			ui.DisplayWarnings(warnings)
+			if err != nil {
+				go discardPoll(progressStream, warningsStream, errStream)
+				return err
+			}
+		case err := <-errStream:
+			return err
+		}
+	}
+}
+
+// discardPoll receives the progress and warnings of a poll that is no longer
+// displayed until it finishes, so that the poll is never blocked sending them.
+func discardPoll(progressStream <-chan v3action.ProcessSummaries, warningsStream <-chan v3action.Warnings, errStream <-chan error) {
+	for {
+		select {
+		case <-progressStream:
+		case <-warningsStream:
+		case <-errStream:
+			return
+		}
+	}
+}
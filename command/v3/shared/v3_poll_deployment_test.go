@@ -0,0 +1,168 @@
+package shared_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("V3PollDeployment", func() {
+	var (
+		fakeActor  *sharedfakes.FakeV3DeploymentActor
+		testUI     *ui.UI
+		interrupt  chan os.Signal
+		executeErr error
+	)
+
+	summaries := func(running int, total int) v3action.ProcessSummaries {
+		var instances []v3action.ProcessInstance
+		for i := 0; i < total; i++ {
+			state := constant.ProcessInstanceStarting
+			if i < running {
+				state = constant.ProcessInstanceRunning
+			}
+			instances = append(instances, v3action.ProcessInstance{State: state})
+		}
+		return v3action.ProcessSummaries{{
+			Process:         v3action.Process{Type: constant.ProcessTypeWeb},
+			InstanceDetails: instances,
+		}}
+	}
+
+	BeforeEach(func() {
+		fakeActor = new(sharedfakes.FakeV3DeploymentActor)
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		interrupt = make(chan os.Signal, 1)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = PollDeployment(fakeActor, "some-deployment-guid", "some-app-guid", interrupt, testUI)
+	})
+
+	Context("when the deployment finishes", func() {
+		BeforeEach(func() {
+			fakeActor.PollDeploymentStub = func(_ string, _ string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+				warningsChannel <- v3action.Warnings{"poll-warning-1"}
+				progressChannel <- summaries(0, 2)
+				progressChannel <- summaries(1, 2)
+				progressChannel <- summaries(1, 2)
+				warningsChannel <- v3action.Warnings{"poll-warning-2"}
+				progressChannel <- summaries(2, 2)
+				return nil
+			}
+		})
+
+		It("displays each change in progress and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`web:0/2`))
+			Expect(testUI.Out).To(Say(`web:1/2`))
+			Expect(testUI.Out).To(Say(`web:2/2`))
+			Expect(testUI.Out).ToNot(Say(`web:1/2`))
+
+			Expect(testUI.Err).To(Say("poll-warning-1"))
+			Expect(testUI.Err).To(Say("poll-warning-2"))
+
+			Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+			deploymentGUID, appGUID, _, _ := fakeActor.PollDeploymentArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when polling returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("poll error")
+			fakeActor.PollDeploymentReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when an interrupt is received", func() {
+		var cancelled chan bool
+
+		BeforeEach(func() {
+			cancelled = make(chan bool, 1)
+			interrupt <- os.Interrupt
+
+			fakeActor.PollDeploymentStub = func(_ string, _ string, _ chan<- v3action.ProcessSummaries, _ chan<- v3action.Warnings) error {
+				<-cancelled
+				return errors.New("deployment was canceled")
+			}
+		})
+
+		Context("when canceling succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.CancelDeploymentStub = func(_ string) (v3action.Warnings, error) {
+					cancelled <- true
+					return v3action.Warnings{"cancel-warning"}, nil
+				}
+			})
+
+			It("cancels the deployment and keeps polling until it finishes", func() {
+				Expect(executeErr).To(MatchError("deployment was canceled"))
+				Expect(testUI.Out).To(Say("Canceling deployment..."))
+				Expect(testUI.Err).To(Say("cancel-warning"))
+
+				Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeActor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when canceling fails", func() {
+			var (
+				expectedErr error
+				pollDone    chan bool
+			)
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cancel error")
+				fakeActor.CancelDeploymentReturns(v3action.Warnings{"cancel-warning"}, expectedErr)
+
+				pollDone = make(chan bool)
+				done, cancel := pollDone, cancelled
+				fakeActor.PollDeploymentStub = func(_ string, _ string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+					defer close(done)
+					<-cancel
+					progressChannel <- summaries(1, 2)
+					warningsChannel <- v3action.Warnings{"poll-warning"}
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				cancelled <- true
+				Eventually(pollDone).Should(BeClosed())
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("cancel-warning"))
+			})
+
+			It("discards the progress and warnings of the poll until it finishes", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+
+				cancelled <- true
+				Eventually(pollDone).Should(BeClosed())
+				Expect(testUI.Out).ToNot(Say("web:1/2"))
+				Expect(testUI.Err).ToNot(Say("poll-warning"))
+			})
+		})
+	})
+})
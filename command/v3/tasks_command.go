@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -61,6 +62,10 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (TasksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd TasksCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRunTaskV3)
 	if err != nil {
@@ -98,6 +103,10 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(output.TasksKind, output.NewTasks(tasks))
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
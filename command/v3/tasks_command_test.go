@@ -213,6 +213,27 @@ var _ = Describe("tasks Command", func() {
 					})
 				})
 
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("displays the tasks as a json document and the warnings as json", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting tasks"))
+						Expect(testUI.Out).To(Say(`"kind": "tasks"`))
+						Expect(testUI.Out).To(Say(`"id": 3,`))
+						Expect(testUI.Out).To(Say(`"state": "RUNNING",`))
+						Expect(testUI.Out).To(Say(`"created_at": "2016-11-08T22:26:02Z",`))
+						Expect(testUI.Out).To(Say(`"command": "some-command"`))
+						Expect(testUI.Out).To(Say(`"id": 2,`))
+						Expect(testUI.Out).To(Say(`"id": 1,`))
+						Expect(testUI.Err).To(Say(`"get-application-warning-1"`))
+						Expect(testUI.Err).To(Say(`"get-tasks-warning-1"`))
+					})
+				})
+
 				Context("when there are no tasks associated with the application", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns([]v3action.Task{}, nil, nil)
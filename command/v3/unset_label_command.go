@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . UnsetLabelActor
+
+type UnsetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateServiceInstanceLabelsByServiceInstanceName(serviceInstanceName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type UnsetLabelCommand struct {
+	RequiredArgs    flag.UnsetLabelArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...\n\nRESOURCES:\n   app\n   org\n   service-instance\n   space\n\nEXAMPLES:\n   cf unset-label app dora team env"`
+	relatedCommands interface{}         `related_commands:"labels, set-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnsetLabelActor
+}
+
+func (cmd *UnsetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd UnsetLabelCommand) Execute(args []string) error {
+	labels := map[string]types.NullString{}
+	for _, key := range cmd.RequiredArgs.LabelKeys {
+		labels[key] = types.NullString{}
+	}
+
+	return updateLabels(cmd.UI, cmd.Config, cmd.SharedActor, cmd.Actor, "Removing label(s) for", cmd.RequiredArgs.ResourceType.Type, cmd.RequiredArgs.ResourceName, labels)
+}
@@ -0,0 +1,94 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unset-label Command", func() {
+	var (
+		cmd             v3.UnsetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnsetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnsetLabelActor)
+
+		cmd = v3.UnsetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "app"}
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.RequiredArgs.LabelKeys = []string{"team", "env"}
+
+		fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"unset-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("removes the labels from the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+		Expect(testUI.Out).To(Say("Removing label\\(s\\) for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+		Expect(testUI.Err).To(Say("unset-warning"))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+		appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(labels).To(Equal(map[string]types.NullString{
+			"team": {},
+			"env":  {},
+		}))
+	})
+
+	Context("when removing labels from an org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: "org"}
+			cmd.RequiredArgs.ResourceName = "some-other-org"
+		})
+
+		It("removes the labels from the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Removing label\\(s\\) for org some-other-org as banana\\.\\.\\."))
+
+			Expect(fakeActor.UpdateOrganizationLabelsByOrganizationNameCallCount()).To(Equal(1))
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-other-org"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"team": {},
+				"env":  {},
+			}))
+		})
+	})
+})
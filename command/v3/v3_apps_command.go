@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/output"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
@@ -20,11 +21,12 @@ import (
 
 type V3AppsActor interface {
 	CloudControllerAPIVersion() string
-	GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	GetApplicationsWithProcessesBySpace(spaceGUID string, labelSelector string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
 }
 
 type V3AppsCommand struct {
-	usage interface{} `usage:"CF_NAME v3-apps"`
+	Labels string      `long:"labels" description:"Selector to filter apps by label, e.g. 'team=payments,env!=prod'"`
+	usage  interface{} `usage:"CF_NAME v3-apps [--labels SELECTOR]"`
 
 	UI              command.UI
 	Config          command.Config
@@ -58,6 +60,10 @@ func (cmd *V3AppsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (V3AppsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd V3AppsCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
@@ -66,6 +72,13 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Labels != "" {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3, "Option '--labels'")
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -83,12 +96,16 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID)
+	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayStructuredApps(summaries)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -126,3 +143,23 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd V3AppsCommand) displayStructuredApps(summaries []v3action.ApplicationWithProcessSummary) error {
+	apps := []output.V3App{}
+	for _, summary := range summaries {
+		var routes v2action.Routes
+		if len(summary.ProcessSummaries) > 0 {
+			var warnings v2action.Warnings
+			var err error
+			routes, warnings, err = cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+
+		apps = append(apps, output.NewV3App(summary, routes))
+	}
+
+	return cmd.UI.DisplayStructuredOutput(output.V3AppsKind, apps)
+}
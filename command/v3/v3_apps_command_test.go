@@ -85,6 +85,38 @@ var _ = Describe("v3-apps Command", func() {
 		})
 	})
 
+	Context("when filtering by labels", func() {
+		BeforeEach(func() {
+			cmd.Labels = "team=payments,env!=prod"
+		})
+
+		Context("when the API version is below the minimum for labels", func() {
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--labels'",
+					CurrentVersion: ccversion.MinVersionV3,
+					MinimumVersion: ccversion.MinVersionMetadataV3,
+				}))
+			})
+		})
+
+		Context("when the API version supports labels", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+				fakeActor.GetApplicationsWithProcessesBySpaceReturns(nil, v3action.Warnings{"warning"}, nil)
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, labelSelector := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(Equal("team=payments,env!=prod"))
+			})
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -284,8 +316,9 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("route-warning-4"))
 
 				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				spaceGUID, labelSelector := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(BeEmpty())
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(2))
 				appGUID := fakeV2Actor.GetApplicationRoutesArgsForCall(0)
@@ -293,6 +326,29 @@ var _ = Describe("v3-apps Command", func() {
 				appGUID = fakeV2Actor.GetApplicationRoutesArgsForCall(1)
 				Expect(appGUID).To(Equal("app-guid-2"))
 			})
+
+			Context("when structured output is requested", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays the applications and their routes as a json document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Getting apps"))
+					Expect(testUI.Out).To(Say(`"kind": "v3-apps"`))
+					Expect(testUI.Out).To(Say(`"name": "some-app-1"`))
+					Expect(testUI.Out).To(Say(`"state": "STARTED"`))
+					Expect(testUI.Out).To(Say(`"type": "web",\s+"running_instances": 2,\s+"total_instances": 2`))
+					Expect(testUI.Out).To(Say(`"some-app-1.some-other-domain",\s+"some-app-1.some-domain"`))
+					Expect(testUI.Out).To(Say(`"name": "some-app-2"`))
+					Expect(testUI.Out).To(Say(`"some-app-2.some-domain"`))
+
+					Expect(testUI.Err).To(Say(`"warning-1"`))
+					Expect(testUI.Err).To(Say(`"route-warning-1"`))
+					Expect(testUI.Err).To(Say(`"route-warning-3"`))
+				})
+			})
 		})
 
 		Context("when app does not have processes", func() {
@@ -320,8 +376,9 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("warning"))
 
 				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				spaceGUID, labelSelector := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(BeEmpty())
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(0))
 			})
@@ -332,6 +389,18 @@ var _ = Describe("v3-apps Command", func() {
 				fakeActor.GetApplicationsWithProcessesBySpaceReturns([]v3action.ApplicationWithProcessSummary{}, v3action.Warnings{"warning-1", "warning-2"}, nil)
 			})
 
+			Context("when structured output is requested", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays an empty list", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`"data": \[\]`))
+					Expect(testUI.Out).ToNot(Say("No apps found"))
+				})
+			})
+
 			It("displays there are no apps", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
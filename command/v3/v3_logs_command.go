@@ -0,0 +1,198 @@
+package v3
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/output"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3LogsActor
+
+type V3LogsActor interface {
+	CloudControllerAPIVersion() string
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient, filter v3action.LogFilter) ([]v3action.LogMessage, v3action.Warnings, error)
+	GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient, filter v3action.LogFilter) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+}
+
+type V3LogsCommand struct {
+	RequiredArgs    flag.AppName       `positional-args:"yes"`
+	Recent          bool               `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.Duration      `long:"since" description:"Only show recent logs newer than a duration such as 30s, 5m or 2h, implies --recent"`
+	Sources         []string           `long:"source" choice:"APP" choice:"STG" choice:"RTR" choice:"CELL" choice:"API" description:"Only show logs from this source, can be repeated"`
+	Instance        flag.InstanceIndex `long:"instance" description:"Only show logs from this app instance index"`
+	Grep            string             `long:"grep" description:"Only show log messages matching this regular expression"`
+	usage           interface{}        `usage:"CF_NAME v3-logs APP_NAME [--recent | --since DURATION] [--source SOURCE]... [--instance INDEX] [--grep PATTERN]\n\nEXAMPLES:\n   CF_NAME v3-logs my-app --since 10m --source APP --grep 'status=5[0-9]{2}'\n   CF_NAME v3-logs my-app --recent --instance 0 --output json"`
+	relatedCommands interface{}        `related_commands:"v3-app, v3-apps, v3-ssh"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3LogsActor
+	NOAAClient  v3action.NOAAClient
+}
+
+func (cmd *V3LogsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (V3LogsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd V3LogsCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	filter, err := cmd.logFilter()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	cmd.UI.DisplayNewline()
+
+	if cmd.Recent || cmd.Since.Duration > 0 {
+		return cmd.displayRecentLogs(filter)
+	}
+
+	return cmd.streamLogs(filter)
+}
+
+func (cmd V3LogsCommand) logFilter() (v3action.LogFilter, error) {
+	filter := v3action.LogFilter{
+		SourceTypes: cmd.Sources,
+	}
+
+	if cmd.Since.Duration > 0 {
+		filter.Since = time.Now().Add(-cmd.Since.Duration)
+	}
+
+	if cmd.Instance.IsSet {
+		filter.SourceInstance = strconv.Itoa(cmd.Instance.Value)
+	}
+
+	if cmd.Grep != "" {
+		pattern, err := regexp.Compile(cmd.Grep)
+		if err != nil {
+			return v3action.LogFilter{}, translatableerror.InvalidLogPatternError{Pattern: cmd.Grep, Err: err}
+		}
+		filter.Pattern = pattern
+	}
+
+	return filter, nil
+}
+
+func (cmd V3LogsCommand) displayRecentLogs(filter v3action.LogFilter) error {
+	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.NOAAClient,
+		filter,
+	)
+
+	for _, message := range messages {
+		displayErr := cmd.displayLogMessage(message)
+		if displayErr != nil {
+			return displayErr
+		}
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+func (cmd V3LogsCommand) streamLogs(filter v3action.LogFilter) error {
+	messages, logErrs, warnings, err := cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.NOAAClient,
+		filter,
+	)
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+
+			err = cmd.displayLogMessage(*message)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			cmd.NOAAClient.Close()
+			return logErr
+		}
+	}
+
+	return nil
+}
+
+func (cmd V3LogsCommand) displayLogMessage(message v3action.LogMessage) error {
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutputLine(output.LogKind, output.NewLogMessage(message))
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
@@ -0,0 +1,273 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-logs Command", func() {
+	var (
+		cmd             v3.V3LogsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3LogsActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3LogsActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3LogsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v3action.NOAAClient, _ v3action.LogFilter) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+			messages := make(chan *v3action.LogMessage)
+			logErrs := make(chan error)
+			close(messages)
+			close(logErrs)
+			return messages, logErrs, nil, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when the grep pattern is invalid", func() {
+		BeforeEach(func() {
+			cmd.Grep = "some-(pattern"
+		})
+
+		It("returns an InvalidLogPatternError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidLogPatternError{}))
+			Expect(executeErr.(translatableerror.InvalidLogPatternError).Pattern).To(Equal("some-(pattern"))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and has targeted an org and space", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when --recent is provided", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				cmd.Sources = []string{"APP", "RTR"}
+				cmd.Instance = flag.InstanceIndex{NullInt: types.NullInt{Value: 1, IsSet: true}}
+				cmd.Grep = "status=5[0-9]{2}"
+
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					[]v3action.LogMessage{
+						*v3action.NewLogMessage("status=500", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+						*v3action.NewLogMessage("status=503", 0, time.Unix(1, 0), "RTR", "1"),
+					},
+					v3action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("displays the recent logs selected by the filter", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Retrieving logs for app some-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/1\] OUT status=500`))
+				Expect(testUI.Out).To(Say(`\[RTR/1\] ERR status=503`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, client, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(fakeNOAAClient))
+				Expect(filter.Since).To(BeZero())
+				Expect(filter.SourceTypes).To(Equal([]string{"APP", "RTR"}))
+				Expect(filter.SourceInstance).To(Equal("1"))
+				Expect(filter.Pattern.String()).To(Equal("status=5[0-9]{2}"))
+
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+
+			Context("when structured output is requested", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays each log message as a json line", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+						`{"version":1,"kind":"log","data":{"timestamp":"1970-01-01T00:00:00Z","source_type":"APP/PROC/WEB","source_instance":"1","type":"OUT","message":"status=500"}}` + "\n" +
+							`{"version":1,"kind":"log","data":{"timestamp":"1970-01-01T00:00:01Z","source_type":"RTR","source_instance":"1","type":"ERR","message":"status=503"}}` + "\n"))
+				})
+			})
+
+			Context("when getting the recent logs fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, v3action.Warnings{"some-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+				})
+
+				It("displays the warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+		})
+
+		Context("when --since is provided", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.Duration{Duration: 10 * time.Minute}
+			})
+
+			It("displays the recent logs since the duration", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				_, _, _, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-10*time.Minute), time.Minute))
+				Expect(filter.SourceInstance).To(BeEmpty())
+				Expect(filter.Pattern).To(BeNil())
+			})
+		})
+
+		Context("when tailing the logs", func() {
+			BeforeEach(func() {
+				cmd.Sources = []string{"APP"}
+
+				fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v3action.NOAAClient, _ v3action.LogFilter) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+					messages := make(chan *v3action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v3action.NewLogMessage("some-message", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v3action.Warnings{"some-warning"}, nil
+				}
+			})
+
+			It("displays the streamed logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/0\] OUT some-message`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, _, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(filter.SourceTypes).To(Equal([]string{"APP"}))
+			})
+
+			Context("when the stream returns an error", func() {
+				BeforeEach(func() {
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v3action.NOAAClient, _ v3action.LogFilter) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+						messages := make(chan *v3action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							logErrs <- errors.New("some-log-error")
+						}()
+
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("closes the client and returns the error", func() {
+					Expect(executeErr).To(MatchError("some-log-error"))
+					Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the messages end before the stream returns an error", func() {
+				BeforeEach(func() {
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v3action.NOAAClient, _ v3action.LogFilter) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+						messages := make(chan *v3action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							close(messages)
+							time.Sleep(10 * time.Millisecond)
+							logErrs <- errors.New("some-log-error")
+						}()
+
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("keeps waiting for the error and returns it", func() {
+					Expect(executeErr).To(MatchError("some-log-error"))
+					Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
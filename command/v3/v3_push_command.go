@@ -2,17 +2,23 @@ package v3
 
 import (
 	"os"
+	"path/filepath"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -30,6 +36,8 @@ type ProgressBar interface {
 type V3PushActor interface {
 	Actualize(state pushaction.PushState, progressBar pushaction.ProgressBar) (<-chan pushaction.PushState, <-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	Conceptualize(setting pushaction.CommandLineSettings, spaceGUID string) ([]pushaction.PushState, pushaction.Warnings, error)
+	PlanPush(spaceGUID string, settings pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]pushaction.PushState, pushaction.Warnings, error)
+	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 }
 
 //go:generate counterfeiter . V3PushVersionActor
@@ -54,9 +62,11 @@ type V3PushCommand struct {
 	// Memory              flag.Megabytes
 	// NoHostname          bool
 	// NoManifest          bool
-	NoRoute bool                        `long:"no-route" description:"Do not map a route to this app"`
-	NoStart bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
-	AppPath flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	NoRoute  bool                        `long:"no-route" description:"Do not map a route to this app"`
+	NoStart  bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
+	AppPath  flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	Strategy flag.DeploymentStrategy     `long:"strategy" description:"Deployment strategy to use when updating a running app, 'rolling' replaces instances one at a time without downtime"`
+	DryRun   bool                        `long:"dry-run" description:"Display the changes v3-push would make to the app without making them"`
 	// RandomRoute         bool
 	// RoutePath           flag.RoutePath
 	// StackName           string
@@ -64,7 +74,7 @@ type V3PushCommand struct {
 	// Vars []template.VarKV
 	// HealthCheckTimeout int
 	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start]"`
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start | --strategy rolling] [--dry-run]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start | --strategy rolling] [--dry-run]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -113,7 +123,8 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd V3PushCommand) Execute(args []string) error {
-	if !cmd.Config.Experimental() {
+	// Rolling deployments are only implemented by the original push.
+	if !cmd.Config.Experimental() || cmd.Strategy.Name != constant.DeploymentStrategyDefault {
 		return cmd.OriginalExecute(args)
 	}
 
@@ -129,7 +140,12 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.displayDryRunPlan(cmd.Actor)
+	}
+
 	cmd.UI.DisplayText("Getting app info...")
+
 	cls, err := cmd.GetCommandLineSettings()
 	if err != nil {
 		return err
@@ -155,6 +171,98 @@ func (cmd V3PushCommand) Execute(args []string) error {
 	return nil
 }
 
+type pushPlanner interface {
+	PlanPush(spaceGUID string, settings pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]pushaction.PushState, pushaction.Warnings, error)
+	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
+}
+
+// displayDryRunPlan displays the apps pushing would create and update, and
+// the files it would upload for each. The plan is conceptualized from the
+// manifest in the current directory, if there is one, merged with the
+// command line settings, so nothing is changed on the Cloud Controller.
+func (cmd V3PushCommand) displayDryRunPlan(planner pushPlanner) error {
+	settings, err := cmd.GetCommandLineSettings()
+	if err != nil {
+		return err
+	}
+
+	manifestApps, err := cmd.readManifest(planner, settings.CurrentDirectory)
+	if err != nil {
+		return err
+	}
+
+	states, warnings, err := planner.PlanPush(cmd.Config.TargetedSpace().GUID, settings, manifestApps)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var toCreate, toUpdate int
+	for _, state := range states {
+		currentName := state.Application.Name
+		if state.Application.GUID == "" {
+			toCreate++
+			currentName = ""
+			cmd.UI.DisplayText("Creating app with these attributes...")
+		} else {
+			toUpdate++
+			cmd.UI.DisplayText("Updating app with these attributes...")
+		}
+		err = cmd.UI.DisplayChangesForPush([]ui.Change{
+			{Header: "name:", CurrentValue: currentName, NewValue: state.Application.Name},
+			{Header: "path:", CurrentValue: state.BitsPath, NewValue: state.BitsPath},
+		})
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+
+		var uploadCount int
+		var uploadSize int64
+		for _, resource := range state.AllResources {
+			if !resource.Mode.IsDir() {
+				uploadCount++
+				uploadSize += resource.Size
+			}
+		}
+		cmd.UI.DisplayTextWithFlavor("Plan for app {{.AppName}}:", map[string]interface{}{
+			"AppName": state.Application.Name,
+		})
+		cmd.UI.DisplayKeyValueTable("  ", [][]string{
+			{cmd.UI.TranslateText("files to upload:"), cmd.UI.TranslateText("{{.Count}} ({{.Size}})", map[string]interface{}{
+				"Count": uploadCount,
+				"Size":  bytefmt.ByteSize(uint64(uploadSize)),
+			})},
+		}, 3)
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("Plan: {{.CreateCount}} app(s) to create, {{.UpdateCount}} app(s) to update.", map[string]interface{}{
+		"CreateCount": toCreate,
+		"UpdateCount": toUpdate,
+	})
+	cmd.UI.DisplayText("Dry run: no changes have been made.")
+	return nil
+}
+
+// readManifest reads manifest.yml, or else manifest.yaml, from dir. It
+// returns no apps when neither exists.
+func (cmd V3PushCommand) readManifest(reader pushPlanner, dir string) ([]manifest.Application, error) {
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		pathToManifest := filepath.Join(dir, name)
+		if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
+			log.WithField("pathToManifest", pathToManifest).Debug("could not find")
+			continue
+		}
+
+		apps, warnings, err := reader.ReadManifest(pathToManifest, nil, nil)
+		cmd.UI.DisplayWarnings(warnings)
+		return apps, err
+	}
+
+	return nil, nil
+}
+
 func (cmd V3PushCommand) processApplyStreams(
 	appName string,
 	stateStream <-chan pushaction.PushState,
@@ -247,6 +355,8 @@ func (cmd V3PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	return pushaction.CommandLineSettings{
 		Buildpacks:       cmd.Buildpacks,
 		CurrentDirectory: pwd,
+		DockerImage:      cmd.DockerImage.Path,
+		DockerUsername:   cmd.DockerUsername,
 		Name:             cmd.RequiredArgs.AppName,
 		ProvidedAppPath:  string(cmd.AppPath),
 	}, nil
@@ -2,11 +2,15 @@ package v3_test
 
 import (
 	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -14,6 +18,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -120,6 +125,26 @@ var _ = Describe("v3-push Command", func() {
 			})
 		})
 
+		Context("when a deployment strategy is provided", func() {
+			var fakeOriginalActor *v3fakes.FakeOriginalV3PushActor
+
+			BeforeEach(func() {
+				fakeOriginalActor = new(v3fakes.FakeOriginalV3PushActor)
+				fakeOriginalActor.CloudControllerAPIVersionReturns("0.0.0")
+				cmd.OriginalActor = fakeOriginalActor
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			})
+
+			It("pushes with the original push, which deploys the app", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					CurrentVersion: "0.0.0",
+					MinimumVersion: ccversion.MinVersionV3,
+				}))
+				Expect(fakeOriginalActor.CloudControllerAPIVersionCallCount()).To(Equal(1))
+				Expect(fakeVersionActor.CloudControllerAPIVersionCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the API version is met", func() {
 			BeforeEach(func() {
 				fakeVersionActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
@@ -248,6 +273,131 @@ var _ = Describe("v3-push Command", func() {
 						})
 					})
 
+					Context("when --dry-run is set", func() {
+						BeforeEach(func() {
+							cmd.DryRun = true
+							cmd.Buildpacks = []string{"some-buildpack"}
+
+							fakeActor.PlanPushReturns(
+								[]pushaction.PushState{
+									{
+										Application: v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+										BitsPath:    "/some/bits/path",
+										AllResources: []sharedaction.Resource{
+											{Filename: "some-dir", Mode: os.ModeDir | 0755},
+											{Filename: "some-dir/some-file", Mode: 0644, Size: 2048},
+											{Filename: "some-other-file", Mode: 0644, Size: 1024},
+										},
+									},
+									{
+										Application:  v3action.Application{Name: "some-new-app"},
+										BitsPath:     "/some/other/bits/path",
+										AllResources: []sharedaction.Resource{{Filename: "some-file", Mode: 0644, Size: 1024}},
+									},
+								},
+								pushaction.Warnings{"some-plan-warning"}, nil)
+						})
+
+						It("displays the planned push states without actualizing them", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.ReadManifestCallCount()).To(Equal(0))
+							Expect(fakeActor.PlanPushCallCount()).To(Equal(1))
+							spaceGUID, settings, manifestApps := fakeActor.PlanPushArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(settings.Name).To(Equal("some-app"))
+							Expect(settings.Buildpacks).To(ConsistOf("some-buildpack"))
+							Expect(manifestApps).To(BeEmpty())
+
+							Expect(testUI.Out).ToNot(Say("Getting app info..."))
+							Expect(testUI.Err).To(Say("some-plan-warning"))
+							Expect(testUI.Out).To(Say(`Updating app with these attributes\.\.\.`))
+							Expect(testUI.Out).To(Say(`\s+name:\s+some-app`))
+							Expect(testUI.Out).To(Say(`\s+path:\s+/some/bits/path`))
+							Expect(testUI.Out).To(Say(`Plan for app some-app:`))
+							Expect(testUI.Out).To(Say(`files to upload:\s+2 \(3K\)`))
+							Expect(testUI.Out).To(Say(`Creating app with these attributes\.\.\.`))
+							Expect(testUI.Out).To(Say(`\+\s+name:\s+some-new-app`))
+							Expect(testUI.Out).To(Say(`\s+path:\s+/some/other/bits/path`))
+							Expect(testUI.Out).To(Say(`Plan for app some-new-app:`))
+							Expect(testUI.Out).To(Say(`files to upload:\s+1 \(1K\)`))
+							Expect(testUI.Out).To(Say(`Plan: 1 app\(s\) to create, 1 app\(s\) to update\.`))
+							Expect(testUI.Out).To(Say(`Dry run: no changes have been made\.`))
+
+							Expect(fakeActor.ConceptualizeCallCount()).To(Equal(0))
+							Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+						})
+
+						Context("when there is a manifest in the current directory", func() {
+							var (
+								originalDir  string
+								tmpDir       string
+								manifestApps []manifest.Application
+							)
+
+							BeforeEach(func() {
+								var err error
+								tmpDir, err = ioutil.TempDir("", "v3-push-command-test")
+								Expect(err).ToNot(HaveOccurred())
+
+								// OS X uses weird symlinks that causes problems for some tests
+								tmpDir, err = filepath.EvalSymlinks(tmpDir)
+								Expect(err).ToNot(HaveOccurred())
+
+								originalDir, err = os.Getwd()
+								Expect(err).ToNot(HaveOccurred())
+								Expect(os.Chdir(tmpDir)).ToNot(HaveOccurred())
+
+								err = ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yml"), []byte("some manifest file"), 0666)
+								Expect(err).ToNot(HaveOccurred())
+
+								manifestApps = []manifest.Application{{Name: "some-app"}, {Name: "some-new-app"}}
+								fakeActor.ReadManifestReturns(manifestApps, pushaction.Warnings{"some-manifest-warning"}, nil)
+							})
+
+							AfterEach(func() {
+								Expect(os.Chdir(originalDir)).ToNot(HaveOccurred())
+								Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+							})
+
+							It("plans the push from the manifest merged with the command line settings", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Err).To(Say("some-manifest-warning"))
+
+								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+								pathToManifest, _, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(pathToManifest).To(Equal(filepath.Join(tmpDir, "manifest.yml")))
+
+								Expect(fakeActor.PlanPushCallCount()).To(Equal(1))
+								_, settings, manifestAppsArg := fakeActor.PlanPushArgsForCall(0)
+								Expect(settings.CurrentDirectory).To(Equal(tmpDir))
+								Expect(manifestAppsArg).To(Equal(manifestApps))
+							})
+
+							Context("when reading the manifest fails", func() {
+								BeforeEach(func() {
+									fakeActor.ReadManifestReturns(nil, nil, errors.New("some-manifest-error"))
+								})
+
+								It("returns the error without planning", func() {
+									Expect(executeErr).To(MatchError("some-manifest-error"))
+									Expect(fakeActor.PlanPushCallCount()).To(Equal(0))
+								})
+							})
+						})
+
+						Context("when planning fails", func() {
+							BeforeEach(func() {
+								fakeActor.PlanPushReturns(nil, pushaction.Warnings{"some-plan-warning"}, errors.New("some-plan-error"))
+							})
+
+							It("returns the error and displays the warnings", func() {
+								Expect(executeErr).To(MatchError("some-plan-error"))
+								Expect(testUI.Err).To(Say("some-plan-warning"))
+							})
+						})
+					})
+
 					Context("when actualizing fails", func() {
 						BeforeEach(func() {
 							fakeActor.ActualizeStub = FillInValues([]Step{
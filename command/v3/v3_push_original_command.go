@@ -2,6 +2,8 @@ package v3
 
 import (
 	"net/http"
+	"os"
+	"os/signal"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
@@ -15,24 +17,31 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
 //go:generate counterfeiter . OriginalV2PushActor
 
 type OriginalV2PushActor interface {
 	CreateAndMapDefaultApplicationRoute(orgGUID string, spaceGUID string, app v2action.Application) (pushaction.Warnings, error)
+	PlanPush(spaceGUID string, settings pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]pushaction.PushState, pushaction.Warnings, error)
+	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 }
 
 //go:generate counterfeiter . OriginalV3PushActor
 
 type OriginalV3PushActor interface {
+	CancelDeployment(deploymentGUID string) (v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
 	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error
 	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
 	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
 	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
@@ -95,6 +104,13 @@ func (cmd V3PushCommand) OriginalExecute(args []string) error {
 		return err
 	}
 
+	if cmd.Strategy.Name == constant.DeploymentStrategyRolling {
+		err = command.MinimumAPIVersionCheck(cmd.OriginalActor.CloudControllerAPIVersion(), ccversion.MinVersionDeploymentsV3, "Option '--strategy'")
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -109,6 +125,10 @@ func (cmd V3PushCommand) OriginalExecute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
+	if cmd.DryRun {
+		return cmd.displayDryRunPlan(cmd.OriginalV2PushActor)
+	}
+
 	var app v3action.Application
 	app, err = cmd.getApplication()
 	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
@@ -130,7 +150,9 @@ func (cmd V3PushCommand) OriginalExecute(args []string) error {
 		return err
 	}
 
-	if app.Started() {
+	rolling := app.Started() && cmd.Strategy.Name == constant.DeploymentStrategyRolling
+
+	if app.Started() && !rolling {
 		err = cmd.stopApplication(app.GUID, user.Name)
 		if err != nil {
 			return err
@@ -146,6 +168,22 @@ func (cmd V3PushCommand) OriginalExecute(args []string) error {
 		return err
 	}
 
+	if rolling {
+		if !cmd.NoRoute {
+			err = cmd.createAndMapRoutes(app)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = cmd.deployApplication(app.GUID, dropletGUID, user.Name)
+		if err != nil {
+			return err
+		}
+
+		return cmd.displayAppSummary(user.Name)
+	}
+
 	err = cmd.setApplicationDroplet(dropletGUID, user.Name)
 	if err != nil {
 		return err
@@ -192,11 +230,15 @@ func (cmd V3PushCommand) OriginalExecute(args []string) error {
 		return err
 	}
 
+	return cmd.displayAppSummary(user.Name)
+}
+
+func (cmd V3PushCommand) displayAppSummary(userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
+		"Username":  userName,
 	})
 	cmd.UI.DisplayNewline()
 
@@ -219,6 +261,10 @@ func (cmd V3PushCommand) validateArgs() error {
 		}
 	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
 		return translatableerror.DockerPasswordNotSetError{}
+	case cmd.NoStart && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-start", "--strategy"},
+		}
 	}
 	return nil
 }
@@ -419,6 +465,46 @@ func (cmd V3PushCommand) stopApplication(appGUID string, userName string) error
 	return nil
 }
 
+func (cmd V3PushCommand) deployApplication(appGUID string, dropletGUID string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Deploying app {{.AppName}} with droplet {{.DropletGUID}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"DropletGUID": dropletGUID,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+	})
+
+	deployment, warnings, err := cmd.OriginalActor.CreateDeployment(appGUID, dropletGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for app to deploy...")
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	err = shared.PollDeployment(cmd.OriginalActor, deployment.GUID, appGUID, interrupt, cmd.UI)
+	switch err.(type) {
+	case nil:
+	case actionerror.DeploymentCanceledError:
+		return translatableerror.DeploymentCanceledError{AppName: cmd.RequiredArgs.AppName}
+	case actionerror.StartupTimeoutError:
+		return translatableerror.StartupTimeoutError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func verifyBuildpacks(buildpacks []string) bool {
 	if len(buildpacks) < 2 {
 		return true
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -177,6 +178,34 @@ var _ = Describe("v3-push Command", func() {
 			}),
 	)
 
+	Context("when --no-start and --strategy are both provided", func() {
+		BeforeEach(func() {
+			cmd.NoStart = true
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--no-start", "--strategy"},
+			}))
+		})
+	})
+
+	Context("when --strategy rolling is provided and the API version does not support deployments", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--strategy'",
+				CurrentVersion: ccversion.MinVersionV3,
+				MinimumVersion: ccversion.MinVersionDeploymentsV3,
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -211,6 +240,60 @@ var _ = Describe("v3-push Command", func() {
 			})
 		})
 
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+				cmd.AppPath = "some-app-path"
+				cmd.Buildpacks = []string{"some-buildpack"}
+
+				fakeV2PushActor.PlanPushReturns(
+					[]pushaction.PushState{{
+						Application:  v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+						BitsPath:     "/some/app/path",
+						AllResources: []sharedaction.Resource{{Filename: "some-file", Mode: 0644, Size: 1024}},
+					}},
+					pushaction.Warnings{"plan-warning"}, nil)
+			})
+
+			It("displays the changes to the app without making them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV2PushActor.PlanPushCallCount()).To(Equal(1))
+				spaceGUID, settings, _ := fakeV2PushActor.PlanPushArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(settings.Name).To(Equal(app))
+				Expect(settings.ProvidedAppPath).To(Equal("some-app-path"))
+				Expect(settings.Buildpacks).To(ConsistOf("some-buildpack"))
+
+				Expect(testUI.Err).To(Say("plan-warning"))
+				Expect(testUI.Out).To(Say(`Updating app with these attributes\.\.\.`))
+				Expect(testUI.Out).To(Say(`\s+name:\s+some-app`))
+				Expect(testUI.Out).To(Say(`\s+path:\s+/some/app/path`))
+				Expect(testUI.Out).To(Say(`Plan for app some-app:`))
+				Expect(testUI.Out).To(Say(`files to upload:\s+1 \(1K\)`))
+				Expect(testUI.Out).To(Say(`Plan: 0 app\(s\) to create, 1 app\(s\) to update\.`))
+				Expect(testUI.Out).To(Say(`Dry run: no changes have been made\.`))
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2PushActor.CreateAndMapDefaultApplicationRouteCallCount()).To(Equal(0))
+			})
+
+			Context("when planning fails", func() {
+				BeforeEach(func() {
+					fakeV2PushActor.PlanPushReturns(nil, pushaction.Warnings{"plan-warning"}, errors.New("some-plan-error"))
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError("some-plan-error"))
+					Expect(testUI.Err).To(Say("plan-warning"))
+				})
+			})
+		})
+
 		Context("when the application doesn't exist", func() {
 			It("doesn't stop the application", func() {
 				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
@@ -970,6 +1053,104 @@ var _ = Describe("v3-push Command", func() {
 							Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
 						})
 					})
+
+					Context("when --strategy rolling is provided", func() {
+						BeforeEach(func() {
+							cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+							fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionDeploymentsV3)
+							fakeActor.StagePackageStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								dropletStream := make(chan v3action.Droplet)
+								warningsStream := make(chan v3action.Warnings)
+								errorStream := make(chan error)
+
+								go func() {
+									defer close(dropletStream)
+									defer close(warningsStream)
+									defer close(errorStream)
+									dropletStream <- v3action.Droplet{GUID: "some-droplet-guid"}
+								}()
+
+								return dropletStream, warningsStream, errorStream
+							}
+							fakeActor.CreateDeploymentReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"deployment-warning"}, nil)
+						})
+
+						Context("when the deployment succeeds", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentStub = func(_ string, _ string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+									warningsChannel <- v3action.Warnings{"poll-warning"}
+									progressChannel <- v3action.ProcessSummaries{{
+										Process:         v3action.Process{Type: constant.ProcessTypeWeb},
+										InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+									}}
+									return nil
+								}
+							})
+
+							It("deploys the new droplet without stopping the application", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Stopping"))
+								Expect(testUI.Out).To(Say("Mapping routes\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Deploying app some-app with droplet some-droplet-guid in org some-org / space some-space as banana\\.\\.\\."))
+								Expect(testUI.Err).To(Say("deployment-warning"))
+								Expect(testUI.Out).To(Say("Waiting for app to deploy\\.\\.\\."))
+								Expect(testUI.Err).To(Say("poll-warning"))
+								Expect(testUI.Out).To(Say("web:1/1"))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as banana\\.\\.\\."))
+
+								Expect(fakeActor.StopApplicationCallCount()).To(Equal(0), "Expected StopApplication to not be called")
+								Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0), "Expected SetApplicationDroplet to not be called")
+								Expect(fakeActor.StartApplicationCallCount()).To(Equal(0), "Expected StartApplication to not be called")
+
+								Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+								appGUID, dropletGUID := fakeActor.CreateDeploymentArgsForCall(0)
+								Expect(appGUID).To(Equal("some-app-guid"))
+								Expect(dropletGUID).To(Equal("some-droplet-guid"))
+
+								Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+								deploymentGUID, appGUID, _, _ := fakeActor.PollDeploymentArgsForCall(0)
+								Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+								Expect(appGUID).To(Equal("some-app-guid"))
+							})
+						})
+
+						Context("when creating the deployment fails", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = errors.New("some deployment error")
+								fakeActor.CreateDeploymentReturns(v3action.Deployment{}, v3action.Warnings{"deployment-warning"}, expectedErr)
+							})
+
+							It("returns the error and displays warnings", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.Err).To(Say("deployment-warning"))
+								Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the deployment is canceled", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentReturns(actionerror.DeploymentCanceledError{})
+							})
+
+							It("returns a DeploymentCanceledError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.DeploymentCanceledError{AppName: "some-app"}))
+							})
+						})
+
+						Context("when the deployment times out", func() {
+							BeforeEach(func() {
+								fakeActor.PollDeploymentReturns(actionerror.StartupTimeoutError{})
+							})
+
+							It("returns a StartupTimeoutError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: binaryName}))
+							})
+						})
+					})
 				})
 			})
 		})
@@ -2,10 +2,14 @@ package v3
 
 import (
 	"net/http"
+	"os"
+	"os/signal"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -16,16 +20,20 @@ import (
 //go:generate counterfeiter . V3RestartActor
 
 type V3RestartActor interface {
+	CancelDeployment(deploymentGUID string) (v3action.Warnings, error)
 	CloudControllerAPIVersion() string
+	CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 }
 
 type V3RestartCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME v3-restart APP_NAME"`
-	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName            `positional-args:"yes"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy to use when restarting a running app, 'rolling' replaces instances one at a time without downtime"`
+	usage               interface{}             `usage:"CF_NAME v3-restart APP_NAME [--strategy rolling]"`
+	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
@@ -59,6 +67,13 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Strategy.Name == constant.DeploymentStrategyRolling {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionDeploymentsV3, "Option '--strategy'")
+		if err != nil {
+			return err
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -75,6 +90,10 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 		return err
 	}
 
+	if app.Started() && cmd.Strategy.Name == constant.DeploymentStrategyRolling {
+		return cmd.deployApplication(app.GUID, user.Name)
+	}
+
 	if app.Started() {
 		cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -110,3 +129,40 @@ func (cmd V3RestartCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd V3RestartCommand) deployApplication(appGUID string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} with a rolling deployment in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	deployment, warnings, err := cmd.Actor.CreateDeployment(appGUID, "")
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	err = shared.PollDeployment(cmd.Actor, deployment.GUID, appGUID, interrupt, cmd.UI)
+	switch err.(type) {
+	case nil:
+	case actionerror.DeploymentCanceledError:
+		return translatableerror.DeploymentCanceledError{AppName: cmd.RequiredArgs.AppName}
+	case actionerror.StartupTimeoutError:
+		return translatableerror.StartupTimeoutError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -322,5 +322,115 @@ var _ = Describe("v3-restart Command", func() {
 				})
 			})
 		})
+
+		Context("when the --strategy rolling flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+				fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionDeploymentsV3)
+				fakeActor.CreateDeploymentReturns(v3action.Deployment{GUID: "some-deployment-guid"}, v3action.Warnings{"deployment-warning-1", "deployment-warning-2"}, nil)
+			})
+
+			Context("when the API version does not support deployments", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+				})
+
+				It("returns a MinimumAPIVersionNotMetError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+						Command:        "Option '--strategy'",
+						CurrentVersion: ccversion.MinVersionV3,
+						MinimumVersion: ccversion.MinVersionDeploymentsV3,
+					}))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the app is started", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted}, v3action.Warnings{"get-warning-1", "get-warning-2"}, nil)
+				})
+
+				Context("when the deployment succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.PollDeploymentStub = func(_ string, _ string, _ chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+							warningsChannel <- v3action.Warnings{"poll-warning"}
+							return nil
+						}
+					})
+
+					It("redeploys the current droplet without stopping the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Restarting app some-app with a rolling deployment in org some-org / space some-space as steve\\.\\.\\."))
+						Expect(testUI.Err).To(Say("deployment-warning-1"))
+						Expect(testUI.Err).To(Say("deployment-warning-2"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(fakeActor.StopApplicationCallCount()).To(BeZero(), "Expected StopApplication to not be called")
+						Expect(fakeActor.StartApplicationCallCount()).To(BeZero(), "Expected StartApplication to not be called")
+
+						Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+						appGUID, dropletGUID := fakeActor.CreateDeploymentArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(dropletGUID).To(BeEmpty())
+
+						Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+						deploymentGUID, appGUID, _, _ := fakeActor.PollDeploymentArgsForCall(0)
+						Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+						Expect(appGUID).To(Equal("some-app-guid"))
+					})
+				})
+
+				Context("when creating the deployment fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("some deployment error")
+						fakeActor.CreateDeploymentReturns(v3action.Deployment{}, v3action.Warnings{"deployment-warning-1"}, expectedErr)
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("deployment-warning-1"))
+						Expect(fakeActor.PollDeploymentCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the deployment is canceled", func() {
+					BeforeEach(func() {
+						fakeActor.PollDeploymentReturns(actionerror.DeploymentCanceledError{})
+					})
+
+					It("returns a DeploymentCanceledError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.DeploymentCanceledError{AppName: "some-app"}))
+					})
+				})
+
+				Context("when the deployment times out", func() {
+					BeforeEach(func() {
+						fakeActor.PollDeploymentReturns(actionerror.StartupTimeoutError{})
+					})
+
+					It("returns a StartupTimeoutError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: binaryName}))
+					})
+				})
+			})
+
+			Context("when the app is stopped", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStopped}, nil, nil)
+				})
+
+				It("starts the app without a deployment", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Starting app some-app in org some-org / space some-space as steve\\.\\.\\."))
+
+					Expect(fakeActor.CreateDeploymentCallCount()).To(BeZero())
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,164 @@
+package v3
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . FileTransferActor
+
+type FileTransferActor interface {
+	TransferFiles(sshClient sharedaction.SecureShellClient, options sharedaction.FileTransferOptions, progressBar sharedaction.FileTransferProgressBar) error
+}
+
+type V3SCPCommand struct {
+	RequiredArgs       flag.CopyFileArgs `positional-args:"yes"`
+	ProcessIndex       uint              `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string            `long:"process" default:"web" description:"App process name"`
+	Recursive          bool              `short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool              `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}       `usage:"CF_NAME v3-scp [-r] [--process PROCESS] [-i INDEX] [--skip-host-validation] SOURCE DESTINATION\n\n   Exactly one of SOURCE and DESTINATION must be a path on an app instance, in the form APP_NAME:PATH. Relative paths on the app instance start from the home directory of the vcap user.\n\nEXAMPLES:\n   CF_NAME v3-scp my-app:logs/app.log .\n   CF_NAME v3-scp -r -i 1 ./config my-app:/home/vcap/app/config"`
+	relatedCommands    interface{}       `related_commands:"v3-ssh"`
+	allproxy           interface{}       `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI                command.UI
+	Config            command.Config
+	SharedActor       command.SharedActor
+	Actor             V3SSHActor
+	FileTransferActor FileTransferActor
+	SSHClient         *clissh.SecureShell
+	ProgressBar       sharedaction.FileTransferProgressBar
+}
+
+func (cmd *V3SCPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.FileTransferActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd V3SCPCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	sourceApp, sourcePath, sourceIsRemote := parseAppPath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseAppPath(cmd.RequiredArgs.Destination)
+	if sourceIsRemote == destinationIsRemote {
+		return translatableerror.InvalidCopyPathsError{}
+	}
+
+	options := sharedaction.FileTransferOptions{
+		SkipHostValidation: cmd.SkipHostValidation,
+		Recursive:          cmd.Recursive,
+	}
+	appName := destinationApp
+	if sourceIsRemote {
+		appName = sourceApp
+		options.Direction = sharedaction.DownloadFromApp
+		options.RemotePath = sourcePath
+		options.LocalPath = destinationPath
+	} else {
+		options.Direction = sharedaction.UploadToApp
+		options.LocalPath = sourcePath
+		options.RemotePath = destinationPath
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Destination}} on instance {{.InstanceIndex}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"Source":        cmd.RequiredArgs.Source,
+			"Destination":   cmd.RequiredArgs.Destination,
+			"InstanceIndex": cmd.ProcessIndex,
+			"AppName":       appName,
+			"OrgName":       cmd.Config.TargetedOrganization().Name,
+			"SpaceName":     cmd.Config.TargetedSpace().Name,
+			"Username":      user.Name,
+		})
+
+	options.Username = sshAuth.Username
+	options.Passcode = sshAuth.Passcode
+	options.Endpoint = sshAuth.Endpoint
+	options.HostKeyFingerprint = sshAuth.HostKeyFingerprint
+
+	err = cmd.FileTransferActor.TransferFiles(cmd.SSHClient, options, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parseAppPath splits an APP_NAME:PATH argument. As with scp, an argument is
+// a local path when it has no colon, or when a slash comes before the first
+// colon; Windows drive letters are also treated as local. An empty PATH is
+// the home directory of the vcap user.
+func parseAppPath(arg string) (string, string, bool) {
+	if filepath.VolumeName(arg) != "" {
+		return "", arg, false
+	}
+
+	colon := strings.Index(arg, ":")
+	if colon < 1 || strings.ContainsAny(arg[:colon], `/\`) {
+		return "", arg, false
+	}
+
+	remotePath := arg[colon+1:]
+	if remotePath == "" {
+		remotePath = "."
+	}
+	return arg[:colon], remotePath, true
+}
@@ -0,0 +1,209 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-scp Command", func() {
+	var (
+		cmd                   v3.V3SCPCommand
+		testUI                *ui.UI
+		fakeConfig            *commandfakes.FakeConfig
+		fakeSharedActor       *commandfakes.FakeSharedActor
+		fakeActor             *v3fakes.FakeV3SSHActor
+		fakeFileTransferActor *v3fakes.FakeFileTransferActor
+		fakeProgressBar       *sharedactionfakes.FakeFileTransferProgressBar
+		executeErr            error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SSHActor)
+		fakeFileTransferActor = new(v3fakes.FakeFileTransferActor)
+		fakeProgressBar = new(sharedactionfakes.FakeFileTransferProgressBar)
+
+		cmd = v3.V3SCPCommand{
+			RequiredArgs: flag.CopyFileArgs{Source: "./some-dir", Destination: "some-app:/home/vcap/some-dir"},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			Recursive:          true,
+			SkipHostValidation: true,
+
+			UI:                testUI,
+			Config:            fakeConfig,
+			SharedActor:       fakeSharedActor,
+			Actor:             fakeActor,
+			FileTransferActor: fakeFileTransferActor,
+			ProgressBar:       fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	DescribeTable("when not exactly one path is on an app instance",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.CopyFileArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.InvalidCopyPathsError{}))
+		},
+
+		Entry("both paths are local", "./some-file", "some-dir/some-file"),
+		Entry("both paths are on app instances", "some-app:some-file", "some-other-app:some-file"),
+		Entry("a slash comes before the colon", "./some:file", "/tmp/some:file"),
+	)
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is targeted to an organization and space", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the secure shell authentication information fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+					v3action.SSHAuthentication{},
+					v3action.Warnings{"some-warnings"},
+					actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1},
+				)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(fakeFileTransferActor.TransferFilesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the secure shell authentication information succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+					v3action.SSHAuthentication{
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+						Passcode:           "some-passcode",
+						Username:           "some-username",
+					},
+					v3action.Warnings{"some-warnings"},
+					nil,
+				)
+			})
+
+			Context("when uploading to the app", func() {
+				It("copies the local path to the app instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Copying \./some-dir to some-app:/home/vcap/some-dir on instance 1 of app some-app in org some-org / space some-space as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("some-warnings"))
+
+					Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+					appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+					Expect(appNameArg).To(Equal("some-app"))
+					Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+					Expect(processTypeArg).To(Equal("some-process-type"))
+					Expect(processIndexArg).To(Equal(uint(1)))
+
+					Expect(fakeFileTransferActor.TransferFilesCallCount()).To(Equal(1))
+					_, optionsArg, progressBarArg := fakeFileTransferActor.TransferFilesArgsForCall(0)
+					Expect(optionsArg).To(Equal(sharedaction.FileTransferOptions{
+						Username:           "some-username",
+						Passcode:           "some-passcode",
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+						SkipHostValidation: true,
+						Direction:          sharedaction.UploadToApp,
+						LocalPath:          "./some-dir",
+						RemotePath:         "/home/vcap/some-dir",
+						Recursive:          true,
+					}))
+					Expect(progressBarArg).To(Equal(fakeProgressBar))
+				})
+			})
+
+			Context("when downloading from the app", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs = flag.CopyFileArgs{Source: "some-app:", Destination: "some-dir"}
+				})
+
+				It("copies from the home directory on the app instance to the local path", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					appNameArg, _, _, _ := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+					Expect(appNameArg).To(Equal("some-app"))
+
+					_, optionsArg, _ := fakeFileTransferActor.TransferFilesArgsForCall(0)
+					Expect(optionsArg.Direction).To(Equal(sharedaction.DownloadFromApp))
+					Expect(optionsArg.RemotePath).To(Equal("."))
+					Expect(optionsArg.LocalPath).To(Equal("some-dir"))
+				})
+			})
+
+			Context("when transferring the files fails", func() {
+				BeforeEach(func() {
+					fakeFileTransferActor.TransferFilesReturns(errors.New("some-transfer-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-transfer-error"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,354 @@
+package v3
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . FileTransferSessionActor
+
+type FileTransferSessionActor interface {
+	StartFileTransferSession(sshClient sharedaction.SecureShellClient, options sharedaction.FileTransferOptions) (sharedaction.FileTransferSession, error)
+}
+
+type V3SFTPCommand struct {
+	RequiredArgs       flag.AppName `positional-args:"yes"`
+	ProcessIndex       uint         `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string       `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}  `usage:"CF_NAME v3-sftp APP_NAME [--process PROCESS] [-i INDEX] [--skip-host-validation]\n\n   Starts an interactive session that reads commands from standard input. Enter help in the session to list the commands.\n\nEXAMPLES:\n   CF_NAME v3-sftp my-app\n   echo 'get -r logs' | CF_NAME v3-sftp -i 1 my-app"`
+	relatedCommands    interface{}  `related_commands:"v3-scp, v3-ssh"`
+	allproxy           interface{}  `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI                command.UI
+	Config            command.Config
+	SharedActor       command.SharedActor
+	Actor             V3SSHActor
+	FileTransferActor FileTransferSessionActor
+	SSHClient         *clissh.SecureShell
+	ProgressBar       sharedaction.FileTransferProgressBar
+}
+
+func (cmd *V3SFTPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.FileTransferActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd V3SFTPCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Connecting to instance {{.InstanceIndex}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"InstanceIndex": cmd.ProcessIndex,
+			"AppName":       cmd.RequiredArgs.AppName,
+			"OrgName":       cmd.Config.TargetedOrganization().Name,
+			"SpaceName":     cmd.Config.TargetedSpace().Name,
+			"Username":      user.Name,
+		})
+
+	session, err := cmd.FileTransferActor.StartFileTransferSession(cmd.SSHClient, sharedaction.FileTransferOptions{
+		Username:           sshAuth.Username,
+		Passcode:           sshAuth.Passcode,
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		SkipHostValidation: cmd.SkipHostValidation,
+	})
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	shell := sftpShell{
+		ui:          cmd.UI,
+		session:     session,
+		progressBar: cmd.ProgressBar,
+	}
+
+	shell.remoteDir, err = session.RealPath(".")
+	if err != nil {
+		return err
+	}
+
+	shell.localDir, err = os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	return shell.run()
+}
+
+// sftpShell runs the commands of an interactive v3-sftp session. Relative
+// remote and local paths start from the remote and local working
+// directories.
+type sftpShell struct {
+	ui          command.UI
+	session     sharedaction.FileTransferSession
+	progressBar sharedaction.FileTransferProgressBar
+	remoteDir   string
+	localDir    string
+}
+
+// run reads and runs commands until the input ends or the session is exited.
+// A failed command is displayed and does not end the session.
+func (shell *sftpShell) run() error {
+	scanner := bufio.NewScanner(shell.ui.GetIn())
+	for {
+		fmt.Fprint(shell.ui.GetOut(), "sftp> ")
+		if !scanner.Scan() {
+			shell.ui.DisplayNewline()
+			return scanner.Err()
+		}
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var err error
+		switch name, args := fields[0], fields[1:]; name {
+		case "exit", "quit", "bye":
+			return nil
+		case "help", "?":
+			shell.help()
+		case "pwd":
+			shell.ui.DisplayText("Remote working directory: {{.Path}}", map[string]interface{}{"Path": shell.remoteDir})
+		case "lpwd":
+			shell.ui.DisplayText("Local working directory: {{.Path}}", map[string]interface{}{"Path": shell.localDir})
+		case "cd":
+			err = shell.cd(args)
+		case "lcd":
+			err = shell.lcd(args)
+		case "ls":
+			err = shell.ls(args)
+		case "mkdir":
+			err = shell.mkdir(args)
+		case "get":
+			err = shell.transfer(sharedaction.DownloadFromApp, args)
+		case "put":
+			err = shell.transfer(sharedaction.UploadToApp, args)
+		default:
+			err = fmt.Errorf("unknown command %s, enter help to list the commands", name)
+		}
+		if err != nil {
+			shell.ui.DisplayWarning("{{.Error}}", map[string]interface{}{"Error": err.Error()})
+		}
+	}
+}
+
+func (shell *sftpShell) help() {
+	shell.ui.DisplayText("Available commands:")
+	shell.ui.DisplayNonWrappingTable("   ", [][]string{
+		{"cd PATH", shell.ui.TranslateText("Change the remote working directory")},
+		{"lcd PATH", shell.ui.TranslateText("Change the local working directory")},
+		{"pwd", shell.ui.TranslateText("Display the remote working directory")},
+		{"lpwd", shell.ui.TranslateText("Display the local working directory")},
+		{"ls [PATH]", shell.ui.TranslateText("List a remote directory")},
+		{"mkdir PATH", shell.ui.TranslateText("Create a remote directory")},
+		{"get [-r] REMOTE_PATH [LOCAL_PATH]", shell.ui.TranslateText("Download a file, or a directory with -r")},
+		{"put [-r] LOCAL_PATH [REMOTE_PATH]", shell.ui.TranslateText("Upload a file, or a directory with -r")},
+		{"help", shell.ui.TranslateText("Display this help")},
+		{"exit", shell.ui.TranslateText("End the session")},
+	}, 3)
+}
+
+func (shell *sftpShell) cd(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: cd PATH")
+	}
+
+	dir, err := shell.session.RealPath(shell.remotePath(args[0]))
+	if err != nil {
+		return err
+	}
+
+	info, err := shell.session.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+
+	shell.remoteDir = dir
+	return nil
+}
+
+func (shell *sftpShell) lcd(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: lcd PATH")
+	}
+
+	dir, err := filepath.Abs(shell.localPath(args[0]))
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+
+	shell.localDir = dir
+	return nil
+}
+
+func (shell *sftpShell) ls(args []string) error {
+	if len(args) > 1 {
+		return errors.New("usage: ls [PATH]")
+	}
+
+	dir := shell.remoteDir
+	if len(args) == 1 {
+		dir = shell.remotePath(args[0])
+	}
+
+	info, err := shell.session.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		shell.ui.DisplayText("{{.Name}}", map[string]interface{}{"Name": path.Base(dir)})
+		return nil
+	}
+
+	infos, err := shell.session.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() {
+			name += "/"
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		shell.ui.DisplayText("{{.Name}}", map[string]interface{}{"Name": name})
+	}
+	return nil
+}
+
+func (shell *sftpShell) mkdir(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: mkdir PATH")
+	}
+
+	return shell.session.Mkdir(shell.remotePath(args[0]))
+}
+
+// transfer runs get and put. Without a destination, files are copied into the
+// working directory on the other side.
+func (shell *sftpShell) transfer(direction sharedaction.FileTransferDirection, args []string) error {
+	options := sharedaction.FileTransferOptions{Direction: direction}
+	if len(args) > 0 && args[0] == "-r" {
+		options.Recursive = true
+		args = args[1:]
+	}
+
+	if len(args) < 1 || len(args) > 2 {
+		if direction == sharedaction.DownloadFromApp {
+			return errors.New("usage: get [-r] REMOTE_PATH [LOCAL_PATH]")
+		}
+		return errors.New("usage: put [-r] LOCAL_PATH [REMOTE_PATH]")
+	}
+
+	if direction == sharedaction.DownloadFromApp {
+		options.RemotePath = shell.remotePath(args[0])
+		options.LocalPath = shell.localDir
+		if len(args) == 2 {
+			options.LocalPath = shell.localPath(args[1])
+		}
+	} else {
+		options.LocalPath = shell.localPath(args[0])
+		options.RemotePath = shell.remoteDir
+		if len(args) == 2 {
+			options.RemotePath = shell.remotePath(args[1])
+		}
+	}
+
+	return shell.session.TransferFiles(options, shell.progressBar)
+}
+
+func (shell *sftpShell) remotePath(name string) string {
+	if path.IsAbs(name) {
+		return path.Clean(name)
+	}
+	return path.Join(shell.remoteDir, name)
+}
+
+func (shell *sftpShell) localPath(name string) string {
+	if filepath.IsAbs(name) {
+		return filepath.Clean(name)
+	}
+	return filepath.Join(shell.localDir, name)
+}
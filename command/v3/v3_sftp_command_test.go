@@ -0,0 +1,288 @@
+package v3_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type sftpFileInfo struct {
+	name string
+	dir  bool
+}
+
+func (i sftpFileInfo) Name() string       { return i.name }
+func (i sftpFileInfo) Size() int64        { return 0 }
+func (i sftpFileInfo) Mode() os.FileMode  { return 0 }
+func (i sftpFileInfo) ModTime() time.Time { return time.Time{} }
+func (i sftpFileInfo) IsDir() bool        { return i.dir }
+func (i sftpFileInfo) Sys() interface{}   { return nil }
+
+var _ = Describe("v3-sftp Command", func() {
+	var (
+		cmd                   v3.V3SFTPCommand
+		input                 *Buffer
+		testUI                *ui.UI
+		fakeConfig            *commandfakes.FakeConfig
+		fakeSharedActor       *commandfakes.FakeSharedActor
+		fakeActor             *v3fakes.FakeV3SSHActor
+		fakeFileTransferActor *v3fakes.FakeFileTransferSessionActor
+		fakeSession           *sharedactionfakes.FakeFileTransferSession
+		fakeProgressBar       *sharedactionfakes.FakeFileTransferProgressBar
+		executeErr            error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SSHActor)
+		fakeFileTransferActor = new(v3fakes.FakeFileTransferSessionActor)
+		fakeSession = new(sharedactionfakes.FakeFileTransferSession)
+		fakeProgressBar = new(sharedactionfakes.FakeFileTransferProgressBar)
+
+		cmd = v3.V3SFTPCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			SkipHostValidation: true,
+
+			UI:                testUI,
+			Config:            fakeConfig,
+			SharedActor:       fakeSharedActor,
+			Actor:             fakeActor,
+			FileTransferActor: fakeFileTransferActor,
+			ProgressBar:       fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeFileTransferActor.StartFileTransferSessionReturns(fakeSession, nil)
+		fakeSession.RealPathStub = func(remotePath string) (string, error) {
+			if remotePath == "." {
+				return "/home/vcap", nil
+			}
+			return remotePath, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is targeted to an organization and space", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the secure shell authentication information fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+					v3action.SSHAuthentication{},
+					v3action.Warnings{"some-warnings"},
+					actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1},
+				)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(fakeFileTransferActor.StartFileTransferSessionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the secure shell authentication information succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+					v3action.SSHAuthentication{
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+						Passcode:           "some-passcode",
+						Username:           "some-username",
+					},
+					v3action.Warnings{"some-warnings"},
+					nil,
+				)
+			})
+
+			It("starts a session on the app instance and closes it when the input ends", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Connecting to instance 1 of app some-app in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("some-warnings"))
+
+				appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(appNameArg).To(Equal("some-app"))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("some-process-type"))
+				Expect(processIndexArg).To(Equal(uint(1)))
+
+				Expect(fakeFileTransferActor.StartFileTransferSessionCallCount()).To(Equal(1))
+				_, optionsArg := fakeFileTransferActor.StartFileTransferSessionArgsForCall(0)
+				Expect(optionsArg).To(Equal(sharedaction.FileTransferOptions{
+					Username:           "some-username",
+					Passcode:           "some-passcode",
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					SkipHostValidation: true,
+				}))
+
+				Expect(testUI.Out).To(Say("sftp> "))
+				Expect(fakeSession.CloseCallCount()).To(Equal(1))
+			})
+
+			Context("when starting the session fails", func() {
+				BeforeEach(func() {
+					fakeFileTransferActor.StartFileTransferSessionReturns(nil, errors.New("some-session-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-session-error"))
+				})
+			})
+
+			Context("when the remote and local working directories are changed", func() {
+				var localDir string
+
+				BeforeEach(func() {
+					var err error
+					localDir, err = os.Getwd()
+					Expect(err).ToNot(HaveOccurred())
+
+					fakeSession.StatReturns(sftpFileInfo{dir: true}, nil)
+					_, err = input.Write([]byte("pwd\ncd some-dir\npwd\nlcd /\nlpwd\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("resolves relative paths against them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Remote working directory: /home/vcap\n"))
+					Expect(testUI.Out).To(Say("Remote working directory: /home/vcap/some-dir\n"))
+					Expect(testUI.Out).To(Say("Local working directory: /\n"))
+					Expect(fakeSession.RealPathArgsForCall(1)).To(Equal("/home/vcap/some-dir"))
+
+					Expect(os.Getwd()).To(Equal(localDir))
+				})
+			})
+
+			Context("when a remote directory is listed", func() {
+				BeforeEach(func() {
+					fakeSession.StatReturns(sftpFileInfo{dir: true}, nil)
+					fakeSession.ReadDirReturns([]os.FileInfo{
+						sftpFileInfo{name: "some-file"},
+						sftpFileInfo{name: "a-dir", dir: true},
+					}, nil)
+					_, err := input.Write([]byte("ls\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("displays the entries in order with a slash after directories", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeSession.ReadDirArgsForCall(0)).To(Equal("/home/vcap"))
+					Expect(testUI.Out).To(Say("a-dir/\n"))
+					Expect(testUI.Out).To(Say("some-file\n"))
+				})
+			})
+
+			Context("when files are copied", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("get -r some-dir /tmp/some-target\nput /tmp/some-file\nmkdir new-dir\nexit\nget not-run\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("transfers them relative to the working directories until the session is exited", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeSession.TransferFilesCallCount()).To(Equal(2))
+					optionsArg, progressBarArg := fakeSession.TransferFilesArgsForCall(0)
+					Expect(optionsArg).To(Equal(sharedaction.FileTransferOptions{
+						Direction:  sharedaction.DownloadFromApp,
+						RemotePath: "/home/vcap/some-dir",
+						LocalPath:  "/tmp/some-target",
+						Recursive:  true,
+					}))
+					Expect(progressBarArg).To(Equal(fakeProgressBar))
+
+					optionsArg, _ = fakeSession.TransferFilesArgsForCall(1)
+					Expect(optionsArg).To(Equal(sharedaction.FileTransferOptions{
+						Direction:  sharedaction.UploadToApp,
+						LocalPath:  "/tmp/some-file",
+						RemotePath: "/home/vcap",
+					}))
+
+					Expect(fakeSession.MkdirArgsForCall(0)).To(Equal("/home/vcap/new-dir"))
+				})
+			})
+
+			Context("when a command fails", func() {
+				BeforeEach(func() {
+					fakeSession.TransferFilesReturns(errors.New("some-transfer-error"))
+					_, err := input.Write([]byte("get some-file\nsome-command\nget\npwd\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("displays the error and keeps reading commands", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("some-transfer-error"))
+					Expect(testUI.Err).To(Say("unknown command some-command, enter help to list the commands"))
+					Expect(testUI.Err).To(Say(`usage: get \[-r\] REMOTE_PATH \[LOCAL_PATH\]`))
+					Expect(testUI.Out).To(Say("Remote working directory: /home/vcap"))
+				})
+			})
+		})
+	})
+})
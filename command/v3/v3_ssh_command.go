@@ -25,21 +25,25 @@ type SSHActor interface {
 type V3SSHActor interface {
 	CloudControllerAPIVersion() string
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+	GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string) ([]v3action.SSHAuthentication, v3action.Warnings, error)
 }
 
 type V3SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Connect to every running instance of the process and spread forwarded connections across them in round-robin order"`
+	ProcessIndex            flag.InstanceIndex              `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic SOCKS5 port forward specification"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX | --all-instances] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]...\n   [-D [BIND_ADDRESS:]LOCAL_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   Any LOCAL_PORT or REMOTE_HOST:REMOTE_PORT pair can be replaced by the path of a UNIX domain socket, which must contain a '/'.\n\nEXAMPLES:\n   cf v3-ssh my-app -N -L 9000:localhost:8080 --all-instances\n   cf v3-ssh my-app -N -R /tmp/agent.sock:./agent.sock -D 1080"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -82,6 +86,12 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.AllInstances && cmd.ProcessIndex.IsSet {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"-i", "--all-instances"},
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -97,29 +107,44 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
-	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.ProcessType,
-		cmd.ProcessIndex,
-	)
-	cmd.UI.DisplayWarnings(warnings)
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardSpecs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardSpecs = append(dynamicForwardSpecs, sharedaction.DynamicPortForward(spec))
+	}
+
+	sshAuths, err := cmd.getSSHAuthentications()
 	if err != nil {
 		return err
 	}
 
+	var additionalLogins []sharedaction.SSHLogin
+	for _, sshAuth := range sshAuths[1:] {
+		additionalLogins = append(additionalLogins, sharedaction.SSHLogin{
+			Username: sshAuth.Username,
+			Passcode: sshAuth.Passcode,
+		})
+	}
+
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			AdditionalLogins:        additionalLogins,
+			Commands:                cmd.Commands,
+			DynamicPortForwardSpecs: dynamicForwardSpecs,
+			Endpoint:                sshAuths[0].Endpoint,
+			HostKeyFingerprint:      sshAuths[0].HostKeyFingerprint,
+			LocalPortForwardSpecs:   forwardSpecs,
+			Passcode:                sshAuths[0].Passcode,
+			RemotePortForwardSpecs:  remoteForwardSpecs,
+			SkipHostValidation:      cmd.SkipHostValidation,
+			SkipRemoteExecution:     cmd.SkipRemoteExecution,
+			TTYOption:               ttyOption,
+			Username:                sshAuths[0].Username,
 		})
 	if err != nil {
 		return err
@@ -128,8 +153,31 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd V3SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
-	return nil, nil
+// getSSHAuthentications returns the authentication information for the
+// requested instance, or for every running instance when --all-instances is
+// provided. The first one is used for interactive sessions.
+func (cmd V3SSHCommand) getSSHAuthentications() ([]v3action.SSHAuthentication, error) {
+	if cmd.AllInstances {
+		sshAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.ProcessType,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+		return sshAuths, err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		uint(cmd.ProcessIndex.Value),
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+	return []v3action.SSHAuthentication{sshAuth}, nil
 }
 
 // EvaluateTTYOption determines which TTY options are mutually exclusive and
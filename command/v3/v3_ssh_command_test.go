@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -44,7 +45,7 @@ var _ = Describe("v3-ssh Command", func() {
 			RequiredArgs: flag.AppName{AppName: appName},
 
 			ProcessType:         "some-process-type",
-			ProcessIndex:        1,
+			ProcessIndex:        flag.InstanceIndex{NullInt: types.NullInt{Value: 1, IsSet: true}},
 			Commands:            []string{"some", "commands"},
 			SkipHostValidation:  true,
 			SkipRemoteExecution: true,
@@ -81,6 +82,21 @@ var _ = Describe("v3-ssh Command", func() {
 			})
 		})
 
+		Context("when both -i and --all-instances are provided", func() {
+			BeforeEach(func() {
+				cmd.AllInstances = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"-i", "--all-instances"},
+				}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when checking target fails", func() {
 			BeforeEach(func() {
 				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
@@ -177,6 +193,30 @@ var _ = Describe("v3-ssh Command", func() {
 					})
 				})
 
+				Context("when working with remote and dynamic port forwarding", func() {
+					BeforeEach(func() {
+						cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+							{RemoteNetwork: "unix", RemoteAddress: "/tmp/remote.sock", LocalNetwork: "tcp", LocalAddress: "localhost:4444"},
+						}
+						cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+							{LocalNetwork: "tcp", LocalAddress: "localhost:1080"},
+						}
+					})
+
+					It("passes along port forwarding information", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+							{RemoteNetwork: "unix", RemoteAddress: "/tmp/remote.sock", LocalNetwork: "tcp", LocalAddress: "localhost:4444"},
+						}))
+						Expect(sshOptionsArg.DynamicPortForwardSpecs).To(Equal([]sharedaction.DynamicPortForward{
+							{LocalNetwork: "tcp", LocalAddress: "localhost:1080"},
+						}))
+					})
+				})
+
 				Context("when executing the secure shell fails", func() {
 					BeforeEach(func() {
 						cmd.DisablePseudoTTY = true
@@ -191,6 +231,58 @@ var _ = Describe("v3-ssh Command", func() {
 				})
 			})
 
+			Context("when --all-instances is provided", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.ProcessIndex = flag.InstanceIndex{}
+				})
+
+				Context("when getting the secure shell authentication information succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns(
+							[]v3action.SSHAuthentication{
+								{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Passcode: "some-passcode-0", Username: "some-username/0"},
+								{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Passcode: "some-passcode-2", Username: "some-username/2"},
+							},
+							v3action.Warnings{"some-warnings"},
+							nil,
+						)
+					})
+
+					It("connects to every running instance", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("some-warnings"))
+
+						Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+						Expect(fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+						appNameArg, spaceGUIDArg, processTypeArg := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+						Expect(appNameArg).To(Equal(appName))
+						Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+						Expect(processTypeArg).To(Equal("some-process-type"))
+
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg.Username).To(Equal("some-username/0"))
+						Expect(sshOptionsArg.Passcode).To(Equal("some-passcode-0"))
+						Expect(sshOptionsArg.AdditionalLogins).To(Equal([]sharedaction.SSHLogin{
+							{Username: "some-username/2", Passcode: "some-passcode-2"},
+						}))
+					})
+				})
+
+				Context("when getting the secure shell authentication information fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns(nil, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when getting the secure shell authentication fails", func() {
 				BeforeEach(func() {
 					fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.SSHAuthentication{}, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
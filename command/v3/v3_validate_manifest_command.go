@@ -0,0 +1,65 @@
+package v3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . ManifestValidator
+
+type ManifestValidator interface {
+	InterpolateAndParse(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) error
+	AppNames() []string
+}
+
+type V3ValidateManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck   `short:"f" description:"Path to app manifest" required:"true"`
+	VarsFilePaths   []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Vars            []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	usage           interface{}                   `usage:"CF_NAME v3-validate-manifest -f APP_MANIFEST_PATH [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]..."`
+	relatedCommands interface{}                   `related_commands:"v3-apply-manifest, v3-push"`
+
+	UI     command.UI
+	Config command.Config
+	Parser ManifestValidator
+}
+
+func (cmd *V3ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Parser = manifestparser.NewParser()
+
+	return nil
+}
+
+func (cmd V3ValidateManifestCommand) Execute(args []string) error {
+	pathToManifest := string(cmd.PathToManifest)
+
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.ManifestPath}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+	})
+
+	var pathsToVarsFiles []string
+	for _, path := range cmd.VarsFilePaths {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+
+	err := cmd.Parser.InterpolateAndParse(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Found {{.AppCount}} valid application(s): {{.AppNames}}", map[string]interface{}{
+		"AppCount": len(cmd.Parser.AppNames()),
+		"AppNames": strings.Join(cmd.Parser.AppNames(), ", "),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
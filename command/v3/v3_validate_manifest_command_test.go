@@ -0,0 +1,82 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-validate-manifest Command", func() {
+	var (
+		cmd        v3.V3ValidateManifestCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeParser *v3fakes.FakeManifestValidator
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeParser = new(v3fakes.FakeManifestValidator)
+
+		cmd = v3.V3ValidateManifestCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			Parser:         fakeParser,
+			PathToManifest: flag.PathWithExistenceCheck("some-manifest-path"),
+			VarsFilePaths:  []flag.PathWithExistenceCheck{"some-vars-file-path"},
+			Vars:           []template.VarKV{{Name: "some-var", Value: "some-value"}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			fakeParser.AppNamesReturns([]string{"app-1", "app-2"})
+		})
+
+		It("validates the interpolated manifest and displays the applications", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Validating manifest some-manifest-path..."))
+			Expect(testUI.Out).To(Say(`Found 2 valid application\(s\): app-1, app-2`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeParser.InterpolateAndParseCallCount()).To(Equal(1))
+			pathToManifest, pathsToVarsFiles, vars := fakeParser.InterpolateAndParseArgsForCall(0)
+			Expect(pathToManifest).To(Equal("some-manifest-path"))
+			Expect(pathsToVarsFiles).To(Equal([]string{"some-vars-file-path"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "some-var", Value: "some-value"}}))
+		})
+	})
+
+	Context("when the manifest is invalid", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("invalid manifest")
+			fakeParser.InterpolateAndParseReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
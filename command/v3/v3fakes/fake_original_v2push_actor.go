@@ -7,6 +7,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
 type FakeOriginalV2PushActor struct {
@@ -25,6 +27,40 @@ type FakeOriginalV2PushActor struct {
 		result1 pushaction.Warnings
 		result2 error
 	}
+	PlanPushStub        func(spaceGUID string, settings pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]pushaction.PushState, pushaction.Warnings, error)
+	planPushMutex       sync.RWMutex
+	planPushArgsForCall []struct {
+		spaceGUID    string
+		settings     pushaction.CommandLineSettings
+		manifestApps []manifest.Application
+	}
+	planPushReturns struct {
+		result1 []pushaction.PushState
+		result2 pushaction.Warnings
+		result3 error
+	}
+	planPushReturnsOnCall map[int]struct {
+		result1 []pushaction.PushState
+		result2 pushaction.Warnings
+		result3 error
+	}
+	ReadManifestStub        func(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
+	readManifestMutex       sync.RWMutex
+	readManifestArgsForCall []struct {
+		pathToManifest   string
+		pathsToVarsFiles []string
+		vars             []template.VarKV
+	}
+	readManifestReturns struct {
+		result1 []manifest.Application
+		result2 pushaction.Warnings
+		result3 error
+	}
+	readManifestReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 pushaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -82,9 +118,140 @@ func (fake *FakeOriginalV2PushActor) CreateAndMapDefaultApplicationRouteReturnsO
 	}{result1, result2}
 }
 
+func (fake *FakeOriginalV2PushActor) PlanPush(spaceGUID string, settings pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]pushaction.PushState, pushaction.Warnings, error) {
+	var manifestAppsCopy []manifest.Application
+	if manifestApps != nil {
+		manifestAppsCopy = make([]manifest.Application, len(manifestApps))
+		copy(manifestAppsCopy, manifestApps)
+	}
+	fake.planPushMutex.Lock()
+	ret, specificReturn := fake.planPushReturnsOnCall[len(fake.planPushArgsForCall)]
+	fake.planPushArgsForCall = append(fake.planPushArgsForCall, struct {
+		spaceGUID    string
+		settings     pushaction.CommandLineSettings
+		manifestApps []manifest.Application
+	}{spaceGUID, settings, manifestAppsCopy})
+	fake.recordInvocation("PlanPush", []interface{}{spaceGUID, settings, manifestAppsCopy})
+	fake.planPushMutex.Unlock()
+	if fake.PlanPushStub != nil {
+		return fake.PlanPushStub(spaceGUID, settings, manifestApps)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.planPushReturns.result1, fake.planPushReturns.result2, fake.planPushReturns.result3
+}
+
+func (fake *FakeOriginalV2PushActor) PlanPushCallCount() int {
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
+	return len(fake.planPushArgsForCall)
+}
+
+func (fake *FakeOriginalV2PushActor) PlanPushArgsForCall(i int) (string, pushaction.CommandLineSettings, []manifest.Application) {
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
+	return fake.planPushArgsForCall[i].spaceGUID, fake.planPushArgsForCall[i].settings, fake.planPushArgsForCall[i].manifestApps
+}
+
+func (fake *FakeOriginalV2PushActor) PlanPushReturns(result1 []pushaction.PushState, result2 pushaction.Warnings, result3 error) {
+	fake.PlanPushStub = nil
+	fake.planPushReturns = struct {
+		result1 []pushaction.PushState
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOriginalV2PushActor) PlanPushReturnsOnCall(i int, result1 []pushaction.PushState, result2 pushaction.Warnings, result3 error) {
+	fake.PlanPushStub = nil
+	if fake.planPushReturnsOnCall == nil {
+		fake.planPushReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.PushState
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.planPushReturnsOnCall[i] = struct {
+		result1 []pushaction.PushState
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOriginalV2PushActor) ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error) {
+	var pathsToVarsFilesCopy []string
+	if pathsToVarsFiles != nil {
+		pathsToVarsFilesCopy = make([]string, len(pathsToVarsFiles))
+		copy(pathsToVarsFilesCopy, pathsToVarsFiles)
+	}
+	var varsCopy []template.VarKV
+	if vars != nil {
+		varsCopy = make([]template.VarKV, len(vars))
+		copy(varsCopy, vars)
+	}
+	fake.readManifestMutex.Lock()
+	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
+	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
+		pathToManifest   string
+		pathsToVarsFiles []string
+		vars             []template.VarKV
+	}{pathToManifest, pathsToVarsFilesCopy, varsCopy})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest, pathsToVarsFilesCopy, varsCopy})
+	fake.readManifestMutex.Unlock()
+	if fake.ReadManifestStub != nil {
+		return fake.ReadManifestStub(pathToManifest, pathsToVarsFiles, vars)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.readManifestReturns.result1, fake.readManifestReturns.result2, fake.readManifestReturns.result3
+}
+
+func (fake *FakeOriginalV2PushActor) ReadManifestCallCount() int {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return len(fake.readManifestArgsForCall)
+}
+
+func (fake *FakeOriginalV2PushActor) ReadManifestArgsForCall(i int) (string, []string, []template.VarKV) {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return fake.readManifestArgsForCall[i].pathToManifest, fake.readManifestArgsForCall[i].pathsToVarsFiles, fake.readManifestArgsForCall[i].vars
+}
+
+func (fake *FakeOriginalV2PushActor) ReadManifestReturns(result1 []manifest.Application, result2 pushaction.Warnings, result3 error) {
+	fake.ReadManifestStub = nil
+	fake.readManifestReturns = struct {
+		result1 []manifest.Application
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOriginalV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest.Application, result2 pushaction.Warnings, result3 error) {
+	fake.ReadManifestStub = nil
+	if fake.readManifestReturnsOnCall == nil {
+		fake.readManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.readManifestReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeOriginalV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	fake.planPushMutex.RLock()
+	defer fake.planPushMutex.RUnlock()
 	fake.createAndMapDefaultApplicationRouteMutex.RLock()
 	defer fake.createAndMapDefaultApplicationRouteMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
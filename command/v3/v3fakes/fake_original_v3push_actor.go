@@ -2,15 +2,26 @@
 package v3fakes
 
 import (
-	"io"
 	"sync"
 
-	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
 )
 
 type FakeOriginalV3PushActor struct {
+	CancelDeploymentStub        func(deploymentGUID string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -20,21 +31,6 @@ type FakeOriginalV3PushActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	CreateBitsPackageByApplicationStub        func(appGUID string) (v3action.Package, v3action.Warnings, error)
-	createBitsPackageByApplicationMutex       sync.RWMutex
-	createBitsPackageByApplicationArgsForCall []struct {
-		appGUID string
-	}
-	createBitsPackageByApplicationReturns struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}
-	createBitsPackageByApplicationReturnsOnCall map[int]struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}
 	CreateAndUploadBitsPackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
 	createAndUploadBitsPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall []struct {
@@ -85,6 +81,22 @@ type FakeOriginalV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CreateDeploymentStub        func(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -136,6 +148,20 @@ type FakeOriginalV3PushActor struct {
 		result3 v3action.Warnings
 		result4 error
 	}
+	PollDeploymentStub        func(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deploymentGUID  string
+		appGUID         string
+		progressChannel chan<- v3action.ProcessSummaries
+		warningsChannel chan<- v3action.Warnings
+	}
+	pollDeploymentReturns struct {
+		result1 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PollStartStub        func(appGUID string, warnings chan<- v3action.Warnings) error
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -222,26 +248,59 @@ type FakeOriginalV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	UploadBitsPackageStub        func(v3action.Package, []sharedaction.Resource, io.Reader, int64) (v3action.Package, v3action.Warnings, error)
-	uploadBitsPackageMutex       sync.RWMutex
-	uploadBitsPackageArgsForCall []struct {
-		arg1 v3action.Package
-		arg2 []sharedaction.Resource
-		arg3 io.Reader
-		arg4 int64
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOriginalV3PushActor) CancelDeployment(deploymentGUID string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
 	}
-	uploadBitsPackageReturns struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
+	if specificReturn {
+		return ret.result1, ret.result2
 	}
-	uploadBitsPackageReturnsOnCall map[int]struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeOriginalV3PushActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeOriginalV3PushActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeOriginalV3PushActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOriginalV3PushActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeOriginalV3PushActor) CloudControllerAPIVersion() string {
@@ -284,60 +343,6 @@ func (fake *FakeOriginalV3PushActor) CloudControllerAPIVersionReturnsOnCall(i in
 	}{result1}
 }
 
-func (fake *FakeOriginalV3PushActor) CreateBitsPackageByApplication(appGUID string) (v3action.Package, v3action.Warnings, error) {
-	fake.createBitsPackageByApplicationMutex.Lock()
-	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
-	fake.createBitsPackageByApplicationArgsForCall = append(fake.createBitsPackageByApplicationArgsForCall, struct {
-		appGUID string
-	}{appGUID})
-	fake.recordInvocation("CreateBitsPackageByApplication", []interface{}{appGUID})
-	fake.createBitsPackageByApplicationMutex.Unlock()
-	if fake.CreateBitsPackageByApplicationStub != nil {
-		return fake.CreateBitsPackageByApplicationStub(appGUID)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fake.createBitsPackageByApplicationReturns.result1, fake.createBitsPackageByApplicationReturns.result2, fake.createBitsPackageByApplicationReturns.result3
-}
-
-func (fake *FakeOriginalV3PushActor) CreateBitsPackageByApplicationCallCount() int {
-	fake.createBitsPackageByApplicationMutex.RLock()
-	defer fake.createBitsPackageByApplicationMutex.RUnlock()
-	return len(fake.createBitsPackageByApplicationArgsForCall)
-}
-
-func (fake *FakeOriginalV3PushActor) CreateBitsPackageByApplicationArgsForCall(i int) string {
-	fake.createBitsPackageByApplicationMutex.RLock()
-	defer fake.createBitsPackageByApplicationMutex.RUnlock()
-	return fake.createBitsPackageByApplicationArgsForCall[i].appGUID
-}
-
-func (fake *FakeOriginalV3PushActor) CreateBitsPackageByApplicationReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
-	fake.CreateBitsPackageByApplicationStub = nil
-	fake.createBitsPackageByApplicationReturns = struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeOriginalV3PushActor) CreateBitsPackageByApplicationReturnsOnCall(i int, result1 v3action.Package, result2 v3action.Warnings, result3 error) {
-	fake.CreateBitsPackageByApplicationStub = nil
-	if fake.createBitsPackageByApplicationReturnsOnCall == nil {
-		fake.createBitsPackageByApplicationReturnsOnCall = make(map[int]struct {
-			result1 v3action.Package
-			result2 v3action.Warnings
-			result3 error
-		})
-	}
-	fake.createBitsPackageByApplicationReturnsOnCall[i] = struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeOriginalV3PushActor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error) {
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)]
@@ -505,6 +510,61 @@ func (fake *FakeOriginalV3PushActor) CreateApplicationInSpaceReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeOriginalV3PushActor) CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentReturns.result1, fake.createDeploymentReturns.result2, fake.createDeploymentReturns.result3
+}
+
+func (fake *FakeOriginalV3PushActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
+}
+
+func (fake *FakeOriginalV3PushActor) CreateDeploymentArgsForCall(i int) (string, string) {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return fake.createDeploymentArgsForCall[i].appGUID, fake.createDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeOriginalV3PushActor) CreateDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOriginalV3PushActor) CreateDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeOriginalV3PushActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -674,6 +734,57 @@ func (fake *FakeOriginalV3PushActor) GetStreamingLogsForApplicationByNameAndSpac
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeOriginalV3PushActor) PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deploymentGUID  string
+		appGUID         string
+		progressChannel chan<- v3action.ProcessSummaries
+		warningsChannel chan<- v3action.Warnings
+	}{deploymentGUID, appGUID, progressChannel, warningsChannel})
+	fake.recordInvocation("PollDeployment", []interface{}{deploymentGUID, appGUID, progressChannel, warningsChannel})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deploymentGUID, appGUID, progressChannel, warningsChannel)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollDeploymentReturns.result1
+}
+
+func (fake *FakeOriginalV3PushActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeOriginalV3PushActor) PollDeploymentArgsForCall(i int) (string, string, chan<- v3action.ProcessSummaries, chan<- v3action.Warnings) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deploymentGUID, fake.pollDeploymentArgsForCall[i].appGUID, fake.pollDeploymentArgsForCall[i].progressChannel, fake.pollDeploymentArgsForCall[i].warningsChannel
+}
+
+func (fake *FakeOriginalV3PushActor) PollDeploymentReturns(result1 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOriginalV3PushActor) PollDeploymentReturnsOnCall(i int, result1 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeOriginalV3PushActor) PollStart(appGUID string, warnings chan<- v3action.Warnings) error {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -990,87 +1101,29 @@ func (fake *FakeOriginalV3PushActor) UpdateApplicationReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
-func (fake *FakeOriginalV3PushActor) UploadBitsPackage(arg1 v3action.Package, arg2 []sharedaction.Resource, arg3 io.Reader, arg4 int64) (v3action.Package, v3action.Warnings, error) {
-	var arg2Copy []sharedaction.Resource
-	if arg2 != nil {
-		arg2Copy = make([]sharedaction.Resource, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.uploadBitsPackageMutex.Lock()
-	ret, specificReturn := fake.uploadBitsPackageReturnsOnCall[len(fake.uploadBitsPackageArgsForCall)]
-	fake.uploadBitsPackageArgsForCall = append(fake.uploadBitsPackageArgsForCall, struct {
-		arg1 v3action.Package
-		arg2 []sharedaction.Resource
-		arg3 io.Reader
-		arg4 int64
-	}{arg1, arg2Copy, arg3, arg4})
-	fake.recordInvocation("UploadBitsPackage", []interface{}{arg1, arg2Copy, arg3, arg4})
-	fake.uploadBitsPackageMutex.Unlock()
-	if fake.UploadBitsPackageStub != nil {
-		return fake.UploadBitsPackageStub(arg1, arg2, arg3, arg4)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fake.uploadBitsPackageReturns.result1, fake.uploadBitsPackageReturns.result2, fake.uploadBitsPackageReturns.result3
-}
-
-func (fake *FakeOriginalV3PushActor) UploadBitsPackageCallCount() int {
-	fake.uploadBitsPackageMutex.RLock()
-	defer fake.uploadBitsPackageMutex.RUnlock()
-	return len(fake.uploadBitsPackageArgsForCall)
-}
-
-func (fake *FakeOriginalV3PushActor) UploadBitsPackageArgsForCall(i int) (v3action.Package, []sharedaction.Resource, io.Reader, int64) {
-	fake.uploadBitsPackageMutex.RLock()
-	defer fake.uploadBitsPackageMutex.RUnlock()
-	return fake.uploadBitsPackageArgsForCall[i].arg1, fake.uploadBitsPackageArgsForCall[i].arg2, fake.uploadBitsPackageArgsForCall[i].arg3, fake.uploadBitsPackageArgsForCall[i].arg4
-}
-
-func (fake *FakeOriginalV3PushActor) UploadBitsPackageReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
-	fake.UploadBitsPackageStub = nil
-	fake.uploadBitsPackageReturns = struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeOriginalV3PushActor) UploadBitsPackageReturnsOnCall(i int, result1 v3action.Package, result2 v3action.Warnings, result3 error) {
-	fake.UploadBitsPackageStub = nil
-	if fake.uploadBitsPackageReturnsOnCall == nil {
-		fake.uploadBitsPackageReturnsOnCall = make(map[int]struct {
-			result1 v3action.Package
-			result2 v3action.Warnings
-			result3 error
-		})
-	}
-	fake.uploadBitsPackageReturnsOnCall[i] = struct {
-		result1 v3action.Package
-		result2 v3action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeOriginalV3PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
-	fake.createBitsPackageByApplicationMutex.RLock()
-	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createDockerPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.setApplicationDropletMutex.RLock()
@@ -1083,8 +1136,6 @@ func (fake *FakeOriginalV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
-	fake.uploadBitsPackageMutex.RLock()
-	defer fake.uploadBitsPackageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,15 +9,16 @@ import (
 )
 
 type FakeRemoveNetworkPolicyActor struct {
-	RemoveNetworkPolicyStub        func(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicyStub        func(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 	removeNetworkPolicyMutex       sync.RWMutex
 	removeNetworkPolicyArgsForCall []struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
 	}
 	removeNetworkPolicyReturns struct {
 		result1 cfnetworkingaction.Warnings
@@ -27,25 +28,42 @@ type FakeRemoveNetworkPolicyActor struct {
 		result1 cfnetworkingaction.Warnings
 		result2 error
 	}
+	GetSpaceGUIDByNameAndOrganizationNameStub        func(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
+	getSpaceGUIDByNameAndOrganizationNameMutex       sync.RWMutex
+	getSpaceGUIDByNameAndOrganizationNameArgsForCall []struct {
+		spaceName string
+		orgName   string
+	}
+	getSpaceGUIDByNameAndOrganizationNameReturns struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getSpaceGUIDByNameAndOrganizationNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
 	fake.removeNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.removeNetworkPolicyReturnsOnCall[len(fake.removeNetworkPolicyArgsForCall)]
 	fake.removeNetworkPolicyArgsForCall = append(fake.removeNetworkPolicyArgsForCall, struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
-	}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
-	fake.recordInvocation("RemoveNetworkPolicy", []interface{}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
+	}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
+	fake.recordInvocation("RemoveNetworkPolicy", []interface{}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
 	fake.removeNetworkPolicyMutex.Unlock()
 	if fake.RemoveNetworkPolicyStub != nil {
-		return fake.RemoveNetworkPolicyStub(spaceGUID, srcAppName, destAppName, protocol, startPort, endPort)
+		return fake.RemoveNetworkPolicyStub(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -59,10 +77,10 @@ func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyCallCount() int {
 	return len(fake.removeNetworkPolicyArgsForCall)
 }
 
-func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyArgsForCall(i int) (string, string, string, string, int, int) {
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyArgsForCall(i int) (string, string, string, string, string, int, int) {
 	fake.removeNetworkPolicyMutex.RLock()
 	defer fake.removeNetworkPolicyMutex.RUnlock()
-	return fake.removeNetworkPolicyArgsForCall[i].spaceGUID, fake.removeNetworkPolicyArgsForCall[i].srcAppName, fake.removeNetworkPolicyArgsForCall[i].destAppName, fake.removeNetworkPolicyArgsForCall[i].protocol, fake.removeNetworkPolicyArgsForCall[i].startPort, fake.removeNetworkPolicyArgsForCall[i].endPort
+	return fake.removeNetworkPolicyArgsForCall[i].srcSpaceGUID, fake.removeNetworkPolicyArgsForCall[i].srcAppName, fake.removeNetworkPolicyArgsForCall[i].destSpaceGUID, fake.removeNetworkPolicyArgsForCall[i].destAppName, fake.removeNetworkPolicyArgsForCall[i].protocol, fake.removeNetworkPolicyArgsForCall[i].startPort, fake.removeNetworkPolicyArgsForCall[i].endPort
 }
 
 func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyReturns(result1 cfnetworkingaction.Warnings, result2 error) {
@@ -87,11 +105,68 @@ func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeRemoveNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error) {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall[len(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall)]
+	fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall = append(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall, struct {
+		spaceName string
+		orgName   string
+	}{spaceName, orgName})
+	fake.recordInvocation("GetSpaceGUIDByNameAndOrganizationName", []interface{}{spaceName, orgName})
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.Unlock()
+	if fake.GetSpaceGUIDByNameAndOrganizationNameStub != nil {
+		return fake.GetSpaceGUIDByNameAndOrganizationNameStub(spaceName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceGUIDByNameAndOrganizationNameReturns.result1, fake.getSpaceGUIDByNameAndOrganizationNameReturns.result2, fake.getSpaceGUIDByNameAndOrganizationNameReturns.result3
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameCallCount() int {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
+	return len(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall)
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameArgsForCall(i int) (string, string) {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
+	return fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall[i].spaceName, fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall[i].orgName
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameReturns(result1 string, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.GetSpaceGUIDByNameAndOrganizationNameStub = nil
+	fake.getSpaceGUIDByNameAndOrganizationNameReturns = struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRemoveNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameReturnsOnCall(i int, result1 string, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.GetSpaceGUIDByNameAndOrganizationNameStub = nil
+	if fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall == nil {
+		fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRemoveNetworkPolicyActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.removeNetworkPolicyMutex.RLock()
 	defer fake.removeNetworkPolicyMutex.RUnlock()
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package v3fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -25,6 +26,36 @@ type FakeRunTaskActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetStreamingLogsStub        func(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	PollTaskStub        func(task v3action.Task, timeout time.Duration) (v3action.Task, v3action.Warnings, error)
+	pollTaskMutex       sync.RWMutex
+	pollTaskArgsForCall []struct {
+		task    v3action.Task
+		timeout time.Duration
+	}
+	pollTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	RunTaskStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	runTaskMutex       sync.RWMutex
 	runTaskArgsForCall []struct {
@@ -109,6 +140,113 @@ func (fake *FakeRunTaskActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRunTaskActor) GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{appGUID, client})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].appGUID, fake.getStreamingLogsArgsForCall[i].client
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) PollTask(task v3action.Task, timeout time.Duration) (v3action.Task, v3action.Warnings, error) {
+	fake.pollTaskMutex.Lock()
+	ret, specificReturn := fake.pollTaskReturnsOnCall[len(fake.pollTaskArgsForCall)]
+	fake.pollTaskArgsForCall = append(fake.pollTaskArgsForCall, struct {
+		task    v3action.Task
+		timeout time.Duration
+	}{task, timeout})
+	fake.recordInvocation("PollTask", []interface{}{task, timeout})
+	fake.pollTaskMutex.Unlock()
+	if fake.PollTaskStub != nil {
+		return fake.PollTaskStub(task, timeout)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollTaskReturns.result1, fake.pollTaskReturns.result2, fake.pollTaskReturns.result3
+}
+
+func (fake *FakeRunTaskActor) PollTaskCallCount() int {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	return len(fake.pollTaskArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) PollTaskArgsForCall(i int) (v3action.Task, time.Duration) {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	return fake.pollTaskArgsForCall[i].task, fake.pollTaskArgsForCall[i].timeout
+}
+
+func (fake *FakeRunTaskActor) PollTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.PollTaskStub = nil
+	fake.pollTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) PollTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.PollTaskStub = nil
+	if fake.pollTaskReturnsOnCall == nil {
+		fake.pollTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRunTaskActor) RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
 	fake.runTaskMutex.Lock()
 	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
@@ -209,6 +347,10 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
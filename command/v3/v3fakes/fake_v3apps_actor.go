@@ -18,10 +18,11 @@ type FakeV3AppsActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	GetApplicationsWithProcessesBySpaceStub        func(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	GetApplicationsWithProcessesBySpaceStub        func(spaceGUID string, labelSelector string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
 	getApplicationsWithProcessesBySpaceMutex       sync.RWMutex
 	getApplicationsWithProcessesBySpaceArgsForCall []struct {
-		spaceGUID string
+		spaceGUID     string
+		labelSelector string
 	}
 	getApplicationsWithProcessesBySpaceReturns struct {
 		result1 []v3action.ApplicationWithProcessSummary
@@ -77,16 +78,17 @@ func (fake *FakeV3AppsActor) CloudControllerAPIVersionReturnsOnCall(i int, resul
 	}{result1}
 }
 
-func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
+func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpace(spaceGUID string, labelSelector string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
 	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationsWithProcessesBySpaceReturnsOnCall[len(fake.getApplicationsWithProcessesBySpaceArgsForCall)]
 	fake.getApplicationsWithProcessesBySpaceArgsForCall = append(fake.getApplicationsWithProcessesBySpaceArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{spaceGUID})
+		spaceGUID     string
+		labelSelector string
+	}{spaceGUID, labelSelector})
+	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{spaceGUID, labelSelector})
 	fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
 	if fake.GetApplicationsWithProcessesBySpaceStub != nil {
-		return fake.GetApplicationsWithProcessesBySpaceStub(spaceGUID)
+		return fake.GetApplicationsWithProcessesBySpaceStub(spaceGUID, labelSelector)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -100,10 +102,10 @@ func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceCallCount() int
 	return len(fake.getApplicationsWithProcessesBySpaceArgsForCall)
 }
 
-func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) string {
+func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) (string, string) {
 	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
 	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
-	return fake.getApplicationsWithProcessesBySpaceArgsForCall[i].spaceGUID
+	return fake.getApplicationsWithProcessesBySpaceArgsForCall[i].spaceGUID, fake.getApplicationsWithProcessesBySpaceArgsForCall[i].labelSelector
 }
 
 func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceReturns(result1 []v3action.ApplicationWithProcessSummary, result2 v3action.Warnings, result3 error) {
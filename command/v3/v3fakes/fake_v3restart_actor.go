@@ -9,6 +9,19 @@ import (
 )
 
 type FakeV3RestartActor struct {
+	CancelDeploymentStub        func(deploymentGUID string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -18,6 +31,22 @@ type FakeV3RestartActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CreateDeploymentStub        func(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -34,6 +63,20 @@ type FakeV3RestartActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	PollDeploymentStub        func(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deploymentGUID  string
+		appGUID         string
+		progressChannel chan<- v3action.ProcessSummaries
+		warningsChannel chan<- v3action.Warnings
+	}
+	pollDeploymentReturns struct {
+		result1 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartApplicationStub        func(appGUID string) (v3action.Application, v3action.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -66,6 +109,57 @@ type FakeV3RestartActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3RestartActor) CancelDeployment(deploymentGUID string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeV3RestartActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeV3RestartActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3RestartActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3RestartActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -106,6 +200,61 @@ func (fake *FakeV3RestartActor) CloudControllerAPIVersionReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeV3RestartActor) CreateDeployment(appGUID string, dropletGUID string) (v3action.Deployment, v3action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentReturns.result1, fake.createDeploymentReturns.result2, fake.createDeploymentReturns.result3
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentArgsForCall(i int) (string, string) {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return fake.createDeploymentArgsForCall[i].appGUID, fake.createDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RestartActor) CreateDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3RestartActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -161,6 +310,57 @@ func (fake *FakeV3RestartActor) GetApplicationByNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3RestartActor) PollDeployment(deploymentGUID string, appGUID string, progressChannel chan<- v3action.ProcessSummaries, warningsChannel chan<- v3action.Warnings) error {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deploymentGUID  string
+		appGUID         string
+		progressChannel chan<- v3action.ProcessSummaries
+		warningsChannel chan<- v3action.Warnings
+	}{deploymentGUID, appGUID, progressChannel, warningsChannel})
+	fake.recordInvocation("PollDeployment", []interface{}{deploymentGUID, appGUID, progressChannel, warningsChannel})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deploymentGUID, appGUID, progressChannel, warningsChannel)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollDeploymentReturns.result1
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentArgsForCall(i int) (string, string, chan<- v3action.ProcessSummaries, chan<- v3action.Warnings) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deploymentGUID, fake.pollDeploymentArgsForCall[i].appGUID, fake.pollDeploymentArgsForCall[i].progressChannel, fake.pollDeploymentArgsForCall[i].warningsChannel
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentReturns(result1 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RestartActor) PollDeploymentReturnsOnCall(i int, result1 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3RestartActor) StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -269,10 +469,16 @@ func (fake *FakeV3RestartActor) StopApplicationReturnsOnCall(i int, result1 v3ac
 func (fake *FakeV3RestartActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
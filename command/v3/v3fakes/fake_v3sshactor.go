@@ -36,6 +36,23 @@ type FakeV3SSHActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeStub        func(appName string, spaceGUID string, processType string) ([]v3action.SSHAuthentication, v3action.Warnings, error)
+	getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex       sync.RWMutex
+	getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns struct {
+		result1 []v3action.SSHAuthentication
+		result2 v3action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall map[int]struct {
+		result1 []v3action.SSHAuthentication
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -137,6 +154,62 @@ func (fake *FakeV3SSHActor) GetSecureShellConfigurationByApplicationNameSpacePro
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string) ([]v3action.SSHAuthentication, v3action.Warnings, error) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall[len(fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall)]
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall = append(fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+	}{appName, spaceGUID, processType})
+	fake.recordInvocation("GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType", []interface{}{appName, spaceGUID, processType})
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	if fake.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeStub != nil {
+		return fake.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeStub(appName, spaceGUID, processType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns.result1, fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns.result2, fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns.result3
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeCallCount() int {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall(i int) (string, string, string) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall[i].appName, fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall[i].spaceGUID, fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall[i].processType
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns(result1 []v3action.SSHAuthentication, result2 v3action.Warnings, result3 error) {
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeStub = nil
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns = struct {
+		result1 []v3action.SSHAuthentication
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall(i int, result1 []v3action.SSHAuthentication, result2 v3action.Warnings, result3 error) {
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeStub = nil
+	if fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 []v3action.SSHAuthentication
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturnsOnCall[i] = struct {
+		result1 []v3action.SSHAuthentication
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3SSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -144,6 +217,8 @@ func (fake *FakeV3SSHActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -35,6 +35,13 @@ type TriggerLegacyMain interface {
 	error
 }
 
+// ExitStatus is implemented by errors that exit the CLI with a status other
+// than 1.
+type ExitStatus interface {
+	ExitStatus() int
+	error
+}
+
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
@@ -62,7 +69,7 @@ func parse(args []string) int {
 		fmt.Println()
 		parse([]string{"help", args[0]})
 		return 1
-	} else if exitError, ok := err.(*ssh.ExitError); ok {
+	} else if exitError, ok := err.(ExitStatus); ok {
 		return exitError.ExitStatus()
 	}
 
@@ -127,6 +134,10 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 		parse([]string{"help", originalArgs[0]})
 		return 1
 	case flags.ErrUnknownCommand:
+		if common.Commands.Context != "" {
+			fmt.Fprintf(os.Stderr, "%s\n", translatableerror.ContextOverrideNotSupportedError{}.Error())
+			return 1
+		}
 		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	case flags.ErrCommandRequired:
 		if common.Commands.VerboseOrVersion {
@@ -156,10 +167,14 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Verbose:      common.Commands.VerboseOrVersion,
+		OutputFormat: common.Commands.Output.Format,
+		Context:      common.Commands.Context,
 	})
 	if configErr != nil {
-		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
+		switch configErr.(type) {
+		case translatableerror.EmptyConfigError, translatableerror.ContextNotFoundError:
+		default:
 			return configErr
 		}
 	}
@@ -174,6 +189,10 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		return err
 	}
 
+	if _, ok := configErr.(translatableerror.ContextNotFoundError); ok {
+		return handleError(configErr, commandUI)
+	}
+
 	// TODO: when the line in the old code under `cf` which calls
 	// configv3.LoadConfig() is finally removed, then we should replace the code
 	// path above with the following:
@@ -205,6 +224,12 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		}
 	}()
 
+	if commandUI.IsStructuredOutput() {
+		if structuredCmd, ok := cmd.(command.StructuredOutputCommander); !ok || !structuredCmd.SupportsStructuredOutput() {
+			return handleError(translatableerror.StructuredOutputNotSupportedError{}, commandUI)
+		}
+	}
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
@@ -228,6 +253,11 @@ func handleError(passedErr error, commandUI UI) error {
 
 	switch typedErr := translatedErr.(type) {
 	case TriggerLegacyMain:
+		if common.Commands.Context != "" {
+			commandUI.DisplayError(translatableerror.ContextOverrideNotSupportedError{})
+			return ErrFailed
+		}
+
 		if typedErr.Error() != "" {
 			commandUI.DisplayWarning("")
 			commandUI.DisplayWarning(typedErr.Error())
@@ -260,5 +290,9 @@ func handleError(passedErr error, commandUI UI) error {
 		return ParseErr
 	}
 
+	if exitErr, ok := translatedErr.(ExitStatus); ok {
+		return exitErr
+	}
+
 	return ErrFailed
 }
@@ -0,0 +1,174 @@
+package plugin
+
+import (
+	"errors"
+	"net/rpc"
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+func (c *cliConnection) PluginAPIVersion() (int, error) {
+	var result int
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.PluginAPIVersion", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Applications(spaceGuid string) ([]plugin_models.V3Application, error) {
+	var result []plugin_models.V3Application
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Applications", spaceGuid, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Application(appName string, spaceGuid string) (plugin_models.V3Application, error) {
+	var result plugin_models.V3Application
+
+	cmdArgs := []string{appName, spaceGuid}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Application", cmdArgs, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Processes(appGuid string) ([]plugin_models.V3Process, error) {
+	var result []plugin_models.V3Process
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Processes", appGuid, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Tasks(appGuid string) ([]plugin_models.V3Task, error) {
+	var result []plugin_models.V3Task
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Tasks", appGuid, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Droplets(appGuid string) ([]plugin_models.V3Droplet, error) {
+	var result []plugin_models.V3Droplet
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Droplets", appGuid, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Packages(appGuid string) ([]plugin_models.V3Package, error) {
+	var result []plugin_models.V3Package
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3Packages", appGuid, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3IsolationSegments() ([]plugin_models.V3IsolationSegment, error) {
+	var result []plugin_models.V3IsolationSegment
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetV3IsolationSegments", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) CloudControllerRequest(request plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error) {
+	var result plugin_models.CloudControllerResponse
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.CloudControllerRequest", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) StreamAppLogs(appGuid string) (<-chan plugin_models.LogMessage, <-chan error, func(), error) {
+	client, err := rpc.Dial("tcp", "127.0.0.1:"+c.cliServerPort)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var streamID int
+	err = client.Call("CliRpcCmdV2.StartAppLogStream", appGuid, &streamID)
+	if err != nil {
+		client.Close()
+		return nil, nil, nil, err
+	}
+
+	messages := make(chan plugin_models.LogMessage)
+	errs := make(chan error)
+	stopped := make(chan struct{})
+
+	var stopOnce sync.Once
+	stop := func() {
+		stopOnce.Do(func() { close(stopped) })
+	}
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+		defer client.Close()
+		defer func() {
+			var success bool
+			_ = client.Call("CliRpcCmdV2.StopAppLogStream", streamID, &success)
+		}()
+
+		for {
+			var batch plugin_models.LogMessageBatch
+			err := client.Call("CliRpcCmdV2.ReadAppLogs", streamID, &batch)
+			if err != nil {
+				select {
+				case errs <- err:
+				case <-stopped:
+				}
+				return
+			}
+
+			for _, message := range batch.Messages {
+				select {
+				case messages <- message:
+				case <-stopped:
+					return
+				}
+			}
+
+			for _, streamErr := range batch.Errors {
+				select {
+				case errs <- errors.New(streamErr):
+				case <-stopped:
+					return
+				}
+			}
+
+			if batch.Done {
+				return
+			}
+
+			select {
+			case <-stopped:
+				return
+			default:
+			}
+		}
+	}()
+
+	return messages, errs, stop, nil
+}
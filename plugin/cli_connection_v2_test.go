@@ -0,0 +1,122 @@
+package plugin_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/util/testhelpers/rpcserver"
+	"code.cloudfoundry.org/cli/cf/util/testhelpers/rpcserver/rpcserverfakes"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CliConnectionV2", func() {
+	var (
+		ts            *rpcserver.TestServer
+		rpcHandlers   *rpcserverfakes.FakeHandlersV2
+		cliConnection plugin.CliConnectionV2
+	)
+
+	BeforeEach(func() {
+		var err error
+		ts, err = rpcserver.NewTestRPCServer(new(rpcserverfakes.FakeHandlers))
+		Expect(err).ToNot(HaveOccurred())
+
+		rpcHandlers = new(rpcserverfakes.FakeHandlersV2)
+		err = ts.RegisterV2(rpcHandlers)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ts.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		var connection plugin.CliConnection = plugin.NewCliConnection(ts.Port())
+		var ok bool
+		cliConnection, ok = connection.(plugin.CliConnectionV2)
+		Expect(ok).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		ts.Stop()
+	})
+
+	Describe("PluginAPIVersion", func() {
+		It("returns the version of the plugin API served by the CLI", func() {
+			rpcHandlers.PluginAPIVersionStub = func(_ string, retVal *int) error {
+				*retVal = plugin.PluginAPIVersion
+				return nil
+			}
+
+			version, err := cliConnection.PluginAPIVersion()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(plugin.PluginAPIVersion))
+		})
+	})
+
+	Describe("GetV3Processes", func() {
+		It("returns the processes of the app", func() {
+			rpcHandlers.GetV3ProcessesStub = func(_ string, retVal *[]plugin_models.V3Process) error {
+				*retVal = []plugin_models.V3Process{{Guid: "process-guid", Type: "web"}}
+				return nil
+			}
+
+			processes, err := cliConnection.GetV3Processes("app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(processes).To(ConsistOf(plugin_models.V3Process{Guid: "process-guid", Type: "web"}))
+
+			Expect(rpcHandlers.GetV3ProcessesCallCount()).To(Equal(1))
+			appGUID, _ := rpcHandlers.GetV3ProcessesArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+		})
+	})
+
+	Describe("StreamAppLogs", func() {
+		BeforeEach(func() {
+			rpcHandlers.StartAppLogStreamStub = func(_ string, retVal *int) error {
+				*retVal = 1
+				return nil
+			}
+		})
+
+		It("streams log messages until the stream ends", func() {
+			rpcHandlers.ReadAppLogsStub = func(_ int, retVal *plugin_models.LogMessageBatch) error {
+				*retVal = plugin_models.LogMessageBatch{
+					Messages: []plugin_models.LogMessage{{Message: "some-message"}},
+					Done:     true,
+				}
+				return nil
+			}
+
+			logs, logErrs, stop, err := cliConnection.StreamAppLogs("app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			defer stop()
+
+			var message plugin_models.LogMessage
+			Eventually(logs, 5*time.Second).Should(Receive(&message))
+			Expect(message.Message).To(Equal("some-message"))
+
+			Eventually(logs, 5*time.Second).Should(BeClosed())
+			Eventually(logErrs, 5*time.Second).Should(BeClosed())
+
+			Eventually(rpcHandlers.StopAppLogStreamCallCount).Should(Equal(1))
+			streamID, _ := rpcHandlers.StopAppLogStreamArgsForCall(0)
+			Expect(streamID).To(Equal(1))
+		})
+
+		It("closes the channels when stopped", func() {
+			rpcHandlers.ReadAppLogsStub = func(_ int, retVal *plugin_models.LogMessageBatch) error {
+				time.Sleep(10 * time.Millisecond)
+				*retVal = plugin_models.LogMessageBatch{}
+				return nil
+			}
+
+			logs, logErrs, stop, err := cliConnection.StreamAppLogs("app-guid")
+			Expect(err).ToNot(HaveOccurred())
+
+			stop()
+
+			Eventually(logs, 5*time.Second).Should(BeClosed())
+			Eventually(logErrs, 5*time.Second).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package plugin_models
+
+type CloudControllerRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+type CloudControllerResponse struct {
+	StatusCode int
+	Body       []byte
+	Warnings   []string
+}
@@ -0,0 +1,19 @@
+package plugin_models
+
+import "time"
+
+type LogMessage struct {
+	Message        string
+	Type           string
+	Timestamp      time.Time
+	SourceType     string
+	SourceInstance string
+}
+
+// LogMessageBatch is the set of log messages and errors read from a log
+// stream in a single call. Done is set once the stream has ended.
+type LogMessageBatch struct {
+	Messages []LogMessage
+	Errors   []string
+	Done     bool
+}
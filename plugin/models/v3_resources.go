@@ -0,0 +1,55 @@
+package plugin_models
+
+type V3Application struct {
+	Guid          string
+	Name          string
+	State         string
+	LifecycleType string
+	Buildpacks    []string
+	SpaceGuid     string
+}
+
+type V3Process struct {
+	Guid                         string
+	Type                         string
+	Instances                    int
+	MemoryInMB                   uint64
+	DiskInMB                     uint64
+	HealthCheckType              string
+	HealthCheckEndpoint          string
+	HealthCheckInvocationTimeout int
+}
+
+type V3Task struct {
+	Guid          string
+	Name          string
+	SequenceId    int
+	Command       string
+	State         string
+	MemoryInMB    uint64
+	DiskInMB      uint64
+	FailureReason string
+	CreatedAt     string
+}
+
+type V3Droplet struct {
+	Guid       string
+	State      string
+	Stack      string
+	Image      string
+	Buildpacks []string
+	CreatedAt  string
+}
+
+type V3Package struct {
+	Guid        string
+	Type        string
+	State       string
+	DockerImage string
+	CreatedAt   string
+}
+
+type V3IsolationSegment struct {
+	Guid string
+	Name string
+}
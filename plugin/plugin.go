@@ -47,6 +47,39 @@ type CliConnection interface {
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
 }
 
+// PluginAPIVersion is the version of the plugin API served over
+// CliConnectionV2.
+const PluginAPIVersion = 2
+
+//go:generate counterfeiter . CliConnectionV2
+/**
+	CliConnectionV2 adds access to V3 resources, authenticated Cloud Controller
+	requests and app log streaming to CliConnection. The CliConnection passed
+	into Run implements it when the CLI serves version 2 or later of the plugin
+	API; call PluginAPIVersion to check before using the other methods, as it
+	returns an error on CLIs that do not.
+**/
+type CliConnectionV2 interface {
+	CliConnection
+	PluginAPIVersion() (int, error)
+	GetV3Applications(spaceGuid string) ([]plugin_models.V3Application, error)
+	GetV3Application(appName string, spaceGuid string) (plugin_models.V3Application, error)
+	GetV3Processes(appGuid string) ([]plugin_models.V3Process, error)
+	GetV3Tasks(appGuid string) ([]plugin_models.V3Task, error)
+	GetV3Droplets(appGuid string) ([]plugin_models.V3Droplet, error)
+	GetV3Packages(appGuid string) ([]plugin_models.V3Package, error)
+	GetV3IsolationSegments() ([]plugin_models.V3IsolationSegment, error)
+	// CloudControllerRequest sends a request to the targeted Cloud Controller
+	// using the CLI's access token, refreshing it if it has expired. Path is
+	// relative to the API endpoint, for example "/v3/apps". Responses with an
+	// error status code are returned without an error.
+	CloudControllerRequest(request plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error)
+	// StreamAppLogs streams the logs of the app with the given GUID until the
+	// returned stop function is called. Both channels are closed once the
+	// stream has ended.
+	StreamAppLogs(appGuid string) (<-chan plugin_models.LogMessage, <-chan error, func(), error)
+}
+
 type VersionType struct {
 	Major int
 	Minor int
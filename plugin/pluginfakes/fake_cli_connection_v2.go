@@ -0,0 +1,2286 @@
+// This file was generated by counterfeiter
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+type FakeCliConnectionV2 struct {
+	CliCommandWithoutTerminalOutputStub        func(args ...string) ([]string, error)
+	cliCommandWithoutTerminalOutputMutex       sync.RWMutex
+	cliCommandWithoutTerminalOutputArgsForCall []struct {
+		args []string
+	}
+	cliCommandWithoutTerminalOutputReturns struct {
+		result1 []string
+		result2 error
+	}
+	cliCommandWithoutTerminalOutputReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CliCommandStub        func(args ...string) ([]string, error)
+	cliCommandMutex       sync.RWMutex
+	cliCommandArgsForCall []struct {
+		args []string
+	}
+	cliCommandReturns struct {
+		result1 []string
+		result2 error
+	}
+	cliCommandReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetCurrentOrgStub        func() (plugin_models.Organization, error)
+	getCurrentOrgMutex       sync.RWMutex
+	getCurrentOrgArgsForCall []struct{}
+	getCurrentOrgReturns     struct {
+		result1 plugin_models.Organization
+		result2 error
+	}
+	getCurrentOrgReturnsOnCall map[int]struct {
+		result1 plugin_models.Organization
+		result2 error
+	}
+	GetCurrentSpaceStub        func() (plugin_models.Space, error)
+	getCurrentSpaceMutex       sync.RWMutex
+	getCurrentSpaceArgsForCall []struct{}
+	getCurrentSpaceReturns     struct {
+		result1 plugin_models.Space
+		result2 error
+	}
+	getCurrentSpaceReturnsOnCall map[int]struct {
+		result1 plugin_models.Space
+		result2 error
+	}
+	UsernameStub        func() (string, error)
+	usernameMutex       sync.RWMutex
+	usernameArgsForCall []struct{}
+	usernameReturns     struct {
+		result1 string
+		result2 error
+	}
+	usernameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	UserGuidStub        func() (string, error)
+	userGuidMutex       sync.RWMutex
+	userGuidArgsForCall []struct{}
+	userGuidReturns     struct {
+		result1 string
+		result2 error
+	}
+	userGuidReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	UserEmailStub        func() (string, error)
+	userEmailMutex       sync.RWMutex
+	userEmailArgsForCall []struct{}
+	userEmailReturns     struct {
+		result1 string
+		result2 error
+	}
+	userEmailReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	IsLoggedInStub        func() (bool, error)
+	isLoggedInMutex       sync.RWMutex
+	isLoggedInArgsForCall []struct{}
+	isLoggedInReturns     struct {
+		result1 bool
+		result2 error
+	}
+	isLoggedInReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IsSSLDisabledStub        func() (bool, error)
+	isSSLDisabledMutex       sync.RWMutex
+	isSSLDisabledArgsForCall []struct{}
+	isSSLDisabledReturns     struct {
+		result1 bool
+		result2 error
+	}
+	isSSLDisabledReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	HasOrganizationStub        func() (bool, error)
+	hasOrganizationMutex       sync.RWMutex
+	hasOrganizationArgsForCall []struct{}
+	hasOrganizationReturns     struct {
+		result1 bool
+		result2 error
+	}
+	hasOrganizationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	HasSpaceStub        func() (bool, error)
+	hasSpaceMutex       sync.RWMutex
+	hasSpaceArgsForCall []struct{}
+	hasSpaceReturns     struct {
+		result1 bool
+		result2 error
+	}
+	hasSpaceReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ApiEndpointStub        func() (string, error)
+	apiEndpointMutex       sync.RWMutex
+	apiEndpointArgsForCall []struct{}
+	apiEndpointReturns     struct {
+		result1 string
+		result2 error
+	}
+	apiEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ApiVersionStub        func() (string, error)
+	apiVersionMutex       sync.RWMutex
+	apiVersionArgsForCall []struct{}
+	apiVersionReturns     struct {
+		result1 string
+		result2 error
+	}
+	apiVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	HasAPIEndpointStub        func() (bool, error)
+	hasAPIEndpointMutex       sync.RWMutex
+	hasAPIEndpointArgsForCall []struct{}
+	hasAPIEndpointReturns     struct {
+		result1 bool
+		result2 error
+	}
+	hasAPIEndpointReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	LoggregatorEndpointStub        func() (string, error)
+	loggregatorEndpointMutex       sync.RWMutex
+	loggregatorEndpointArgsForCall []struct{}
+	loggregatorEndpointReturns     struct {
+		result1 string
+		result2 error
+	}
+	loggregatorEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DopplerEndpointStub        func() (string, error)
+	dopplerEndpointMutex       sync.RWMutex
+	dopplerEndpointArgsForCall []struct{}
+	dopplerEndpointReturns     struct {
+		result1 string
+		result2 error
+	}
+	dopplerEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AccessTokenStub        func() (string, error)
+	accessTokenMutex       sync.RWMutex
+	accessTokenArgsForCall []struct{}
+	accessTokenReturns     struct {
+		result1 string
+		result2 error
+	}
+	accessTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetAppStub        func(arg1 string) (plugin_models.GetAppModel, error)
+	getAppMutex       sync.RWMutex
+	getAppArgsForCall []struct {
+		arg1 string
+	}
+	getAppReturns struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}
+	getAppReturnsOnCall map[int]struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}
+	GetAppsStub        func() ([]plugin_models.GetAppsModel, error)
+	getAppsMutex       sync.RWMutex
+	getAppsArgsForCall []struct{}
+	getAppsReturns     struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}
+	getAppsReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}
+	GetOrgsStub        func() ([]plugin_models.GetOrgs_Model, error)
+	getOrgsMutex       sync.RWMutex
+	getOrgsArgsForCall []struct{}
+	getOrgsReturns     struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}
+	getOrgsReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}
+	GetSpacesStub        func() ([]plugin_models.GetSpaces_Model, error)
+	getSpacesMutex       sync.RWMutex
+	getSpacesArgsForCall []struct{}
+	getSpacesReturns     struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}
+	GetOrgUsersStub        func(arg1 string, arg2 ...string) ([]plugin_models.GetOrgUsers_Model, error)
+	getOrgUsersMutex       sync.RWMutex
+	getOrgUsersArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	getOrgUsersReturns struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}
+	getOrgUsersReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}
+	GetSpaceUsersStub        func(arg1 string, arg2 string) ([]plugin_models.GetSpaceUsers_Model, error)
+	getSpaceUsersMutex       sync.RWMutex
+	getSpaceUsersArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceUsersReturns struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}
+	getSpaceUsersReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}
+	GetServicesStub        func() ([]plugin_models.GetServices_Model, error)
+	getServicesMutex       sync.RWMutex
+	getServicesArgsForCall []struct{}
+	getServicesReturns     struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}
+	GetServiceStub        func(arg1 string) (plugin_models.GetService_Model, error)
+	getServiceMutex       sync.RWMutex
+	getServiceArgsForCall []struct {
+		arg1 string
+	}
+	getServiceReturns struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}
+	GetOrgStub        func(arg1 string) (plugin_models.GetOrg_Model, error)
+	getOrgMutex       sync.RWMutex
+	getOrgArgsForCall []struct {
+		arg1 string
+	}
+	getOrgReturns struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}
+	getOrgReturnsOnCall map[int]struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}
+	GetSpaceStub        func(arg1 string) (plugin_models.GetSpace_Model, error)
+	getSpaceMutex       sync.RWMutex
+	getSpaceArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceReturns struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}
+	getSpaceReturnsOnCall map[int]struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}
+	PluginAPIVersionStub        func() (int, error)
+	pluginAPIVersionMutex       sync.RWMutex
+	pluginAPIVersionArgsForCall []struct{}
+	pluginAPIVersionReturns     struct {
+		result1 int
+		result2 error
+	}
+	pluginAPIVersionReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	GetV3ApplicationsStub        func(spaceGuid string) ([]plugin_models.V3Application, error)
+	getV3ApplicationsMutex       sync.RWMutex
+	getV3ApplicationsArgsForCall []struct {
+		spaceGuid string
+	}
+	getV3ApplicationsReturns struct {
+		result1 []plugin_models.V3Application
+		result2 error
+	}
+	getV3ApplicationsReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3Application
+		result2 error
+	}
+	GetV3ApplicationStub        func(appName string, spaceGuid string) (plugin_models.V3Application, error)
+	getV3ApplicationMutex       sync.RWMutex
+	getV3ApplicationArgsForCall []struct {
+		appName   string
+		spaceGuid string
+	}
+	getV3ApplicationReturns struct {
+		result1 plugin_models.V3Application
+		result2 error
+	}
+	getV3ApplicationReturnsOnCall map[int]struct {
+		result1 plugin_models.V3Application
+		result2 error
+	}
+	GetV3ProcessesStub        func(appGuid string) ([]plugin_models.V3Process, error)
+	getV3ProcessesMutex       sync.RWMutex
+	getV3ProcessesArgsForCall []struct {
+		appGuid string
+	}
+	getV3ProcessesReturns struct {
+		result1 []plugin_models.V3Process
+		result2 error
+	}
+	getV3ProcessesReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3Process
+		result2 error
+	}
+	GetV3TasksStub        func(appGuid string) ([]plugin_models.V3Task, error)
+	getV3TasksMutex       sync.RWMutex
+	getV3TasksArgsForCall []struct {
+		appGuid string
+	}
+	getV3TasksReturns struct {
+		result1 []plugin_models.V3Task
+		result2 error
+	}
+	getV3TasksReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3Task
+		result2 error
+	}
+	GetV3DropletsStub        func(appGuid string) ([]plugin_models.V3Droplet, error)
+	getV3DropletsMutex       sync.RWMutex
+	getV3DropletsArgsForCall []struct {
+		appGuid string
+	}
+	getV3DropletsReturns struct {
+		result1 []plugin_models.V3Droplet
+		result2 error
+	}
+	getV3DropletsReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3Droplet
+		result2 error
+	}
+	GetV3PackagesStub        func(appGuid string) ([]plugin_models.V3Package, error)
+	getV3PackagesMutex       sync.RWMutex
+	getV3PackagesArgsForCall []struct {
+		appGuid string
+	}
+	getV3PackagesReturns struct {
+		result1 []plugin_models.V3Package
+		result2 error
+	}
+	getV3PackagesReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3Package
+		result2 error
+	}
+	GetV3IsolationSegmentsStub        func() ([]plugin_models.V3IsolationSegment, error)
+	getV3IsolationSegmentsMutex       sync.RWMutex
+	getV3IsolationSegmentsArgsForCall []struct{}
+	getV3IsolationSegmentsReturns     struct {
+		result1 []plugin_models.V3IsolationSegment
+		result2 error
+	}
+	getV3IsolationSegmentsReturnsOnCall map[int]struct {
+		result1 []plugin_models.V3IsolationSegment
+		result2 error
+	}
+	CloudControllerRequestStub        func(request plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error)
+	cloudControllerRequestMutex       sync.RWMutex
+	cloudControllerRequestArgsForCall []struct {
+		request plugin_models.CloudControllerRequest
+	}
+	cloudControllerRequestReturns struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}
+	cloudControllerRequestReturnsOnCall map[int]struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}
+	StreamAppLogsStub        func(appGuid string) (<-chan plugin_models.LogMessage, <-chan error, func(), error)
+	streamAppLogsMutex       sync.RWMutex
+	streamAppLogsArgsForCall []struct {
+		appGuid string
+	}
+	streamAppLogsReturns struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 func()
+		result4 error
+	}
+	streamAppLogsReturnsOnCall map[int]struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 func()
+		result4 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCliConnectionV2) CliCommandWithoutTerminalOutput(args ...string) ([]string, error) {
+	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	ret, specificReturn := fake.cliCommandWithoutTerminalOutputReturnsOnCall[len(fake.cliCommandWithoutTerminalOutputArgsForCall)]
+	fake.cliCommandWithoutTerminalOutputArgsForCall = append(fake.cliCommandWithoutTerminalOutputArgsForCall, struct {
+		args []string
+	}{args})
+	fake.recordInvocation("CliCommandWithoutTerminalOutput", []interface{}{args})
+	fake.cliCommandWithoutTerminalOutputMutex.Unlock()
+	if fake.CliCommandWithoutTerminalOutputStub != nil {
+		return fake.CliCommandWithoutTerminalOutputStub(args...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cliCommandWithoutTerminalOutputReturns.result1, fake.cliCommandWithoutTerminalOutputReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) CliCommandWithoutTerminalOutputCallCount() int {
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	return len(fake.cliCommandWithoutTerminalOutputArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) CliCommandWithoutTerminalOutputArgsForCall(i int) []string {
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	return fake.cliCommandWithoutTerminalOutputArgsForCall[i].args
+}
+
+func (fake *FakeCliConnectionV2) CliCommandWithoutTerminalOutputReturns(result1 []string, result2 error) {
+	fake.CliCommandWithoutTerminalOutputStub = nil
+	fake.cliCommandWithoutTerminalOutputReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) CliCommandWithoutTerminalOutputReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.CliCommandWithoutTerminalOutputStub = nil
+	if fake.cliCommandWithoutTerminalOutputReturnsOnCall == nil {
+		fake.cliCommandWithoutTerminalOutputReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandWithoutTerminalOutputReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) CliCommand(args ...string) ([]string, error) {
+	fake.cliCommandMutex.Lock()
+	ret, specificReturn := fake.cliCommandReturnsOnCall[len(fake.cliCommandArgsForCall)]
+	fake.cliCommandArgsForCall = append(fake.cliCommandArgsForCall, struct {
+		args []string
+	}{args})
+	fake.recordInvocation("CliCommand", []interface{}{args})
+	fake.cliCommandMutex.Unlock()
+	if fake.CliCommandStub != nil {
+		return fake.CliCommandStub(args...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cliCommandReturns.result1, fake.cliCommandReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) CliCommandCallCount() int {
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	return len(fake.cliCommandArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) CliCommandArgsForCall(i int) []string {
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	return fake.cliCommandArgsForCall[i].args
+}
+
+func (fake *FakeCliConnectionV2) CliCommandReturns(result1 []string, result2 error) {
+	fake.CliCommandStub = nil
+	fake.cliCommandReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) CliCommandReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.CliCommandStub = nil
+	if fake.cliCommandReturnsOnCall == nil {
+		fake.cliCommandReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentOrg() (plugin_models.Organization, error) {
+	fake.getCurrentOrgMutex.Lock()
+	ret, specificReturn := fake.getCurrentOrgReturnsOnCall[len(fake.getCurrentOrgArgsForCall)]
+	fake.getCurrentOrgArgsForCall = append(fake.getCurrentOrgArgsForCall, struct{}{})
+	fake.recordInvocation("GetCurrentOrg", []interface{}{})
+	fake.getCurrentOrgMutex.Unlock()
+	if fake.GetCurrentOrgStub != nil {
+		return fake.GetCurrentOrgStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCurrentOrgReturns.result1, fake.getCurrentOrgReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentOrgCallCount() int {
+	fake.getCurrentOrgMutex.RLock()
+	defer fake.getCurrentOrgMutex.RUnlock()
+	return len(fake.getCurrentOrgArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentOrgReturns(result1 plugin_models.Organization, result2 error) {
+	fake.GetCurrentOrgStub = nil
+	fake.getCurrentOrgReturns = struct {
+		result1 plugin_models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentOrgReturnsOnCall(i int, result1 plugin_models.Organization, result2 error) {
+	fake.GetCurrentOrgStub = nil
+	if fake.getCurrentOrgReturnsOnCall == nil {
+		fake.getCurrentOrgReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.Organization
+			result2 error
+		})
+	}
+	fake.getCurrentOrgReturnsOnCall[i] = struct {
+		result1 plugin_models.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentSpace() (plugin_models.Space, error) {
+	fake.getCurrentSpaceMutex.Lock()
+	ret, specificReturn := fake.getCurrentSpaceReturnsOnCall[len(fake.getCurrentSpaceArgsForCall)]
+	fake.getCurrentSpaceArgsForCall = append(fake.getCurrentSpaceArgsForCall, struct{}{})
+	fake.recordInvocation("GetCurrentSpace", []interface{}{})
+	fake.getCurrentSpaceMutex.Unlock()
+	if fake.GetCurrentSpaceStub != nil {
+		return fake.GetCurrentSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCurrentSpaceReturns.result1, fake.getCurrentSpaceReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentSpaceCallCount() int {
+	fake.getCurrentSpaceMutex.RLock()
+	defer fake.getCurrentSpaceMutex.RUnlock()
+	return len(fake.getCurrentSpaceArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentSpaceReturns(result1 plugin_models.Space, result2 error) {
+	fake.GetCurrentSpaceStub = nil
+	fake.getCurrentSpaceReturns = struct {
+		result1 plugin_models.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetCurrentSpaceReturnsOnCall(i int, result1 plugin_models.Space, result2 error) {
+	fake.GetCurrentSpaceStub = nil
+	if fake.getCurrentSpaceReturnsOnCall == nil {
+		fake.getCurrentSpaceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.Space
+			result2 error
+		})
+	}
+	fake.getCurrentSpaceReturnsOnCall[i] = struct {
+		result1 plugin_models.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) Username() (string, error) {
+	fake.usernameMutex.Lock()
+	ret, specificReturn := fake.usernameReturnsOnCall[len(fake.usernameArgsForCall)]
+	fake.usernameArgsForCall = append(fake.usernameArgsForCall, struct{}{})
+	fake.recordInvocation("Username", []interface{}{})
+	fake.usernameMutex.Unlock()
+	if fake.UsernameStub != nil {
+		return fake.UsernameStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.usernameReturns.result1, fake.usernameReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) UsernameCallCount() int {
+	fake.usernameMutex.RLock()
+	defer fake.usernameMutex.RUnlock()
+	return len(fake.usernameArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) UsernameReturns(result1 string, result2 error) {
+	fake.UsernameStub = nil
+	fake.usernameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) UsernameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UsernameStub = nil
+	if fake.usernameReturnsOnCall == nil {
+		fake.usernameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.usernameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) UserGuid() (string, error) {
+	fake.userGuidMutex.Lock()
+	ret, specificReturn := fake.userGuidReturnsOnCall[len(fake.userGuidArgsForCall)]
+	fake.userGuidArgsForCall = append(fake.userGuidArgsForCall, struct{}{})
+	fake.recordInvocation("UserGuid", []interface{}{})
+	fake.userGuidMutex.Unlock()
+	if fake.UserGuidStub != nil {
+		return fake.UserGuidStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.userGuidReturns.result1, fake.userGuidReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) UserGuidCallCount() int {
+	fake.userGuidMutex.RLock()
+	defer fake.userGuidMutex.RUnlock()
+	return len(fake.userGuidArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) UserGuidReturns(result1 string, result2 error) {
+	fake.UserGuidStub = nil
+	fake.userGuidReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) UserGuidReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UserGuidStub = nil
+	if fake.userGuidReturnsOnCall == nil {
+		fake.userGuidReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.userGuidReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) UserEmail() (string, error) {
+	fake.userEmailMutex.Lock()
+	ret, specificReturn := fake.userEmailReturnsOnCall[len(fake.userEmailArgsForCall)]
+	fake.userEmailArgsForCall = append(fake.userEmailArgsForCall, struct{}{})
+	fake.recordInvocation("UserEmail", []interface{}{})
+	fake.userEmailMutex.Unlock()
+	if fake.UserEmailStub != nil {
+		return fake.UserEmailStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.userEmailReturns.result1, fake.userEmailReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) UserEmailCallCount() int {
+	fake.userEmailMutex.RLock()
+	defer fake.userEmailMutex.RUnlock()
+	return len(fake.userEmailArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) UserEmailReturns(result1 string, result2 error) {
+	fake.UserEmailStub = nil
+	fake.userEmailReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) UserEmailReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UserEmailStub = nil
+	if fake.userEmailReturnsOnCall == nil {
+		fake.userEmailReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.userEmailReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) IsLoggedIn() (bool, error) {
+	fake.isLoggedInMutex.Lock()
+	ret, specificReturn := fake.isLoggedInReturnsOnCall[len(fake.isLoggedInArgsForCall)]
+	fake.isLoggedInArgsForCall = append(fake.isLoggedInArgsForCall, struct{}{})
+	fake.recordInvocation("IsLoggedIn", []interface{}{})
+	fake.isLoggedInMutex.Unlock()
+	if fake.IsLoggedInStub != nil {
+		return fake.IsLoggedInStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.isLoggedInReturns.result1, fake.isLoggedInReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) IsLoggedInCallCount() int {
+	fake.isLoggedInMutex.RLock()
+	defer fake.isLoggedInMutex.RUnlock()
+	return len(fake.isLoggedInArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) IsLoggedInReturns(result1 bool, result2 error) {
+	fake.IsLoggedInStub = nil
+	fake.isLoggedInReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) IsLoggedInReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IsLoggedInStub = nil
+	if fake.isLoggedInReturnsOnCall == nil {
+		fake.isLoggedInReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isLoggedInReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) IsSSLDisabled() (bool, error) {
+	fake.isSSLDisabledMutex.Lock()
+	ret, specificReturn := fake.isSSLDisabledReturnsOnCall[len(fake.isSSLDisabledArgsForCall)]
+	fake.isSSLDisabledArgsForCall = append(fake.isSSLDisabledArgsForCall, struct{}{})
+	fake.recordInvocation("IsSSLDisabled", []interface{}{})
+	fake.isSSLDisabledMutex.Unlock()
+	if fake.IsSSLDisabledStub != nil {
+		return fake.IsSSLDisabledStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.isSSLDisabledReturns.result1, fake.isSSLDisabledReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) IsSSLDisabledCallCount() int {
+	fake.isSSLDisabledMutex.RLock()
+	defer fake.isSSLDisabledMutex.RUnlock()
+	return len(fake.isSSLDisabledArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) IsSSLDisabledReturns(result1 bool, result2 error) {
+	fake.IsSSLDisabledStub = nil
+	fake.isSSLDisabledReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) IsSSLDisabledReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IsSSLDisabledStub = nil
+	if fake.isSSLDisabledReturnsOnCall == nil {
+		fake.isSSLDisabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isSSLDisabledReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasOrganization() (bool, error) {
+	fake.hasOrganizationMutex.Lock()
+	ret, specificReturn := fake.hasOrganizationReturnsOnCall[len(fake.hasOrganizationArgsForCall)]
+	fake.hasOrganizationArgsForCall = append(fake.hasOrganizationArgsForCall, struct{}{})
+	fake.recordInvocation("HasOrganization", []interface{}{})
+	fake.hasOrganizationMutex.Unlock()
+	if fake.HasOrganizationStub != nil {
+		return fake.HasOrganizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasOrganizationReturns.result1, fake.hasOrganizationReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) HasOrganizationCallCount() int {
+	fake.hasOrganizationMutex.RLock()
+	defer fake.hasOrganizationMutex.RUnlock()
+	return len(fake.hasOrganizationArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) HasOrganizationReturns(result1 bool, result2 error) {
+	fake.HasOrganizationStub = nil
+	fake.hasOrganizationReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasOrganizationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasOrganizationStub = nil
+	if fake.hasOrganizationReturnsOnCall == nil {
+		fake.hasOrganizationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasOrganizationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasSpace() (bool, error) {
+	fake.hasSpaceMutex.Lock()
+	ret, specificReturn := fake.hasSpaceReturnsOnCall[len(fake.hasSpaceArgsForCall)]
+	fake.hasSpaceArgsForCall = append(fake.hasSpaceArgsForCall, struct{}{})
+	fake.recordInvocation("HasSpace", []interface{}{})
+	fake.hasSpaceMutex.Unlock()
+	if fake.HasSpaceStub != nil {
+		return fake.HasSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasSpaceReturns.result1, fake.hasSpaceReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) HasSpaceCallCount() int {
+	fake.hasSpaceMutex.RLock()
+	defer fake.hasSpaceMutex.RUnlock()
+	return len(fake.hasSpaceArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) HasSpaceReturns(result1 bool, result2 error) {
+	fake.HasSpaceStub = nil
+	fake.hasSpaceReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasSpaceReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasSpaceStub = nil
+	if fake.hasSpaceReturnsOnCall == nil {
+		fake.hasSpaceReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasSpaceReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) ApiEndpoint() (string, error) {
+	fake.apiEndpointMutex.Lock()
+	ret, specificReturn := fake.apiEndpointReturnsOnCall[len(fake.apiEndpointArgsForCall)]
+	fake.apiEndpointArgsForCall = append(fake.apiEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("ApiEndpoint", []interface{}{})
+	fake.apiEndpointMutex.Unlock()
+	if fake.ApiEndpointStub != nil {
+		return fake.ApiEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.apiEndpointReturns.result1, fake.apiEndpointReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) ApiEndpointCallCount() int {
+	fake.apiEndpointMutex.RLock()
+	defer fake.apiEndpointMutex.RUnlock()
+	return len(fake.apiEndpointArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) ApiEndpointReturns(result1 string, result2 error) {
+	fake.ApiEndpointStub = nil
+	fake.apiEndpointReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) ApiEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ApiEndpointStub = nil
+	if fake.apiEndpointReturnsOnCall == nil {
+		fake.apiEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.apiEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) ApiVersion() (string, error) {
+	fake.apiVersionMutex.Lock()
+	ret, specificReturn := fake.apiVersionReturnsOnCall[len(fake.apiVersionArgsForCall)]
+	fake.apiVersionArgsForCall = append(fake.apiVersionArgsForCall, struct{}{})
+	fake.recordInvocation("ApiVersion", []interface{}{})
+	fake.apiVersionMutex.Unlock()
+	if fake.ApiVersionStub != nil {
+		return fake.ApiVersionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.apiVersionReturns.result1, fake.apiVersionReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) ApiVersionCallCount() int {
+	fake.apiVersionMutex.RLock()
+	defer fake.apiVersionMutex.RUnlock()
+	return len(fake.apiVersionArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) ApiVersionReturns(result1 string, result2 error) {
+	fake.ApiVersionStub = nil
+	fake.apiVersionReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) ApiVersionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ApiVersionStub = nil
+	if fake.apiVersionReturnsOnCall == nil {
+		fake.apiVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.apiVersionReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasAPIEndpoint() (bool, error) {
+	fake.hasAPIEndpointMutex.Lock()
+	ret, specificReturn := fake.hasAPIEndpointReturnsOnCall[len(fake.hasAPIEndpointArgsForCall)]
+	fake.hasAPIEndpointArgsForCall = append(fake.hasAPIEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("HasAPIEndpoint", []interface{}{})
+	fake.hasAPIEndpointMutex.Unlock()
+	if fake.HasAPIEndpointStub != nil {
+		return fake.HasAPIEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasAPIEndpointReturns.result1, fake.hasAPIEndpointReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) HasAPIEndpointCallCount() int {
+	fake.hasAPIEndpointMutex.RLock()
+	defer fake.hasAPIEndpointMutex.RUnlock()
+	return len(fake.hasAPIEndpointArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) HasAPIEndpointReturns(result1 bool, result2 error) {
+	fake.HasAPIEndpointStub = nil
+	fake.hasAPIEndpointReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) HasAPIEndpointReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasAPIEndpointStub = nil
+	if fake.hasAPIEndpointReturnsOnCall == nil {
+		fake.hasAPIEndpointReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasAPIEndpointReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) LoggregatorEndpoint() (string, error) {
+	fake.loggregatorEndpointMutex.Lock()
+	ret, specificReturn := fake.loggregatorEndpointReturnsOnCall[len(fake.loggregatorEndpointArgsForCall)]
+	fake.loggregatorEndpointArgsForCall = append(fake.loggregatorEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("LoggregatorEndpoint", []interface{}{})
+	fake.loggregatorEndpointMutex.Unlock()
+	if fake.LoggregatorEndpointStub != nil {
+		return fake.LoggregatorEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.loggregatorEndpointReturns.result1, fake.loggregatorEndpointReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) LoggregatorEndpointCallCount() int {
+	fake.loggregatorEndpointMutex.RLock()
+	defer fake.loggregatorEndpointMutex.RUnlock()
+	return len(fake.loggregatorEndpointArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) LoggregatorEndpointReturns(result1 string, result2 error) {
+	fake.LoggregatorEndpointStub = nil
+	fake.loggregatorEndpointReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) LoggregatorEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.LoggregatorEndpointStub = nil
+	if fake.loggregatorEndpointReturnsOnCall == nil {
+		fake.loggregatorEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.loggregatorEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) DopplerEndpoint() (string, error) {
+	fake.dopplerEndpointMutex.Lock()
+	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
+	fake.dopplerEndpointArgsForCall = append(fake.dopplerEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("DopplerEndpoint", []interface{}{})
+	fake.dopplerEndpointMutex.Unlock()
+	if fake.DopplerEndpointStub != nil {
+		return fake.DopplerEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.dopplerEndpointReturns.result1, fake.dopplerEndpointReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) DopplerEndpointCallCount() int {
+	fake.dopplerEndpointMutex.RLock()
+	defer fake.dopplerEndpointMutex.RUnlock()
+	return len(fake.dopplerEndpointArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) DopplerEndpointReturns(result1 string, result2 error) {
+	fake.DopplerEndpointStub = nil
+	fake.dopplerEndpointReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) DopplerEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.DopplerEndpointStub = nil
+	if fake.dopplerEndpointReturnsOnCall == nil {
+		fake.dopplerEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.dopplerEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) AccessToken() (string, error) {
+	fake.accessTokenMutex.Lock()
+	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
+	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct{}{})
+	fake.recordInvocation("AccessToken", []interface{}{})
+	fake.accessTokenMutex.Unlock()
+	if fake.AccessTokenStub != nil {
+		return fake.AccessTokenStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.accessTokenReturns.result1, fake.accessTokenReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) AccessTokenCallCount() int {
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
+	return len(fake.accessTokenArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) AccessTokenReturns(result1 string, result2 error) {
+	fake.AccessTokenStub = nil
+	fake.accessTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) AccessTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AccessTokenStub = nil
+	if fake.accessTokenReturnsOnCall == nil {
+		fake.accessTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.accessTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetApp(arg1 string) (plugin_models.GetAppModel, error) {
+	fake.getAppMutex.Lock()
+	ret, specificReturn := fake.getAppReturnsOnCall[len(fake.getAppArgsForCall)]
+	fake.getAppArgsForCall = append(fake.getAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApp", []interface{}{arg1})
+	fake.getAppMutex.Unlock()
+	if fake.GetAppStub != nil {
+		return fake.GetAppStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppReturns.result1, fake.getAppReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetAppCallCount() int {
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	return len(fake.getAppArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetAppArgsForCall(i int) string {
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	return fake.getAppArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnectionV2) GetAppReturns(result1 plugin_models.GetAppModel, result2 error) {
+	fake.GetAppStub = nil
+	fake.getAppReturns = struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetAppReturnsOnCall(i int, result1 plugin_models.GetAppModel, result2 error) {
+	fake.GetAppStub = nil
+	if fake.getAppReturnsOnCall == nil {
+		fake.getAppReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetAppModel
+			result2 error
+		})
+	}
+	fake.getAppReturnsOnCall[i] = struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetApps() ([]plugin_models.GetAppsModel, error) {
+	fake.getAppsMutex.Lock()
+	ret, specificReturn := fake.getAppsReturnsOnCall[len(fake.getAppsArgsForCall)]
+	fake.getAppsArgsForCall = append(fake.getAppsArgsForCall, struct{}{})
+	fake.recordInvocation("GetApps", []interface{}{})
+	fake.getAppsMutex.Unlock()
+	if fake.GetAppsStub != nil {
+		return fake.GetAppsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppsReturns.result1, fake.getAppsReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetAppsCallCount() int {
+	fake.getAppsMutex.RLock()
+	defer fake.getAppsMutex.RUnlock()
+	return len(fake.getAppsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetAppsReturns(result1 []plugin_models.GetAppsModel, result2 error) {
+	fake.GetAppsStub = nil
+	fake.getAppsReturns = struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetAppsReturnsOnCall(i int, result1 []plugin_models.GetAppsModel, result2 error) {
+	fake.GetAppsStub = nil
+	if fake.getAppsReturnsOnCall == nil {
+		fake.getAppsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetAppsModel
+			result2 error
+		})
+	}
+	fake.getAppsReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrgs() ([]plugin_models.GetOrgs_Model, error) {
+	fake.getOrgsMutex.Lock()
+	ret, specificReturn := fake.getOrgsReturnsOnCall[len(fake.getOrgsArgsForCall)]
+	fake.getOrgsArgsForCall = append(fake.getOrgsArgsForCall, struct{}{})
+	fake.recordInvocation("GetOrgs", []interface{}{})
+	fake.getOrgsMutex.Unlock()
+	if fake.GetOrgsStub != nil {
+		return fake.GetOrgsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgsReturns.result1, fake.getOrgsReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetOrgsCallCount() int {
+	fake.getOrgsMutex.RLock()
+	defer fake.getOrgsMutex.RUnlock()
+	return len(fake.getOrgsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetOrgsReturns(result1 []plugin_models.GetOrgs_Model, result2 error) {
+	fake.GetOrgsStub = nil
+	fake.getOrgsReturns = struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrgsReturnsOnCall(i int, result1 []plugin_models.GetOrgs_Model, result2 error) {
+	fake.GetOrgsStub = nil
+	if fake.getOrgsReturnsOnCall == nil {
+		fake.getOrgsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetOrgs_Model
+			result2 error
+		})
+	}
+	fake.getOrgsReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpaces() ([]plugin_models.GetSpaces_Model, error) {
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct{}{})
+	fake.recordInvocation("GetSpaces", []interface{}{})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetSpacesReturns(result1 []plugin_models.GetSpaces_Model, result2 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpacesReturnsOnCall(i int, result1 []plugin_models.GetSpaces_Model, result2 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetSpaces_Model
+			result2 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrgUsers(arg1 string, arg2 ...string) ([]plugin_models.GetOrgUsers_Model, error) {
+	fake.getOrgUsersMutex.Lock()
+	ret, specificReturn := fake.getOrgUsersReturnsOnCall[len(fake.getOrgUsersArgsForCall)]
+	fake.getOrgUsersArgsForCall = append(fake.getOrgUsersArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2})
+	fake.recordInvocation("GetOrgUsers", []interface{}{arg1, arg2})
+	fake.getOrgUsersMutex.Unlock()
+	if fake.GetOrgUsersStub != nil {
+		return fake.GetOrgUsersStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgUsersReturns.result1, fake.getOrgUsersReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetOrgUsersCallCount() int {
+	fake.getOrgUsersMutex.RLock()
+	defer fake.getOrgUsersMutex.RUnlock()
+	return len(fake.getOrgUsersArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetOrgUsersArgsForCall(i int) (string, []string) {
+	fake.getOrgUsersMutex.RLock()
+	defer fake.getOrgUsersMutex.RUnlock()
+	return fake.getOrgUsersArgsForCall[i].arg1, fake.getOrgUsersArgsForCall[i].arg2
+}
+
+func (fake *FakeCliConnectionV2) GetOrgUsersReturns(result1 []plugin_models.GetOrgUsers_Model, result2 error) {
+	fake.GetOrgUsersStub = nil
+	fake.getOrgUsersReturns = struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrgUsersReturnsOnCall(i int, result1 []plugin_models.GetOrgUsers_Model, result2 error) {
+	fake.GetOrgUsersStub = nil
+	if fake.getOrgUsersReturnsOnCall == nil {
+		fake.getOrgUsersReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetOrgUsers_Model
+			result2 error
+		})
+	}
+	fake.getOrgUsersReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceUsers(arg1 string, arg2 string) ([]plugin_models.GetSpaceUsers_Model, error) {
+	fake.getSpaceUsersMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersReturnsOnCall[len(fake.getSpaceUsersArgsForCall)]
+	fake.getSpaceUsersArgsForCall = append(fake.getSpaceUsersArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceUsers", []interface{}{arg1, arg2})
+	fake.getSpaceUsersMutex.Unlock()
+	if fake.GetSpaceUsersStub != nil {
+		return fake.GetSpaceUsersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpaceUsersReturns.result1, fake.getSpaceUsersReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceUsersCallCount() int {
+	fake.getSpaceUsersMutex.RLock()
+	defer fake.getSpaceUsersMutex.RUnlock()
+	return len(fake.getSpaceUsersArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceUsersArgsForCall(i int) (string, string) {
+	fake.getSpaceUsersMutex.RLock()
+	defer fake.getSpaceUsersMutex.RUnlock()
+	return fake.getSpaceUsersArgsForCall[i].arg1, fake.getSpaceUsersArgsForCall[i].arg2
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceUsersReturns(result1 []plugin_models.GetSpaceUsers_Model, result2 error) {
+	fake.GetSpaceUsersStub = nil
+	fake.getSpaceUsersReturns = struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceUsersReturnsOnCall(i int, result1 []plugin_models.GetSpaceUsers_Model, result2 error) {
+	fake.GetSpaceUsersStub = nil
+	if fake.getSpaceUsersReturnsOnCall == nil {
+		fake.getSpaceUsersReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetSpaceUsers_Model
+			result2 error
+		})
+	}
+	fake.getSpaceUsersReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetServices() ([]plugin_models.GetServices_Model, error) {
+	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
+	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct{}{})
+	fake.recordInvocation("GetServices", []interface{}{})
+	fake.getServicesMutex.Unlock()
+	if fake.GetServicesStub != nil {
+		return fake.GetServicesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetServicesCallCount() int {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return len(fake.getServicesArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetServicesReturns(result1 []plugin_models.GetServices_Model, result2 error) {
+	fake.GetServicesStub = nil
+	fake.getServicesReturns = struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetServicesReturnsOnCall(i int, result1 []plugin_models.GetServices_Model, result2 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetServices_Model
+			result2 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetService(arg1 string) (plugin_models.GetService_Model, error) {
+	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
+	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetService", []interface{}{arg1})
+	fake.getServiceMutex.Unlock()
+	if fake.GetServiceStub != nil {
+		return fake.GetServiceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServiceReturns.result1, fake.getServiceReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetServiceCallCount() int {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return len(fake.getServiceArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetServiceArgsForCall(i int) string {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return fake.getServiceArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnectionV2) GetServiceReturns(result1 plugin_models.GetService_Model, result2 error) {
+	fake.GetServiceStub = nil
+	fake.getServiceReturns = struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetServiceReturnsOnCall(i int, result1 plugin_models.GetService_Model, result2 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetService_Model
+			result2 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrg(arg1 string) (plugin_models.GetOrg_Model, error) {
+	fake.getOrgMutex.Lock()
+	ret, specificReturn := fake.getOrgReturnsOnCall[len(fake.getOrgArgsForCall)]
+	fake.getOrgArgsForCall = append(fake.getOrgArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrg", []interface{}{arg1})
+	fake.getOrgMutex.Unlock()
+	if fake.GetOrgStub != nil {
+		return fake.GetOrgStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgReturns.result1, fake.getOrgReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetOrgCallCount() int {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	return len(fake.getOrgArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetOrgArgsForCall(i int) string {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	return fake.getOrgArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnectionV2) GetOrgReturns(result1 plugin_models.GetOrg_Model, result2 error) {
+	fake.GetOrgStub = nil
+	fake.getOrgReturns = struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetOrgReturnsOnCall(i int, result1 plugin_models.GetOrg_Model, result2 error) {
+	fake.GetOrgStub = nil
+	if fake.getOrgReturnsOnCall == nil {
+		fake.getOrgReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetOrg_Model
+			result2 error
+		})
+	}
+	fake.getOrgReturnsOnCall[i] = struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpace(arg1 string) (plugin_models.GetSpace_Model, error) {
+	fake.getSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceReturnsOnCall[len(fake.getSpaceArgsForCall)]
+	fake.getSpaceArgsForCall = append(fake.getSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpace", []interface{}{arg1})
+	fake.getSpaceMutex.Unlock()
+	if fake.GetSpaceStub != nil {
+		return fake.GetSpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpaceReturns.result1, fake.getSpaceReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceCallCount() int {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	return len(fake.getSpaceArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceArgsForCall(i int) string {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	return fake.getSpaceArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceReturns(result1 plugin_models.GetSpace_Model, result2 error) {
+	fake.GetSpaceStub = nil
+	fake.getSpaceReturns = struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetSpaceReturnsOnCall(i int, result1 plugin_models.GetSpace_Model, result2 error) {
+	fake.GetSpaceStub = nil
+	if fake.getSpaceReturnsOnCall == nil {
+		fake.getSpaceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetSpace_Model
+			result2 error
+		})
+	}
+	fake.getSpaceReturnsOnCall[i] = struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) PluginAPIVersion() (int, error) {
+	fake.pluginAPIVersionMutex.Lock()
+	ret, specificReturn := fake.pluginAPIVersionReturnsOnCall[len(fake.pluginAPIVersionArgsForCall)]
+	fake.pluginAPIVersionArgsForCall = append(fake.pluginAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("PluginAPIVersion", []interface{}{})
+	fake.pluginAPIVersionMutex.Unlock()
+	if fake.PluginAPIVersionStub != nil {
+		return fake.PluginAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pluginAPIVersionReturns.result1, fake.pluginAPIVersionReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) PluginAPIVersionCallCount() int {
+	fake.pluginAPIVersionMutex.RLock()
+	defer fake.pluginAPIVersionMutex.RUnlock()
+	return len(fake.pluginAPIVersionArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) PluginAPIVersionReturns(result1 int, result2 error) {
+	fake.PluginAPIVersionStub = nil
+	fake.pluginAPIVersionReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) PluginAPIVersionReturnsOnCall(i int, result1 int, result2 error) {
+	fake.PluginAPIVersionStub = nil
+	if fake.pluginAPIVersionReturnsOnCall == nil {
+		fake.pluginAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.pluginAPIVersionReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Applications(spaceGuid string) ([]plugin_models.V3Application, error) {
+	fake.getV3ApplicationsMutex.Lock()
+	ret, specificReturn := fake.getV3ApplicationsReturnsOnCall[len(fake.getV3ApplicationsArgsForCall)]
+	fake.getV3ApplicationsArgsForCall = append(fake.getV3ApplicationsArgsForCall, struct {
+		spaceGuid string
+	}{spaceGuid})
+	fake.recordInvocation("GetV3Applications", []interface{}{spaceGuid})
+	fake.getV3ApplicationsMutex.Unlock()
+	if fake.GetV3ApplicationsStub != nil {
+		return fake.GetV3ApplicationsStub(spaceGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3ApplicationsReturns.result1, fake.getV3ApplicationsReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationsCallCount() int {
+	fake.getV3ApplicationsMutex.RLock()
+	defer fake.getV3ApplicationsMutex.RUnlock()
+	return len(fake.getV3ApplicationsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationsArgsForCall(i int) string {
+	fake.getV3ApplicationsMutex.RLock()
+	defer fake.getV3ApplicationsMutex.RUnlock()
+	return fake.getV3ApplicationsArgsForCall[i].spaceGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationsReturns(result1 []plugin_models.V3Application, result2 error) {
+	fake.GetV3ApplicationsStub = nil
+	fake.getV3ApplicationsReturns = struct {
+		result1 []plugin_models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationsReturnsOnCall(i int, result1 []plugin_models.V3Application, result2 error) {
+	fake.GetV3ApplicationsStub = nil
+	if fake.getV3ApplicationsReturnsOnCall == nil {
+		fake.getV3ApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3Application
+			result2 error
+		})
+	}
+	fake.getV3ApplicationsReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Application(appName string, spaceGuid string) (plugin_models.V3Application, error) {
+	fake.getV3ApplicationMutex.Lock()
+	ret, specificReturn := fake.getV3ApplicationReturnsOnCall[len(fake.getV3ApplicationArgsForCall)]
+	fake.getV3ApplicationArgsForCall = append(fake.getV3ApplicationArgsForCall, struct {
+		appName   string
+		spaceGuid string
+	}{appName, spaceGuid})
+	fake.recordInvocation("GetV3Application", []interface{}{appName, spaceGuid})
+	fake.getV3ApplicationMutex.Unlock()
+	if fake.GetV3ApplicationStub != nil {
+		return fake.GetV3ApplicationStub(appName, spaceGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3ApplicationReturns.result1, fake.getV3ApplicationReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationCallCount() int {
+	fake.getV3ApplicationMutex.RLock()
+	defer fake.getV3ApplicationMutex.RUnlock()
+	return len(fake.getV3ApplicationArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationArgsForCall(i int) (string, string) {
+	fake.getV3ApplicationMutex.RLock()
+	defer fake.getV3ApplicationMutex.RUnlock()
+	return fake.getV3ApplicationArgsForCall[i].appName, fake.getV3ApplicationArgsForCall[i].spaceGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationReturns(result1 plugin_models.V3Application, result2 error) {
+	fake.GetV3ApplicationStub = nil
+	fake.getV3ApplicationReturns = struct {
+		result1 plugin_models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3ApplicationReturnsOnCall(i int, result1 plugin_models.V3Application, result2 error) {
+	fake.GetV3ApplicationStub = nil
+	if fake.getV3ApplicationReturnsOnCall == nil {
+		fake.getV3ApplicationReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.V3Application
+			result2 error
+		})
+	}
+	fake.getV3ApplicationReturnsOnCall[i] = struct {
+		result1 plugin_models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Processes(appGuid string) ([]plugin_models.V3Process, error) {
+	fake.getV3ProcessesMutex.Lock()
+	ret, specificReturn := fake.getV3ProcessesReturnsOnCall[len(fake.getV3ProcessesArgsForCall)]
+	fake.getV3ProcessesArgsForCall = append(fake.getV3ProcessesArgsForCall, struct {
+		appGuid string
+	}{appGuid})
+	fake.recordInvocation("GetV3Processes", []interface{}{appGuid})
+	fake.getV3ProcessesMutex.Unlock()
+	if fake.GetV3ProcessesStub != nil {
+		return fake.GetV3ProcessesStub(appGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3ProcessesReturns.result1, fake.getV3ProcessesReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3ProcessesCallCount() int {
+	fake.getV3ProcessesMutex.RLock()
+	defer fake.getV3ProcessesMutex.RUnlock()
+	return len(fake.getV3ProcessesArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3ProcessesArgsForCall(i int) string {
+	fake.getV3ProcessesMutex.RLock()
+	defer fake.getV3ProcessesMutex.RUnlock()
+	return fake.getV3ProcessesArgsForCall[i].appGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3ProcessesReturns(result1 []plugin_models.V3Process, result2 error) {
+	fake.GetV3ProcessesStub = nil
+	fake.getV3ProcessesReturns = struct {
+		result1 []plugin_models.V3Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3ProcessesReturnsOnCall(i int, result1 []plugin_models.V3Process, result2 error) {
+	fake.GetV3ProcessesStub = nil
+	if fake.getV3ProcessesReturnsOnCall == nil {
+		fake.getV3ProcessesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3Process
+			result2 error
+		})
+	}
+	fake.getV3ProcessesReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Tasks(appGuid string) ([]plugin_models.V3Task, error) {
+	fake.getV3TasksMutex.Lock()
+	ret, specificReturn := fake.getV3TasksReturnsOnCall[len(fake.getV3TasksArgsForCall)]
+	fake.getV3TasksArgsForCall = append(fake.getV3TasksArgsForCall, struct {
+		appGuid string
+	}{appGuid})
+	fake.recordInvocation("GetV3Tasks", []interface{}{appGuid})
+	fake.getV3TasksMutex.Unlock()
+	if fake.GetV3TasksStub != nil {
+		return fake.GetV3TasksStub(appGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3TasksReturns.result1, fake.getV3TasksReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3TasksCallCount() int {
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	return len(fake.getV3TasksArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3TasksArgsForCall(i int) string {
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	return fake.getV3TasksArgsForCall[i].appGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3TasksReturns(result1 []plugin_models.V3Task, result2 error) {
+	fake.GetV3TasksStub = nil
+	fake.getV3TasksReturns = struct {
+		result1 []plugin_models.V3Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3TasksReturnsOnCall(i int, result1 []plugin_models.V3Task, result2 error) {
+	fake.GetV3TasksStub = nil
+	if fake.getV3TasksReturnsOnCall == nil {
+		fake.getV3TasksReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3Task
+			result2 error
+		})
+	}
+	fake.getV3TasksReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Droplets(appGuid string) ([]plugin_models.V3Droplet, error) {
+	fake.getV3DropletsMutex.Lock()
+	ret, specificReturn := fake.getV3DropletsReturnsOnCall[len(fake.getV3DropletsArgsForCall)]
+	fake.getV3DropletsArgsForCall = append(fake.getV3DropletsArgsForCall, struct {
+		appGuid string
+	}{appGuid})
+	fake.recordInvocation("GetV3Droplets", []interface{}{appGuid})
+	fake.getV3DropletsMutex.Unlock()
+	if fake.GetV3DropletsStub != nil {
+		return fake.GetV3DropletsStub(appGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3DropletsReturns.result1, fake.getV3DropletsReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3DropletsCallCount() int {
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	return len(fake.getV3DropletsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3DropletsArgsForCall(i int) string {
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	return fake.getV3DropletsArgsForCall[i].appGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3DropletsReturns(result1 []plugin_models.V3Droplet, result2 error) {
+	fake.GetV3DropletsStub = nil
+	fake.getV3DropletsReturns = struct {
+		result1 []plugin_models.V3Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3DropletsReturnsOnCall(i int, result1 []plugin_models.V3Droplet, result2 error) {
+	fake.GetV3DropletsStub = nil
+	if fake.getV3DropletsReturnsOnCall == nil {
+		fake.getV3DropletsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3Droplet
+			result2 error
+		})
+	}
+	fake.getV3DropletsReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3Packages(appGuid string) ([]plugin_models.V3Package, error) {
+	fake.getV3PackagesMutex.Lock()
+	ret, specificReturn := fake.getV3PackagesReturnsOnCall[len(fake.getV3PackagesArgsForCall)]
+	fake.getV3PackagesArgsForCall = append(fake.getV3PackagesArgsForCall, struct {
+		appGuid string
+	}{appGuid})
+	fake.recordInvocation("GetV3Packages", []interface{}{appGuid})
+	fake.getV3PackagesMutex.Unlock()
+	if fake.GetV3PackagesStub != nil {
+		return fake.GetV3PackagesStub(appGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3PackagesReturns.result1, fake.getV3PackagesReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3PackagesCallCount() int {
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	return len(fake.getV3PackagesArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3PackagesArgsForCall(i int) string {
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	return fake.getV3PackagesArgsForCall[i].appGuid
+}
+
+func (fake *FakeCliConnectionV2) GetV3PackagesReturns(result1 []plugin_models.V3Package, result2 error) {
+	fake.GetV3PackagesStub = nil
+	fake.getV3PackagesReturns = struct {
+		result1 []plugin_models.V3Package
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3PackagesReturnsOnCall(i int, result1 []plugin_models.V3Package, result2 error) {
+	fake.GetV3PackagesStub = nil
+	if fake.getV3PackagesReturnsOnCall == nil {
+		fake.getV3PackagesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3Package
+			result2 error
+		})
+	}
+	fake.getV3PackagesReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3Package
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3IsolationSegments() ([]plugin_models.V3IsolationSegment, error) {
+	fake.getV3IsolationSegmentsMutex.Lock()
+	ret, specificReturn := fake.getV3IsolationSegmentsReturnsOnCall[len(fake.getV3IsolationSegmentsArgsForCall)]
+	fake.getV3IsolationSegmentsArgsForCall = append(fake.getV3IsolationSegmentsArgsForCall, struct{}{})
+	fake.recordInvocation("GetV3IsolationSegments", []interface{}{})
+	fake.getV3IsolationSegmentsMutex.Unlock()
+	if fake.GetV3IsolationSegmentsStub != nil {
+		return fake.GetV3IsolationSegmentsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getV3IsolationSegmentsReturns.result1, fake.getV3IsolationSegmentsReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) GetV3IsolationSegmentsCallCount() int {
+	fake.getV3IsolationSegmentsMutex.RLock()
+	defer fake.getV3IsolationSegmentsMutex.RUnlock()
+	return len(fake.getV3IsolationSegmentsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) GetV3IsolationSegmentsReturns(result1 []plugin_models.V3IsolationSegment, result2 error) {
+	fake.GetV3IsolationSegmentsStub = nil
+	fake.getV3IsolationSegmentsReturns = struct {
+		result1 []plugin_models.V3IsolationSegment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) GetV3IsolationSegmentsReturnsOnCall(i int, result1 []plugin_models.V3IsolationSegment, result2 error) {
+	fake.GetV3IsolationSegmentsStub = nil
+	if fake.getV3IsolationSegmentsReturnsOnCall == nil {
+		fake.getV3IsolationSegmentsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.V3IsolationSegment
+			result2 error
+		})
+	}
+	fake.getV3IsolationSegmentsReturnsOnCall[i] = struct {
+		result1 []plugin_models.V3IsolationSegment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) CloudControllerRequest(request plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error) {
+	fake.cloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.cloudControllerRequestReturnsOnCall[len(fake.cloudControllerRequestArgsForCall)]
+	fake.cloudControllerRequestArgsForCall = append(fake.cloudControllerRequestArgsForCall, struct {
+		request plugin_models.CloudControllerRequest
+	}{request})
+	fake.recordInvocation("CloudControllerRequest", []interface{}{request})
+	fake.cloudControllerRequestMutex.Unlock()
+	if fake.CloudControllerRequestStub != nil {
+		return fake.CloudControllerRequestStub(request)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cloudControllerRequestReturns.result1, fake.cloudControllerRequestReturns.result2
+}
+
+func (fake *FakeCliConnectionV2) CloudControllerRequestCallCount() int {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return len(fake.cloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) CloudControllerRequestArgsForCall(i int) plugin_models.CloudControllerRequest {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return fake.cloudControllerRequestArgsForCall[i].request
+}
+
+func (fake *FakeCliConnectionV2) CloudControllerRequestReturns(result1 plugin_models.CloudControllerResponse, result2 error) {
+	fake.CloudControllerRequestStub = nil
+	fake.cloudControllerRequestReturns = struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) CloudControllerRequestReturnsOnCall(i int, result1 plugin_models.CloudControllerResponse, result2 error) {
+	fake.CloudControllerRequestStub = nil
+	if fake.cloudControllerRequestReturnsOnCall == nil {
+		fake.cloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.CloudControllerResponse
+			result2 error
+		})
+	}
+	fake.cloudControllerRequestReturnsOnCall[i] = struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnectionV2) StreamAppLogs(appGuid string) (<-chan plugin_models.LogMessage, <-chan error, func(), error) {
+	fake.streamAppLogsMutex.Lock()
+	ret, specificReturn := fake.streamAppLogsReturnsOnCall[len(fake.streamAppLogsArgsForCall)]
+	fake.streamAppLogsArgsForCall = append(fake.streamAppLogsArgsForCall, struct {
+		appGuid string
+	}{appGuid})
+	fake.recordInvocation("StreamAppLogs", []interface{}{appGuid})
+	fake.streamAppLogsMutex.Unlock()
+	if fake.StreamAppLogsStub != nil {
+		return fake.StreamAppLogsStub(appGuid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.streamAppLogsReturns.result1, fake.streamAppLogsReturns.result2, fake.streamAppLogsReturns.result3, fake.streamAppLogsReturns.result4
+}
+
+func (fake *FakeCliConnectionV2) StreamAppLogsCallCount() int {
+	fake.streamAppLogsMutex.RLock()
+	defer fake.streamAppLogsMutex.RUnlock()
+	return len(fake.streamAppLogsArgsForCall)
+}
+
+func (fake *FakeCliConnectionV2) StreamAppLogsArgsForCall(i int) string {
+	fake.streamAppLogsMutex.RLock()
+	defer fake.streamAppLogsMutex.RUnlock()
+	return fake.streamAppLogsArgsForCall[i].appGuid
+}
+
+func (fake *FakeCliConnectionV2) StreamAppLogsReturns(result1 <-chan plugin_models.LogMessage, result2 <-chan error, result3 func(), result4 error) {
+	fake.StreamAppLogsStub = nil
+	fake.streamAppLogsReturns = struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 func()
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCliConnectionV2) StreamAppLogsReturnsOnCall(i int, result1 <-chan plugin_models.LogMessage, result2 <-chan error, result3 func(), result4 error) {
+	fake.StreamAppLogsStub = nil
+	if fake.streamAppLogsReturnsOnCall == nil {
+		fake.streamAppLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan plugin_models.LogMessage
+			result2 <-chan error
+			result3 func()
+			result4 error
+		})
+	}
+	fake.streamAppLogsReturnsOnCall[i] = struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 func()
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCliConnectionV2) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cliCommandWithoutTerminalOutputMutex.RLock()
+	defer fake.cliCommandWithoutTerminalOutputMutex.RUnlock()
+	fake.cliCommandMutex.RLock()
+	defer fake.cliCommandMutex.RUnlock()
+	fake.getCurrentOrgMutex.RLock()
+	defer fake.getCurrentOrgMutex.RUnlock()
+	fake.getCurrentSpaceMutex.RLock()
+	defer fake.getCurrentSpaceMutex.RUnlock()
+	fake.usernameMutex.RLock()
+	defer fake.usernameMutex.RUnlock()
+	fake.userGuidMutex.RLock()
+	defer fake.userGuidMutex.RUnlock()
+	fake.userEmailMutex.RLock()
+	defer fake.userEmailMutex.RUnlock()
+	fake.isLoggedInMutex.RLock()
+	defer fake.isLoggedInMutex.RUnlock()
+	fake.isSSLDisabledMutex.RLock()
+	defer fake.isSSLDisabledMutex.RUnlock()
+	fake.hasOrganizationMutex.RLock()
+	defer fake.hasOrganizationMutex.RUnlock()
+	fake.hasSpaceMutex.RLock()
+	defer fake.hasSpaceMutex.RUnlock()
+	fake.apiEndpointMutex.RLock()
+	defer fake.apiEndpointMutex.RUnlock()
+	fake.apiVersionMutex.RLock()
+	defer fake.apiVersionMutex.RUnlock()
+	fake.hasAPIEndpointMutex.RLock()
+	defer fake.hasAPIEndpointMutex.RUnlock()
+	fake.loggregatorEndpointMutex.RLock()
+	defer fake.loggregatorEndpointMutex.RUnlock()
+	fake.dopplerEndpointMutex.RLock()
+	defer fake.dopplerEndpointMutex.RUnlock()
+	fake.accessTokenMutex.RLock()
+	defer fake.accessTokenMutex.RUnlock()
+	fake.getAppMutex.RLock()
+	defer fake.getAppMutex.RUnlock()
+	fake.getAppsMutex.RLock()
+	defer fake.getAppsMutex.RUnlock()
+	fake.getOrgsMutex.RLock()
+	defer fake.getOrgsMutex.RUnlock()
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	fake.getOrgUsersMutex.RLock()
+	defer fake.getOrgUsersMutex.RUnlock()
+	fake.getSpaceUsersMutex.RLock()
+	defer fake.getSpaceUsersMutex.RUnlock()
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	fake.pluginAPIVersionMutex.RLock()
+	defer fake.pluginAPIVersionMutex.RUnlock()
+	fake.getV3ApplicationsMutex.RLock()
+	defer fake.getV3ApplicationsMutex.RUnlock()
+	fake.getV3ApplicationMutex.RLock()
+	defer fake.getV3ApplicationMutex.RUnlock()
+	fake.getV3ProcessesMutex.RLock()
+	defer fake.getV3ProcessesMutex.RUnlock()
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	fake.getV3IsolationSegmentsMutex.RLock()
+	defer fake.getV3IsolationSegmentsMutex.RUnlock()
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	fake.streamAppLogsMutex.RLock()
+	defer fake.streamAppLogsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCliConnectionV2) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.CliConnectionV2 = new(FakeCliConnectionV2)
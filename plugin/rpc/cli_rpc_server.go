@@ -33,6 +33,7 @@ type CliRpcService struct {
 	stopCh   chan struct{}
 	Pinged   bool
 	RpcCmd   *CliRpcCmd
+	RpcCmdV2 *CliRpcCmdV2
 	Server   *rpc.Server
 }
 
@@ -85,6 +86,7 @@ func NewRpcService(
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
 		},
+		RpcCmdV2: &CliRpcCmdV2{},
 	}
 
 	err := rpcService.Server.Register(rpcService.RpcCmd)
@@ -92,6 +94,11 @@ func NewRpcService(
 		return nil, err
 	}
 
+	err = rpcService.Server.Register(rpcService.RpcCmdV2)
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcService, nil
 }
 
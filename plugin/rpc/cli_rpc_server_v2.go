@@ -0,0 +1,479 @@
+package rpc
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// logReadInterval is how long ReadAppLogs collects log messages before
+// returning them.
+var logReadInterval = 500 * time.Millisecond
+
+// maxLogBatchSize is the maximum number of log messages returned by a single
+// ReadAppLogs call.
+const maxLogBatchSize = 500
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor is the actor used to serve version 2 of the plugin API.
+type V3Actor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetDropletsByApplication(appGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	GetIsolationSegments() ([]v3action.IsolationSegment, v3action.Warnings, error)
+	GetPackagesByApplication(appGUID string) ([]v3action.Package, v3action.Warnings, error)
+	GetProcessesByApplication(appGUID string) ([]v3action.Process, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	MakeCloudControllerRequest(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error)
+}
+
+// CliRpcCmdV2 serves version 2 of the plugin API. It is registered alongside
+// CliRpcCmd so that plugins built against older versions of the plugin
+// package keep working.
+//
+// Config is the configuration the CLI is running with. UI, Actor and
+// NewNOAAClient are built from it the first time they are needed, unless they
+// have already been set. Warnings are displayed on the UI, except for those of
+// CloudControllerRequest, which are returned to the plugin.
+type CliRpcCmdV2 struct {
+	Config        *configv3.Config
+	UI            command.UI
+	Actor         V3Actor
+	NewNOAAClient func() v3action.NOAAClient
+
+	setupMutex  sync.Mutex
+	accessToken string
+
+	logStreamsMutex sync.Mutex
+	logStreams      map[int]*appLogStream
+	lastLogStreamID int
+}
+
+type appLogStream struct {
+	mutex    sync.Mutex
+	client   v3action.NOAAClient
+	messages <-chan *v3action.LogMessage
+	errs     <-chan error
+}
+
+func (cmd *CliRpcCmdV2) PluginAPIVersion(_ string, retVal *int) error {
+	*retVal = plugin.PluginAPIVersion
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Applications(spaceGUID string, retVal *[]plugin_models.V3Application) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3Application, 0, len(apps))
+	for _, app := range apps {
+		*retVal = append(*retVal, convertApplication(app))
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Application(args []string, retVal *plugin_models.V3Application) error {
+	if len(args) != 2 {
+		return errors.New("GetV3Application requires an app name and a space GUID")
+	}
+
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(args[0], args[1])
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = convertApplication(app)
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Processes(appGUID string, retVal *[]plugin_models.V3Process) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	processes, warnings, err := cmd.Actor.GetProcessesByApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3Process, 0, len(processes))
+	for _, process := range processes {
+		*retVal = append(*retVal, plugin_models.V3Process{
+			Guid:                         process.GUID,
+			Type:                         process.Type,
+			Instances:                    process.Instances.Value,
+			MemoryInMB:                   process.MemoryInMB.Value,
+			DiskInMB:                     process.DiskInMB.Value,
+			HealthCheckType:              process.HealthCheckType,
+			HealthCheckEndpoint:          process.HealthCheckEndpoint,
+			HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Tasks(appGUID string, retVal *[]plugin_models.V3Task) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	tasks, warnings, err := cmd.Actor.GetApplicationTasks(appGUID, v3action.Ascending)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3Task, 0, len(tasks))
+	for _, task := range tasks {
+		*retVal = append(*retVal, plugin_models.V3Task{
+			Guid:          task.GUID,
+			Name:          task.Name,
+			SequenceId:    task.SequenceID,
+			Command:       task.Command,
+			State:         string(task.State),
+			MemoryInMB:    task.MemoryInMB,
+			DiskInMB:      task.DiskInMB,
+			FailureReason: task.FailureReason,
+			CreatedAt:     task.CreatedAt,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Droplets(appGUID string, retVal *[]plugin_models.V3Droplet) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	droplets, warnings, err := cmd.Actor.GetDropletsByApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3Droplet, 0, len(droplets))
+	for _, droplet := range droplets {
+		var buildpacks []string
+		for _, buildpack := range droplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+
+		*retVal = append(*retVal, plugin_models.V3Droplet{
+			Guid:       droplet.GUID,
+			State:      string(droplet.State),
+			Stack:      droplet.Stack,
+			Image:      droplet.Image,
+			Buildpacks: buildpacks,
+			CreatedAt:  droplet.CreatedAt,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3Packages(appGUID string, retVal *[]plugin_models.V3Package) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	packages, warnings, err := cmd.Actor.GetPackagesByApplication(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3Package, 0, len(packages))
+	for _, pkg := range packages {
+		*retVal = append(*retVal, plugin_models.V3Package{
+			Guid:        pkg.GUID,
+			Type:        string(pkg.Type),
+			State:       string(pkg.State),
+			DockerImage: pkg.DockerImage,
+			CreatedAt:   pkg.CreatedAt,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetV3IsolationSegments(_ string, retVal *[]plugin_models.V3IsolationSegment) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	isolationSegments, warnings, err := cmd.Actor.GetIsolationSegments()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = make([]plugin_models.V3IsolationSegment, 0, len(isolationSegments))
+	for _, isolationSegment := range isolationSegments {
+		*retVal = append(*retVal, plugin_models.V3IsolationSegment{
+			Guid: isolationSegment.GUID,
+			Name: isolationSegment.Name,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+	defer cmd.writeRefreshedToken()
+
+	response, warnings, err := cmd.Actor.MakeCloudControllerRequest(request.Method, request.Path, request.Body)
+	if err != nil {
+		return err
+	}
+
+	*retVal = plugin_models.CloudControllerResponse{
+		StatusCode: response.StatusCode,
+		Body:       response.Body,
+		Warnings:   warnings,
+	}
+
+	return nil
+}
+
+// StartAppLogStream starts streaming the logs of an app and returns the ID of
+// the stream, to be passed to ReadAppLogs and StopAppLogStream.
+func (cmd *CliRpcCmdV2) StartAppLogStream(appGUID string, retVal *int) error {
+	err := cmd.setup()
+	if err != nil {
+		return err
+	}
+
+	client := cmd.NewNOAAClient()
+	messages, errs := cmd.Actor.GetStreamingLogs(appGUID, client)
+
+	cmd.logStreamsMutex.Lock()
+	defer cmd.logStreamsMutex.Unlock()
+
+	if cmd.logStreams == nil {
+		cmd.logStreams = map[int]*appLogStream{}
+	}
+	cmd.lastLogStreamID++
+	cmd.logStreams[cmd.lastLogStreamID] = &appLogStream{
+		client:   client,
+		messages: messages,
+		errs:     errs,
+	}
+	*retVal = cmd.lastLogStreamID
+
+	return nil
+}
+
+// ReadAppLogs returns the log messages and errors received on a log stream
+// over the next logReadInterval, or until the stream ends.
+func (cmd *CliRpcCmdV2) ReadAppLogs(streamID int, retVal *plugin_models.LogMessageBatch) error {
+	stream, err := cmd.logStream(streamID)
+	if err != nil {
+		return err
+	}
+
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	timer := time.NewTimer(logReadInterval)
+	defer timer.Stop()
+
+	batch := plugin_models.LogMessageBatch{}
+	for len(batch.Messages) < maxLogBatchSize {
+		select {
+		case message, ok := <-stream.messages:
+			if !ok {
+				stream.messages = nil
+				break
+			}
+			batch.Messages = append(batch.Messages, plugin_models.LogMessage{
+				Message:        message.Message(),
+				Type:           message.Type(),
+				Timestamp:      message.Timestamp(),
+				SourceType:     message.SourceType(),
+				SourceInstance: message.SourceInstance(),
+			})
+		case err, ok := <-stream.errs:
+			if !ok {
+				stream.errs = nil
+				break
+			}
+			batch.Errors = append(batch.Errors, err.Error())
+		case <-timer.C:
+			*retVal = batch
+			return nil
+		}
+
+		if stream.messages == nil && stream.errs == nil {
+			batch.Done = true
+			cmd.removeLogStream(streamID)
+			break
+		}
+	}
+
+	*retVal = batch
+	return nil
+}
+
+// StopAppLogStream stops a log stream started by StartAppLogStream.
+func (cmd *CliRpcCmdV2) StopAppLogStream(streamID int, retVal *bool) error {
+	cmd.removeLogStream(streamID)
+	*retVal = true
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) logStream(streamID int) (*appLogStream, error) {
+	cmd.logStreamsMutex.Lock()
+	defer cmd.logStreamsMutex.Unlock()
+
+	stream, ok := cmd.logStreams[streamID]
+	if !ok {
+		return nil, errors.New("log stream not found")
+	}
+
+	return stream, nil
+}
+
+// removeLogStream closes the NOAA client of a log stream and drains its
+// channels, once any read in progress has finished, so that the goroutine
+// feeding them can exit.
+func (cmd *CliRpcCmdV2) removeLogStream(streamID int) {
+	cmd.logStreamsMutex.Lock()
+	stream, ok := cmd.logStreams[streamID]
+	delete(cmd.logStreams, streamID)
+	cmd.logStreamsMutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	_ = stream.client.Close()
+	go func() {
+		stream.mutex.Lock()
+		defer stream.mutex.Unlock()
+
+		for stream.messages != nil || stream.errs != nil {
+			select {
+			case _, ok := <-stream.messages:
+				if !ok {
+					stream.messages = nil
+				}
+			case _, ok := <-stream.errs:
+				if !ok {
+					stream.errs = nil
+				}
+			}
+		}
+	}()
+}
+
+// setup builds the UI, actor and NOAA client from the CLI configuration,
+// unless they have already been set.
+func (cmd *CliRpcCmdV2) setup() error {
+	cmd.setupMutex.Lock()
+	defer cmd.setupMutex.Unlock()
+
+	if cmd.UI != nil && cmd.Actor != nil {
+		return nil
+	}
+
+	if cmd.Config == nil {
+		return errors.New("the CLI configuration is not available to the plugin API")
+	}
+
+	if cmd.UI == nil {
+		commandUI, err := ui.NewUI(cmd.Config)
+		if err != nil {
+			return err
+		}
+		cmd.UI = commandUI
+	}
+
+	if cmd.Actor == nil {
+		ccClient, uaaClient, err := shared.NewClients(cmd.Config, cmd.UI, true)
+		if err != nil {
+			return err
+		}
+
+		cmd.Actor = v3action.NewActor(ccClient, cmd.Config, nil, uaaClient)
+		cmd.NewNOAAClient = func() v3action.NOAAClient {
+			return shared.NewNOAAClient(ccClient.Info.Logging(), cmd.Config, uaaClient, cmd.UI)
+		}
+		cmd.accessToken = cmd.Config.AccessToken()
+	}
+
+	return nil
+}
+
+// writeRefreshedToken writes the configuration if the access token was
+// refreshed while serving a request, so that the CLI and later plugin calls
+// reuse it.
+func (cmd *CliRpcCmdV2) writeRefreshedToken() {
+	cmd.setupMutex.Lock()
+	defer cmd.setupMutex.Unlock()
+
+	if cmd.Config == nil || cmd.Config.AccessToken() == cmd.accessToken {
+		return
+	}
+
+	if err := configv3.WriteConfig(cmd.Config); err == nil {
+		cmd.accessToken = cmd.Config.AccessToken()
+	}
+}
+
+func convertApplication(app v3action.Application) plugin_models.V3Application {
+	return plugin_models.V3Application{
+		Guid:          app.GUID,
+		Name:          app.Name,
+		State:         string(app.State),
+		LifecycleType: string(app.LifecycleType),
+		Buildpacks:    app.LifecycleBuildpacks,
+		SpaceGuid:     app.SpaceGUID,
+	}
+}
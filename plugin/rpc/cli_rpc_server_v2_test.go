@@ -0,0 +1,385 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Server V2", func() {
+	var (
+		err            error
+		client         *rpc.Client
+		rpcService     *CliRpcService
+		fakeActor      *rpcfakes.FakeV3Actor
+		fakeNOAAClient *v3actionfakes.FakeNOAAClient
+		testUI         *ui.UI
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeActor = new(rpcfakes.FakeV3Actor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		rpcService.RpcCmdV2.UI = testUI
+		rpcService.RpcCmdV2.Actor = fakeActor
+		rpcService.RpcCmdV2.NewNOAAClient = func() v3action.NOAAClient { return fakeNOAAClient }
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe("PluginAPIVersion", func() {
+		It("returns the version of the plugin API", func() {
+			var version int
+			err = client.Call("CliRpcCmdV2.PluginAPIVersion", "", &version)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(plugin.PluginAPIVersion))
+		})
+	})
+
+	Describe("GetV3Applications", func() {
+		It("returns the apps in the space", func() {
+			fakeActor.GetApplicationsBySpaceReturns(
+				[]v3action.Application{
+					{
+						GUID:                "app-guid",
+						Name:                "some-app",
+						State:               constant.ApplicationStarted,
+						LifecycleType:       constant.AppLifecycleTypeBuildpack,
+						LifecycleBuildpacks: []string{"ruby_buildpack"},
+						SpaceGUID:           "space-guid",
+					},
+				},
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+
+			var apps []plugin_models.V3Application
+			err = client.Call("CliRpcCmdV2.GetV3Applications", "space-guid", &apps)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(ConsistOf(plugin_models.V3Application{
+				Guid:          "app-guid",
+				Name:          "some-app",
+				State:         "STARTED",
+				LifecycleType: "buildpack",
+				Buildpacks:    []string{"ruby_buildpack"},
+				SpaceGuid:     "space-guid",
+			}))
+
+			Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("space-guid"))
+
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		It("returns the error from the actor and displays the warnings", func() {
+			fakeActor.GetApplicationsBySpaceReturns(nil, v3action.Warnings{"some-warning"}, errors.New("get apps error"))
+
+			var apps []plugin_models.V3Application
+			err = client.Call("CliRpcCmdV2.GetV3Applications", "space-guid", &apps)
+			Expect(err).To(MatchError("get apps error"))
+
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		Context("when the CLI configuration has not been set", func() {
+			BeforeEach(func() {
+				rpcService.RpcCmdV2.UI = nil
+				rpcService.RpcCmdV2.Actor = nil
+			})
+
+			It("returns an error", func() {
+				var apps []plugin_models.V3Application
+				err = client.Call("CliRpcCmdV2.GetV3Applications", "space-guid", &apps)
+				Expect(err).To(MatchError("the CLI configuration is not available to the plugin API"))
+				Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetV3Application", func() {
+		It("returns the app with the given name in the space", func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "app-guid", Name: "some-app"}, nil, nil)
+
+			var app plugin_models.V3Application
+			err = client.Call("CliRpcCmdV2.GetV3Application", []string{"some-app", "space-guid"}, &app)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app.Guid).To(Equal("app-guid"))
+			Expect(app.Name).To(Equal("some-app"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+
+		It("returns an error when the arguments are missing", func() {
+			var app plugin_models.V3Application
+			err = client.Call("CliRpcCmdV2.GetV3Application", []string{"some-app"}, &app)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("GetV3Processes", func() {
+		It("returns the processes of the app", func() {
+			fakeActor.GetProcessesByApplicationReturns(
+				[]v3action.Process{
+					{
+						GUID:            "process-guid",
+						Type:            "web",
+						Instances:       types.NullInt{Value: 2, IsSet: true},
+						MemoryInMB:      types.NullUint64{Value: 256, IsSet: true},
+						DiskInMB:        types.NullUint64{Value: 512, IsSet: true},
+						HealthCheckType: "port",
+					},
+				},
+				v3action.Warnings{"process-warning"},
+				nil,
+			)
+
+			var processes []plugin_models.V3Process
+			err = client.Call("CliRpcCmdV2.GetV3Processes", "app-guid", &processes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(processes).To(ConsistOf(plugin_models.V3Process{
+				Guid:            "process-guid",
+				Type:            "web",
+				Instances:       2,
+				MemoryInMB:      256,
+				DiskInMB:        512,
+				HealthCheckType: "port",
+			}))
+			Expect(fakeActor.GetProcessesByApplicationArgsForCall(0)).To(Equal("app-guid"))
+			Expect(testUI.Err).To(Say("process-warning"))
+		})
+	})
+
+	Describe("GetV3Tasks", func() {
+		It("returns the tasks of the app in ascending order", func() {
+			fakeActor.GetApplicationTasksReturns(
+				[]v3action.Task{
+					{GUID: "task-guid", Name: "some-task", SequenceID: 3, Command: "echo hi", State: constant.TaskSucceeded},
+				},
+				nil,
+				nil,
+			)
+
+			var tasks []plugin_models.V3Task
+			err = client.Call("CliRpcCmdV2.GetV3Tasks", "app-guid", &tasks)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(ConsistOf(plugin_models.V3Task{
+				Guid:       "task-guid",
+				Name:       "some-task",
+				SequenceId: 3,
+				Command:    "echo hi",
+				State:      "SUCCEEDED",
+			}))
+
+			appGUID, sortOrder := fakeActor.GetApplicationTasksArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(sortOrder).To(Equal(v3action.Ascending))
+		})
+	})
+
+	Describe("GetV3Droplets", func() {
+		It("returns the droplets of the app", func() {
+			fakeActor.GetDropletsByApplicationReturns(
+				[]v3action.Droplet{
+					{
+						GUID:       "droplet-guid",
+						State:      constant.DropletStaged,
+						Stack:      "cflinuxfs2",
+						Buildpacks: []v3action.Buildpack{{Name: "ruby_buildpack"}},
+					},
+				},
+				nil,
+				nil,
+			)
+
+			var droplets []plugin_models.V3Droplet
+			err = client.Call("CliRpcCmdV2.GetV3Droplets", "app-guid", &droplets)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(droplets).To(ConsistOf(plugin_models.V3Droplet{
+				Guid:       "droplet-guid",
+				State:      "STAGED",
+				Stack:      "cflinuxfs2",
+				Buildpacks: []string{"ruby_buildpack"},
+			}))
+			Expect(fakeActor.GetDropletsByApplicationArgsForCall(0)).To(Equal("app-guid"))
+		})
+	})
+
+	Describe("GetV3Packages", func() {
+		It("returns the packages of the app", func() {
+			fakeActor.GetPackagesByApplicationReturns(
+				[]v3action.Package{
+					{GUID: "package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady},
+				},
+				nil,
+				nil,
+			)
+
+			var packages []plugin_models.V3Package
+			err = client.Call("CliRpcCmdV2.GetV3Packages", "app-guid", &packages)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(packages).To(ConsistOf(plugin_models.V3Package{
+				Guid:  "package-guid",
+				Type:  "bits",
+				State: "READY",
+			}))
+			Expect(fakeActor.GetPackagesByApplicationArgsForCall(0)).To(Equal("app-guid"))
+		})
+	})
+
+	Describe("GetV3IsolationSegments", func() {
+		It("returns the isolation segments", func() {
+			fakeActor.GetIsolationSegmentsReturns(
+				[]v3action.IsolationSegment{{GUID: "iso-guid", Name: "some-iso"}},
+				nil,
+				nil,
+			)
+
+			var isolationSegments []plugin_models.V3IsolationSegment
+			err = client.Call("CliRpcCmdV2.GetV3IsolationSegments", "", &isolationSegments)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isolationSegments).To(ConsistOf(plugin_models.V3IsolationSegment{Guid: "iso-guid", Name: "some-iso"}))
+		})
+	})
+
+	Describe("CloudControllerRequest", func() {
+		It("returns the response and warnings from the Cloud Controller", func() {
+			fakeActor.MakeCloudControllerRequestReturns(
+				v3action.CloudControllerResponse(ccv3.RawResponse{StatusCode: http.StatusCreated, Body: []byte(`{"guid":"app-guid"}`)}),
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+
+			var response plugin_models.CloudControllerResponse
+			err = client.Call("CliRpcCmdV2.CloudControllerRequest", plugin_models.CloudControllerRequest{
+				Method: http.MethodPost,
+				Path:   "/v3/apps",
+				Body:   []byte(`{"name":"some-app"}`),
+			}, &response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Body).To(MatchJSON(`{"guid":"app-guid"}`))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(testUI.Err).ToNot(Say("some-warning"))
+
+			method, path, body := fakeActor.MakeCloudControllerRequestArgsForCall(0)
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/v3/apps"))
+			Expect(body).To(MatchJSON(`{"name":"some-app"}`))
+		})
+
+		It("returns the error when the request could not be made", func() {
+			fakeActor.MakeCloudControllerRequestReturns(v3action.CloudControllerResponse{}, nil, errors.New("request error"))
+
+			var response plugin_models.CloudControllerResponse
+			err = client.Call("CliRpcCmdV2.CloudControllerRequest", plugin_models.CloudControllerRequest{Method: http.MethodGet, Path: "/v3/apps"}, &response)
+			Expect(err).To(MatchError("request error"))
+		})
+	})
+
+	Describe("app log streams", func() {
+		var (
+			messages chan *v3action.LogMessage
+			errs     chan error
+			streamID int
+		)
+
+		BeforeEach(func() {
+			messages = make(chan *v3action.LogMessage)
+			errs = make(chan error)
+			fakeActor.GetStreamingLogsReturns(messages, errs)
+
+			err = client.Call("CliRpcCmdV2.StartAppLogStream", "app-guid", &streamID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("streams the logs of the app with a new NOAA client", func() {
+			Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+			appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(noaaClient).To(Equal(fakeNOAAClient))
+		})
+
+		It("returns the messages and errors received on the stream", func() {
+			timestamp := time.Unix(0, 1000)
+			go func() {
+				messages <- v3action.NewLogMessage("some-message", 1, timestamp, "APP/PROC/WEB", "0")
+				errs <- errors.New("some-stream-error")
+			}()
+
+			var batch plugin_models.LogMessageBatch
+			err = client.Call("CliRpcCmdV2.ReadAppLogs", streamID, &batch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch.Messages).To(HaveLen(1))
+			Expect(batch.Messages[0].Message).To(Equal("some-message"))
+			Expect(batch.Messages[0].Type).To(Equal("OUT"))
+			Expect(batch.Messages[0].Timestamp.Equal(timestamp)).To(BeTrue())
+			Expect(batch.Messages[0].SourceType).To(Equal("APP/PROC/WEB"))
+			Expect(batch.Messages[0].SourceInstance).To(Equal("0"))
+			Expect(batch.Errors).To(ConsistOf("some-stream-error"))
+			Expect(batch.Done).To(BeFalse())
+		})
+
+		It("reports when the stream has ended", func() {
+			close(messages)
+			close(errs)
+
+			var batch plugin_models.LogMessageBatch
+			err = client.Call("CliRpcCmdV2.ReadAppLogs", streamID, &batch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch.Done).To(BeTrue())
+
+			err = client.Call("CliRpcCmdV2.ReadAppLogs", streamID, &batch)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("closes the NOAA client when the stream is stopped", func() {
+			var success bool
+			err = client.Call("CliRpcCmdV2.StopAppLogStream", streamID, &success)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(success).To(BeTrue())
+			Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+
+			var batch plugin_models.LogMessageBatch
+			err = client.Call("CliRpcCmdV2.ReadAppLogs", streamID, &batch)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
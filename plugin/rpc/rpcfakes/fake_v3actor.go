@@ -0,0 +1,677 @@
+// This file was generated by counterfeiter
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV3Actor struct {
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	getApplicationTasksMutex       sync.RWMutex
+	getApplicationTasksArgsForCall []struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}
+	getApplicationTasksReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationTasksReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetDropletsByApplicationStub        func(appGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	getDropletsByApplicationMutex       sync.RWMutex
+	getDropletsByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getDropletsByApplicationReturns struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	getDropletsByApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentsStub        func() ([]v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentsMutex       sync.RWMutex
+	getIsolationSegmentsArgsForCall []struct{}
+	getIsolationSegmentsReturns     struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentsReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetPackagesByApplicationStub        func(appGUID string) ([]v3action.Package, v3action.Warnings, error)
+	getPackagesByApplicationMutex       sync.RWMutex
+	getPackagesByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getPackagesByApplicationReturns struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	getPackagesByApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetProcessesByApplicationStub        func(appGUID string) ([]v3action.Process, v3action.Warnings, error)
+	getProcessesByApplicationMutex       sync.RWMutex
+	getProcessesByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getProcessesByApplicationReturns struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessesByApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetStreamingLogsStub        func(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	MakeCloudControllerRequestStub        func(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error)
+	makeCloudControllerRequestMutex       sync.RWMutex
+	makeCloudControllerRequestArgsForCall []struct {
+		method string
+		path   string
+		body   []byte
+	}
+	makeCloudControllerRequestReturns struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	makeCloudControllerRequestReturnsOnCall map[int]struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceReturns(result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}{appGUID, sortOrder})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder})
+	fake.getApplicationTasksMutex.Unlock()
+	if fake.GetApplicationTasksStub != nil {
+		return fake.GetApplicationTasksStub(appGUID, sortOrder)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksCallCount() int {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return len(fake.getApplicationTasksArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder) {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	fake.getApplicationTasksReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	if fake.getApplicationTasksReturnsOnCall == nil {
+		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetDropletsByApplication(appGUID string) ([]v3action.Droplet, v3action.Warnings, error) {
+	fake.getDropletsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getDropletsByApplicationReturnsOnCall[len(fake.getDropletsByApplicationArgsForCall)]
+	fake.getDropletsByApplicationArgsForCall = append(fake.getDropletsByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetDropletsByApplication", []interface{}{appGUID})
+	fake.getDropletsByApplicationMutex.Unlock()
+	if fake.GetDropletsByApplicationStub != nil {
+		return fake.GetDropletsByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDropletsByApplicationReturns.result1, fake.getDropletsByApplicationReturns.result2, fake.getDropletsByApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetDropletsByApplicationCallCount() int {
+	fake.getDropletsByApplicationMutex.RLock()
+	defer fake.getDropletsByApplicationMutex.RUnlock()
+	return len(fake.getDropletsByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetDropletsByApplicationArgsForCall(i int) string {
+	fake.getDropletsByApplicationMutex.RLock()
+	defer fake.getDropletsByApplicationMutex.RUnlock()
+	return fake.getDropletsByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) GetDropletsByApplicationReturns(result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetDropletsByApplicationStub = nil
+	fake.getDropletsByApplicationReturns = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetDropletsByApplicationReturnsOnCall(i int, result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetDropletsByApplicationStub = nil
+	if fake.getDropletsByApplicationReturnsOnCall == nil {
+		fake.getDropletsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getDropletsByApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegments() ([]v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentsMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsReturnsOnCall[len(fake.getIsolationSegmentsArgsForCall)]
+	fake.getIsolationSegmentsArgsForCall = append(fake.getIsolationSegmentsArgsForCall, struct{}{})
+	fake.recordInvocation("GetIsolationSegments", []interface{}{})
+	fake.getIsolationSegmentsMutex.Unlock()
+	if fake.GetIsolationSegmentsStub != nil {
+		return fake.GetIsolationSegmentsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentsReturns.result1, fake.getIsolationSegmentsReturns.result2, fake.getIsolationSegmentsReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsCallCount() int {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return len(fake.getIsolationSegmentsArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsReturns(result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsStub = nil
+	fake.getIsolationSegmentsReturns = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsReturnsOnCall(i int, result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsStub = nil
+	if fake.getIsolationSegmentsReturnsOnCall == nil {
+		fake.getIsolationSegmentsReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetPackagesByApplication(appGUID string) ([]v3action.Package, v3action.Warnings, error) {
+	fake.getPackagesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getPackagesByApplicationReturnsOnCall[len(fake.getPackagesByApplicationArgsForCall)]
+	fake.getPackagesByApplicationArgsForCall = append(fake.getPackagesByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetPackagesByApplication", []interface{}{appGUID})
+	fake.getPackagesByApplicationMutex.Unlock()
+	if fake.GetPackagesByApplicationStub != nil {
+		return fake.GetPackagesByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPackagesByApplicationReturns.result1, fake.getPackagesByApplicationReturns.result2, fake.getPackagesByApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetPackagesByApplicationCallCount() int {
+	fake.getPackagesByApplicationMutex.RLock()
+	defer fake.getPackagesByApplicationMutex.RUnlock()
+	return len(fake.getPackagesByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetPackagesByApplicationArgsForCall(i int) string {
+	fake.getPackagesByApplicationMutex.RLock()
+	defer fake.getPackagesByApplicationMutex.RUnlock()
+	return fake.getPackagesByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) GetPackagesByApplicationReturns(result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetPackagesByApplicationStub = nil
+	fake.getPackagesByApplicationReturns = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetPackagesByApplicationReturnsOnCall(i int, result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetPackagesByApplicationStub = nil
+	if fake.getPackagesByApplicationReturnsOnCall == nil {
+		fake.getPackagesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Package
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getPackagesByApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetProcessesByApplication(appGUID string) ([]v3action.Process, v3action.Warnings, error) {
+	fake.getProcessesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessesByApplicationReturnsOnCall[len(fake.getProcessesByApplicationArgsForCall)]
+	fake.getProcessesByApplicationArgsForCall = append(fake.getProcessesByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetProcessesByApplication", []interface{}{appGUID})
+	fake.getProcessesByApplicationMutex.Unlock()
+	if fake.GetProcessesByApplicationStub != nil {
+		return fake.GetProcessesByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessesByApplicationReturns.result1, fake.getProcessesByApplicationReturns.result2, fake.getProcessesByApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetProcessesByApplicationCallCount() int {
+	fake.getProcessesByApplicationMutex.RLock()
+	defer fake.getProcessesByApplicationMutex.RUnlock()
+	return len(fake.getProcessesByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetProcessesByApplicationArgsForCall(i int) string {
+	fake.getProcessesByApplicationMutex.RLock()
+	defer fake.getProcessesByApplicationMutex.RUnlock()
+	return fake.getProcessesByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) GetProcessesByApplicationReturns(result1 []v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessesByApplicationStub = nil
+	fake.getProcessesByApplicationReturns = struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetProcessesByApplicationReturnsOnCall(i int, result1 []v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessesByApplicationStub = nil
+	if fake.getProcessesByApplicationReturnsOnCall == nil {
+		fake.getProcessesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Process
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessesByApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{appGUID, client})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2
+}
+
+func (fake *FakeV3Actor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].appGUID, fake.getStreamingLogsArgsForCall[i].client
+}
+
+func (fake *FakeV3Actor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequest(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error) {
+	var bodyCopy []byte
+	if body != nil {
+		bodyCopy = make([]byte, len(body))
+		copy(bodyCopy, body)
+	}
+	fake.makeCloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.makeCloudControllerRequestReturnsOnCall[len(fake.makeCloudControllerRequestArgsForCall)]
+	fake.makeCloudControllerRequestArgsForCall = append(fake.makeCloudControllerRequestArgsForCall, struct {
+		method string
+		path   string
+		body   []byte
+	}{method, path, bodyCopy})
+	fake.recordInvocation("MakeCloudControllerRequest", []interface{}{method, path, bodyCopy})
+	fake.makeCloudControllerRequestMutex.Unlock()
+	if fake.MakeCloudControllerRequestStub != nil {
+		return fake.MakeCloudControllerRequestStub(method, path, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeCloudControllerRequestReturns.result1, fake.makeCloudControllerRequestReturns.result2, fake.makeCloudControllerRequestReturns.result3
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestCallCount() int {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	return len(fake.makeCloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestArgsForCall(i int) (string, string, []byte) {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	return fake.makeCloudControllerRequestArgsForCall[i].method, fake.makeCloudControllerRequestArgsForCall[i].path, fake.makeCloudControllerRequestArgsForCall[i].body
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestReturns(result1 v3action.CloudControllerResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeCloudControllerRequestStub = nil
+	fake.makeCloudControllerRequestReturns = struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestReturnsOnCall(i int, result1 v3action.CloudControllerResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeCloudControllerRequestStub = nil
+	if fake.makeCloudControllerRequestReturnsOnCall == nil {
+		fake.makeCloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 v3action.CloudControllerResponse
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.makeCloudControllerRequestReturnsOnCall[i] = struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getDropletsByApplicationMutex.RLock()
+	defer fake.getDropletsByApplicationMutex.RUnlock()
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	fake.getPackagesByApplicationMutex.RLock()
+	defer fake.getPackagesByApplicationMutex.RUnlock()
+	fake.getProcessesByApplicationMutex.RLock()
+	defer fake.getProcessesByApplicationMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V3Actor = new(FakeV3Actor)
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullString", func() {
+	var nullString NullString
+
+	BeforeEach(func() {
+		nullString = NullString{}
+	})
+
+	Describe("NewNullString", func() {
+		It("returns a set NullString", func() {
+			Expect(NewNullString("")).To(Equal(NullString{Value: "", IsSet: true}))
+		})
+	})
+
+	DescribeTable("UnmarshalJSON",
+		func(input string, expected NullString) {
+			err := nullString.UnmarshalJSON([]byte(input))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nullString).To(Equal(expected))
+		},
+		Entry("null", "null", NullString{Value: "", IsSet: false}),
+		Entry("empty string", `""`, NullString{Value: "", IsSet: true}),
+		Entry("string", `"some-value"`, NullString{Value: "some-value", IsSet: true}),
+	)
+
+	DescribeTable("MarshalJSON",
+		func(input NullString, expected string) {
+			bytes, err := input.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(bytes)).To(Equal(expected))
+		},
+		Entry("not set", NullString{}, "null"),
+		Entry("empty string", NullString{IsSet: true}, `""`),
+		Entry("string", NullString{Value: `some "value"`, IsSet: true}, `"some \"value\""`),
+	)
+})
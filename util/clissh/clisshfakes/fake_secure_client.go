@@ -44,6 +44,20 @@ type FakeSecureClient struct {
 		result1 net.Conn
 		result2 error
 	}
+	ListenStub        func(network, address string) (net.Listener, error)
+	listenMutex       sync.RWMutex
+	listenArgsForCall []struct {
+		network string
+		address string
+	}
+	listenReturns struct {
+		result1 net.Listener
+		result2 error
+	}
+	listenReturnsOnCall map[int]struct {
+		result1 net.Listener
+		result2 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
@@ -201,6 +215,58 @@ func (fake *FakeSecureClient) DialReturnsOnCall(i int, result1 net.Conn, result2
 	}{result1, result2}
 }
 
+func (fake *FakeSecureClient) Listen(network string, address string) (net.Listener, error) {
+	fake.listenMutex.Lock()
+	ret, specificReturn := fake.listenReturnsOnCall[len(fake.listenArgsForCall)]
+	fake.listenArgsForCall = append(fake.listenArgsForCall, struct {
+		network string
+		address string
+	}{network, address})
+	fake.recordInvocation("Listen", []interface{}{network, address})
+	fake.listenMutex.Unlock()
+	if fake.ListenStub != nil {
+		return fake.ListenStub(network, address)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listenReturns.result1, fake.listenReturns.result2
+}
+
+func (fake *FakeSecureClient) ListenCallCount() int {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return len(fake.listenArgsForCall)
+}
+
+func (fake *FakeSecureClient) ListenArgsForCall(i int) (string, string) {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return fake.listenArgsForCall[i].network, fake.listenArgsForCall[i].address
+}
+
+func (fake *FakeSecureClient) ListenReturns(result1 net.Listener, result2 error) {
+	fake.ListenStub = nil
+	fake.listenReturns = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureClient) ListenReturnsOnCall(i int, result1 net.Listener, result2 error) {
+	fake.ListenStub = nil
+	if fake.listenReturnsOnCall == nil {
+		fake.listenReturnsOnCall = make(map[int]struct {
+			result1 net.Listener
+			result2 error
+		})
+	}
+	fake.listenReturnsOnCall[i] = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -290,6 +356,8 @@ func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	defer fake.connMutex.RUnlock()
 	fake.dialMutex.RLock()
 	defer fake.dialMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
@@ -83,6 +83,17 @@ type FakeSecureSession struct {
 	startReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RequestSubsystemStub        func(subsystem string) error
+	requestSubsystemMutex       sync.RWMutex
+	requestSubsystemArgsForCall []struct {
+		subsystem string
+	}
+	requestSubsystemReturns struct {
+		result1 error
+	}
+	requestSubsystemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ShellStub        func() error
 	shellMutex       sync.RWMutex
 	shellArgsForCall []struct{}
@@ -400,6 +411,54 @@ func (fake *FakeSecureSession) StartReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) RequestSubsystem(subsystem string) error {
+	fake.requestSubsystemMutex.Lock()
+	ret, specificReturn := fake.requestSubsystemReturnsOnCall[len(fake.requestSubsystemArgsForCall)]
+	fake.requestSubsystemArgsForCall = append(fake.requestSubsystemArgsForCall, struct {
+		subsystem string
+	}{subsystem})
+	fake.recordInvocation("RequestSubsystem", []interface{}{subsystem})
+	fake.requestSubsystemMutex.Unlock()
+	if fake.RequestSubsystemStub != nil {
+		return fake.RequestSubsystemStub(subsystem)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestSubsystemReturns.result1
+}
+
+func (fake *FakeSecureSession) RequestSubsystemCallCount() int {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return len(fake.requestSubsystemArgsForCall)
+}
+
+func (fake *FakeSecureSession) RequestSubsystemArgsForCall(i int) string {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return fake.requestSubsystemArgsForCall[i].subsystem
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturns(result1 error) {
+	fake.RequestSubsystemStub = nil
+	fake.requestSubsystemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturnsOnCall(i int, result1 error) {
+	fake.RequestSubsystemStub = nil
+	if fake.requestSubsystemReturnsOnCall == nil {
+		fake.requestSubsystemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestSubsystemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) Shell() error {
 	fake.shellMutex.Lock()
 	ret, specificReturn := fake.shellReturnsOnCall[len(fake.shellArgsForCall)]
@@ -535,6 +594,8 @@ func (fake *FakeSecureSession) Invocations() map[string][][]interface{} {
 	defer fake.stderrPipeMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
 	fake.shellMutex.RLock()
 	defer fake.shellMutex.RUnlock()
 	fake.waitMutex.RLock()
@@ -22,6 +22,10 @@ func (sc secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
 
+func (sc secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
+
 func (sc secureClient) Conn() ssh.Conn {
 	return sc.client.Conn
 }
@@ -0,0 +1,706 @@
+package clissh
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+)
+
+// Only the parts of SFTP version 3 (draft-ietf-secsh-filexfer-02) needed to
+// copy files and directories are supported. Reads and writes of a file keep
+// up to sftpMaxOutstandingRequests requests in flight, so that transfers are
+// not limited by the round trip time to the app instance.
+const (
+	sftpProtocolVersion        = 3
+	sftpSubsystem              = "sftp"
+	sftpMaxChunkSize           = 32768
+	sftpMaxPacketSize          = 256 * 1024
+	sftpMaxOutstandingRequests = 64
+
+	sftpPacketInit     = 1
+	sftpPacketVersion  = 2
+	sftpPacketOpen     = 3
+	sftpPacketClose    = 4
+	sftpPacketRead     = 5
+	sftpPacketWrite    = 6
+	sftpPacketOpenDir  = 11
+	sftpPacketReadDir  = 12
+	sftpPacketMkdir    = 14
+	sftpPacketRealPath = 16
+	sftpPacketStat     = 17
+	sftpPacketStatus   = 101
+	sftpPacketHandle   = 102
+	sftpPacketData     = 103
+	sftpPacketName     = 104
+	sftpPacketAttrs    = 105
+
+	sftpOpenRead     = 0x01
+	sftpOpenWrite    = 0x02
+	sftpOpenCreate   = 0x08
+	sftpOpenTruncate = 0x10
+
+	sftpAttrSize       = 0x01
+	sftpAttrUIDGID     = 0x02
+	sftpAttrPermission = 0x04
+	sftpAttrACModTime  = 0x08
+	sftpAttrExtended   = 0x80000000
+
+	sftpStatusOK         = 0
+	sftpStatusEOF        = 1
+	sftpStatusNoSuchFile = 2
+
+	sftpModeTypeMask = 0170000
+	sftpModeDir      = 0040000
+	sftpModeSymlink  = 0120000
+)
+
+//go:generate counterfeiter . SFTPSession
+
+// SFTPSession is a file transfer session with an app instance. Paths are
+// interpreted by the app instance and always use forward slashes.
+type SFTPSession interface {
+	Stat(path string) (os.FileInfo, error)
+	ReadDir(path string) ([]os.FileInfo, error)
+	Mkdir(path string, mode os.FileMode) error
+	RealPath(path string) (string, error)
+	Open(path string) (io.ReadCloser, error)
+	Create(path string, mode os.FileMode) (io.WriteCloser, error)
+	Close() error
+}
+
+// SFTPStatusError is returned when the app instance fails an SFTP request.
+type SFTPStatusError struct {
+	Code    uint32
+	Message string
+}
+
+func (e SFTPStatusError) Error() string {
+	return fmt.Sprintf("sftp: %s (status %d)", e.Message, e.Code)
+}
+
+// SFTPSession starts the SFTP subsystem on the first connected app instance.
+func (c *SecureShell) SFTPSession() (SFTPSession, error) {
+	session, err := c.secureClients[0].NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+
+	client, err := newSFTPClient(session)
+	if err != nil {
+		_ = session.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+type sftpClient struct {
+	session SecureSession
+	in      io.WriteCloser
+	out     io.Reader
+
+	writeMutex sync.Mutex
+
+	mutex     sync.Mutex
+	requestID uint32
+	pending   map[uint32]chan sftpResponse
+	err       error
+}
+
+type sftpResponse struct {
+	packetType byte
+	data       []byte
+	err        error
+}
+
+func newSFTPClient(session SecureSession) (*sftpClient, error) {
+	in, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.RequestSubsystem(sftpSubsystem)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &sftpClient{
+		session: session,
+		in:      in,
+		out:     out,
+		pending: map[uint32]chan sftpResponse{},
+	}
+
+	err = client.writePacket(sftpPacketInit, uint32ToBytes(sftpProtocolVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	packetType, data, err := client.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	if packetType != sftpPacketVersion {
+		return nil, fmt.Errorf("sftp: unexpected packet type %d during initialization", packetType)
+	}
+	if version, _, ok := readUint32(data); !ok || version != sftpProtocolVersion {
+		return nil, fmt.Errorf("sftp: unsupported protocol version %d", version)
+	}
+
+	go client.dispatchResponses()
+
+	return client, nil
+}
+
+func (c *sftpClient) Close() error {
+	_ = c.in.Close()
+	return c.session.Close()
+}
+
+func (c *sftpClient) Stat(name string) (os.FileInfo, error) {
+	packetType, data, err := c.request(sftpPacketStat, stringToBytes(name))
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	if packetType != sftpPacketAttrs {
+		return nil, pathError("stat", name, unexpectedPacket(packetType))
+	}
+
+	info, _, ok := readFileInfo(path.Base(name), data)
+	if !ok {
+		return nil, pathError("stat", name, errMalformedSFTPPacket)
+	}
+	return info, nil
+}
+
+func (c *sftpClient) ReadDir(name string) ([]os.FileInfo, error) {
+	handle, err := c.openHandle(sftpPacketOpenDir, stringToBytes(name))
+	if err != nil {
+		return nil, pathError("readdir", name, err)
+	}
+	defer c.closeHandle(handle)
+
+	var infos []os.FileInfo
+	for {
+		packetType, data, err := c.request(sftpPacketReadDir, stringToBytes(handle))
+		if err == io.EOF {
+			return infos, nil
+		}
+		if err != nil {
+			return nil, pathError("readdir", name, err)
+		}
+		if packetType != sftpPacketName {
+			return nil, pathError("readdir", name, unexpectedPacket(packetType))
+		}
+
+		count, data, ok := readUint32(data)
+		for i := uint32(0); ok && i < count; i++ {
+			var filename string
+			var info os.FileInfo
+			filename, data, ok = readString(data)
+			if !ok {
+				break
+			}
+			_, data, ok = readString(data) // long name
+			if !ok {
+				break
+			}
+			info, data, ok = readFileInfo(filename, data)
+			if ok && filename != "." && filename != ".." {
+				infos = append(infos, info)
+			}
+		}
+		if !ok {
+			return nil, pathError("readdir", name, errMalformedSFTPPacket)
+		}
+	}
+}
+
+func (c *sftpClient) Mkdir(name string, mode os.FileMode) error {
+	payload := append(stringToBytes(name), permissionAttrs(mode)...)
+	packetType, _, err := c.request(sftpPacketMkdir, payload)
+	if err == nil && packetType != sftpPacketStatus {
+		err = unexpectedPacket(packetType)
+	}
+	if err != nil {
+		return pathError("mkdir", name, err)
+	}
+	return nil
+}
+
+func (c *sftpClient) RealPath(name string) (string, error) {
+	packetType, data, err := c.request(sftpPacketRealPath, stringToBytes(name))
+	if err == nil && packetType != sftpPacketName {
+		err = unexpectedPacket(packetType)
+	}
+	if err != nil {
+		return "", pathError("realpath", name, err)
+	}
+
+	count, data, ok := readUint32(data)
+	if ok && count > 0 {
+		var realPath string
+		if realPath, _, ok = readString(data); ok {
+			return realPath, nil
+		}
+	}
+	return "", pathError("realpath", name, errMalformedSFTPPacket)
+}
+
+func (c *sftpClient) Open(name string) (io.ReadCloser, error) {
+	payload := append(stringToBytes(name), uint32ToBytes(sftpOpenRead)...)
+	payload = append(payload, uint32ToBytes(0)...)
+	handle, err := c.openHandle(sftpPacketOpen, payload)
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+	return &sftpFile{client: c, name: name, handle: handle}, nil
+}
+
+func (c *sftpClient) Create(name string, mode os.FileMode) (io.WriteCloser, error) {
+	payload := append(stringToBytes(name), uint32ToBytes(sftpOpenWrite|sftpOpenCreate|sftpOpenTruncate)...)
+	payload = append(payload, permissionAttrs(mode)...)
+	handle, err := c.openHandle(sftpPacketOpen, payload)
+	if err != nil {
+		return nil, pathError("create", name, err)
+	}
+	return &sftpFile{client: c, name: name, handle: handle}, nil
+}
+
+func (c *sftpClient) openHandle(packetType byte, payload []byte) (string, error) {
+	responseType, data, err := c.request(packetType, payload)
+	if err != nil {
+		return "", err
+	}
+	if responseType != sftpPacketHandle {
+		return "", unexpectedPacket(responseType)
+	}
+
+	handle, _, ok := readString(data)
+	if !ok {
+		return "", errMalformedSFTPPacket
+	}
+	return handle, nil
+}
+
+func (c *sftpClient) closeHandle(handle string) error {
+	_, _, err := c.request(sftpPacketClose, stringToBytes(handle))
+	return err
+}
+
+// request sends a request and waits for its response.
+func (c *sftpClient) request(packetType byte, payload []byte) (byte, []byte, error) {
+	response, err := c.send(packetType, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+	return c.wait(response)
+}
+
+// send sends a request without waiting for its response, which is delivered
+// on the returned channel.
+func (c *sftpClient) send(packetType byte, payload []byte) (<-chan sftpResponse, error) {
+	c.mutex.Lock()
+	if c.err != nil {
+		c.mutex.Unlock()
+		return nil, c.err
+	}
+	c.requestID++
+	id := c.requestID
+	response := make(chan sftpResponse, 1)
+	c.pending[id] = response
+	c.mutex.Unlock()
+
+	c.writeMutex.Lock()
+	err := c.writePacket(packetType, append(uint32ToBytes(id), payload...))
+	c.writeMutex.Unlock()
+	if err != nil {
+		c.mutex.Lock()
+		delete(c.pending, id)
+		c.mutex.Unlock()
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// wait returns the type and data of a response, without the request ID.
+// Status responses other than OK are returned as errors, with EOF reported as
+// io.EOF.
+func (c *sftpClient) wait(response <-chan sftpResponse) (byte, []byte, error) {
+	r := <-response
+	if r.err != nil {
+		return 0, nil, r.err
+	}
+
+	if r.packetType == sftpPacketStatus {
+		code, rest, ok := readUint32(r.data)
+		if !ok {
+			return 0, nil, errMalformedSFTPPacket
+		}
+		message, _, _ := readString(rest)
+
+		switch code {
+		case sftpStatusOK:
+		case sftpStatusEOF:
+			return 0, nil, io.EOF
+		case sftpStatusNoSuchFile:
+			return 0, nil, os.ErrNotExist
+		default:
+			return 0, nil, SFTPStatusError{Code: code, Message: message}
+		}
+	}
+
+	return r.packetType, r.data, nil
+}
+
+// dispatchResponses delivers each response to the request with the same ID
+// until the connection fails, after which every request fails with the
+// connection error.
+func (c *sftpClient) dispatchResponses() {
+	for {
+		packetType, data, err := c.readPacket()
+		if err == nil {
+			id, rest, ok := readUint32(data)
+			if !ok {
+				err = errMalformedSFTPPacket
+			} else {
+				c.mutex.Lock()
+				response, found := c.pending[id]
+				delete(c.pending, id)
+				c.mutex.Unlock()
+
+				if found {
+					response <- sftpResponse{packetType: packetType, data: rest}
+				}
+				continue
+			}
+		}
+
+		if err == io.EOF {
+			err = errSFTPConnectionClosed
+		}
+
+		c.mutex.Lock()
+		c.err = err
+		for id, response := range c.pending {
+			response <- sftpResponse{err: err}
+			delete(c.pending, id)
+		}
+		c.mutex.Unlock()
+		return
+	}
+}
+
+func (c *sftpClient) writePacket(packetType byte, payload []byte) error {
+	packet := append(uint32ToBytes(uint32(len(payload)+1)), packetType)
+	_, err := c.in.Write(append(packet, payload...))
+	return err
+}
+
+func (c *sftpClient) readPacket() (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(c.out, header); err != nil {
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header)
+	if length < 1 || length > sftpMaxPacketSize {
+		return 0, nil, errMalformedSFTPPacket
+	}
+
+	data := make([]byte, length-1)
+	if _, err := io.ReadFull(c.out, data); err != nil {
+		return 0, nil, err
+	}
+	return header[4], data, nil
+}
+
+// sftpFile is a file opened for either reading or writing.
+type sftpFile struct {
+	client *sftpClient
+	name   string
+	handle string
+
+	// Reads are requested ahead of the data returned by Read, starting with a
+	// single request and doubling up to sftpMaxOutstandingRequests while the
+	// file keeps returning full chunks.
+	readOffset  uint64
+	readWindow  int
+	reads       []sftpRead
+	readBuffer  []byte
+	readErr     error
+	writeOffset uint64
+	writes      []<-chan sftpResponse
+	writeErr    error
+}
+
+type sftpRead struct {
+	offset   uint64
+	length   uint32
+	response <-chan sftpResponse
+}
+
+func (f *sftpFile) Read(p []byte) (int, error) {
+	for len(f.readBuffer) == 0 {
+		if f.readErr != nil {
+			return 0, f.readErr
+		}
+		f.readErr = f.readChunk()
+	}
+
+	n := copy(p, f.readBuffer)
+	f.readBuffer = f.readBuffer[n:]
+	return n, nil
+}
+
+// readChunk fills the read buffer with the response to the oldest read
+// request, after sending enough requests to fill the read window.
+func (f *sftpFile) readChunk() error {
+	if f.readWindow == 0 {
+		f.readWindow = 1
+	}
+	for len(f.reads) < f.readWindow {
+		payload := append(stringToBytes(f.handle), uint64ToBytes(f.readOffset)...)
+		payload = append(payload, uint32ToBytes(sftpMaxChunkSize)...)
+		response, err := f.client.send(sftpPacketRead, payload)
+		if err != nil {
+			return pathError("read", f.name, err)
+		}
+		f.reads = append(f.reads, sftpRead{offset: f.readOffset, length: sftpMaxChunkSize, response: response})
+		f.readOffset += sftpMaxChunkSize
+	}
+
+	read := f.reads[0]
+	f.reads = f.reads[1:]
+
+	packetType, data, err := f.client.wait(read.response)
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err == nil && packetType != sftpPacketData {
+		err = unexpectedPacket(packetType)
+	}
+	if err != nil {
+		return pathError("read", f.name, err)
+	}
+
+	chunk, _, ok := readString(data)
+	if !ok {
+		return pathError("read", f.name, errMalformedSFTPPacket)
+	}
+
+	if uint32(len(chunk)) < read.length {
+		// The requests after a short read may have skipped data, so they are
+		// abandoned and reading continues after the returned data.
+		f.reads = nil
+		f.readOffset = read.offset + uint64(len(chunk))
+		f.readWindow = 1
+	} else if f.readWindow < sftpMaxOutstandingRequests {
+		f.readWindow *= 2
+	}
+
+	f.readBuffer = []byte(chunk)
+	return nil
+}
+
+// Write sends the data without waiting for it to be written. A failed write
+// is returned by a later call to Write or by Close.
+func (f *sftpFile) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		if f.writeErr != nil {
+			return written, f.writeErr
+		}
+
+		if len(f.writes) == sftpMaxOutstandingRequests {
+			f.waitForWrite()
+			continue
+		}
+
+		chunk := p[written:]
+		if len(chunk) > sftpMaxChunkSize {
+			chunk = chunk[:sftpMaxChunkSize]
+		}
+
+		payload := append(stringToBytes(f.handle), uint64ToBytes(f.writeOffset)...)
+		payload = append(payload, stringToBytes(string(chunk))...)
+		response, err := f.client.send(sftpPacketWrite, payload)
+		if err != nil {
+			f.writeErr = pathError("write", f.name, err)
+			continue
+		}
+		f.writes = append(f.writes, response)
+
+		written += len(chunk)
+		f.writeOffset += uint64(len(chunk))
+	}
+
+	return written, nil
+}
+
+func (f *sftpFile) waitForWrite() {
+	response := f.writes[0]
+	f.writes = f.writes[1:]
+
+	packetType, _, err := f.client.wait(response)
+	if err == nil && packetType != sftpPacketStatus {
+		err = unexpectedPacket(packetType)
+	}
+	if err != nil && f.writeErr == nil {
+		f.writeErr = pathError("write", f.name, err)
+	}
+}
+
+// Close waits for the outstanding writes and closes the file. Responses to
+// abandoned read requests are discarded as they arrive.
+func (f *sftpFile) Close() error {
+	for len(f.writes) > 0 {
+		f.waitForWrite()
+	}
+
+	err := f.client.closeHandle(f.handle)
+	if f.writeErr != nil {
+		return f.writeErr
+	}
+	if err != nil {
+		return pathError("close", f.name, err)
+	}
+	return nil
+}
+
+type sftpFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (i sftpFileInfo) Name() string       { return i.name }
+func (i sftpFileInfo) Size() int64        { return i.size }
+func (i sftpFileInfo) Mode() os.FileMode  { return i.mode }
+func (i sftpFileInfo) ModTime() time.Time { return i.modTime }
+func (i sftpFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i sftpFileInfo) Sys() interface{}   { return nil }
+
+var (
+	errMalformedSFTPPacket  = errors.New("sftp: malformed packet")
+	errSFTPConnectionClosed = errors.New("sftp: connection closed")
+)
+
+func unexpectedPacket(packetType byte) error {
+	return fmt.Errorf("sftp: unexpected packet type %d", packetType)
+}
+
+func pathError(op string, name string, err error) error {
+	return &os.PathError{Op: op, Path: name, Err: err}
+}
+
+func readFileInfo(name string, data []byte) (os.FileInfo, []byte, bool) {
+	info := sftpFileInfo{name: name}
+
+	flags, data, ok := readUint32(data)
+	if !ok {
+		return nil, nil, false
+	}
+
+	if flags&sftpAttrSize != 0 {
+		var size uint64
+		if size, data, ok = readUint64(data); !ok {
+			return nil, nil, false
+		}
+		info.size = int64(size)
+	}
+	if flags&sftpAttrUIDGID != 0 {
+		if len(data) < 8 {
+			return nil, nil, false
+		}
+		data = data[8:]
+	}
+	if flags&sftpAttrPermission != 0 {
+		var permissions uint32
+		if permissions, data, ok = readUint32(data); !ok {
+			return nil, nil, false
+		}
+		info.mode = os.FileMode(permissions) & os.ModePerm
+		switch permissions & sftpModeTypeMask {
+		case sftpModeDir:
+			info.mode |= os.ModeDir
+		case sftpModeSymlink:
+			info.mode |= os.ModeSymlink
+		}
+	}
+	if flags&sftpAttrACModTime != 0 {
+		var modTime uint32
+		if len(data) < 8 {
+			return nil, nil, false
+		}
+		modTime, data, _ = readUint32(data[4:])
+		info.modTime = time.Unix(int64(modTime), 0)
+	}
+	if flags&sftpAttrExtended != 0 {
+		var count uint32
+		if count, data, ok = readUint32(data); !ok {
+			return nil, nil, false
+		}
+		for i := uint32(0); i < 2*count; i++ {
+			if _, data, ok = readString(data); !ok {
+				return nil, nil, false
+			}
+		}
+	}
+
+	return info, data, true
+}
+
+func permissionAttrs(mode os.FileMode) []byte {
+	return append(uint32ToBytes(sftpAttrPermission), uint32ToBytes(uint32(mode&os.ModePerm))...)
+}
+
+func uint32ToBytes(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func uint64ToBytes(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func stringToBytes(s string) []byte {
+	return append(uint32ToBytes(uint32(len(s))), s...)
+}
+
+func readUint32(data []byte) (uint32, []byte, bool) {
+	if len(data) < 4 {
+		return 0, nil, false
+	}
+	return binary.BigEndian.Uint32(data), data[4:], true
+}
+
+func readUint64(data []byte) (uint64, []byte, bool) {
+	if len(data) < 8 {
+		return 0, nil, false
+	}
+	return binary.BigEndian.Uint64(data), data[8:], true
+}
+
+func readString(data []byte) (string, []byte, bool) {
+	length, data, ok := readUint32(data)
+	if !ok || uint32(len(data)) < length {
+		return "", nil, false
+	}
+	return string(data[:length]), data[length:], true
+}
@@ -0,0 +1,526 @@
+package clissh_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testSFTPServer serves the SFTP requests made by the client from a local
+// directory.
+type testSFTPServer struct {
+	root    string
+	version uint32
+	in      io.ReadCloser
+	out     io.WriteCloser
+
+	handles    map[string]*os.File
+	listedDirs map[string]bool
+
+	// delay is waited before each request is handled, and maxQueued is the
+	// largest number of requests that were waiting to be handled at once.
+	delay     time.Duration
+	maxQueued int
+
+	failWrites bool
+}
+
+type sftpTestPacket struct {
+	packetType byte
+	data       []byte
+}
+
+type sftpPacketReader struct {
+	data []byte
+}
+
+func (r *sftpPacketReader) uint32() uint32 {
+	v := binary.BigEndian.Uint32(r.data)
+	r.data = r.data[4:]
+	return v
+}
+
+func (r *sftpPacketReader) uint64() uint64 {
+	v := binary.BigEndian.Uint64(r.data)
+	r.data = r.data[8:]
+	return v
+}
+
+func (r *sftpPacketReader) string() string {
+	length := r.uint32()
+	v := string(r.data[:length])
+	r.data = r.data[length:]
+	return v
+}
+
+func sftpUint32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func sftpString(s string) []byte {
+	return append(sftpUint32(uint32(len(s))), s...)
+}
+
+func sftpAttrs(info os.FileInfo) []byte {
+	permissions := uint32(info.Mode().Perm()) | 0100000
+	if info.IsDir() {
+		permissions = uint32(info.Mode().Perm()) | 0040000
+	}
+
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(info.Size()))
+
+	attrs := append(sftpUint32(0x01|0x04|0x08), size...)
+	attrs = append(attrs, sftpUint32(permissions)...)
+	attrs = append(attrs, sftpUint32(uint32(info.ModTime().Unix()))...)
+	return append(attrs, sftpUint32(uint32(info.ModTime().Unix()))...)
+}
+
+func (s *testSFTPServer) serve() {
+	defer s.out.Close()
+
+	packets := make(chan sftpTestPacket, 1024)
+	go func() {
+		defer close(packets)
+		for {
+			header := make([]byte, 5)
+			if _, err := io.ReadFull(s.in, header); err != nil {
+				return
+			}
+			data := make([]byte, binary.BigEndian.Uint32(header)-1)
+			if _, err := io.ReadFull(s.in, data); err != nil {
+				return
+			}
+			packets <- sftpTestPacket{packetType: header[4], data: data}
+		}
+	}()
+
+	for packet := range packets {
+		if queued := len(packets) + 1; queued > s.maxQueued {
+			s.maxQueued = queued
+		}
+		time.Sleep(s.delay)
+
+		if packet.packetType == 1 {
+			s.send(2, sftpUint32(s.version))
+			continue
+		}
+
+		r := &sftpPacketReader{data: packet.data}
+		s.handle(packet.packetType, r.uint32(), r)
+	}
+}
+
+func (s *testSFTPServer) send(packetType byte, payload []byte) {
+	packet := append(sftpUint32(uint32(len(payload)+1)), packetType)
+	_, _ = s.out.Write(append(packet, payload...))
+}
+
+func (s *testSFTPServer) sendStatus(id uint32, err error) {
+	code := uint32(0)
+	switch {
+	case err == io.EOF:
+		code = 1
+	case os.IsNotExist(err):
+		code = 2
+	case err != nil:
+		code = 4
+	}
+
+	payload := append(sftpUint32(id), sftpUint32(code)...)
+	payload = append(payload, sftpString(fmt.Sprint(err))...)
+	s.send(101, append(payload, sftpString("")...))
+}
+
+func (s *testSFTPServer) sendHandle(id uint32, file *os.File) {
+	handle := fmt.Sprintf("handle-%d", len(s.handles))
+	s.handles[handle] = file
+	s.send(102, append(sftpUint32(id), sftpString(handle)...))
+}
+
+func (s *testSFTPServer) localPath(path string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path))
+}
+
+func (s *testSFTPServer) handle(packetType byte, id uint32, r *sftpPacketReader) {
+	switch packetType {
+	case 17: // STAT
+		info, err := os.Stat(s.localPath(r.string()))
+		if err != nil {
+			s.sendStatus(id, err)
+			return
+		}
+		s.send(105, append(sftpUint32(id), sftpAttrs(info)...))
+	case 11: // OPENDIR
+		dir, err := os.Open(s.localPath(r.string()))
+		if err != nil {
+			s.sendStatus(id, err)
+			return
+		}
+		s.sendHandle(id, dir)
+	case 12: // READDIR
+		handle := r.string()
+		if s.listedDirs[handle] {
+			s.sendStatus(id, io.EOF)
+			return
+		}
+		s.listedDirs[handle] = true
+
+		dir := s.handles[handle]
+		infos, err := dir.Readdir(-1)
+		if err != nil {
+			s.sendStatus(id, err)
+			return
+		}
+		self, err := dir.Stat()
+		Expect(err).ToNot(HaveOccurred())
+
+		payload := append(sftpUint32(id), sftpUint32(uint32(len(infos)+1))...)
+		payload = append(payload, sftpString(".")...)
+		payload = append(payload, sftpString("drwxr-xr-x .")...)
+		payload = append(payload, sftpAttrs(self)...)
+		for _, info := range infos {
+			payload = append(payload, sftpString(info.Name())...)
+			payload = append(payload, sftpString(info.Name())...)
+			payload = append(payload, sftpAttrs(info)...)
+		}
+		s.send(104, payload)
+	case 16: // REALPATH
+		realPath := path.Join("/home/vcap", r.string())
+		payload := append(sftpUint32(id), sftpUint32(1)...)
+		payload = append(payload, sftpString(realPath)...)
+		payload = append(payload, sftpString(realPath)...)
+		s.send(104, append(payload, sftpUint32(0)...))
+	case 4: // CLOSE
+		s.sendStatus(id, s.handles[r.string()].Close())
+	case 14: // MKDIR
+		path := r.string()
+		r.uint32()
+		s.sendStatus(id, os.Mkdir(s.localPath(path), os.FileMode(r.uint32())))
+	case 3: // OPEN
+		path := r.string()
+		flags := r.uint32()
+
+		var (
+			file *os.File
+			err  error
+		)
+		if flags&0x02 != 0 {
+			r.uint32()
+			file, err = os.OpenFile(s.localPath(path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(r.uint32()))
+		} else {
+			file, err = os.Open(s.localPath(path))
+		}
+		if err != nil {
+			s.sendStatus(id, err)
+			return
+		}
+		s.sendHandle(id, file)
+	case 5: // READ
+		file := s.handles[r.string()]
+		offset := r.uint64()
+		data := make([]byte, r.uint32())
+		n, err := file.ReadAt(data, int64(offset))
+		if n == 0 {
+			s.sendStatus(id, err)
+			return
+		}
+		s.send(103, append(sftpUint32(id), sftpString(string(data[:n]))...))
+	case 6: // WRITE
+		if s.failWrites {
+			s.sendStatus(id, errors.New("some-write-error"))
+			return
+		}
+		file := s.handles[r.string()]
+		offset := r.uint64()
+		_, err := file.WriteAt([]byte(r.string()), int64(offset))
+		s.sendStatus(id, err)
+	default:
+		s.sendStatus(id, errors.New("unsupported"))
+	}
+}
+
+var _ = Describe("SFTP", func() {
+	var (
+		fakeSecureDialer    *clisshfakes.FakeSecureDialer
+		fakeSecureClient    *clisshfakes.FakeSecureClient
+		fakeSecureSession   *clisshfakes.FakeSecureSession
+		fakeTerminalHelper  *clisshfakes.FakeTerminalHelper
+		fakeListenerFactory *clisshfakes.FakeListenerFactory
+
+		server      *testSFTPServer
+		secureShell *SecureShell
+
+		session    SFTPSession
+		sessionErr error
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+		fakeTerminalHelper = new(clisshfakes.FakeTerminalHelper)
+		fakeListenerFactory = new(clisshfakes.FakeListenerFactory)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+
+		root, err := ioutil.TempDir("", "sftp-root")
+		Expect(err).ToNot(HaveOccurred())
+
+		requests, requestsWriter := io.Pipe()
+		responses, responsesWriter := io.Pipe()
+		server = &testSFTPServer{
+			root:       root,
+			version:    3,
+			in:         requests,
+			out:        responsesWriter,
+			handles:    map[string]*os.File{},
+			listedDirs: map[string]bool{},
+		}
+
+		fakeSecureSession.StdinPipeReturns(requestsWriter, nil)
+		fakeSecureSession.StdoutPipeReturns(responses, nil)
+		fakeSecureSession.RequestSubsystemStub = func(string) error {
+			go server.serve()
+			return nil
+		}
+
+		secureShell = NewSecureShell(fakeSecureDialer, fakeTerminalHelper, fakeListenerFactory, DefaultKeepAliveInterval)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		session, sessionErr = secureShell.SFTPSession()
+	})
+
+	AfterEach(func() {
+		if session != nil {
+			Expect(session.Close()).To(Succeed())
+		}
+		Expect(os.RemoveAll(server.root)).To(Succeed())
+	})
+
+	It("starts the sftp subsystem on a new session", func() {
+		Expect(sessionErr).ToNot(HaveOccurred())
+		Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(1))
+		Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+		Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+	})
+
+	Context("when the session cannot be allocated", func() {
+		BeforeEach(func() {
+			fakeSecureClient.NewSessionReturns(nil, errors.New("some-session-error"))
+		})
+
+		It("returns an error", func() {
+			Expect(sessionErr).To(MatchError("SSH session allocation failed: some-session-error"))
+		})
+	})
+
+	Context("when requesting the subsystem fails", func() {
+		BeforeEach(func() {
+			fakeSecureSession.RequestSubsystemStub = nil
+			fakeSecureSession.RequestSubsystemReturns(errors.New("some-subsystem-error"))
+		})
+
+		It("closes the session and returns the error", func() {
+			Expect(sessionErr).To(MatchError("some-subsystem-error"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the server does not speak version 3", func() {
+		BeforeEach(func() {
+			server.version = 6
+		})
+
+		It("closes the session and returns an error", func() {
+			Expect(sessionErr).To(MatchError("sftp: unsupported protocol version 6"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("Stat", func() {
+		BeforeEach(func() {
+			Expect(os.Mkdir(filepath.Join(server.root, "some-dir"), 0750)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(server.root, "some-dir", "some-file"), []byte("some-content"), 0640)).To(Succeed())
+		})
+
+		It("returns the file info of files and directories", func() {
+			Expect(sessionErr).ToNot(HaveOccurred())
+
+			info, err := session.Stat("/some-dir/some-file")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Name()).To(Equal("some-file"))
+			Expect(info.Size()).To(BeEquivalentTo(len("some-content")))
+			Expect(info.Mode()).To(Equal(os.FileMode(0640)))
+			Expect(info.IsDir()).To(BeFalse())
+
+			info, err = session.Stat("/some-dir")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode()).To(Equal(os.ModeDir | 0750))
+			Expect(info.IsDir()).To(BeTrue())
+		})
+
+		Context("when the path does not exist", func() {
+			It("returns a not exist error", func() {
+				Expect(sessionErr).ToNot(HaveOccurred())
+
+				_, err := session.Stat("/some-missing-file")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("ReadDir", func() {
+		BeforeEach(func() {
+			Expect(os.Mkdir(filepath.Join(server.root, "some-dir"), 0755)).To(Succeed())
+			Expect(os.Mkdir(filepath.Join(server.root, "some-dir", "some-subdir"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(server.root, "some-dir", "some-file"), nil, 0644)).To(Succeed())
+		})
+
+		It("returns the directory entries except . and ..", func() {
+			Expect(sessionErr).ToNot(HaveOccurred())
+
+			infos, err := session.ReadDir("/some-dir")
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, info := range infos {
+				names = append(names, info.Name())
+			}
+			sort.Strings(names)
+			Expect(names).To(Equal([]string{"some-file", "some-subdir"}))
+		})
+	})
+
+	Describe("Mkdir", func() {
+		It("creates the directory", func() {
+			Expect(sessionErr).ToNot(HaveOccurred())
+			Expect(session.Mkdir("/some-dir", 0700)).To(Succeed())
+
+			info, err := os.Stat(filepath.Join(server.root, "some-dir"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.IsDir()).To(BeTrue())
+		})
+
+		Context("when the directory cannot be created", func() {
+			It("returns an error", func() {
+				Expect(sessionErr).ToNot(HaveOccurred())
+
+				err := session.Mkdir("/some-missing-dir/some-dir", 0700)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("RealPath", func() {
+		It("returns the absolute path", func() {
+			Expect(sessionErr).ToNot(HaveOccurred())
+
+			realPath, err := session.RealPath("some-dir/../other-dir")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(realPath).To(Equal("/home/vcap/other-dir"))
+		})
+	})
+
+	Describe("Create and Open", func() {
+		var content []byte
+
+		BeforeEach(func() {
+			content = bytes.Repeat([]byte("0123456789abcdef"), 10000)
+		})
+
+		It("writes and reads files larger than a single packet", func() {
+			Expect(sessionErr).ToNot(HaveOccurred())
+
+			file, err := session.Create("/some-file", 0600)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = io.Copy(file, bytes.NewReader(content))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			written, err := ioutil.ReadFile(filepath.Join(server.root, "some-file"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(written).To(Equal(content))
+
+			reader, err := session.Open("/some-file")
+			Expect(err).ToNot(HaveOccurred())
+			read, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reader.Close()).To(Succeed())
+			Expect(read).To(Equal(content))
+		})
+
+		Context("when the app instance is slow to respond", func() {
+			BeforeEach(func() {
+				server.delay = 5 * time.Millisecond
+			})
+
+			It("keeps several reads and writes in flight", func() {
+				Expect(sessionErr).ToNot(HaveOccurred())
+
+				file, err := session.Create("/some-file", 0600)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = io.Copy(file, bytes.NewReader(content))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+				Expect(server.maxQueued).To(BeNumerically(">", 1))
+
+				server.maxQueued = 0
+				reader, err := session.Open("/some-file")
+				Expect(err).ToNot(HaveOccurred())
+				read, err := ioutil.ReadAll(reader)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reader.Close()).To(Succeed())
+				Expect(read).To(Equal(content))
+				Expect(server.maxQueued).To(BeNumerically(">", 1))
+			})
+		})
+
+		Context("when a write fails", func() {
+			BeforeEach(func() {
+				server.failWrites = true
+			})
+
+			It("returns the error from a later write or when the file is closed", func() {
+				Expect(sessionErr).ToNot(HaveOccurred())
+
+				file, err := session.Create("/some-file", 0600)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = io.Copy(file, bytes.NewReader(content))
+				if err == nil {
+					err = file.Close()
+				} else {
+					_ = file.Close()
+				}
+				Expect(err).To(MatchError(ContainSubstring("some-write-error")))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns a not exist error", func() {
+				Expect(sessionErr).ToNot(HaveOccurred())
+
+				_, err := session.Open("/some-missing-file")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
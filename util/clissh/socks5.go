@@ -0,0 +1,113 @@
+package clissh
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// Only the parts of SOCKS5 (RFC 1928) needed by dynamic port forwarding are
+// supported: no authentication and the CONNECT command.
+const (
+	socks5Version = 0x05
+
+	socks5AuthNone         = 0x00
+	socks5AuthNoAcceptable = 0xff
+
+	socks5CommandConnect = 0x01
+
+	socks5AddressIPv4   = 0x01
+	socks5AddressDomain = 0x03
+	socks5AddressIPv6   = 0x04
+
+	socks5ReplySucceeded               = 0x00
+	socks5ReplyHostUnreachable         = 0x04
+	socks5ReplyCommandNotSupported     = 0x07
+	socks5ReplyAddressTypeNotSupported = 0x08
+)
+
+// readSOCKS5Request negotiates the authentication method with a SOCKS5
+// client and returns the address of its CONNECT request. Failures are
+// reported to the client before being returned.
+func readSOCKS5Request(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socks5Version {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(socks5AuthNoAcceptable)
+	for _, m := range methods {
+		if m == socks5AuthNone {
+			method = socks5AuthNone
+			break
+		}
+	}
+	if _, err := conn.Write([]byte{socks5Version, method}); err != nil {
+		return "", err
+	}
+	if method == socks5AuthNoAcceptable {
+		return "", errors.New("no acceptable SOCKS authentication method")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[0] != socks5Version {
+		return "", fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+	if request[1] != socks5CommandConnect {
+		_ = writeSOCKS5Reply(conn, socks5ReplyCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socks5AddressIPv4, socks5AddressIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if request[3] == socks5AddressIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case socks5AddressDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKS5Reply(conn, socks5ReplyAddressTypeNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(port[0])<<8|int(port[1]))), nil
+}
+
+// writeSOCKS5Reply replies to a CONNECT request. The bound address is always
+// reported as 0.0.0.0:0 because the connection is made from the app instance.
+func writeSOCKS5Reply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socks5Version, reply, 0x00, socks5AddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -40,11 +41,35 @@ const (
 	DefaultKeepAliveInterval = 30 * time.Second
 )
 
+// LocalPortForward forwards connections made to a local address through the
+// app instance to a remote address. An empty network is treated as "tcp"; use
+// "unix" for UNIX domain sockets.
 type LocalPortForward struct {
+	LocalNetwork  string
 	LocalAddress  string
+	RemoteNetwork string
 	RemoteAddress string
 }
 
+// RemotePortForward forwards connections made to a remote address on the app
+// instance back to a local address. An empty network is treated as "tcp"; use
+// "unix" for UNIX domain sockets.
+type RemotePortForward struct {
+	RemoteNetwork string
+	RemoteAddress string
+	LocalNetwork  string
+	LocalAddress  string
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on a local address which forwards
+// connections through the app instance to the address requested by the
+// SOCKS5 client. An empty network is treated as "tcp"; use "unix" for UNIX
+// domain sockets.
+type DynamicPortForward struct {
+	LocalNetwork string
+	LocalAddress string
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -57,6 +82,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -85,20 +111,26 @@ type SecureSession interface {
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
 	StderrPipe() (io.Reader, error)
+	RequestSubsystem(subsystem string) error
 	Start(command string) error
 	Shell() error
 	Wait() error
 	Close() error
 }
 
+// SecureShell is an SSH connection to one or more app instances. Connect can
+// be called more than once to connect to several instances through the same
+// endpoint; forwarded connections are then spread across the instances in
+// round-robin order, while interactive sessions use the first instance.
 type SecureShell struct {
 	secureDialer    SecureDialer
-	secureClient    SecureClient
+	secureClients   []SecureClient
 	terminalHelper  TerminalHelper
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
 	keepAliveInterval time.Duration
+	nextClientIndex   uint32
 }
 
 func NewDefaultSecureShell() *SecureShell {
@@ -146,7 +178,7 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 		return err
 	}
 
-	c.secureClient = secureClient
+	c.secureClients = append(c.secureClients, secureClient)
 	return nil
 }
 
@@ -154,24 +186,70 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
-	return c.secureClient.Close()
+
+	var closeErr error
+	for _, secureClient := range c.secureClients {
+		err := secureClient.Close()
+		if err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	return closeErr
 }
 
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
-		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		listener, err := c.listenerFactory.Listen(networkOrTCP(spec.LocalNetwork), spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		network, addr := networkOrTCP(spec.RemoteNetwork), spec.RemoteAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, network, addr)
+		})
+	}
+
+	return nil
+}
+
+// RemotePortForward listens on the remote address of every connected app
+// instance and forwards the connections made to it to the local address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		for _, secureClient := range c.secureClients {
+			listener, err := secureClient.Listen(networkOrTCP(spec.RemoteNetwork), spec.RemoteAddress)
+			if err != nil {
+				return err
+			}
+
+			network, addr := networkOrTCP(spec.LocalNetwork), spec.LocalAddress
+			go acceptLoop(listener, func(conn net.Conn) {
+				handleRemoteForwardConnection(conn, network, addr)
+			})
+		}
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each local address.
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen(networkOrTCP(spec.LocalNetwork), spec.LocalAddress)
 		if err != nil {
 			return err
 		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, spec.RemoteAddress)
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
 	}
 
 	return nil
 }
 
-func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func acceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -184,20 +262,74 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
+	}
+}
+
+func (c *SecureShell) handleForwardConnection(conn net.Conn, network string, targetAddr string) {
+	defer conn.Close()
+
+	target, err := c.nextClient().Dial(network, targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
+func handleRemoteForwardConnection(conn net.Conn, network string, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial(network, targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
 	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
 }
 
-func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *SecureShell) handleDynamicForwardConnection(conn net.Conn) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	targetAddr, err := readSOCKS5Request(conn)
 	if err != nil {
+		return
+	}
+
+	target, err := c.nextClient().Dial("tcp", targetAddr)
+	if err != nil {
+		_ = writeSOCKS5Reply(conn, socks5ReplyHostUnreachable)
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
 
+	err = writeSOCKS5Reply(conn, socks5ReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	copyBothWays(conn, target)
+}
+
+// nextClient returns the connected app instances in round-robin order.
+func (c *SecureShell) nextClient() SecureClient {
+	index := atomic.AddUint32(&c.nextClientIndex, 1) - 1
+	return c.secureClients[index%uint32(len(c.secureClients))]
+}
+
+func networkOrTCP(network string) string {
+	if network == "" {
+		return "tcp"
+	}
+	return network
+}
+
+func copyBothWays(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -220,7 +352,7 @@ func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 }
 
 func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYRequest) error {
-	session, err := c.secureClient.NewSession()
+	session, err := c.secureClients[0].NewSession()
 	if err != nil {
 		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
 	}
@@ -311,20 +443,27 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
 
-	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+	go keepalive(c.secureClients[0].Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
 
 	result := session.Wait()
 	wg.Wait()
 	return result
 }
 
+// Wait blocks until the connection to any of the app instances is closed.
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
 
-	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+	waitErrs := make(chan error, len(c.secureClients))
+	for _, secureClient := range c.secureClients {
+		go keepalive(secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+		go func(secureClient SecureClient) {
+			waitErrs <- secureClient.Wait()
+		}(secureClient)
+	}
 
-	return c.secureClient.Wait()
+	return <-waitErrs
 }
 
 func md5Fingerprint(key ssh.PublicKey) string {
@@ -10,8 +10,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"github.com/kr/pty"
 	"github.com/moby/moby/pkg/term"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 
 	. "code.cloudfoundry.org/cli/util/clissh"
 	. "github.com/onsi/ginkgo"
@@ -50,6 +53,41 @@ func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	}
 }
 
+func StartEchoListener(network string, address string) net.Listener {
+	listener, err := net.Listen(network, address)
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	return listener
+}
+
+func ExpectEcho(conn net.Conn) {
+	msg := "Hello from the other side\n"
+	n, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(n).To(Equal(len(msg)))
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(response)).To(Equal(msg))
+
+	Expect(conn.Close()).To(Succeed())
+}
+
 var _ = Describe("CLI SSH", func() {
 	var (
 		fakeSecureDialer    *clisshfakes.FakeSecureDialer
@@ -186,6 +224,9 @@ var _ = Describe("CLI SSH", func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -397,7 +438,7 @@ var _ = Describe("CLI SSH", func() {
 
 			AfterEach(func() {
 				master.Close()
-				// slave.Close() // race
+				slave.Close()
 			})
 
 			Context("when a command is not specified", func() {
@@ -1052,6 +1093,59 @@ var _ = Describe("CLI SSH", func() {
 			})
 		})
 
+		Context("when the forward spec uses UNIX domain sockets", func() {
+			BeforeEach(func() {
+				forwardSpecs = []LocalPortForward{{
+					LocalNetwork:  "unix",
+					LocalAddress:  "/some/local.sock",
+					RemoteNetwork: "unix",
+					RemoteAddress: "/some/remote.sock",
+				}}
+
+				fakeSecureClient.DialStub = func(network, address string) (net.Conn, error) {
+					return net.Dial("tcp", echoAddress)
+				}
+			})
+
+			It("listens and dials using the UNIX network", func() {
+				Expect(forwardErr).NotTo(HaveOccurred())
+
+				network, addr := fakeListenerFactory.ListenArgsForCall(0)
+				Expect(network).To(Equal("unix"))
+				Expect(addr).To(Equal("/some/local.sock"))
+
+				validateConnectivity(localAddress)
+
+				network, addr = fakeSecureClient.DialArgsForCall(0)
+				Expect(network).To(Equal("unix"))
+				Expect(addr).To(Equal("/some/remote.sock"))
+			})
+		})
+
+		Context("when connected to several app instances", func() {
+			var fakeSecureClient2 *clisshfakes.FakeSecureClient
+
+			BeforeEach(func() {
+				fakeSecureClient2 = new(clisshfakes.FakeSecureClient)
+				fakeSecureClient2.DialStub = net.Dial
+				fakeSecureDialer.DialReturnsOnCall(1, fakeSecureClient2, nil)
+			})
+
+			JustBeforeEach(func() {
+				connectErr := secureShell.Connect("some-other-user", passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+				Expect(connectErr).NotTo(HaveOccurred())
+			})
+
+			It("dials through the instances in round-robin order", func() {
+				validateConnectivity(localAddress)
+				validateConnectivity(localAddress)
+				validateConnectivity(localAddress)
+
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(2))
+				Expect(fakeSecureClient2.DialCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when listen fails", func() {
 			BeforeEach(func() {
 				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
@@ -1149,6 +1243,233 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr     error
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			echoListener = StartEchoListener("tcp", "127.0.0.1:0")
+
+			var err error
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:8080",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+			remoteListener.Close()
+		})
+
+		It("listens on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:8080"))
+		})
+
+		It("copies data between remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			ExpectEcho(conn)
+		})
+
+		Context("when the local address is a UNIX domain socket", func() {
+			var socketDir string
+
+			BeforeEach(func() {
+				var err error
+				socketDir, err = ioutil.TempDir("", "clissh")
+				Expect(err).NotTo(HaveOccurred())
+
+				socketPath := filepath.Join(socketDir, "local.sock")
+				unixEchoListener := StartEchoListener("unix", socketPath)
+				echoListener.Close()
+				echoListener = unixEchoListener
+
+				forwardSpecs = []RemotePortForward{{
+					RemoteNetwork: "unix",
+					RemoteAddress: "/some/remote.sock",
+					LocalNetwork:  "unix",
+					LocalAddress:  socketPath,
+				}}
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(socketDir)
+			})
+
+			It("listens on the remote socket and connects to the local socket", func() {
+				network, addr := fakeSecureClient.ListenArgsForCall(0)
+				Expect(network).To(Equal("unix"))
+				Expect(addr).To(Equal("/some/remote.sock"))
+
+				conn, err := net.Dial("tcp", remoteListener.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				ExpectEcho(conn)
+			})
+		})
+
+		Context("when connected to several app instances", func() {
+			var (
+				fakeSecureClient2 *clisshfakes.FakeSecureClient
+				remoteListener2   net.Listener
+			)
+
+			BeforeEach(func() {
+				var err error
+				remoteListener2, err = net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeSecureClient2 = new(clisshfakes.FakeSecureClient)
+				fakeSecureClient2.ListenReturns(remoteListener2, nil)
+				fakeSecureDialer.DialReturnsOnCall(1, fakeSecureClient2, nil)
+			})
+
+			JustBeforeEach(func() {
+				connectErr := secureShell.Connect("some-other-user", passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+				Expect(connectErr).NotTo(HaveOccurred())
+
+				forwardErr = secureShell.RemotePortForward(forwardSpecs)
+			})
+
+			AfterEach(func() {
+				remoteListener2.Close()
+			})
+
+			It("listens on every instance", func() {
+				Expect(forwardErr).NotTo(HaveOccurred())
+				Expect(fakeSecureClient2.ListenCallCount()).To(Equal(1))
+
+				conn, err := net.Dial("tcp", remoteListener2.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				ExpectEcho(conn)
+			})
+		})
+
+		Context("when listening on the remote address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr    error
+			echoListener  net.Listener
+			localListener net.Listener
+			forwardSpecs  []DynamicPortForward
+			socksDialer   proxy.Dialer
+		)
+
+		BeforeEach(func() {
+			echoListener = StartEchoListener("tcp", "127.0.0.1:0")
+
+			var err error
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			socksDialer, err = proxy.SOCKS5("tcp", localListener.Addr().String(), nil, proxy.Direct)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeSecureClient.DialStub = net.Dial
+
+			forwardSpecs = []DynamicPortForward{{LocalAddress: "localhost:1080"}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+			localListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the address requested by the SOCKS5 client", func() {
+			conn, err := socksDialer.Dial("tcp", echoListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			ExpectEcho(conn)
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+		})
+
+		It("resolves domain names on the app instance", func() {
+			_, port, err := net.SplitHostPort(echoListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+
+			conn, err := socksDialer.Dial("tcp", net.JoinHostPort("localhost", port))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conn.Close()).To(Succeed())
+
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal(net.JoinHostPort("localhost", port)))
+		})
+
+		Context("when dialing the requested address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("boom"))
+			})
+
+			It("reports the failure to the SOCKS5 client", func() {
+				_, err := socksDialer.Dial("tcp", echoListener.Addr().String())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -1215,5 +1536,27 @@ var _ = Describe("CLI SSH", func() {
 
 			Expect(fakeSecureClient.CloseCallCount()).To(Equal(1))
 		})
+
+		Context("when connected to several app instances", func() {
+			var fakeSecureClient2 *clisshfakes.FakeSecureClient
+
+			BeforeEach(func() {
+				fakeSecureClient2 = new(clisshfakes.FakeSecureClient)
+				fakeSecureDialer.DialReturnsOnCall(1, fakeSecureClient2, nil)
+			})
+
+			JustBeforeEach(func() {
+				connectErr := secureShell.Connect("some-other-user", passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+				Expect(connectErr).NotTo(HaveOccurred())
+			})
+
+			It("closes every secureClient", func() {
+				err := secureShell.Close()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSecureClient.CloseCallCount()).To(Equal(1))
+				Expect(fakeSecureClient2.CloseCallCount()).To(Equal(1))
+			})
+		})
 	})
 })
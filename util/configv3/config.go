@@ -4,10 +4,16 @@ package configv3
 import (
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/version"
 )
 
+// TraceHARPrefix marks a trace file path as a HAR file, as in
+// CF_TRACE=har:/path/to/trace.har.
+const TraceHARPrefix = "har:"
+
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
 // plugin config.
 type Config struct {
@@ -24,6 +30,21 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// contextOverride is the context passed to the '--context' global flag.
+	contextOverride string
+
+	// storedSession is the session of the stored current context while
+	// contextOverride is in use.
+	storedSession NamedContext
+
+	// loadedConfigFile is the config.json as it was last read from or written
+	// to disk. WriteConfig uses it to find the fields a command changed.
+	loadedConfigFile *JSONConfig
+
+	// tokenLock is held by the clients sharing the config while they read or
+	// refresh the access and refresh tokens.
+	tokenLock sync.Mutex
 }
 
 // BinaryVersion is the current version of the CF binary.
@@ -59,6 +80,9 @@ func (config *Config) TerminalWidth() int {
 //   - The $CF_TRACE enviroment variable if set (true/false/file path)
 //   - The '-v/--verbose' global flag
 //   - Defaults to false
+//
+// File paths starting with TraceHARPrefix keep the prefix, so that the
+// request logs written to them are in HAR format.
 func (config *Config) Verbose() (bool, []string) {
 	var (
 		verbose     bool
@@ -86,9 +110,15 @@ func (config *Config) Verbose() (bool, []string) {
 	verbose = config.Flags.Verbose || verbose
 
 	for i, path := range filePath {
+		var prefix string
+		if strings.HasPrefix(path, TraceHARPrefix) {
+			prefix = TraceHARPrefix
+			path = strings.TrimPrefix(path, TraceHARPrefix)
+		}
 		if !filepath.IsAbs(path) {
-			filePath[i] = filepath.Join(config.detectedSettings.currentDirectory, path)
+			path = filepath.Join(config.detectedSettings.currentDirectory, path)
 		}
+		filePath[i] = prefix + path
 	}
 
 	return verbose, filePath
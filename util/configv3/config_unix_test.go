@@ -69,6 +69,9 @@ var _ = Describe("Verbose", func() {
 		Entry("CF_TRACE filepath, config trace true: enables verbose AND logging to file", "/foo/bar", "true", false, true, []string{"/foo/bar"}),
 		Entry("CF_TRACE filepath, config trace filepath: enables logging to file for BOTH paths", "/foo/bar", "/baz", false, false, []string{"/foo/bar", "/baz"}),
 		Entry("CF_TRACE filepath, config trace filepath, '-v': enables verbose AND logging to file for BOTH paths", "/foo/bar", "/baz", true, true, []string{"/foo/bar", "/baz"}),
+
+		Entry("CF_TRACE HAR filepath: enables logging to a HAR file", "har:/foo/bar.har", "", false, false, []string{"har:/foo/bar.har"}),
+		Entry("CF_TRACE HAR filepath, config trace filepath: enables logging to a HAR file AND a text file", "har:/foo/bar.har", "/baz", false, false, []string{"har:/foo/bar.har", "/baz"}),
 	)
 
 	Context("relative paths (cannot be tested in DescribeTable)", func() {
@@ -91,5 +94,18 @@ var _ = Describe("Verbose", func() {
 			_, parsedLocation := config.Verbose()
 			Expect(parsedLocation).To(Equal([]string{filepath.Join(cwd, cfTrace), filepath.Join(cwd, configTrace)}))
 		})
+
+		It("resolves relative HAR paths into absolute paths, keeping the prefix", func() {
+			Expect(os.Setenv("CF_TRACE", "har:foo/bar.har")).ToNot(HaveOccurred())
+			defer os.Unsetenv("CF_TRACE")
+
+			config, err := LoadConfig(FlagOverride{})
+			Expect(err).ToNot(HaveOccurred())
+
+			cwd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			_, parsedLocation := config.Verbose()
+			Expect(parsedLocation).To(Equal([]string{"har:" + filepath.Join(cwd, "foo/bar.har")}))
+		})
 	})
 })
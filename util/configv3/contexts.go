@@ -0,0 +1,225 @@
+package configv3
+
+import "code.cloudfoundry.org/cli/command/translatableerror"
+
+// NamedContext is a named session stored in .cf/config.json. Each context carries
+// its own endpoints, tokens, SSL settings and targeted organization and space.
+// The top level fields of JSONConfig always hold the session of the current
+// context.
+type NamedContext struct {
+	Name                     string       `json:"Name"`
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	RefreshToken             string       `json:"RefreshToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// Contexts returns all the stored contexts. The entry for the current context
+// reflects the current session.
+func (config *Config) Contexts() []NamedContext {
+	contexts := make([]NamedContext, len(config.ConfigFile.Contexts))
+	copy(contexts, config.ConfigFile.Contexts)
+
+	current := config.CurrentContext()
+	for i := range contexts {
+		if contexts[i].Name == current {
+			contexts[i] = sessionContext(current, config.ConfigFile)
+		}
+	}
+	return contexts
+}
+
+// CurrentContext returns the name of the context in use. This is the context
+// passed to the '--context' global flag if set, otherwise the stored current
+// context.
+func (config *Config) CurrentContext() string {
+	if config.contextOverride != "" {
+		return config.contextOverride
+	}
+	return config.ConfigFile.CurrentContext
+}
+
+// CreateContext saves the current session as a new context with the provided
+// name and makes it the current context.
+func (config *Config) CreateContext(name string) error {
+	if _, found := config.findContext(name); found {
+		return translatableerror.ContextAlreadyExistsError{Name: name}
+	}
+
+	config.endContextOverride()
+	config.saveCurrentContext()
+	config.ConfigFile.Contexts = append(config.ConfigFile.Contexts, sessionContext(name, config.ConfigFile))
+	config.ConfigFile.CurrentContext = name
+	return nil
+}
+
+// SwitchContext saves the current session into the current context and then
+// loads the session of the named context.
+func (config *Config) SwitchContext(name string) error {
+	index, found := config.findContext(name)
+	if !found {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.endContextOverride()
+	config.saveCurrentContext()
+	config.ConfigFile.Contexts[index].applyTo(&config.ConfigFile)
+	config.ConfigFile.CurrentContext = name
+	return nil
+}
+
+// RenameContext renames the context oldName to newName.
+func (config *Config) RenameContext(oldName string, newName string) error {
+	index, found := config.findContext(oldName)
+	if !found {
+		return translatableerror.ContextNotFoundError{Name: oldName}
+	}
+	if _, exists := config.findContext(newName); exists && oldName != newName {
+		return translatableerror.ContextAlreadyExistsError{Name: newName}
+	}
+
+	config.endContextOverride()
+	config.ConfigFile.Contexts[index].Name = newName
+	if config.ConfigFile.CurrentContext == oldName {
+		config.ConfigFile.CurrentContext = newName
+	}
+	return nil
+}
+
+// DeleteContext removes the named context. The current context cannot be
+// deleted.
+func (config *Config) DeleteContext(name string) error {
+	index, found := config.findContext(name)
+	if !found {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+	if name == config.ConfigFile.CurrentContext {
+		return translatableerror.CurrentContextDeletionError{Name: name}
+	}
+
+	config.endContextOverride()
+	contexts := config.ConfigFile.Contexts
+	config.ConfigFile.Contexts = append(contexts[:index:index], contexts[index+1:]...)
+	return nil
+}
+
+// useContext loads the named context for this invocation only. The stored
+// session is kept so that WriteConfig can restore it.
+func (config *Config) useContext(name string) error {
+	index, found := config.findContext(name)
+	if !found {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.storedSession = sessionContext(config.ConfigFile.CurrentContext, config.ConfigFile)
+	config.contextOverride = name
+	config.ConfigFile.Contexts[index].applyTo(&config.ConfigFile)
+	return nil
+}
+
+// fileContents returns the JSONConfig as it should be written to disk. When a
+// context is used for this invocation only, its session is saved into the
+// context and the stored session is restored to the top level.
+func (config *Config) fileContents() JSONConfig {
+	if config.contextOverride == "" {
+		config.saveCurrentContext()
+		return config.ConfigFile
+	}
+
+	contents := config.ConfigFile
+	contents.Contexts = make([]NamedContext, len(config.ConfigFile.Contexts))
+	copy(contents.Contexts, config.ConfigFile.Contexts)
+	if index, found := config.findContext(config.contextOverride); found {
+		contents.Contexts[index] = sessionContext(config.contextOverride, config.ConfigFile)
+	}
+	config.storedSession.applyTo(&contents)
+	return contents
+}
+
+// endContextOverride saves the session of the context used for this
+// invocation and restores the stored session, so that commands managing
+// contexts always act on the stored current context.
+func (config *Config) endContextOverride() {
+	if config.contextOverride == "" {
+		return
+	}
+
+	if index, found := config.findContext(config.contextOverride); found {
+		config.ConfigFile.Contexts[index] = sessionContext(config.contextOverride, config.ConfigFile)
+	}
+	config.storedSession.applyTo(&config.ConfigFile)
+	config.contextOverride = ""
+}
+
+// saveCurrentContext copies the current session into the entry for the stored
+// current context, if there is one.
+func (config *Config) saveCurrentContext() {
+	if index, found := config.findContext(config.ConfigFile.CurrentContext); found {
+		config.ConfigFile.Contexts[index] = sessionContext(config.ConfigFile.CurrentContext, config.ConfigFile)
+	}
+}
+
+func (config *Config) findContext(name string) (int, bool) {
+	for i, context := range config.ConfigFile.Contexts {
+		if context.Name == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func sessionContext(name string, jsonConfig JSONConfig) NamedContext {
+	return NamedContext{
+		Name:                     name,
+		Target:                   jsonConfig.Target,
+		APIVersion:               jsonConfig.APIVersion,
+		AuthorizationEndpoint:    jsonConfig.AuthorizationEndpoint,
+		DopplerEndpoint:          jsonConfig.DopplerEndpoint,
+		UAAEndpoint:              jsonConfig.UAAEndpoint,
+		RoutingEndpoint:          jsonConfig.RoutingEndpoint,
+		AccessToken:              jsonConfig.AccessToken,
+		RefreshToken:             jsonConfig.RefreshToken,
+		SSHOAuthClient:           jsonConfig.SSHOAuthClient,
+		UAAOAuthClient:           jsonConfig.UAAOAuthClient,
+		UAAOAuthClientSecret:     jsonConfig.UAAOAuthClientSecret,
+		UAAGrantType:             jsonConfig.UAAGrantType,
+		TargetedOrganization:     jsonConfig.TargetedOrganization,
+		TargetedSpace:            jsonConfig.TargetedSpace,
+		SkipSSLValidation:        jsonConfig.SkipSSLValidation,
+		MinCLIVersion:            jsonConfig.MinCLIVersion,
+		MinRecommendedCLIVersion: jsonConfig.MinRecommendedCLIVersion,
+	}
+}
+
+func (context NamedContext) applyTo(jsonConfig *JSONConfig) {
+	jsonConfig.Target = context.Target
+	jsonConfig.APIVersion = context.APIVersion
+	jsonConfig.AuthorizationEndpoint = context.AuthorizationEndpoint
+	jsonConfig.DopplerEndpoint = context.DopplerEndpoint
+	jsonConfig.UAAEndpoint = context.UAAEndpoint
+	jsonConfig.RoutingEndpoint = context.RoutingEndpoint
+	jsonConfig.AccessToken = context.AccessToken
+	jsonConfig.RefreshToken = context.RefreshToken
+	jsonConfig.SSHOAuthClient = context.SSHOAuthClient
+	jsonConfig.UAAOAuthClient = context.UAAOAuthClient
+	jsonConfig.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	jsonConfig.UAAGrantType = context.UAAGrantType
+	jsonConfig.TargetedOrganization = context.TargetedOrganization
+	jsonConfig.TargetedSpace = context.TargetedSpace
+	jsonConfig.SkipSSLValidation = context.SkipSSLValidation
+	jsonConfig.MinCLIVersion = context.MinCLIVersion
+	jsonConfig.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+}
@@ -0,0 +1,268 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() JSONConfig {
+		file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var jsonConfig JSONConfig
+		Expect(json.Unmarshal(file, &jsonConfig)).To(Succeed())
+		return jsonConfig
+	}
+
+	Context("when there are no contexts", func() {
+		BeforeEach(func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					ConfigVersion: 3,
+					Target:        "https://api.staging.com",
+					AccessToken:   "staging-token",
+				},
+			}
+		})
+
+		Describe("Contexts", func() {
+			It("returns no contexts", func() {
+				Expect(config.Contexts()).To(BeEmpty())
+				Expect(config.CurrentContext()).To(BeEmpty())
+			})
+		})
+
+		Describe("CreateContext", func() {
+			It("saves the current session as the new current context", func() {
+				Expect(config.CreateContext("staging")).To(Succeed())
+
+				Expect(config.CurrentContext()).To(Equal("staging"))
+				Expect(config.Contexts()).To(ConsistOf(
+					NamedContext{Name: "staging", Target: "https://api.staging.com", AccessToken: "staging-token"},
+				))
+			})
+		})
+
+		Describe("WriteConfig", func() {
+			It("does not write the context fields", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(file)).ToNot(ContainSubstring("Contexts"))
+				Expect(string(file)).ToNot(ContainSubstring("CurrentContext"))
+			})
+		})
+	})
+
+	Context("when there are contexts", func() {
+		BeforeEach(func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					ConfigVersion:        3,
+					Target:               "https://api.staging.com",
+					AccessToken:          "new-staging-token",
+					TargetedOrganization: Organization{GUID: "staging-org-guid", Name: "staging-org"},
+					CurrentContext:       "staging",
+					Contexts: []NamedContext{
+						{Name: "staging", Target: "https://api.staging.com", AccessToken: "staging-token"},
+						{
+							Name:                 "production",
+							Target:               "https://api.production.com",
+							AccessToken:          "production-token",
+							SkipSSLValidation:    true,
+							TargetedOrganization: Organization{GUID: "production-org-guid", Name: "production-org"},
+							TargetedSpace:        Space{GUID: "production-space-guid", Name: "production-space"},
+						},
+					},
+				},
+			}
+		})
+
+		Describe("Contexts", func() {
+			It("returns the current context with the current session", func() {
+				contexts := config.Contexts()
+				Expect(contexts).To(HaveLen(2))
+				Expect(contexts[0].AccessToken).To(Equal("new-staging-token"))
+				Expect(contexts[0].TargetedOrganization.Name).To(Equal("staging-org"))
+				Expect(contexts[1].AccessToken).To(Equal("production-token"))
+			})
+		})
+
+		Describe("CreateContext", func() {
+			Context("when the context already exists", func() {
+				It("returns a ContextAlreadyExistsError", func() {
+					Expect(config.CreateContext("production")).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "production"}))
+					Expect(config.CurrentContext()).To(Equal("staging"))
+				})
+			})
+		})
+
+		Describe("SwitchContext", func() {
+			Context("when the context exists", func() {
+				BeforeEach(func() {
+					Expect(config.SwitchContext("production")).To(Succeed())
+				})
+
+				It("loads the session of the context", func() {
+					Expect(config.CurrentContext()).To(Equal("production"))
+					Expect(config.Target()).To(Equal("https://api.production.com"))
+					Expect(config.AccessToken()).To(Equal("production-token"))
+					Expect(config.SkipSSLValidation()).To(BeTrue())
+					Expect(config.TargetedOrganization().Name).To(Equal("production-org"))
+					Expect(config.TargetedSpace().Name).To(Equal("production-space"))
+				})
+
+				It("saves the session of the previous context", func() {
+					Expect(config.Contexts()[0].AccessToken).To(Equal("new-staging-token"))
+					Expect(config.Contexts()[0].TargetedOrganization.Name).To(Equal("staging-org"))
+				})
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					Expect(config.SwitchContext("missing")).To(MatchError(translatableerror.ContextNotFoundError{Name: "missing"}))
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+				})
+			})
+		})
+
+		Describe("RenameContext", func() {
+			It("renames the context and keeps it current", func() {
+				Expect(config.RenameContext("staging", "stage")).To(Succeed())
+				Expect(config.CurrentContext()).To(Equal("stage"))
+				Expect(config.Contexts()[0].Name).To(Equal("stage"))
+			})
+
+			Context("when the new name is taken", func() {
+				It("returns a ContextAlreadyExistsError", func() {
+					Expect(config.RenameContext("staging", "production")).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "production"}))
+				})
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					Expect(config.RenameContext("missing", "other")).To(MatchError(translatableerror.ContextNotFoundError{Name: "missing"}))
+				})
+			})
+		})
+
+		Describe("DeleteContext", func() {
+			It("deletes the context", func() {
+				Expect(config.DeleteContext("production")).To(Succeed())
+				Expect(config.Contexts()).To(HaveLen(1))
+				Expect(config.Contexts()[0].Name).To(Equal("staging"))
+			})
+
+			Context("when the context is the current context", func() {
+				It("returns a CurrentContextDeletionError", func() {
+					Expect(config.DeleteContext("staging")).To(MatchError(translatableerror.CurrentContextDeletionError{Name: "staging"}))
+					Expect(config.Contexts()).To(HaveLen(2))
+				})
+			})
+
+			Context("when the context does not exist", func() {
+				It("returns a ContextNotFoundError", func() {
+					Expect(config.DeleteContext("missing")).To(MatchError(translatableerror.ContextNotFoundError{Name: "missing"}))
+				})
+			})
+		})
+
+		Describe("WriteConfig", func() {
+			It("saves the current session into the current context", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				jsonConfig := readConfigFile()
+				Expect(jsonConfig.CurrentContext).To(Equal("staging"))
+				Expect(jsonConfig.Contexts).To(HaveLen(2))
+				Expect(jsonConfig.Contexts[0].AccessToken).To(Equal("new-staging-token"))
+				Expect(jsonConfig.Contexts[1].AccessToken).To(Equal("production-token"))
+			})
+		})
+	})
+
+	Describe("the --context override", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.staging.com",
+				"AccessToken": "staging-token",
+				"CurrentContext": "staging",
+				"Contexts": [
+					{"Name": "staging", "Target": "https://api.staging.com", "AccessToken": "staging-token"},
+					{"Name": "production", "Target": "https://api.production.com", "AccessToken": "production-token"}
+				]
+			}`
+			setConfig(homeDir, rawConfig)
+		})
+
+		Context("when the context exists", func() {
+			BeforeEach(func() {
+				var err error
+				config, err = LoadConfig(FlagOverride{Context: "production"})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the named context", func() {
+				Expect(config.CurrentContext()).To(Equal("production"))
+				Expect(config.Target()).To(Equal("https://api.production.com"))
+				Expect(config.AccessToken()).To(Equal("production-token"))
+			})
+
+			It("saves the session into the named context without changing the stored current context", func() {
+				config.SetAccessToken("refreshed-production-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				jsonConfig := readConfigFile()
+				Expect(jsonConfig.CurrentContext).To(Equal("staging"))
+				Expect(jsonConfig.Target).To(Equal("https://api.staging.com"))
+				Expect(jsonConfig.AccessToken).To(Equal("staging-token"))
+				Expect(jsonConfig.Contexts[0].AccessToken).To(Equal("staging-token"))
+				Expect(jsonConfig.Contexts[1].AccessToken).To(Equal("refreshed-production-token"))
+
+				Expect(config.AccessToken()).To(Equal("refreshed-production-token"))
+			})
+
+			Context("when switching contexts", func() {
+				It("switches from the stored current context", func() {
+					Expect(config.SwitchContext("staging")).To(Succeed())
+					Expect(WriteConfig(config)).To(Succeed())
+
+					jsonConfig := readConfigFile()
+					Expect(jsonConfig.CurrentContext).To(Equal("staging"))
+					Expect(jsonConfig.AccessToken).To(Equal("staging-token"))
+				})
+			})
+		})
+
+		Context("when the context does not exist", func() {
+			It("returns the config and a ContextNotFoundError", func() {
+				config, err := LoadConfig(FlagOverride{Context: "missing"})
+				Expect(err).To(MatchError(translatableerror.ContextNotFoundError{Name: "missing"}))
+				Expect(config).ToNot(BeNil())
+				Expect(config.Target()).To(Equal("https://api.staging.com"))
+			})
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package configv3
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
 const (
 	// DefaultDialTimeout is the default timeout for the dail.
@@ -47,6 +50,11 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultPageRequestConcurrency is the default number of pages of a list
+	// that are requested from the Cloud Controller at the same time. Pages are
+	// requested one at a time unless $CF_PAGE_REQUEST_CONCURRENCY is set.
+	DefaultPageRequestConcurrency = 1
 )
 
 // NOAARequestRetryCount returns the number of request retries.
@@ -54,13 +62,39 @@ func (*Config) NOAARequestRetryCount() int {
 	return DefaultNOAARetryCount
 }
 
+// PageRequestConcurrency returns the maximum number of pages of a list that
+// are requested from the Cloud Controller at the same time. It is based off
+// of:
+//   1. The $CF_PAGE_REQUEST_CONCURRENCY environment variable if set
+//   2. Defaults to DefaultPageRequestConcurrency
+func (config *Config) PageRequestConcurrency() int {
+	if config.ENV.CFPageConcurrency != "" {
+		val, err := strconv.Atoi(config.ENV.CFPageConcurrency)
+		if err == nil && val >= 1 {
+			return val
+		}
+	}
+
+	return DefaultPageRequestConcurrency
+}
+
 // PollingInterval returns the time between polls.
 func (config *Config) PollingInterval() time.Duration {
 	return DefaultPollingInterval
 }
 
-// RequestRetryCount returns the number of request retries.
-func (*Config) RequestRetryCount() int {
+// RequestRetryCount returns the number of request retries. It is based off
+// of:
+//   1. The $CF_REQUEST_RETRY_COUNT environment variable if set
+//   2. Defaults to DefaultRetryCount
+func (config *Config) RequestRetryCount() int {
+	if config.ENV.CFRetryCount != "" {
+		val, err := strconv.Atoi(config.ENV.CFRetryCount)
+		if err == nil && val >= 0 {
+			return val
+		}
+	}
+
 	return DefaultRetryCount
 }
 
@@ -1,30 +1,41 @@
 package configv3
 
 import (
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
+
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName        string
+	CFCassetteRecord  string
+	CFCassetteReplay  string
+	CFColor           string
+	CFDialTimeout     string
+	CFHome            string
+	CFLogLevel        string
+	CFPageConcurrency string
+	CFPassword        string
+	CFPluginHome      string
+	CFResponseCache   string
+	CFRetryCount      string
+	CFRetryInitial    string
+	CFRetryMax        string
+	CFRetryMaxTotal   string
+	CFStagingTimeout  string
+	CFStartupTimeout  string
+	CFTrace           string
+	CFUsername        string
+	DockerPassword    string
+	Experimental      string
+	ForceTTY          string
+	HTTPSProxy        string
+	Lang              string
+	LCAll             string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -116,6 +127,72 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// RequestRetryPolicy returns the policy used to retry failed requests to the
+// Cloud Controller and UAA. It is based off of:
+//   - The $CF_REQUEST_RETRY_COUNT environment variable if set, otherwise
+//     DefaultRetryCount
+//   - The $CF_REQUEST_RETRY_INITIAL_INTERVAL, $CF_REQUEST_RETRY_MAX_INTERVAL
+//     and $CF_REQUEST_RETRY_MAX_ELAPSED_TIME environment variables if set,
+//     either in seconds or as a duration such as "750ms", otherwise the
+//     defaults of the retry package
+func (config *Config) RequestRetryPolicy() retry.Policy {
+	policy := retry.NewPolicy(config.RequestRetryCount())
+
+	if val, ok := parseRetryDuration(config.ENV.CFRetryInitial); ok {
+		policy.InitialInterval = val
+	}
+	if val, ok := parseRetryDuration(config.ENV.CFRetryMax); ok {
+		policy.MaxInterval = val
+	}
+	if val, ok := parseRetryDuration(config.ENV.CFRetryMaxTotal); ok {
+		policy.MaxElapsedTime = val
+	}
+
+	return policy
+}
+
+// Cassette returns the full path of the cassette that Cloud Controller and UAA
+// requests are recorded to or played back from, and whether it is played
+// back. This is based off of:
+//   1. The $CF_CASSETTE_REPLAY environment variable if set, to play back
+//   2. The $CF_CASSETTE_RECORD environment variable if set, to record
+//   3. Defaults to an empty path, when no cassette is used
+func (config *Config) Cassette() (string, bool) {
+	path, replay := config.ENV.CFCassetteReplay, true
+	if path == "" {
+		path, replay = config.ENV.CFCassetteRecord, false
+	}
+	if path == "" {
+		return "", false
+	}
+
+	if fullPath, err := filepath.Abs(path); err == nil {
+		path = fullPath
+	}
+	return path, replay
+}
+
+// ResponseCacheEnabled returns whether to cache Cloud Controller responses on
+// disk between commands. This is based off of:
+//   1. Disabled when a cassette is used, so that every request is recorded or
+//      played back
+//   2. The $CF_RESPONSE_CACHE environment variable if set
+//   3. Defaults to false
+func (config *Config) ResponseCacheEnabled() bool {
+	if path, _ := config.Cassette(); path != "" {
+		return false
+	}
+
+	if config.ENV.CFResponseCache != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFResponseCache)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
@@ -145,3 +222,19 @@ func (config *Config) StartupTimeout() time.Duration {
 
 	return DefaultStartupTimeout
 }
+
+func parseRetryDuration(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+		return duration, true
+	}
+
+	return 0, false
+}
@@ -2,8 +2,10 @@ package configv3_test
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo"
@@ -118,4 +120,90 @@ var _ = Describe("Config", func() {
 		Entry("debug returns 5", "debug", 5),
 		Entry("dEbUg returns 5", "dEbUg", 5),
 	)
+
+	Describe("RequestRetryPolicy", func() {
+		It("defaults to the retry package's policy with DefaultRetryCount retries", func() {
+			config := Config{}
+			Expect(config.RequestRetryCount()).To(Equal(DefaultRetryCount))
+			Expect(config.RequestRetryPolicy()).To(Equal(retry.NewPolicy(DefaultRetryCount)))
+		})
+
+		It("overrides the policy with the environment", func() {
+			config := Config{ENV: EnvOverride{
+				CFRetryCount:    "5",
+				CFRetryInitial:  "750ms",
+				CFRetryMax:      "20",
+				CFRetryMaxTotal: "2m",
+			}}
+
+			policy := config.RequestRetryPolicy()
+			Expect(policy.MaxRetries).To(Equal(5))
+			Expect(policy.InitialInterval).To(Equal(750 * time.Millisecond))
+			Expect(policy.MaxInterval).To(Equal(20 * time.Second))
+			Expect(policy.MaxElapsedTime).To(Equal(2 * time.Minute))
+		})
+
+		It("ignores invalid environment values", func() {
+			config := Config{ENV: EnvOverride{
+				CFRetryCount:   "-1",
+				CFRetryInitial: "banana",
+			}}
+
+			policy := config.RequestRetryPolicy()
+			Expect(policy.MaxRetries).To(Equal(DefaultRetryCount))
+			Expect(policy.InitialInterval).To(Equal(retry.DefaultInitialInterval))
+		})
+	})
+
+	DescribeTable("PageRequestConcurrency",
+		func(envVal string, expected int) {
+			config := Config{ENV: EnvOverride{CFPageConcurrency: envVal}}
+			Expect(config.PageRequestConcurrency()).To(Equal(expected))
+		},
+
+		Entry("defaults to requesting one page at a time", "", 1),
+		Entry("uses the environment value when valid", "8", 8),
+		Entry("ignores zero", "0", DefaultPageRequestConcurrency),
+		Entry("ignores invalid values", "banana", DefaultPageRequestConcurrency),
+	)
+
+	DescribeTable("ResponseCacheEnabled",
+		func(envVal string, expected bool) {
+			config := Config{ENV: EnvOverride{CFResponseCache: envVal}}
+			Expect(config.ResponseCacheEnabled()).To(Equal(expected))
+		},
+
+		Entry("defaults to false", "", false),
+		Entry("is enabled with true", "true", true),
+		Entry("is disabled with false", "false", false),
+		Entry("ignores invalid values", "banana", false),
+	)
+
+	It("disables the response cache when a cassette is used", func() {
+		config := Config{ENV: EnvOverride{CFResponseCache: "true", CFCassetteReplay: "/some/cassette.json"}}
+		Expect(config.ResponseCacheEnabled()).To(BeFalse())
+	})
+
+	DescribeTable("Cassette",
+		func(record string, replay string, expectedPath string, expectedReplay bool) {
+			config := Config{ENV: EnvOverride{CFCassetteRecord: record, CFCassetteReplay: replay}}
+			path, isReplay := config.Cassette()
+			Expect(path).To(Equal(expectedPath))
+			Expect(isReplay).To(Equal(expectedReplay))
+		},
+
+		Entry("defaults to no cassette", "", "", "", false),
+		Entry("records with CF_CASSETTE_RECORD", "/some/cassette.json", "", "/some/cassette.json", false),
+		Entry("plays back with CF_CASSETTE_REPLAY", "", "/some/cassette.json", "/some/cassette.json", true),
+		Entry("prefers playing back", "/some/record.json", "/some/replay.json", "/some/replay.json", true),
+	)
+
+	It("returns the full path of a relative cassette path", func() {
+		config := Config{ENV: EnvOverride{CFCassetteRecord: "cassette.json"}}
+		path, _ := config.Cassette()
+
+		wd, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal(filepath.Join(wd, "cassette.json")))
+	})
 })
@@ -1,6 +1,7 @@
 package configv3
 
 import (
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -29,8 +30,11 @@ type JSONConfig struct {
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	TrustedPluginKeys        []TrustedPluginKey `json:"TrustedPluginKeys,omitempty"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	CurrentContext           string             `json:"CurrentContext,omitempty"`
+	Contexts                 []NamedContext     `json:"Contexts,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -65,6 +69,12 @@ type User struct {
 	Name string
 }
 
+// TokenLock returns the lock that clients sharing the config hold while they
+// read or refresh the tokens, so that a rejected token is refreshed once.
+func (config *Config) TokenLock() sync.Locker {
+	return &config.tokenLock
+}
+
 // AccessToken returns the access token for making authenticated API calls.
 func (config *Config) AccessToken() string {
 	return config.ConfigFile.AccessToken
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/filelock"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -65,6 +66,9 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 				return nil, err
 			}
 			config.ConfigFile = configFile
+
+			loadedConfigFile := copyJSONConfig(configFile)
+			config.loadedConfigFile = &loadedConfigFile
 		}
 	}
 
@@ -78,22 +82,30 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:        filepath.Base(os.Args[0]),
+		CFCassetteRecord:  os.Getenv("CF_CASSETTE_RECORD"),
+		CFCassetteReplay:  os.Getenv("CF_CASSETTE_REPLAY"),
+		CFColor:           os.Getenv("CF_COLOR"),
+		CFDialTimeout:     os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:        os.Getenv("CF_LOG_LEVEL"),
+		CFPageConcurrency: os.Getenv("CF_PAGE_REQUEST_CONCURRENCY"),
+		CFPassword:        os.Getenv("CF_PASSWORD"),
+		CFPluginHome:      os.Getenv("CF_PLUGIN_HOME"),
+		CFResponseCache:   os.Getenv("CF_RESPONSE_CACHE"),
+		CFRetryCount:      os.Getenv("CF_REQUEST_RETRY_COUNT"),
+		CFRetryInitial:    os.Getenv("CF_REQUEST_RETRY_INITIAL_INTERVAL"),
+		CFRetryMax:        os.Getenv("CF_REQUEST_RETRY_MAX_INTERVAL"),
+		CFRetryMaxTotal:   os.Getenv("CF_REQUEST_RETRY_MAX_ELAPSED_TIME"),
+		CFStagingTimeout:  os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:  os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:           os.Getenv("CF_TRACE"),
+		CFUsername:        os.Getenv("CF_USERNAME"),
+		DockerPassword:    os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:      os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:          os.Getenv("FORCE_TTY"),
+		HTTPSProxy:        os.Getenv("https_proxy"),
+		Lang:              os.Getenv("LANG"),
+		LCAll:             os.Getenv("LC_ALL"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -146,11 +158,32 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		tty:              isTTY,
 	}
 
+	if config.Flags.Context != "" {
+		err = config.useContext(config.Flags.Context)
+		if err != nil {
+			return &config, err
+		}
+	}
+
 	return &config, jsonError
 }
 
+// removeOldTempConfigFiles removes temporary config files left behind by
+// interrupted writes. The config lock is held so that the temporary file of a
+// write in progress in another process is not removed.
 func removeOldTempConfigFiles() error {
 	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
+	if err != nil || len(oldTempFileNames) == 0 {
+		return err
+	}
+
+	lock, err := filelock.Acquire(configLockFilePath())
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	oldTempFileNames, err = filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
 	if err != nil {
 		return err
 	}
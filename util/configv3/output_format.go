@@ -0,0 +1,21 @@
+package configv3
+
+// OutputFormat is the format in which commands display their results.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault means results are displayed as human readable text
+	// and tables.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON means results are displayed as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means results are displayed as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat returns the format requested with the global --output flag.
+func (config *Config) OutputFormat() OutputFormat {
+	return config.Flags.OutputFormat
+}
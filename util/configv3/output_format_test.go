@@ -0,0 +1,37 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Context("when the --output flag is not provided", func() {
+		It("returns the default output format", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.OutputFormat()).To(Equal(OutputFormatDefault))
+		})
+	})
+
+	Context("when the --output flag is provided", func() {
+		It("returns the output format from the flag", func() {
+			config, err := LoadConfig(FlagOverride{OutputFormat: OutputFormatYAML})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.OutputFormat()).To(Equal(OutputFormatYAML))
+		})
+	})
+})
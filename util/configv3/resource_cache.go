@@ -0,0 +1,15 @@
+package configv3
+
+import "path/filepath"
+
+// ResourceCacheFilePath returns the location of the file caching the SHA1s of
+// app files between pushes.
+func (*Config) ResourceCacheFilePath() string {
+	return filepath.Join(configDirectory(), "resource_cache.json")
+}
+
+// ResponseCacheDirectory returns the location of the directory caching Cloud
+// Controller responses between commands.
+func (*Config) ResponseCacheDirectory() string {
+	return filepath.Join(configDirectory(), "response_cache")
+}
@@ -0,0 +1,43 @@
+package configv3
+
+import (
+	"sort"
+	"strings"
+)
+
+// TrustedPluginKey is a saved ed25519 public key that plugin signatures are
+// verified against.
+type TrustedPluginKey struct {
+	Name string `json:"Name"`
+	// PublicKey is the base64 encoded ed25519 public key.
+	PublicKey string `json:"PublicKey"`
+}
+
+// AddTrustedPluginKey adds a new trusted key to the plugin config. It does not
+// check for duplicates.
+func (config *Config) AddTrustedPluginKey(name string, publicKey string) {
+	config.ConfigFile.TrustedPluginKeys = append(config.ConfigFile.TrustedPluginKeys,
+		TrustedPluginKey{Name: name, PublicKey: publicKey})
+}
+
+// RemoveTrustedPluginKey removes the trusted keys with the given name,
+// ignoring case.
+func (config *Config) RemoveTrustedPluginKey(name string) {
+	var keys []TrustedPluginKey
+	for _, key := range config.ConfigFile.TrustedPluginKeys {
+		if !strings.EqualFold(key.Name, name) {
+			keys = append(keys, key)
+		}
+	}
+	config.ConfigFile.TrustedPluginKeys = keys
+}
+
+// TrustedPluginKeys returns the currently configured trusted plugin keys from
+// the .cf/config.json, sorted by name.
+func (config *Config) TrustedPluginKeys() []TrustedPluginKey {
+	keys := config.ConfigFile.TrustedPluginKeys
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.ToLower(keys[i].Name) < strings.ToLower(keys[j].Name)
+	})
+	return keys
+}
@@ -0,0 +1,51 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TrustedPluginKey", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			ConfigFile: JSONConfig{
+				TrustedPluginKeys: []TrustedPluginKey{
+					{Name: "S-key", PublicKey: "S-public-key"},
+					{Name: "key-2", PublicKey: "public-key-2"},
+					{Name: "key-1", PublicKey: "public-key-1"},
+				},
+			},
+		}
+	})
+
+	Describe("TrustedPluginKeys", func() {
+		It("returns sorted trusted plugin keys", func() {
+			Expect(config.TrustedPluginKeys()).To(Equal([]TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+				{Name: "S-key", PublicKey: "S-public-key"},
+			}))
+		})
+	})
+
+	Describe("AddTrustedPluginKey", func() {
+		It("adds the key name and public key to the list of trusted keys", func() {
+			config.AddTrustedPluginKey("some-key", "some-public-key")
+			Expect(config.TrustedPluginKeys()).To(ContainElement(TrustedPluginKey{Name: "some-key", PublicKey: "some-public-key"}))
+		})
+	})
+
+	Describe("RemoveTrustedPluginKey", func() {
+		It("removes the key with the given name, ignoring case", func() {
+			config.RemoveTrustedPluginKey("KEY-2")
+			Expect(config.TrustedPluginKeys()).To(Equal([]TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "S-key", PublicKey: "S-public-key"},
+			}))
+		})
+	})
+})
@@ -5,20 +5,37 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"reflect"
 	"syscall"
+
+	"code.cloudfoundry.org/cli/util/filelock"
 )
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// Other CLI processes sharing the same .cf directory may have written the
+// config.json since it was loaded. To avoid clobbering their changes,
+// WriteConfig holds an advisory lock while it rereads the config.json, and
+// only replaces the fields that were changed since the config was loaded.
+// The new config.json is written to a temporary file and renamed into place
+// so that readers never see a partially written file.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	dir := configDirectory()
+	err := os.MkdirAll(dir, 0700)
 	if err != nil {
 		return err
 	}
 
-	dir := configDirectory()
-	err = os.MkdirAll(dir, 0700)
+	lock, err := filelock.Acquire(configLockFilePath())
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	contents := c.fileContents()
+	rawConfig, err := json.MarshalIndent(mergeConfigFile(c.loadedConfigFile, contents), "", "  ")
 	if err != nil {
 		return err
 	}
@@ -44,7 +61,65 @@ func WriteConfig(c *Config) error {
 		return err
 	}
 
-	return os.Rename(tempConfigFileName, ConfigFilePath())
+	err = os.Rename(tempConfigFileName, ConfigFilePath())
+	if err != nil {
+		return err
+	}
+
+	written := copyJSONConfig(contents)
+	c.loadedConfigFile = &written
+	return nil
+}
+
+// mergeConfigFile returns the config.json currently on disk with the fields
+// that differ between loaded and contents replaced by the values in
+// contents. When the config was not loaded from disk, or the config.json
+// cannot be read, contents is returned unchanged.
+func mergeConfigFile(loaded *JSONConfig, contents JSONConfig) JSONConfig {
+	if loaded == nil {
+		return contents
+	}
+
+	rawConfig, err := ioutil.ReadFile(ConfigFilePath())
+	if err != nil || len(rawConfig) == 0 {
+		return contents
+	}
+
+	var merged JSONConfig
+	err = json.Unmarshal(rawConfig, &merged)
+	if err != nil {
+		return contents
+	}
+
+	loadedValue := reflect.ValueOf(*loaded)
+	contentsValue := reflect.ValueOf(contents)
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	for i := 0; i < contentsValue.NumField(); i++ {
+		if !reflect.DeepEqual(loadedValue.Field(i).Interface(), contentsValue.Field(i).Interface()) {
+			mergedValue.Field(i).Set(contentsValue.Field(i))
+		}
+	}
+
+	return merged
+}
+
+// copyJSONConfig returns a copy of jsonConfig that does not share slices with
+// it.
+func copyJSONConfig(jsonConfig JSONConfig) JSONConfig {
+	if jsonConfig.PluginRepositories != nil {
+		jsonConfig.PluginRepositories = append([]PluginRepository{}, jsonConfig.PluginRepositories...)
+	}
+	if jsonConfig.TrustedPluginKeys != nil {
+		jsonConfig.TrustedPluginKeys = append([]TrustedPluginKey{}, jsonConfig.TrustedPluginKeys...)
+	}
+	if jsonConfig.Contexts != nil {
+		jsonConfig.Contexts = append([]NamedContext{}, jsonConfig.Contexts...)
+	}
+	return jsonConfig
+}
+
+func configLockFilePath() string {
+	return ConfigFilePath() + ".lock"
 }
 
 // catchSignal tries to catch SIGHUP, SIGINT, SIGKILL, SIGQUIT and SIGTERM, and
@@ -54,6 +54,83 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.Target).To(Equal(config.ConfigFile.Target))
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
+
+			It("does not leave temporary config files behind", func() {
+				err := WriteConfig(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				tempFiles, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFiles).To(BeEmpty())
+			})
+		})
+
+		Context("when the config was loaded from disk", func() {
+			BeforeEach(func() {
+				rawConfig := `{
+					"ConfigVersion": 3,
+					"Target": "https://api.foo.com",
+					"AccessToken": "some-access-token",
+					"RefreshToken": "some-refresh-token",
+					"OrganizationFields": {"GUID": "some-org-guid", "Name": "some-org"}
+				}`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when another process changed the config.json after it was loaded", func() {
+				BeforeEach(func() {
+					rawConfig := `{
+						"ConfigVersion": 3,
+						"Target": "https://api.foo.com",
+						"AccessToken": "some-access-token",
+						"RefreshToken": "some-refresh-token",
+						"OrganizationFields": {"GUID": "other-org-guid", "Name": "other-org"},
+						"SpaceFields": {"GUID": "other-space-guid", "Name": "other-space"}
+					}`
+					setConfig(homeDir, rawConfig)
+				})
+
+				It("only writes the fields that were changed since loading", func() {
+					config.SetAccessToken("refreshed-access-token")
+					config.SetRefreshToken("refreshed-refresh-token")
+
+					err := WriteConfig(config)
+					Expect(err).ToNot(HaveOccurred())
+
+					file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+					Expect(err).ToNot(HaveOccurred())
+
+					var writtenCFConfig JSONConfig
+					err = json.Unmarshal(file, &writtenCFConfig)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(writtenCFConfig.AccessToken).To(Equal("refreshed-access-token"))
+					Expect(writtenCFConfig.RefreshToken).To(Equal("refreshed-refresh-token"))
+					Expect(writtenCFConfig.TargetedOrganization.Name).To(Equal("other-org"))
+					Expect(writtenCFConfig.TargetedSpace.Name).To(Equal("other-space"))
+				})
+
+				It("overwrites the fields that were changed since loading", func() {
+					config.SetOrganizationInformation("some-new-org-guid", "some-new-org")
+
+					err := WriteConfig(config)
+					Expect(err).ToNot(HaveOccurred())
+
+					file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+					Expect(err).ToNot(HaveOccurred())
+
+					var writtenCFConfig JSONConfig
+					err = json.Unmarshal(file, &writtenCFConfig)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(writtenCFConfig.TargetedOrganization.Name).To(Equal("some-new-org"))
+					Expect(writtenCFConfig.TargetedSpace.Name).To(Equal("other-space"))
+				})
+			})
 		})
 	})
 })
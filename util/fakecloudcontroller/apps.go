@@ -0,0 +1,748 @@
+package fakecloudcontroller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// app is an application, which is both a V2 app and a V3 app. The V2 app's
+// instances, memory, disk quota, command and health check are those of its
+// web process.
+type app struct {
+	guid      string
+	name      string
+	spaceGUID string
+	state     string
+
+	lifecycleType string
+	buildpacks    []string
+	stackName     string
+	dockerImage   string
+	environment   map[string]interface{}
+
+	// packageState is the V2 package state, which is STAGED once the app has
+	// been staged with its latest package.
+	packageState     string
+	packageUpdatedAt *string
+	dropletGUID      string
+}
+
+// Application is an app on the server.
+type Application struct {
+	GUID      string
+	Name      string
+	SpaceGUID string
+	State     string
+
+	// DropletGUID is the GUID of the app's current droplet, if it has been
+	// staged.
+	DropletGUID string
+}
+
+// Applications returns the apps on the server.
+func (server *Server) Applications() []Application {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var applications []Application
+	for _, app := range server.state.apps {
+		applications = append(applications, Application{
+			GUID:        app.guid,
+			Name:        app.name,
+			SpaceGUID:   app.spaceGUID,
+			State:       app.state,
+			DropletGUID: app.dropletGUID,
+		})
+	}
+	return applications
+}
+
+func (s *state) findApp(guid string) *app {
+	for _, app := range s.apps {
+		if app.guid == guid {
+			return app
+		}
+	}
+	return nil
+}
+
+func (s *state) findAppByName(spaceGUID string, name string) *app {
+	for _, app := range s.apps {
+		if app.spaceGUID == spaceGUID && app.name == name {
+			return app
+		}
+	}
+	return nil
+}
+
+// createApp creates an app with a web process, unless an app with the same
+// name exists in the space.
+func (s *state) createApp(name string, spaceGUID string) (*app, bool) {
+	if s.findAppByName(spaceGUID, name) != nil {
+		return nil, false
+	}
+
+	app := &app{
+		guid:          s.newGUID(),
+		name:          name,
+		spaceGUID:     spaceGUID,
+		state:         "STOPPED",
+		lifecycleType: "buildpack",
+		stackName:     DefaultStack,
+		environment:   map[string]interface{}{},
+		packageState:  "PENDING",
+	}
+	s.apps = append(s.apps, app)
+	s.createProcess(app.guid, "web")
+	return app, true
+}
+
+func (s *state) deleteApp(app *app) {
+	for i, candidate := range s.apps {
+		if candidate == app {
+			s.apps = append(s.apps[:i], s.apps[i+1:]...)
+			break
+		}
+	}
+
+	var processes []*process
+	for _, process := range s.processes {
+		if process.appGUID != app.guid {
+			processes = append(processes, process)
+		}
+	}
+	s.processes = processes
+
+	var bindings []*serviceBinding
+	for _, binding := range s.serviceBindings {
+		if binding.appGUID != app.guid {
+			bindings = append(bindings, binding)
+		}
+	}
+	s.serviceBindings = bindings
+
+	for _, route := range s.routes {
+		route.unmap(app.guid)
+	}
+}
+
+// start starts the app. A V2 app is staged first if it has a package that has
+// not been staged.
+func (s *state) start(app *app) {
+	app.state = "STARTED"
+
+	if app.packageState == "STAGED" {
+		return
+	}
+
+	pkg := s.latestPackage(app.guid)
+	switch {
+	case pkg != nil && pkg.state == "READY":
+		app.dropletGUID = s.stage(pkg).guid
+		app.packageState = "STAGED"
+	case app.dropletGUID != "":
+		app.packageState = "STAGED"
+	default:
+		app.packageState = "FAILED"
+	}
+}
+
+// runningInstances returns the number of running instances of the process,
+// which are all of its instances once its app is started with a droplet.
+func (s *state) runningInstances(process *process) int {
+	app := s.findApp(process.appGUID)
+	if app == nil || app.state != "STARTED" || app.dropletGUID == "" {
+		return 0
+	}
+	return process.instances
+}
+
+func (s *state) appV2(app *app) v2Resource {
+	web := s.findProcessByType(app.guid, "web")
+
+	var buildpack interface{}
+	if len(app.buildpacks) > 0 {
+		buildpack = app.buildpacks[0]
+	}
+	var stackGUID string
+	if stack := s.findStackByName(app.stackName); stack != nil {
+		stackGUID = stack.guid
+	}
+
+	return v2Resource{
+		Metadata: newMetadata("apps", app.guid),
+		Entity: map[string]interface{}{
+			"name":                       app.name,
+			"space_guid":                 app.spaceGUID,
+			"state":                      app.state,
+			"stack_guid":                 stackGUID,
+			"buildpack":                  buildpack,
+			"detected_buildpack":         "",
+			"command":                    nilIfEmpty(web.command),
+			"detected_start_command":     "",
+			"docker_image":               nilIfEmpty(app.dockerImage),
+			"docker_credentials":         map[string]interface{}{"username": nil, "password": nil},
+			"environment_json":           app.environment,
+			"instances":                  web.instances,
+			"memory":                     web.memory,
+			"disk_quota":                 web.disk,
+			"health_check_type":          web.healthCheckType,
+			"health_check_http_endpoint": web.healthCheckEndpoint,
+			"health_check_timeout":       nilIfZero(web.healthCheckTimeout),
+			"package_state":              app.packageState,
+			"package_updated_at":         app.packageUpdatedAt,
+			"staging_failed_reason":      stagingFailedReason(app),
+			"staging_failed_description": stagingFailedDescription(app),
+			"diego":                      true,
+			"enable_ssh":                 true,
+			"ports":                      []int{8080},
+			"space_url":                  "/v2/spaces/" + app.spaceGUID,
+			"routes_url":                 "/v2/apps/" + app.guid + "/routes",
+			"service_bindings_url":       "/v2/apps/" + app.guid + "/service_bindings",
+		},
+	}
+}
+
+func stagingFailedReason(app *app) interface{} {
+	if app.packageState == "FAILED" {
+		return "NoAppDetectedError"
+	}
+	return nil
+}
+
+func stagingFailedDescription(app *app) interface{} {
+	if app.packageState == "FAILED" {
+		return "An app was not successfully detected by any available buildpack"
+	}
+	return nil
+}
+
+func nilIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func nilIfZero(value int) interface{} {
+	if value == 0 {
+		return nil
+	}
+	return value
+}
+
+type v2AppRequest struct {
+	Name                    *string                `json:"name"`
+	SpaceGUID               *string                `json:"space_guid"`
+	State                   *string                `json:"state"`
+	Instances               *int                   `json:"instances"`
+	Memory                  *uint64                `json:"memory"`
+	DiskQuota               *uint64                `json:"disk_quota"`
+	Buildpack               *string                `json:"buildpack"`
+	Command                 *string                `json:"command"`
+	DockerImage             *string                `json:"docker_image"`
+	EnvironmentJSON         map[string]interface{} `json:"environment_json"`
+	HealthCheckType         *string                `json:"health_check_type"`
+	HealthCheckHTTPEndpoint *string                `json:"health_check_http_endpoint"`
+	HealthCheckTimeout      *int                   `json:"health_check_timeout"`
+	StackGUID               *string                `json:"stack_guid"`
+}
+
+func (s *state) listAppsV2(request *http.Request, params params) response {
+	filters := v2Filters(request)
+	if spaceGUID, ok := params["space_guid"]; ok {
+		filters["space_guid"] = []string{spaceGUID}
+	}
+
+	var resources []v2Resource
+	for _, app := range s.apps {
+		if filters.match("name", app.name) && filters.match("space_guid", app.spaceGUID) {
+			resources = append(resources, s.appV2(app))
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) getAppV2(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	return respond(http.StatusOK, s.appV2(app))
+}
+
+func (s *state) createAppV2(request *http.Request, _ params) response {
+	var body v2AppRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v2InvalidRequest(err.Error())
+	}
+	if body.Name == nil || body.SpaceGUID == nil {
+		return v2InvalidRequest("name and space_guid are required")
+	}
+	if s.findSpace(*body.SpaceGUID) == nil {
+		return v2NotFound(40004, "Space", *body.SpaceGUID)
+	}
+
+	app, ok := s.createApp(*body.Name, *body.SpaceGUID)
+	if !ok {
+		return v2Error(http.StatusBadRequest, 100002, "CF-AppNameTaken", "The app name is taken: "+*body.Name)
+	}
+	if errResponse, ok := s.updateAppV2(app, body); !ok {
+		s.deleteApp(app)
+		return errResponse
+	}
+	return respond(http.StatusCreated, s.appV2(app))
+}
+
+func (s *state) putAppV2(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+
+	var body v2AppRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v2InvalidRequest(err.Error())
+	}
+	if body.Name != nil && *body.Name != app.name && s.findAppByName(app.spaceGUID, *body.Name) != nil {
+		return v2Error(http.StatusBadRequest, 100002, "CF-AppNameTaken", "The app name is taken: "+*body.Name)
+	}
+	if errResponse, ok := s.updateAppV2(app, body); !ok {
+		return errResponse
+	}
+	return respond(http.StatusCreated, s.appV2(app))
+}
+
+func (s *state) updateAppV2(app *app, body v2AppRequest) (response, bool) {
+	web := s.findProcessByType(app.guid, "web")
+
+	if body.StackGUID != nil {
+		var found bool
+		for _, stack := range s.stacks {
+			if stack.guid == *body.StackGUID {
+				app.stackName, found = stack.name, true
+			}
+		}
+		if !found {
+			return v2NotFound(250003, "Stack", *body.StackGUID), false
+		}
+	}
+	if body.Name != nil {
+		app.name = *body.Name
+	}
+	if body.Buildpack != nil {
+		app.buildpacks = nil
+		if *body.Buildpack != "" {
+			app.buildpacks = []string{*body.Buildpack}
+		}
+	}
+	if body.DockerImage != nil {
+		app.dockerImage = *body.DockerImage
+		app.lifecycleType = "docker"
+	}
+	if body.EnvironmentJSON != nil {
+		app.environment = body.EnvironmentJSON
+	}
+	if body.Instances != nil {
+		web.instances = *body.Instances
+	}
+	if body.Memory != nil {
+		web.memory = *body.Memory
+	}
+	if body.DiskQuota != nil {
+		web.disk = *body.DiskQuota
+	}
+	if body.Command != nil {
+		web.command = *body.Command
+	}
+	if body.HealthCheckType != nil {
+		web.healthCheckType = *body.HealthCheckType
+	}
+	if body.HealthCheckHTTPEndpoint != nil {
+		web.healthCheckEndpoint = *body.HealthCheckHTTPEndpoint
+	}
+	if body.HealthCheckTimeout != nil {
+		web.healthCheckTimeout = *body.HealthCheckTimeout
+	}
+
+	if body.State != nil {
+		switch *body.State {
+		case "STARTED":
+			s.start(app)
+		case "STOPPED":
+			app.state = "STOPPED"
+		default:
+			return v2InvalidRequest("invalid state " + *body.State), false
+		}
+	}
+
+	return response{}, true
+}
+
+func (s *state) deleteAppV2(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	s.deleteApp(app)
+	return respond(http.StatusNoContent, nil)
+}
+
+type instanceState struct {
+	State string  `json:"state"`
+	Since float64 `json:"since"`
+}
+
+// getAppInstancesV2 returns the state of the web process instances of a
+// started app.
+func (s *state) getAppInstancesV2(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	if app.state != "STARTED" {
+		return v2Error(http.StatusBadRequest, 220001, "CF-InstancesError", "Instances error: app is stopped")
+	}
+	if app.packageState != "STAGED" {
+		return v2Error(http.StatusBadRequest, 170002, "CF-NotStaged", "App has not finished staging")
+	}
+
+	web := s.findProcessByType(app.guid, "web")
+	instances := map[string]instanceState{}
+	for i := 0; i < s.runningInstances(web); i++ {
+		instances[fmt.Sprint(i)] = instanceState{State: "RUNNING", Since: float64(time.Now().Unix())}
+	}
+	return respond(http.StatusOK, instances)
+}
+
+// getAppStatsV2 returns the usage of the web process instances of a started
+// app.
+func (s *state) getAppStatsV2(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	if app.state != "STARTED" {
+		return v2Error(http.StatusBadRequest, 200003, "CF-AppStoppedStatsError", "Could not fetch stats for stopped app: "+app.name)
+	}
+
+	web := s.findProcessByType(app.guid, "web")
+	stats := map[string]interface{}{}
+	for i := 0; i < s.runningInstances(web); i++ {
+		stats[fmt.Sprint(i)] = map[string]interface{}{
+			"state": "RUNNING",
+			"stats": map[string]interface{}{
+				"name":       app.name,
+				"uris":       s.appURIs(app.guid),
+				"host":       "127.0.0.1",
+				"port":       8080,
+				"uptime":     1,
+				"mem_quota":  web.memory * 1024 * 1024,
+				"disk_quota": web.disk * 1024 * 1024,
+				"fds_quota":  16384,
+				"usage": map[string]interface{}{
+					"time": timestamp(),
+					"cpu":  0,
+					"mem":  0,
+					"disk": 0,
+				},
+			},
+		}
+	}
+	return respond(http.StatusOK, stats)
+}
+
+func (s *state) appV3(app *app) interface{} {
+	lifecycleData := map[string]interface{}{}
+	if app.lifecycleType == "buildpack" {
+		buildpacks := app.buildpacks
+		if buildpacks == nil {
+			buildpacks = []string{}
+		}
+		lifecycleData["buildpacks"] = buildpacks
+		lifecycleData["stack"] = app.stackName
+	}
+
+	self := "/v3/apps/" + app.guid
+	return map[string]interface{}{
+		"guid":       app.guid,
+		"name":       app.name,
+		"state":      app.state,
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"lifecycle": map[string]interface{}{
+			"type": app.lifecycleType,
+			"data": lifecycleData,
+		},
+		"relationships": map[string]interface{}{
+			"space": relationship(app.spaceGUID),
+		},
+		"links": map[string]link{
+			"self":            {HREF: s.url + self},
+			"space":           {HREF: s.url + "/v3/spaces/" + app.spaceGUID},
+			"processes":       {HREF: s.url + self + "/processes"},
+			"packages":        {HREF: s.url + self + "/packages"},
+			"current_droplet": {HREF: s.url + self + "/droplets/current"},
+			"droplets":        {HREF: s.url + self + "/droplets"},
+			"tasks":           {HREF: s.url + self + "/tasks"},
+			"start":           {HREF: s.url + self + "/actions/start", Method: http.MethodPost},
+			"stop":            {HREF: s.url + self + "/actions/stop", Method: http.MethodPost},
+		},
+	}
+}
+
+type v3Lifecycle struct {
+	Type string `json:"type"`
+	Data struct {
+		Buildpacks []string `json:"buildpacks"`
+		Stack      string   `json:"stack"`
+	} `json:"data"`
+}
+
+type v3AppRequest struct {
+	Name          *string      `json:"name"`
+	Lifecycle     *v3Lifecycle `json:"lifecycle"`
+	Relationships struct {
+		Space struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"space"`
+	} `json:"relationships"`
+	EnvironmentVariables map[string]interface{} `json:"environment_variables"`
+}
+
+func (s *state) listAppsV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, app := range s.apps {
+		if filters.match("names", app.name) &&
+			filters.match("guids", app.guid) &&
+			filters.match("space_guids", app.spaceGUID) {
+			resources = append(resources, s.appV3(app))
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+func (s *state) getAppV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	return respond(http.StatusOK, s.appV3(app))
+}
+
+func (s *state) createAppV3(request *http.Request, _ params) response {
+	var body v3AppRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	if body.Name == nil {
+		return v3UnprocessableEntity("Name must be a string")
+	}
+	spaceGUID := body.Relationships.Space.Data.GUID
+	if s.findSpace(spaceGUID) == nil {
+		return v3UnprocessableEntity("Invalid space. Ensure that the space exists and you have access to it.")
+	}
+
+	app, ok := s.createApp(*body.Name, spaceGUID)
+	if !ok {
+		return v3UnprocessableEntity("name must be unique in space")
+	}
+	updateAppV3(app, body)
+	return respond(http.StatusCreated, s.appV3(app))
+}
+
+func (s *state) patchAppV3(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+
+	var body v3AppRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	if body.Name != nil && *body.Name != app.name && s.findAppByName(app.spaceGUID, *body.Name) != nil {
+		return v3UnprocessableEntity("name must be unique in space")
+	}
+	updateAppV3(app, body)
+	return respond(http.StatusOK, s.appV3(app))
+}
+
+func updateAppV3(app *app, body v3AppRequest) {
+	if body.Name != nil {
+		app.name = *body.Name
+	}
+	if body.Lifecycle != nil {
+		app.lifecycleType = body.Lifecycle.Type
+		if body.Lifecycle.Type == "buildpack" {
+			app.buildpacks = body.Lifecycle.Data.Buildpacks
+			if body.Lifecycle.Data.Stack != "" {
+				app.stackName = body.Lifecycle.Data.Stack
+			}
+		}
+	}
+	for name, value := range body.EnvironmentVariables {
+		app.environment[name] = value
+	}
+}
+
+func (s *state) deleteAppV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	s.deleteApp(app)
+
+	job := s.createJob()
+	return response{
+		statusCode: http.StatusAccepted,
+		header:     http.Header{"Location": {s.url + "/v3/jobs/" + job.guid}},
+	}
+}
+
+func (s *state) startAppV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	if app.dropletGUID == "" {
+		return v3UnprocessableEntity("Assign a droplet before starting this app.")
+	}
+	s.start(app)
+	return respond(http.StatusOK, s.appV3(app))
+}
+
+func (s *state) stopAppV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	app.state = "STOPPED"
+	return respond(http.StatusOK, s.appV3(app))
+}
+
+func (s *state) setCurrentDropletV3(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+
+	var body struct {
+		Data struct {
+			GUID string `json:"guid"`
+		} `json:"data"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	droplet := s.findDroplet(body.Data.GUID)
+	if droplet == nil || droplet.appGUID != app.guid {
+		return v3UnprocessableEntity("Unable to assign current droplet. Ensure the droplet exists and belongs to this app.")
+	}
+
+	app.dropletGUID = droplet.guid
+	app.packageState = "STAGED"
+	return respond(http.StatusOK, map[string]interface{}{
+		"data": map[string]string{"guid": droplet.guid},
+		"links": map[string]link{
+			"self":    {HREF: s.url + "/v3/apps/" + app.guid + "/relationships/current_droplet"},
+			"related": {HREF: s.url + "/v3/apps/" + app.guid + "/droplets/current"},
+		},
+	})
+}
+
+func (s *state) getCurrentDropletV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	droplet := s.findDroplet(app.dropletGUID)
+	if droplet == nil {
+		return v3NotFound("Droplet")
+	}
+	return respond(http.StatusOK, s.dropletV3(droplet))
+}
+
+func (s *state) getAppEnvironmentV3(_ *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+	return respond(http.StatusOK, map[string]interface{}{
+		"environment_variables": app.environment,
+		"staging_env_json":      map[string]interface{}{},
+		"running_env_json":      map[string]interface{}{},
+		"system_env_json":       map[string]interface{}{},
+		"application_env_json":  map[string]interface{}{},
+	})
+}
+
+// patchAppEnvironmentVariablesV3 sets the app's environment variables in the
+// request, and unsets those set to null.
+func (s *state) patchAppEnvironmentVariablesV3(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+
+	var body struct {
+		Var map[string]interface{} `json:"var"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	for name, value := range body.Var {
+		if value == nil {
+			delete(app.environment, name)
+		} else {
+			app.environment[name] = value
+		}
+	}
+
+	return respond(http.StatusOK, map[string]interface{}{
+		"var": app.environment,
+		"links": map[string]link{
+			"self": {HREF: s.url + "/v3/apps/" + app.guid + "/environment_variables"},
+			"app":  {HREF: s.url + "/v3/apps/" + app.guid},
+		},
+	})
+}
+
+// getSpaceSummaryV2 returns the apps in the space with their running
+// instances and routes.
+func (s *state) getSpaceSummaryV2(_ *http.Request, params params) response {
+	space := s.findSpace(params["guid"])
+	if space == nil {
+		return v2NotFound(40004, "Space", params["guid"])
+	}
+
+	apps := []interface{}{}
+	for _, app := range s.apps {
+		if app.spaceGUID != space.guid {
+			continue
+		}
+
+		summary := map[string]interface{}{}
+		for name, value := range s.appV2(app).Entity.(map[string]interface{}) {
+			summary[name] = value
+		}
+		summary["guid"] = app.guid
+		summary["running_instances"] = s.runningInstances(s.findProcessByType(app.guid, "web"))
+		summary["urls"] = s.appURIs(app.guid)
+		summary["routes"] = s.routeSummaries(app.guid)
+		summary["service_names"] = []string{}
+		apps = append(apps, summary)
+	}
+
+	return respond(http.StatusOK, map[string]interface{}{
+		"guid":     space.guid,
+		"name":     space.name,
+		"apps":     apps,
+		"services": []interface{}{},
+	})
+}
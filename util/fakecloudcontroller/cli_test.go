@@ -0,0 +1,124 @@
+package fakecloudcontroller_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/fakecloudcontroller"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("running the CLI against the server", func() {
+	var (
+		server  *Server
+		homeDir string
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		orgGUID := server.CreateOrganization("some-org")
+		server.CreateSpace(orgGUID, "some-space")
+
+		var err error
+		homeDir, err = ioutil.TempDir("", "fake-cloud-controller-cf-home")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(homeDir)).To(Succeed())
+	})
+
+	// cf runs the CLI with its own CF_HOME and waits for it to exit.
+	cf := func(args ...string) *Session {
+		command := exec.Command(cfPath, args...)
+		command.Env = append(os.Environ(), "CF_HOME="+homeDir, "CF_COLOR=false", "CF_TRACE=false")
+
+		session, err := Start(command, GinkgoWriter, GinkgoWriter)
+		Expect(err).ToNot(HaveOccurred())
+		return session.Wait("30s")
+	}
+
+	It("targets the server with cf api", func() {
+		session := cf("api", server.URL())
+		Expect(session).To(Exit(0))
+		Expect(session).To(Say(`api version:\s+` + APIVersionV2))
+	})
+
+	Context("when the API is targeted", func() {
+		BeforeEach(func() {
+			Expect(cf("api", server.URL())).To(Exit(0))
+		})
+
+		It("logs in and targets the org and space with cf login", func() {
+			session := cf("login", "-u", DefaultUsername, "-p", DefaultPassword, "-o", "some-org", "-s", "some-space")
+			Expect(session).To(Exit(0))
+			Expect(session).To(Say(`Org:\s+some-org`))
+			Expect(session).To(Say(`Space:\s+some-space`))
+		})
+
+		It("does not log in with the wrong password", func() {
+			Expect(cf("login", "-u", DefaultUsername, "-p", "wrong-password", "-o", "some-org", "-s", "some-space")).To(Exit(1))
+		})
+
+		Context("when logged in", func() {
+			var appDir string
+
+			BeforeEach(func() {
+				Expect(cf("login", "-u", DefaultUsername, "-p", DefaultPassword, "-o", "some-org", "-s", "some-space")).To(Exit(0))
+
+				var err error
+				appDir, err = ioutil.TempDir("", "fake-cloud-controller-app")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(filepath.Join(appDir, "index.html"), []byte("some-content"), 0666)).To(Succeed())
+
+				session := cf("push", "some-app", "-p", appDir)
+				Expect(session).To(Exit(0))
+				Expect(session).To(Say(`#0\s+running`))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(appDir)).To(Succeed())
+			})
+
+			It("pushes the app with cf push", func() {
+				applications := server.Applications()
+				Expect(applications).To(HaveLen(1))
+				Expect(applications[0].Name).To(Equal("some-app"))
+				Expect(applications[0].State).To(Equal("STARTED"))
+				Expect(applications[0].DropletGUID).ToNot(BeEmpty())
+			})
+
+			It("runs tasks until they are completed or failed with cf run-task", func() {
+				session := cf("run-task", "some-app", "echo hi", "--name", "some-task")
+				Expect(session).To(Exit(0))
+				Expect(session).To(Say("Task has been submitted successfully for execution."))
+
+				tasks := server.Tasks()
+				Expect(tasks).To(HaveLen(1))
+				Expect(tasks[0].Name).To(Equal("some-task"))
+				Expect(tasks[0].Command).To(Equal("echo hi"))
+				Expect(tasks[0].State).To(Equal("RUNNING"))
+
+				Expect(cf("tasks", "some-app")).To(Say(`1\s+some-task\s+RUNNING`))
+
+				Expect(server.CompleteTask(tasks[0].GUID)).To(Succeed())
+				Expect(cf("tasks", "some-app")).To(Say(`1\s+some-task\s+SUCCEEDED`))
+
+				Expect(cf("run-task", "some-app", "exit 1", "--name", "other-task")).To(Exit(0))
+				for _, task := range server.Tasks() {
+					if task.Name == "other-task" {
+						Expect(server.FailTask(task.GUID, "some-reason")).To(Succeed())
+					}
+				}
+				Expect(cf("tasks", "some-app")).To(Say(`2\s+other-task\s+FAILED`))
+			})
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type domain struct {
+	guid                   string
+	name                   string
+	owningOrganizationGUID string
+}
+
+// CreatePrivateDomain creates a domain owned by the organization and returns
+// its GUID.
+func (server *Server) CreatePrivateDomain(organizationGUID string, name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.state.createDomain(name, organizationGUID).guid
+}
+
+func (s *state) createDomain(name string, owningOrganizationGUID string) *domain {
+	domain := &domain{guid: s.newGUID(), name: name, owningOrganizationGUID: owningOrganizationGUID}
+	s.domains = append(s.domains, domain)
+	return domain
+}
+
+func (s *state) findDomain(guid string) *domain {
+	for _, domain := range s.domains {
+		if domain.guid == guid {
+			return domain
+		}
+	}
+	return nil
+}
+
+func (domain *domain) shared() bool {
+	return domain.owningOrganizationGUID == ""
+}
+
+func (domain *domain) v2() v2Resource {
+	collection := "private_domains"
+	entity := map[string]interface{}{
+		"name": domain.name,
+	}
+	if domain.shared() {
+		collection = "shared_domains"
+		entity["internal"] = false
+		entity["router_group_guid"] = nil
+		entity["router_group_type"] = nil
+	} else {
+		entity["owning_organization_guid"] = domain.owningOrganizationGUID
+		entity["owning_organization_url"] = "/v2/organizations/" + domain.owningOrganizationGUID
+	}
+
+	return v2Resource{
+		Metadata: newMetadata(collection, domain.guid),
+		Entity:   entity,
+	}
+}
+
+func (s *state) listDomainsV2(shared bool, request *http.Request, params params) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, domain := range s.domains {
+		if domain.shared() != shared || !filters.match("name", domain.name) {
+			continue
+		}
+		if orgGUID, ok := params["organization_guid"]; ok && domain.owningOrganizationGUID != orgGUID {
+			continue
+		}
+		resources = append(resources, domain.v2())
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) listSharedDomainsV2(request *http.Request, params params) response {
+	return s.listDomainsV2(true, request, params)
+}
+
+func (s *state) listPrivateDomainsV2(request *http.Request, params params) response {
+	return s.listDomainsV2(false, request, params)
+}
+
+func (s *state) getDomainV2(_ *http.Request, params params) response {
+	domain := s.findDomain(params["guid"])
+	if domain == nil {
+		return v2NotFound(130002, "Domain", params["guid"])
+	}
+	return respond(http.StatusOK, domain.v2())
+}
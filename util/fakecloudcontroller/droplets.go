@@ -0,0 +1,150 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type droplet struct {
+	guid        string
+	appGUID     string
+	packageGUID string
+	stack       string
+	buildpacks  []string
+	image       string
+}
+
+type build struct {
+	guid        string
+	packageGUID string
+	dropletGUID string
+}
+
+// stage stages the package into a new droplet, which succeeds immediately.
+func (s *state) stage(pkg *appPackage) *droplet {
+	droplet := &droplet{
+		guid:        s.newGUID(),
+		appGUID:     pkg.appGUID,
+		packageGUID: pkg.guid,
+		image:       pkg.dockerImage,
+	}
+	if app := s.findApp(pkg.appGUID); app != nil && pkg.packageType != "docker" {
+		droplet.stack = app.stackName
+		droplet.buildpacks = app.buildpacks
+	}
+	s.droplets = append(s.droplets, droplet)
+	return droplet
+}
+
+func (s *state) findDroplet(guid string) *droplet {
+	for _, droplet := range s.droplets {
+		if droplet.guid == guid {
+			return droplet
+		}
+	}
+	return nil
+}
+
+func (s *state) findBuild(guid string) *build {
+	for _, build := range s.builds {
+		if build.guid == guid {
+			return build
+		}
+	}
+	return nil
+}
+
+func (s *state) dropletV3(droplet *droplet) interface{} {
+	buildpacks := []map[string]string{}
+	for _, buildpack := range droplet.buildpacks {
+		buildpacks = append(buildpacks, map[string]string{"name": buildpack, "detect_output": ""})
+	}
+
+	return map[string]interface{}{
+		"guid":       droplet.guid,
+		"state":      "STAGED",
+		"stack":      nilIfEmpty(droplet.stack),
+		"buildpacks": buildpacks,
+		"image":      nilIfEmpty(droplet.image),
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"links": map[string]link{
+			"self":    {HREF: s.url + "/v3/droplets/" + droplet.guid},
+			"package": {HREF: s.url + "/v3/packages/" + droplet.packageGUID},
+			"app":     {HREF: s.url + "/v3/apps/" + droplet.appGUID},
+		},
+	}
+}
+
+func (s *state) buildV3(build *build) interface{} {
+	return map[string]interface{}{
+		"guid":       build.guid,
+		"state":      "STAGED",
+		"error":      nil,
+		"package":    map[string]string{"guid": build.packageGUID},
+		"droplet":    map[string]string{"guid": build.dropletGUID},
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"links": map[string]link{
+			"self": {HREF: s.url + "/v3/builds/" + build.guid},
+		},
+	}
+}
+
+func (s *state) listDropletsV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, droplet := range s.droplets {
+		if filters.match("app_guids", droplet.appGUID) &&
+			filters.match("guids", droplet.guid) &&
+			filters.match("states", "STAGED") {
+			resources = append(resources, s.dropletV3(droplet))
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+func (s *state) getDropletV3(_ *http.Request, params params) response {
+	droplet := s.findDroplet(params["guid"])
+	if droplet == nil {
+		return v3NotFound("Droplet")
+	}
+	return respond(http.StatusOK, s.dropletV3(droplet))
+}
+
+// createBuildV3 stages the package of the build, which is staged as soon as it
+// is created.
+func (s *state) createBuildV3(request *http.Request, _ params) response {
+	var body struct {
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+
+	pkg := s.findPackage(body.Package.GUID)
+	if pkg == nil {
+		return v3UnprocessableEntity("Unable to use package. Ensure that the package exists and you have access to it.")
+	}
+	if pkg.state != "READY" {
+		return v3UnprocessableEntity("Package must be in a READY state to stage.")
+	}
+
+	build := &build{
+		guid:        s.newGUID(),
+		packageGUID: pkg.guid,
+		dropletGUID: s.stage(pkg).guid,
+	}
+	s.builds = append(s.builds, build)
+	return respond(http.StatusCreated, s.buildV3(build))
+}
+
+func (s *state) getBuildV3(_ *http.Request, params params) response {
+	build := s.findBuild(params["guid"])
+	if build == nil {
+		return v3NotFound("Build")
+	}
+	return respond(http.StatusOK, s.buildV3(build))
+}
@@ -0,0 +1,70 @@
+package fakecloudcontroller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type v2ErrorBody struct {
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+	ErrorCode   string `json:"error_code"`
+}
+
+type v3ErrorBody struct {
+	Errors []v3Error `json:"errors"`
+}
+
+type v3Error struct {
+	Code   int    `json:"code"`
+	Detail string `json:"detail"`
+	Title  string `json:"title"`
+}
+
+func v2Error(statusCode int, code int, errorCode string, description string) response {
+	return respond(statusCode, v2ErrorBody{Code: code, Description: description, ErrorCode: errorCode})
+}
+
+func v3ErrorResponse(statusCode int, code int, title string, detail string) response {
+	return respond(statusCode, v3ErrorBody{Errors: []v3Error{{Code: code, Detail: detail, Title: title}}})
+}
+
+func isV3(path string) bool {
+	return strings.HasPrefix(path, "/v3")
+}
+
+func notFound(path string) response {
+	if isV3(path) {
+		return v3ErrorResponse(http.StatusNotFound, 10010, "CF-ResourceNotFound", "Resource not found")
+	}
+	return v2Error(http.StatusNotFound, 10000, "CF-NotFound", "Unknown request")
+}
+
+func invalidAuthToken(path string) response {
+	if isV3(path) {
+		return v3ErrorResponse(http.StatusUnauthorized, 1000, "CF-InvalidAuthToken", "Invalid Auth Token")
+	}
+	return v2Error(http.StatusUnauthorized, 1000, "CF-InvalidAuthToken", "Invalid Auth Token")
+}
+
+// v2NotFound is the response to a request for a V2 resource that does not
+// exist, such as an app with code 100004 and error code CF-AppNotFound.
+func v2NotFound(code int, resource string, guid string) response {
+	return v2Error(http.StatusNotFound, code, fmt.Sprintf("CF-%sNotFound", resource),
+		fmt.Sprintf("The %s could not be found: %s", strings.ToLower(resource), guid))
+}
+
+// v3NotFound is the response to a request for a V3 resource that does not
+// exist, such as an app with the detail "App not found".
+func v3NotFound(resource string) response {
+	return v3ErrorResponse(http.StatusNotFound, 10010, "CF-ResourceNotFound", resource+" not found")
+}
+
+func v2InvalidRequest(description string) response {
+	return v2Error(http.StatusBadRequest, 10001, "CF-MessageParseError", description)
+}
+
+func v3UnprocessableEntity(detail string) response {
+	return v3ErrorResponse(http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", detail)
+}
@@ -0,0 +1,29 @@
+package fakecloudcontroller_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+
+	"testing"
+)
+
+func TestFakeCloudController(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fake Cloud Controller Suite")
+}
+
+// cfPath is the path of the CLI binary the commands are run with.
+var cfPath string
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	path, err := Build("code.cloudfoundry.org/cli")
+	Expect(err).ToNot(HaveOccurred())
+	return []byte(path)
+}, func(data []byte) {
+	cfPath = string(data)
+})
+
+var _ = SynchronizedAfterSuite(func() {}, func() {
+	CleanupBuildArtifacts()
+})
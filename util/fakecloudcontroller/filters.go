@@ -0,0 +1,51 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+	"strings"
+)
+
+// filters are the values that the fields of the listed resources are
+// filtered by.
+type filters map[string][]string
+
+// v2Filters returns the filters in the q parameters of a V2 list request, such
+// as "name:some-app" or "name IN some-app,other-app".
+func v2Filters(request *http.Request) filters {
+	parsed := filters{}
+	for _, query := range request.URL.Query()["q"] {
+		if parts := strings.SplitN(query, " IN ", 2); len(parts) == 2 {
+			parsed[parts[0]] = strings.Split(parts[1], ",")
+		} else if parts := strings.SplitN(query, ":", 2); len(parts) == 2 {
+			parsed[parts[0]] = []string{parts[1]}
+		}
+	}
+	return parsed
+}
+
+// v3Filters returns the filters in the parameters of a V3 list request, such
+// as "names=some-app,other-app". The names of the parameters are used as is.
+func v3Filters(request *http.Request) filters {
+	parsed := filters{}
+	for name, values := range request.URL.Query() {
+		for _, value := range values {
+			parsed[name] = append(parsed[name], strings.Split(value, ",")...)
+		}
+	}
+	return parsed
+}
+
+// match returns whether the value of the field matches the filter, which it
+// does when the field is not filtered.
+func (f filters) match(field string, value string) bool {
+	values, ok := f[field]
+	if !ok {
+		return true
+	}
+	for _, filterValue := range values {
+		if filterValue == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+	"strings"
+)
+
+type versionMeta struct {
+	Version string `json:"version"`
+}
+
+type versionedLink struct {
+	HREF string      `json:"href"`
+	Meta versionMeta `json:"meta"`
+}
+
+type appSSHMeta struct {
+	HostKeyFingerprint string `json:"host_key_fingerprint"`
+	OAuthClient        string `json:"oauth_client"`
+}
+
+type appSSHLink struct {
+	HREF string     `json:"href"`
+	Meta appSSHMeta `json:"meta"`
+}
+
+type rootLinks struct {
+	Self              link          `json:"self"`
+	CloudControllerV2 versionedLink `json:"cloud_controller_v2"`
+	CloudControllerV3 versionedLink `json:"cloud_controller_v3"`
+	NetworkPolicyV0   link          `json:"network_policy_v0"`
+	NetworkPolicyV1   link          `json:"network_policy_v1"`
+	UAA               link          `json:"uaa"`
+	Logging           link          `json:"logging"`
+	AppSSH            appSSHLink    `json:"app_ssh"`
+}
+
+func (s *state) loggingURL() string {
+	return "ws" + strings.TrimPrefix(s.url, "http")
+}
+
+func (s *state) root(_ *http.Request, _ params) response {
+	return respond(http.StatusOK, map[string]rootLinks{
+		"links": {
+			Self:              link{HREF: s.url},
+			CloudControllerV2: versionedLink{HREF: s.url + "/v2", Meta: versionMeta{Version: APIVersionV2}},
+			CloudControllerV3: versionedLink{HREF: s.url + "/v3", Meta: versionMeta{Version: APIVersionV3}},
+			NetworkPolicyV0:   link{HREF: s.url + "/networking/v0/external"},
+			NetworkPolicyV1:   link{HREF: s.url + "/networking/v1/external"},
+			UAA:               link{HREF: s.url},
+			Logging:           link{HREF: s.loggingURL()},
+			AppSSH: appSSHLink{
+				HREF: "127.0.0.1:2222",
+				Meta: appSSHMeta{HostKeyFingerprint: "fake-fingerprint", OAuthClient: "ssh-proxy"},
+			},
+		},
+	})
+}
+
+func (s *state) v2Info(_ *http.Request, _ params) response {
+	return respond(http.StatusOK, map[string]interface{}{
+		"name":                         "fake-cloud-controller",
+		"build":                        "",
+		"support":                      "",
+		"version":                      0,
+		"description":                  "Fake Cloud Controller",
+		"authorization_endpoint":       s.url,
+		"token_endpoint":               s.url,
+		"min_cli_version":              nil,
+		"min_recommended_cli_version":  nil,
+		"api_version":                  APIVersionV2,
+		"app_ssh_endpoint":             "127.0.0.1:2222",
+		"app_ssh_host_key_fingerprint": "fake-fingerprint",
+		"app_ssh_oauth_client":         "ssh-proxy",
+		"doppler_logging_endpoint":     s.loggingURL(),
+		"routing_endpoint":             s.url + "/routing",
+	})
+}
+
+func (s *state) v3Root(_ *http.Request, _ params) response {
+	resources := map[string]link{}
+	for _, resource := range []string{"apps", "builds", "droplets", "isolation_segments", "organizations", "packages", "processes", "service_instances", "spaces", "tasks"} {
+		resources[resource] = link{HREF: s.url + "/v3/" + resource}
+	}
+	return respond(http.StatusOK, map[string]interface{}{"links": resources})
+}
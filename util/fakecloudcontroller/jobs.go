@@ -0,0 +1,59 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+// job is an asynchronous operation, which has finished by the time it is
+// returned.
+type job struct {
+	guid string
+}
+
+func (s *state) createJob() *job {
+	job := &job{guid: s.newGUID()}
+	s.jobs = append(s.jobs, job)
+	return job
+}
+
+func (s *state) findJob(guid string) *job {
+	for _, job := range s.jobs {
+		if job.guid == guid {
+			return job
+		}
+	}
+	return nil
+}
+
+func (s *state) jobV2(job *job) v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("jobs", job.guid),
+		Entity: map[string]interface{}{
+			"guid":   job.guid,
+			"status": "finished",
+		},
+	}
+}
+
+func (s *state) getJobV2(_ *http.Request, params params) response {
+	job := s.findJob(params["guid"])
+	if job == nil {
+		return v2NotFound(10000, "Job", params["guid"])
+	}
+	return respond(http.StatusOK, s.jobV2(job))
+}
+
+func (s *state) getJobV3(_ *http.Request, params params) response {
+	job := s.findJob(params["guid"])
+	if job == nil {
+		return v3NotFound("Job")
+	}
+	return respond(http.StatusOK, map[string]interface{}{
+		"guid":   job.guid,
+		"state":  "COMPLETE",
+		"errors": []interface{}{},
+		"links": map[string]link{
+			"self": {HREF: s.url + "/v3/jobs/" + job.guid},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(_ *http.Request) bool { return true },
+}
+
+// streamLogs accepts log streaming connections. No logs are sent; the
+// connection is kept open until the client closes it.
+func streamLogs(writer http.ResponseWriter, request *http.Request, _ params) {
+	connection, err := upgrader.Upgrade(writer, request, nil)
+	if err != nil {
+		return
+	}
+	defer connection.Close()
+
+	for {
+		if _, _, err := connection.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// recentLogs returns no recent logs.
+func (s *state) recentLogs(_ *http.Request, _ params) response {
+	return response{
+		statusCode: http.StatusOK,
+		header:     http.Header{"Content-Type": {"multipart/x-protobuf; boundary=fake-boundary"}},
+		body:       []byte("--fake-boundary--\r\n"),
+	}
+}
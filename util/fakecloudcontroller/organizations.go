@@ -0,0 +1,90 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type organization struct {
+	guid string
+	name string
+}
+
+// CreateOrganization creates an organization and returns its GUID.
+func (server *Server) CreateOrganization(name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	org := &organization{guid: server.state.newGUID(), name: name}
+	server.state.organizations = append(server.state.organizations, org)
+	return org.guid
+}
+
+func (s *state) findOrganization(guid string) *organization {
+	for _, org := range s.organizations {
+		if org.guid == guid {
+			return org
+		}
+	}
+	return nil
+}
+
+func (org *organization) v2() v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("organizations", org.guid),
+		Entity: map[string]interface{}{
+			"name":                           org.name,
+			"billing_enabled":                false,
+			"quota_definition_guid":          "fake-quota-guid",
+			"status":                         "active",
+			"default_isolation_segment_guid": nil,
+			"spaces_url":                     "/v2/organizations/" + org.guid + "/spaces",
+			"domains_url":                    "/v2/organizations/" + org.guid + "/domains",
+			"private_domains_url":            "/v2/organizations/" + org.guid + "/private_domains",
+			"users_url":                      "/v2/organizations/" + org.guid + "/users",
+			"managers_url":                   "/v2/organizations/" + org.guid + "/managers",
+			"space_quota_definitions_url":    "/v2/organizations/" + org.guid + "/space_quota_definitions",
+		},
+	}
+}
+
+func (org *organization) v3() interface{} {
+	return map[string]interface{}{
+		"guid":       org.guid,
+		"name":       org.name,
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"links":      map[string]link{"self": {HREF: "/v3/organizations/" + org.guid}},
+	}
+}
+
+func (s *state) listOrganizationsV2(request *http.Request, _ params) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, org := range s.organizations {
+		if filters.match("name", org.name) {
+			resources = append(resources, org.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) getOrganizationV2(_ *http.Request, params params) response {
+	org := s.findOrganization(params["guid"])
+	if org == nil {
+		return v2NotFound(30003, "Organization", params["guid"])
+	}
+	return respond(http.StatusOK, org.v2())
+}
+
+func (s *state) listOrganizationsV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, org := range s.organizations {
+		if filters.match("names", org.name) && filters.match("guids", org.guid) {
+			resources = append(resources, org.v3())
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
@@ -0,0 +1,163 @@
+package fakecloudcontroller
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+type appPackage struct {
+	guid        string
+	appGUID     string
+	packageType string
+	state       string
+	dockerImage string
+}
+
+func (s *state) createPackage(appGUID string, packageType string) *appPackage {
+	pkg := &appPackage{
+		guid:        s.newGUID(),
+		appGUID:     appGUID,
+		packageType: packageType,
+		state:       "AWAITING_UPLOAD",
+	}
+	s.packages = append(s.packages, pkg)
+	return pkg
+}
+
+func (s *state) findPackage(guid string) *appPackage {
+	for _, pkg := range s.packages {
+		if pkg.guid == guid {
+			return pkg
+		}
+	}
+	return nil
+}
+
+// latestPackage returns the package of the app that was created last.
+func (s *state) latestPackage(appGUID string) *appPackage {
+	var latest *appPackage
+	for _, pkg := range s.packages {
+		if pkg.appGUID == appGUID {
+			latest = pkg
+		}
+	}
+	return latest
+}
+
+func (s *state) packageV3(pkg *appPackage) interface{} {
+	self := "/v3/packages/" + pkg.guid
+	data := map[string]interface{}{}
+	if pkg.packageType == "docker" {
+		data["image"] = pkg.dockerImage
+	}
+	return map[string]interface{}{
+		"guid":       pkg.guid,
+		"type":       pkg.packageType,
+		"state":      pkg.state,
+		"data":       data,
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"links": map[string]link{
+			"self":     {HREF: s.url + self},
+			"upload":   {HREF: s.url + self + "/upload", Method: http.MethodPost},
+			"download": {HREF: s.url + self + "/download", Method: http.MethodGet},
+			"stage":    {HREF: s.url + self + "/droplets", Method: http.MethodPost},
+			"app":      {HREF: s.url + "/v3/apps/" + pkg.appGUID},
+		},
+	}
+}
+
+// uploadBitsV2 stores the uploaded bits of a V2 app as a new package, which is
+// staged when the app is next started.
+func (s *state) uploadBitsV2(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	io.Copy(ioutil.Discard, request.Body)
+
+	pkg := s.createPackage(app.guid, "bits")
+	pkg.state = "READY"
+	app.packageState = "PENDING"
+	updatedAt := timestamp()
+	app.packageUpdatedAt = &updatedAt
+
+	if request.URL.Query().Get("async") == "true" {
+		return respond(http.StatusCreated, s.jobV2(s.createJob()))
+	}
+	return respond(http.StatusCreated, map[string]interface{}{})
+}
+
+// matchResources returns the resources of the request that the server already
+// has, which are none.
+func (s *state) matchResources(request *http.Request, _ params) response {
+	io.Copy(ioutil.Discard, request.Body)
+	return respond(http.StatusOK, []interface{}{})
+}
+
+func (s *state) listPackagesV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, pkg := range s.packages {
+		if filters.match("app_guids", pkg.appGUID) &&
+			filters.match("guids", pkg.guid) &&
+			filters.match("states", pkg.state) {
+			resources = append(resources, s.packageV3(pkg))
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+func (s *state) getPackageV3(_ *http.Request, params params) response {
+	pkg := s.findPackage(params["guid"])
+	if pkg == nil {
+		return v3NotFound("Package")
+	}
+	return respond(http.StatusOK, s.packageV3(pkg))
+}
+
+// createPackageV3 creates a package, which is ready immediately for a Docker
+// image and once its bits are uploaded otherwise.
+func (s *state) createPackageV3(request *http.Request, _ params) response {
+	var body struct {
+		Type string `json:"type"`
+		Data struct {
+			Image string `json:"image"`
+		} `json:"data"`
+		Relationships struct {
+			App struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"app"`
+		} `json:"relationships"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+
+	app := s.findApp(body.Relationships.App.Data.GUID)
+	if app == nil {
+		return v3UnprocessableEntity("App is invalid. Ensure it exists and you have access to it.")
+	}
+
+	pkg := s.createPackage(app.guid, body.Type)
+	if body.Type == "docker" {
+		pkg.dockerImage = body.Data.Image
+		pkg.state = "READY"
+	}
+	return respond(http.StatusCreated, s.packageV3(pkg))
+}
+
+func (s *state) uploadPackageV3(request *http.Request, params params) response {
+	pkg := s.findPackage(params["guid"])
+	if pkg == nil {
+		return v3NotFound("Package")
+	}
+	io.Copy(ioutil.Discard, request.Body)
+
+	pkg.state = "READY"
+	return respond(http.StatusOK, s.packageV3(pkg))
+}
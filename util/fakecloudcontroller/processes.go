@@ -0,0 +1,210 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type process struct {
+	guid        string
+	appGUID     string
+	processType string
+	command     string
+	instances   int
+	memory      uint64
+	disk        uint64
+
+	healthCheckType     string
+	healthCheckEndpoint string
+	healthCheckTimeout  int
+}
+
+func (s *state) createProcess(appGUID string, processType string) *process {
+	process := &process{
+		guid:            s.newGUID(),
+		appGUID:         appGUID,
+		processType:     processType,
+		instances:       1,
+		memory:          1024,
+		disk:            1024,
+		healthCheckType: "port",
+	}
+	s.processes = append(s.processes, process)
+	return process
+}
+
+func (s *state) findProcess(guid string) *process {
+	for _, process := range s.processes {
+		if process.guid == guid {
+			return process
+		}
+	}
+	return nil
+}
+
+func (s *state) findProcessByType(appGUID string, processType string) *process {
+	for _, process := range s.processes {
+		if process.appGUID == appGUID && process.processType == processType {
+			return process
+		}
+	}
+	return nil
+}
+
+func (s *state) processV3(process *process) interface{} {
+	self := "/v3/processes/" + process.guid
+	return map[string]interface{}{
+		"guid":         process.guid,
+		"type":         process.processType,
+		"command":      nilIfEmpty(process.command),
+		"instances":    process.instances,
+		"memory_in_mb": process.memory,
+		"disk_in_mb":   process.disk,
+		"health_check": map[string]interface{}{
+			"type": process.healthCheckType,
+			"data": map[string]interface{}{
+				"timeout":            nil,
+				"invocation_timeout": nilIfZero(process.healthCheckTimeout),
+				"endpoint":           nilIfEmpty(process.healthCheckEndpoint),
+			},
+		},
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"links": map[string]link{
+			"self":  {HREF: s.url + self},
+			"scale": {HREF: s.url + self + "/actions/scale", Method: http.MethodPost},
+			"app":   {HREF: s.url + "/v3/apps/" + process.appGUID},
+			"stats": {HREF: s.url + self + "/stats"},
+		},
+	}
+}
+
+type v3ProcessRequest struct {
+	Command     *string `json:"command"`
+	Instances   *int    `json:"instances"`
+	MemoryInMB  *uint64 `json:"memory_in_mb"`
+	DiskInMB    *uint64 `json:"disk_in_mb"`
+	HealthCheck *struct {
+		Type string `json:"type"`
+		Data struct {
+			Endpoint          *string `json:"endpoint"`
+			InvocationTimeout int     `json:"invocation_timeout"`
+		} `json:"data"`
+	} `json:"health_check"`
+}
+
+func (process *process) update(body v3ProcessRequest) {
+	if body.Command != nil {
+		process.command = *body.Command
+	}
+	if body.Instances != nil {
+		process.instances = *body.Instances
+	}
+	if body.MemoryInMB != nil {
+		process.memory = *body.MemoryInMB
+	}
+	if body.DiskInMB != nil {
+		process.disk = *body.DiskInMB
+	}
+	if body.HealthCheck != nil {
+		process.healthCheckType = body.HealthCheck.Type
+		process.healthCheckEndpoint = ""
+		if body.HealthCheck.Data.Endpoint != nil {
+			process.healthCheckEndpoint = *body.HealthCheck.Data.Endpoint
+		}
+		process.healthCheckTimeout = body.HealthCheck.Data.InvocationTimeout
+	}
+}
+
+func (s *state) listAppProcessesV3(request *http.Request, params params) response {
+	if s.findApp(params["guid"]) == nil {
+		return v3NotFound("App")
+	}
+
+	var resources []interface{}
+	for _, process := range s.processes {
+		if process.appGUID == params["guid"] {
+			resources = append(resources, s.processV3(process))
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+func (s *state) getAppProcessV3(_ *http.Request, params params) response {
+	process := s.findProcessByType(params["guid"], params["type"])
+	if process == nil {
+		return v3NotFound("Process")
+	}
+	return respond(http.StatusOK, s.processV3(process))
+}
+
+func (s *state) patchProcessV3(request *http.Request, params params) response {
+	process := s.findProcess(params["guid"])
+	if process == nil {
+		return v3NotFound("Process")
+	}
+
+	var body v3ProcessRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	process.update(body)
+	return respond(http.StatusOK, s.processV3(process))
+}
+
+func (s *state) scaleAppProcessV3(request *http.Request, params params) response {
+	process := s.findProcessByType(params["guid"], params["type"])
+	if process == nil {
+		return v3NotFound("Process")
+	}
+
+	var body v3ProcessRequest
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	process.update(v3ProcessRequest{
+		Instances:  body.Instances,
+		MemoryInMB: body.MemoryInMB,
+		DiskInMB:   body.DiskInMB,
+	})
+	return respond(http.StatusAccepted, s.processV3(process))
+}
+
+func (s *state) deleteAppProcessInstanceV3(_ *http.Request, params params) response {
+	if s.findProcessByType(params["guid"], params["type"]) == nil {
+		return v3NotFound("Process")
+	}
+	return respond(http.StatusNoContent, nil)
+}
+
+// getProcessStatsV3 returns the stats of every instance of the process, which
+// are down unless its app is started.
+func (s *state) getProcessStatsV3(request *http.Request, params params) response {
+	process := s.findProcess(params["guid"])
+	if process == nil {
+		return v3NotFound("Process")
+	}
+
+	running := s.runningInstances(process)
+	var resources []interface{}
+	for i := 0; i < process.instances; i++ {
+		stats := map[string]interface{}{
+			"type":  process.processType,
+			"index": i,
+			"state": "DOWN",
+		}
+		if i < running {
+			stats["state"] = "RUNNING"
+			stats["uptime"] = 1
+			stats["mem_quota"] = process.memory * 1024 * 1024
+			stats["disk_quota"] = process.disk * 1024 * 1024
+			stats["usage"] = map[string]interface{}{
+				"time": timestamp(),
+				"cpu":  0,
+				"mem":  0,
+				"disk": 0,
+			}
+		}
+		resources = append(resources, stats)
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
@@ -0,0 +1,150 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+	"strings"
+)
+
+type params map[string]string
+
+// endpoint handles the requests with a method and a path matching its pattern,
+// in which segments starting with ":" match any value and are passed to the
+// handler as params. Endpoints are handled with the server locked, except
+// streaming endpoints, which are handled without it.
+type endpoint struct {
+	method  string
+	pattern string
+	public  bool
+	handle  func(request *http.Request, params params) response
+	stream  func(writer http.ResponseWriter, request *http.Request, params params)
+}
+
+func (server *Server) findEndpoint(request *http.Request) (endpoint, params, bool) {
+	segments := strings.Split(strings.TrimSuffix(request.URL.Path, "/"), "/")
+
+	for _, candidate := range server.endpoints {
+		if candidate.method != request.Method {
+			continue
+		}
+
+		patternSegments := strings.Split(candidate.pattern, "/")
+		if len(patternSegments) != len(segments) {
+			continue
+		}
+
+		matched := params{}
+		for i, patternSegment := range patternSegments {
+			if strings.HasPrefix(patternSegment, ":") {
+				matched[patternSegment[1:]] = segments[i]
+			} else if patternSegment != segments[i] {
+				matched = nil
+				break
+			}
+		}
+		if matched != nil {
+			return candidate, matched, true
+		}
+	}
+
+	return endpoint{}, nil, false
+}
+
+func (server *Server) endpointTable() []endpoint {
+	state := server.state
+
+	return []endpoint{
+		{method: http.MethodGet, pattern: "", public: true, handle: state.root},
+		{method: http.MethodGet, pattern: "/v2/info", public: true, handle: state.v2Info},
+		{method: http.MethodGet, pattern: "/v3", public: true, handle: state.v3Root},
+
+		{method: http.MethodGet, pattern: "/login", public: true, handle: state.uaaLogin},
+		{method: http.MethodPost, pattern: "/oauth/token", public: true, handle: state.uaaToken},
+
+		{method: http.MethodGet, pattern: "/v2/organizations", handle: state.listOrganizationsV2},
+		{method: http.MethodGet, pattern: "/v2/organizations/:guid", handle: state.getOrganizationV2},
+		{method: http.MethodGet, pattern: "/v2/organizations/:organization_guid/spaces", handle: state.listSpacesV2},
+		{method: http.MethodGet, pattern: "/v2/organizations/:organization_guid/private_domains", handle: state.listPrivateDomainsV2},
+		{method: http.MethodGet, pattern: "/v2/spaces", handle: state.listSpacesV2},
+		{method: http.MethodGet, pattern: "/v2/spaces/:guid", handle: state.getSpaceV2},
+		{method: http.MethodGet, pattern: "/v2/shared_domains", handle: state.listSharedDomainsV2},
+		{method: http.MethodGet, pattern: "/v2/shared_domains/:guid", handle: state.getDomainV2},
+		{method: http.MethodGet, pattern: "/v2/private_domains", handle: state.listPrivateDomainsV2},
+		{method: http.MethodGet, pattern: "/v2/private_domains/:guid", handle: state.getDomainV2},
+		{method: http.MethodGet, pattern: "/v2/stacks", handle: state.listStacksV2},
+		{method: http.MethodGet, pattern: "/v2/stacks/:guid", handle: state.getStackV2},
+
+		{method: http.MethodGet, pattern: "/v2/apps", handle: state.listAppsV2},
+		{method: http.MethodPost, pattern: "/v2/apps", handle: state.createAppV2},
+		{method: http.MethodGet, pattern: "/v2/apps/:guid", handle: state.getAppV2},
+		{method: http.MethodPut, pattern: "/v2/apps/:guid", handle: state.putAppV2},
+		{method: http.MethodDelete, pattern: "/v2/apps/:guid", handle: state.deleteAppV2},
+		{method: http.MethodPut, pattern: "/v2/apps/:guid/bits", handle: state.uploadBitsV2},
+		{method: http.MethodGet, pattern: "/v2/apps/:guid/instances", handle: state.getAppInstancesV2},
+		{method: http.MethodGet, pattern: "/v2/apps/:guid/stats", handle: state.getAppStatsV2},
+		{method: http.MethodGet, pattern: "/v2/apps/:guid/routes", handle: state.listAppRoutesV2},
+		{method: http.MethodGet, pattern: "/v2/apps/:app_guid/service_bindings", handle: state.listServiceBindingsV2},
+		{method: http.MethodGet, pattern: "/v2/spaces/:space_guid/apps", handle: state.listAppsV2},
+		{method: http.MethodGet, pattern: "/v2/spaces/:guid/summary", handle: state.getSpaceSummaryV2},
+		{method: http.MethodPut, pattern: "/v2/resource_match", handle: state.matchResources},
+		{method: http.MethodGet, pattern: "/v2/jobs/:guid", handle: state.getJobV2},
+
+		{method: http.MethodGet, pattern: "/v2/routes", handle: state.listRoutesV2},
+		{method: http.MethodPost, pattern: "/v2/routes", handle: state.createRouteV2},
+		{method: http.MethodGet, pattern: "/v2/routes/:guid", handle: state.getRouteV2},
+		{method: http.MethodDelete, pattern: "/v2/routes/:guid", handle: state.deleteRouteV2},
+		{method: http.MethodGet, pattern: "/v2/routes/reserved/domain/:domain_guid", handle: state.checkRouteReservedV2},
+		{method: http.MethodGet, pattern: "/v2/routes/:guid/apps", handle: state.listRouteAppsV2},
+		{method: http.MethodPut, pattern: "/v2/routes/:guid/apps/:app_guid", handle: state.mapRouteV2},
+		{method: http.MethodDelete, pattern: "/v2/routes/:guid/apps/:app_guid", handle: state.unmapRouteV2},
+		{method: http.MethodGet, pattern: "/v2/spaces/:guid/routes", handle: state.listSpaceRoutesV2},
+
+		{method: http.MethodGet, pattern: "/v2/service_instances", handle: state.listServiceInstancesV2},
+		{method: http.MethodGet, pattern: "/v2/service_instances/:guid", handle: state.getServiceInstanceV2},
+		{method: http.MethodGet, pattern: "/v2/user_provided_service_instances", handle: state.listServiceInstancesV2},
+		{method: http.MethodGet, pattern: "/v2/user_provided_service_instances/:guid", handle: state.getServiceInstanceV2},
+		{method: http.MethodGet, pattern: "/v2/spaces/:guid/service_instances", handle: state.listSpaceServiceInstancesV2},
+		{method: http.MethodGet, pattern: "/v2/service_bindings", handle: state.listServiceBindingsV2},
+		{method: http.MethodPost, pattern: "/v2/service_bindings", handle: state.createServiceBindingV2},
+		{method: http.MethodDelete, pattern: "/v2/service_bindings/:guid", handle: state.deleteServiceBindingV2},
+		{method: http.MethodGet, pattern: "/v2/service_instances/:service_instance_guid/service_bindings", handle: state.listServiceBindingsV2},
+		{method: http.MethodGet, pattern: "/v2/user_provided_service_instances/:service_instance_guid/service_bindings", handle: state.listServiceBindingsV2},
+
+		{method: http.MethodGet, pattern: "/v3/organizations", handle: state.listOrganizationsV3},
+		{method: http.MethodGet, pattern: "/v3/spaces", handle: state.listSpacesV3},
+		{method: http.MethodGet, pattern: "/v3/service_instances", handle: state.listServiceInstancesV3},
+
+		{method: http.MethodGet, pattern: "/v3/apps", handle: state.listAppsV3},
+		{method: http.MethodPost, pattern: "/v3/apps", handle: state.createAppV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid", handle: state.getAppV3},
+		{method: http.MethodPatch, pattern: "/v3/apps/:guid", handle: state.patchAppV3},
+		{method: http.MethodDelete, pattern: "/v3/apps/:guid", handle: state.deleteAppV3},
+		{method: http.MethodPost, pattern: "/v3/apps/:guid/actions/start", handle: state.startAppV3},
+		{method: http.MethodPost, pattern: "/v3/apps/:guid/actions/stop", handle: state.stopAppV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid/droplets/current", handle: state.getCurrentDropletV3},
+		{method: http.MethodPatch, pattern: "/v3/apps/:guid/relationships/current_droplet", handle: state.setCurrentDropletV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid/env", handle: state.getAppEnvironmentV3},
+		{method: http.MethodPatch, pattern: "/v3/apps/:guid/environment_variables", handle: state.patchAppEnvironmentVariablesV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid/processes", handle: state.listAppProcessesV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid/processes/:type", handle: state.getAppProcessV3},
+		{method: http.MethodPost, pattern: "/v3/apps/:guid/processes/:type/actions/scale", handle: state.scaleAppProcessV3},
+		{method: http.MethodDelete, pattern: "/v3/apps/:guid/processes/:type/instances/:index", handle: state.deleteAppProcessInstanceV3},
+		{method: http.MethodGet, pattern: "/v3/apps/:guid/tasks", handle: state.listAppTasksV3},
+		{method: http.MethodPost, pattern: "/v3/apps/:guid/tasks", handle: state.createAppTaskV3},
+		{method: http.MethodPatch, pattern: "/v3/processes/:guid", handle: state.patchProcessV3},
+		{method: http.MethodGet, pattern: "/v3/processes/:guid/stats", handle: state.getProcessStatsV3},
+		{method: http.MethodGet, pattern: "/v3/packages", handle: state.listPackagesV3},
+		{method: http.MethodPost, pattern: "/v3/packages", handle: state.createPackageV3},
+		{method: http.MethodGet, pattern: "/v3/packages/:guid", handle: state.getPackageV3},
+		{method: http.MethodPost, pattern: "/v3/packages/:guid/upload", handle: state.uploadPackageV3},
+		{method: http.MethodPost, pattern: "/v3/builds", handle: state.createBuildV3},
+		{method: http.MethodGet, pattern: "/v3/builds/:guid", handle: state.getBuildV3},
+		{method: http.MethodGet, pattern: "/v3/droplets", handle: state.listDropletsV3},
+		{method: http.MethodGet, pattern: "/v3/droplets/:guid", handle: state.getDropletV3},
+		{method: http.MethodGet, pattern: "/v3/tasks/:guid", handle: state.getTaskV3},
+		{method: http.MethodPut, pattern: "/v3/tasks/:guid/cancel", handle: state.cancelTaskV3},
+		{method: http.MethodGet, pattern: "/v3/jobs/:guid", handle: state.getJobV3},
+
+		{method: http.MethodGet, pattern: "/apps/:guid/stream", stream: streamLogs},
+		{method: http.MethodGet, pattern: "/apps/:guid/recentlogs", public: true, handle: state.recentLogs},
+	}
+}
@@ -0,0 +1,259 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// appRoute is a route, which is mapped to the apps with appGUIDs.
+type appRoute struct {
+	guid       string
+	host       string
+	path       string
+	port       int
+	domainGUID string
+	spaceGUID  string
+	appGUIDs   []string
+}
+
+func (s *state) findRoute(guid string) *appRoute {
+	for _, route := range s.routes {
+		if route.guid == guid {
+			return route
+		}
+	}
+	return nil
+}
+
+func (s *state) findRouteByAddress(domainGUID string, host string, path string, port int) *appRoute {
+	for _, route := range s.routes {
+		if route.domainGUID == domainGUID && route.host == host && route.path == path && route.port == port {
+			return route
+		}
+	}
+	return nil
+}
+
+func (route *appRoute) mapped(appGUID string) bool {
+	for _, guid := range route.appGUIDs {
+		if guid == appGUID {
+			return true
+		}
+	}
+	return false
+}
+
+func (route *appRoute) unmap(appGUID string) {
+	var appGUIDs []string
+	for _, guid := range route.appGUIDs {
+		if guid != appGUID {
+			appGUIDs = append(appGUIDs, guid)
+		}
+	}
+	route.appGUIDs = appGUIDs
+}
+
+// appURIs returns the URIs of the routes mapped to the app.
+func (s *state) appURIs(appGUID string) []string {
+	uris := []string{}
+	for _, route := range s.routes {
+		if !route.mapped(appGUID) {
+			continue
+		}
+
+		uri := route.host
+		if domain := s.findDomain(route.domainGUID); domain != nil {
+			if uri != "" {
+				uri += "."
+			}
+			uri += domain.name
+		}
+		if route.port != 0 {
+			uri += ":" + strconv.Itoa(route.port)
+		}
+		uris = append(uris, uri+route.path)
+	}
+	return uris
+}
+
+func (route *appRoute) v2() v2Resource {
+	var port interface{}
+	if route.port != 0 {
+		port = route.port
+	}
+
+	return v2Resource{
+		Metadata: newMetadata("routes", route.guid),
+		Entity: map[string]interface{}{
+			"host":        route.host,
+			"path":        route.path,
+			"port":        port,
+			"domain_guid": route.domainGUID,
+			"space_guid":  route.spaceGUID,
+			"domain_url":  "/v2/shared_domains/" + route.domainGUID,
+			"space_url":   "/v2/spaces/" + route.spaceGUID,
+			"apps_url":    "/v2/routes/" + route.guid + "/apps",
+		},
+	}
+}
+
+func (s *state) listRoutes(request *http.Request, include func(*appRoute) bool) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, route := range s.routes {
+		if include(route) &&
+			filters.match("host", route.host) &&
+			filters.match("domain_guid", route.domainGUID) &&
+			filters.match("path", route.path) &&
+			filters.match("port", strconv.Itoa(route.port)) {
+			resources = append(resources, route.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) listRoutesV2(request *http.Request, _ params) response {
+	return s.listRoutes(request, func(*appRoute) bool { return true })
+}
+
+func (s *state) listSpaceRoutesV2(request *http.Request, params params) response {
+	return s.listRoutes(request, func(route *appRoute) bool {
+		return route.spaceGUID == params["guid"]
+	})
+}
+
+func (s *state) listAppRoutesV2(request *http.Request, params params) response {
+	if s.findApp(params["guid"]) == nil {
+		return v2NotFound(100004, "App", params["guid"])
+	}
+	return s.listRoutes(request, func(route *appRoute) bool {
+		return route.mapped(params["guid"])
+	})
+}
+
+func (s *state) getRouteV2(_ *http.Request, params params) response {
+	route := s.findRoute(params["guid"])
+	if route == nil {
+		return v2NotFound(210002, "Route", params["guid"])
+	}
+	return respond(http.StatusOK, route.v2())
+}
+
+func (s *state) createRouteV2(request *http.Request, _ params) response {
+	var body struct {
+		Host       string `json:"host"`
+		Path       string `json:"path"`
+		Port       int    `json:"port"`
+		DomainGUID string `json:"domain_guid"`
+		SpaceGUID  string `json:"space_guid"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v2InvalidRequest(err.Error())
+	}
+	if s.findDomain(body.DomainGUID) == nil {
+		return v2NotFound(130002, "Domain", body.DomainGUID)
+	}
+	if s.findSpace(body.SpaceGUID) == nil {
+		return v2NotFound(40004, "Space", body.SpaceGUID)
+	}
+	if s.findRouteByAddress(body.DomainGUID, body.Host, body.Path, body.Port) != nil {
+		return v2Error(http.StatusBadRequest, 210003, "CF-RouteHostTaken", "The host is taken: "+body.Host)
+	}
+
+	route := &appRoute{
+		guid:       s.newGUID(),
+		host:       body.Host,
+		path:       body.Path,
+		port:       body.Port,
+		domainGUID: body.DomainGUID,
+		spaceGUID:  body.SpaceGUID,
+	}
+	s.routes = append(s.routes, route)
+	return respond(http.StatusCreated, route.v2())
+}
+
+func (s *state) deleteRouteV2(_ *http.Request, params params) response {
+	for i, route := range s.routes {
+		if route.guid == params["guid"] {
+			s.routes = append(s.routes[:i], s.routes[i+1:]...)
+			return respond(http.StatusNoContent, nil)
+		}
+	}
+	return v2NotFound(210002, "Route", params["guid"])
+}
+
+// checkRouteReservedV2 responds with no content when a route with the host and
+// path in the query exists in the domain, and not found otherwise.
+func (s *state) checkRouteReservedV2(request *http.Request, params params) response {
+	query := request.URL.Query()
+	port, _ := strconv.Atoi(query.Get("port"))
+	if s.findRouteByAddress(params["domain_guid"], query.Get("host"), query.Get("path"), port) == nil {
+		return v2NotFound(210002, "Route", query.Get("host"))
+	}
+	return respond(http.StatusNoContent, nil)
+}
+
+func (s *state) listRouteAppsV2(_ *http.Request, params params) response {
+	route := s.findRoute(params["guid"])
+	if route == nil {
+		return v2NotFound(210002, "Route", params["guid"])
+	}
+
+	var resources []v2Resource
+	for _, appGUID := range route.appGUIDs {
+		if app := s.findApp(appGUID); app != nil {
+			resources = append(resources, s.appV2(app))
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) mapRouteV2(_ *http.Request, params params) response {
+	route := s.findRoute(params["guid"])
+	if route == nil {
+		return v2NotFound(210002, "Route", params["guid"])
+	}
+	if s.findApp(params["app_guid"]) == nil {
+		return v2NotFound(100004, "App", params["app_guid"])
+	}
+
+	if !route.mapped(params["app_guid"]) {
+		route.appGUIDs = append(route.appGUIDs, params["app_guid"])
+	}
+	return respond(http.StatusCreated, route.v2())
+}
+
+func (s *state) unmapRouteV2(_ *http.Request, params params) response {
+	route := s.findRoute(params["guid"])
+	if route == nil {
+		return v2NotFound(210002, "Route", params["guid"])
+	}
+	route.unmap(params["app_guid"])
+	return respond(http.StatusNoContent, nil)
+}
+
+// routeSummaries returns the routes mapped to the app as they appear in a V2
+// summary.
+func (s *state) routeSummaries(appGUID string) []interface{} {
+	summaries := []interface{}{}
+	for _, route := range s.routes {
+		if !route.mapped(appGUID) {
+			continue
+		}
+
+		domainSummary := map[string]interface{}{"guid": route.domainGUID}
+		if domain := s.findDomain(route.domainGUID); domain != nil {
+			domainSummary["name"] = domain.name
+			domainSummary["owning_organization_guid"] = domain.owningOrganizationGUID
+		}
+		summaries = append(summaries, map[string]interface{}{
+			"guid":   route.guid,
+			"host":   route.host,
+			"path":   route.path,
+			"port":   route.port,
+			"domain": domainSummary,
+		})
+	}
+	return summaries
+}
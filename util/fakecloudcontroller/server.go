@@ -0,0 +1,225 @@
+// Package fakecloudcontroller provides an in-process HTTP server emulating the
+// subset of the Cloud Controller V2 and V3 APIs, UAA and the logging endpoint
+// that the CLI uses, backed by in-memory state. It allows commands and plugins
+// to be tested end to end without a Cloud Foundry deployment:
+//
+//	server := fakecloudcontroller.NewServer()
+//	defer server.Close()
+//	orgGUID := server.CreateOrganization("some-org")
+//	server.CreateSpace(orgGUID, "some-space")
+//
+//	cf api <server.URL()>
+//	cf login -u admin -p admin -o some-org -s some-space
+//	cf push some-app
+//
+// Organizations, spaces, apps, processes, packages, droplets, builds, tasks,
+// routes, domains, stacks and service instances are emulated. Staging and
+// starting complete immediately, and every started instance is running. Tasks
+// run until they are completed with CompleteTask or FailTask, or cancelled.
+// Responses can be replaced with errors with Fail.
+package fakecloudcontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// APIVersionV2 is the Cloud Controller V2 API version the server reports.
+	APIVersionV2 = "2.100.0"
+
+	// APIVersionV3 is the Cloud Controller V3 API version the server reports.
+	APIVersionV3 = "3.33.3"
+
+	// DefaultUsername and DefaultPassword are the credentials of the user the
+	// server starts with.
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+
+	// DefaultDomain is the name of the shared domain the server starts with.
+	DefaultDomain = "fake-domain.com"
+
+	// DefaultStack is the name of the stack the server starts with.
+	DefaultStack = "cflinuxfs2"
+)
+
+// Failure describes requests the server fails instead of handling them.
+type Failure struct {
+	// Method is the HTTP method of the failed requests. Requests with any
+	// method are failed when it is empty.
+	Method string
+
+	// Path is the path of the failed requests, such as "/v2/apps". A path
+	// ending in "*" fails the requests with paths starting with the rest.
+	Path string
+
+	// StatusCode and Body are the response to the failed requests.
+	StatusCode int
+	Body       string
+
+	// Times is the number of requests that are failed. Every matching request
+	// is failed when it is 0.
+	Times int
+}
+
+func (failure Failure) matches(request *http.Request) bool {
+	if failure.Method != "" && failure.Method != request.Method {
+		return false
+	}
+	if strings.HasSuffix(failure.Path, "*") {
+		return strings.HasPrefix(request.URL.Path, strings.TrimSuffix(failure.Path, "*"))
+	}
+	return failure.Path == request.URL.Path
+}
+
+// Server is a fake Cloud Controller, UAA and logging server. It is safe for
+// concurrent use.
+type Server struct {
+	httpServer *httptest.Server
+	endpoints  []endpoint
+
+	mutex    sync.Mutex
+	state    *state
+	failures []*Failure
+	requests []string
+}
+
+// NewServer starts and returns a Server listening on a local HTTP address. It
+// starts with the user DefaultUsername, the shared domain DefaultDomain and
+// the stack DefaultStack.
+func NewServer() *Server {
+	server := &Server{
+		state: newState(),
+	}
+	server.endpoints = server.endpointTable()
+	server.httpServer = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	server.state.url = server.httpServer.URL
+
+	server.AddUser(DefaultUsername, DefaultPassword)
+	server.state.createDomain(DefaultDomain, "")
+	server.state.createStack(DefaultStack)
+
+	return server
+}
+
+// URL returns the URL of the server, which is the API endpoint to target.
+func (server *Server) URL() string {
+	return server.httpServer.URL
+}
+
+// Close shuts down the server.
+func (server *Server) Close() {
+	server.httpServer.CloseClientConnections()
+	server.httpServer.Close()
+}
+
+// Fail fails the requests matching failure with its response.
+func (server *Server) Fail(failure Failure) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.failures = append(server.failures, &failure)
+}
+
+// Requests returns the method and request URI of every request the server has
+// received, in the order they were received.
+func (server *Server) Requests() []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]string(nil), server.requests...)
+}
+
+func (server *Server) serveHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.mutex.Lock()
+	server.requests = append(server.requests, request.Method+" "+request.URL.RequestURI())
+	failure := server.takeFailure(request)
+	server.mutex.Unlock()
+
+	if failure != nil {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(failure.StatusCode)
+		fmt.Fprint(writer, failure.Body)
+		return
+	}
+
+	handler, params, found := server.findEndpoint(request)
+	if !found {
+		writeResponse(writer, notFound(request.URL.Path))
+		return
+	}
+
+	if handler.stream != nil {
+		handler.stream(writer, request, params)
+		return
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if !handler.public && !server.state.authorized(request) {
+		writeResponse(writer, invalidAuthToken(request.URL.Path))
+		return
+	}
+
+	writeResponse(writer, handler.handle(request, params))
+}
+
+// takeFailure returns the failure matching the request, counting it against
+// the failure's Times.
+func (server *Server) takeFailure(request *http.Request) *Failure {
+	for i, failure := range server.failures {
+		if !failure.matches(request) {
+			continue
+		}
+
+		if failure.Times > 0 {
+			failure.Times--
+			if failure.Times == 0 {
+				server.failures = append(server.failures[:i], server.failures[i+1:]...)
+			}
+		}
+		return failure
+	}
+	return nil
+}
+
+// response is the status code, headers and body returned by an endpoint.
+// A body that is not a []byte is encoded as JSON.
+type response struct {
+	statusCode int
+	header     http.Header
+	body       interface{}
+}
+
+func writeResponse(writer http.ResponseWriter, resp response) {
+	for name, values := range resp.header {
+		writer.Header()[name] = values
+	}
+
+	var raw []byte
+	switch body := resp.body.(type) {
+	case nil:
+	case []byte:
+		raw = body
+	default:
+		writer.Header().Set("Content-Type", "application/json")
+		var err error
+		raw, err = json.Marshal(body)
+		if err != nil {
+			resp.statusCode = http.StatusInternalServerError
+			raw = []byte(err.Error())
+		}
+	}
+
+	writer.WriteHeader(resp.statusCode)
+	writer.Write(raw)
+}
+
+func respond(statusCode int, body interface{}) response {
+	return response{statusCode: statusCode, body: body}
+}
@@ -0,0 +1,369 @@
+package fakecloudcontroller_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/fakecloudcontroller"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		server      *Server
+		accessToken string
+		spaceGUID   string
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		orgGUID := server.CreateOrganization("some-org")
+		spaceGUID = server.CreateSpace(orgGUID, "some-space")
+		accessToken = ""
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// do makes a request to the server with the access token, if there is one,
+	// and returns the status code and decoded JSON body of the response.
+	do := func(method string, path string, body interface{}) (int, map[string]interface{}) {
+		var reader *strings.Reader
+		if body == nil {
+			reader = strings.NewReader("")
+		} else {
+			raw, err := json.Marshal(body)
+			Expect(err).ToNot(HaveOccurred())
+			reader = strings.NewReader(string(raw))
+		}
+
+		request, err := http.NewRequest(method, server.URL()+path, reader)
+		Expect(err).ToNot(HaveOccurred())
+		if accessToken != "" {
+			request.Header.Set("Authorization", "bearer "+accessToken)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		raw, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		decoded := map[string]interface{}{}
+		if len(raw) > 0 {
+			Expect(json.Unmarshal(raw, &decoded)).To(Succeed())
+		}
+		return response.StatusCode, decoded
+	}
+
+	logIn := func(username string, password string) (int, map[string]interface{}) {
+		response, err := http.PostForm(server.URL()+"/oauth/token", url.Values{
+			"grant_type": {"password"},
+			"username":   {username},
+			"password":   {password},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+
+		decoded := map[string]interface{}{}
+		Expect(json.NewDecoder(response.Body).Decode(&decoded)).To(Succeed())
+		return response.StatusCode, decoded
+	}
+
+	entity := func(resource map[string]interface{}) map[string]interface{} {
+		return resource["entity"].(map[string]interface{})
+	}
+
+	guid := func(resource map[string]interface{}) string {
+		if metadata, ok := resource["metadata"]; ok {
+			return metadata.(map[string]interface{})["guid"].(string)
+		}
+		return resource["guid"].(string)
+	}
+
+	Describe("info", func() {
+		It("reports the API versions without authentication", func() {
+			status, info := do(http.MethodGet, "/v2/info", nil)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(info["api_version"]).To(Equal(APIVersionV2))
+
+			status, root := do(http.MethodGet, "/", nil)
+			Expect(status).To(Equal(http.StatusOK))
+			links := root["links"].(map[string]interface{})
+			Expect(links["cloud_controller_v3"].(map[string]interface{})["meta"]).To(HaveKeyWithValue("version", APIVersionV3))
+		})
+	})
+
+	Describe("authentication", func() {
+		Context("when the request has no access token", func() {
+			It("returns an invalid auth token error", func() {
+				status, body := do(http.MethodGet, "/v2/apps", nil)
+				Expect(status).To(Equal(http.StatusUnauthorized))
+				Expect(body["error_code"]).To(Equal("CF-InvalidAuthToken"))
+			})
+		})
+
+		Context("when the credentials are wrong", func() {
+			It("does not issue a token", func() {
+				status, body := logIn(DefaultUsername, "wrong-password")
+				Expect(status).To(Equal(http.StatusUnauthorized))
+				Expect(body["error"]).To(Equal("unauthorized"))
+			})
+		})
+
+		Context("when the user was added", func() {
+			It("issues tokens that authorize requests", func() {
+				server.AddUser("some-user", "some-password")
+
+				status, body := logIn("some-user", "some-password")
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(body["refresh_token"]).ToNot(BeEmpty())
+
+				accessToken = body["access_token"].(string)
+				status, _ = do(http.MethodGet, "/v2/apps", nil)
+				Expect(status).To(Equal(http.StatusOK))
+			})
+		})
+	})
+
+	Context("when logged in", func() {
+		BeforeEach(func() {
+			_, body := logIn(DefaultUsername, DefaultPassword)
+			accessToken = body["access_token"].(string)
+		})
+
+		Describe("V2 apps", func() {
+			var appGUID string
+
+			BeforeEach(func() {
+				status, app := do(http.MethodPost, "/v2/apps", map[string]interface{}{
+					"name":       "some-app",
+					"space_guid": spaceGUID,
+				})
+				Expect(status).To(Equal(http.StatusCreated))
+				appGUID = guid(app)
+			})
+
+			It("filters apps by name and space", func() {
+				_, list := do(http.MethodGet, "/v2/apps?q=name:some-app&q=space_guid:"+spaceGUID, nil)
+				Expect(list["total_results"]).To(BeNumerically("==", 1))
+
+				_, list = do(http.MethodGet, "/v2/apps?q=name:other-app", nil)
+				Expect(list["total_results"]).To(BeNumerically("==", 0))
+			})
+
+			Context("when an app with the same name exists in the space", func() {
+				It("returns an app name taken error", func() {
+					status, body := do(http.MethodPost, "/v2/apps", map[string]interface{}{
+						"name":       "some-app",
+						"space_guid": spaceGUID,
+					})
+					Expect(status).To(Equal(http.StatusBadRequest))
+					Expect(body["error_code"]).To(Equal("CF-AppNameTaken"))
+				})
+			})
+
+			Context("when the app is started after its bits are uploaded", func() {
+				BeforeEach(func() {
+					status, job := do(http.MethodPut, "/v2/apps/"+appGUID+"/bits?async=true", nil)
+					Expect(status).To(Equal(http.StatusCreated))
+
+					_, job = do(http.MethodGet, "/v2/jobs/"+guid(job), nil)
+					Expect(entity(job)["status"]).To(Equal("finished"))
+
+					_, app := do(http.MethodPut, "/v2/apps/"+appGUID, map[string]interface{}{
+						"state":     "STARTED",
+						"instances": 2,
+					})
+					Expect(entity(app)["package_state"]).To(Equal("STAGED"))
+				})
+
+				It("stages the app and runs every instance", func() {
+					status, instances := do(http.MethodGet, "/v2/apps/"+appGUID+"/instances", nil)
+					Expect(status).To(Equal(http.StatusOK))
+					Expect(instances).To(HaveLen(2))
+					Expect(instances["0"]).To(HaveKeyWithValue("state", "RUNNING"))
+
+					applications := server.Applications()
+					Expect(applications).To(HaveLen(1))
+					Expect(applications[0].State).To(Equal("STARTED"))
+					Expect(applications[0].DropletGUID).ToNot(BeEmpty())
+				})
+			})
+
+			Context("when the app is started without any bits", func() {
+				It("fails staging", func() {
+					_, app := do(http.MethodPut, "/v2/apps/"+appGUID, map[string]interface{}{"state": "STARTED"})
+					Expect(entity(app)["package_state"]).To(Equal("FAILED"))
+					Expect(entity(app)["staging_failed_reason"]).To(Equal("NoAppDetectedError"))
+				})
+			})
+
+			It("maps routes to the app", func() {
+				_, domains := do(http.MethodGet, "/v2/shared_domains?q=name:"+DefaultDomain, nil)
+				domainGUID := guid(domains["resources"].([]interface{})[0].(map[string]interface{}))
+
+				status, route := do(http.MethodPost, "/v2/routes", map[string]interface{}{
+					"host":        "some-host",
+					"domain_guid": domainGUID,
+					"space_guid":  spaceGUID,
+				})
+				Expect(status).To(Equal(http.StatusCreated))
+
+				status, _ = do(http.MethodPut, "/v2/routes/"+guid(route)+"/apps/"+appGUID, nil)
+				Expect(status).To(Equal(http.StatusCreated))
+
+				_, routes := do(http.MethodGet, "/v2/apps/"+appGUID+"/routes", nil)
+				Expect(routes["total_results"]).To(BeNumerically("==", 1))
+
+				status, body := do(http.MethodPost, "/v2/routes", map[string]interface{}{
+					"host":        "some-host",
+					"domain_guid": domainGUID,
+					"space_guid":  spaceGUID,
+				})
+				Expect(status).To(Equal(http.StatusBadRequest))
+				Expect(body["error_code"]).To(Equal("CF-RouteHostTaken"))
+			})
+		})
+
+		Describe("V3 apps", func() {
+			var appGUID string
+
+			BeforeEach(func() {
+				status, app := do(http.MethodPost, "/v3/apps", map[string]interface{}{
+					"name": "some-app",
+					"relationships": map[string]interface{}{
+						"space": map[string]interface{}{"data": map[string]string{"guid": spaceGUID}},
+					},
+				})
+				Expect(status).To(Equal(http.StatusCreated))
+				appGUID = guid(app)
+			})
+
+			Context("when the app has no droplet", func() {
+				It("cannot be started", func() {
+					status, body := do(http.MethodPost, "/v3/apps/"+appGUID+"/actions/start", nil)
+					Expect(status).To(Equal(http.StatusUnprocessableEntity))
+					Expect(body["errors"].([]interface{})[0]).To(HaveKeyWithValue("detail", "Assign a droplet before starting this app."))
+				})
+			})
+
+			Context("when the app is staged and started", func() {
+				BeforeEach(func() {
+					_, pkg := do(http.MethodPost, "/v3/packages", map[string]interface{}{
+						"type": "bits",
+						"relationships": map[string]interface{}{
+							"app": map[string]interface{}{"data": map[string]string{"guid": appGUID}},
+						},
+					})
+					Expect(pkg["state"]).To(Equal("AWAITING_UPLOAD"))
+
+					_, pkg = do(http.MethodPost, "/v3/packages/"+guid(pkg)+"/upload", nil)
+					Expect(pkg["state"]).To(Equal("READY"))
+
+					status, build := do(http.MethodPost, "/v3/builds", map[string]interface{}{
+						"package": map[string]string{"guid": guid(pkg)},
+					})
+					Expect(status).To(Equal(http.StatusCreated))
+					Expect(build["state"]).To(Equal("STAGED"))
+					dropletGUID := build["droplet"].(map[string]interface{})["guid"].(string)
+
+					status, _ = do(http.MethodPatch, "/v3/apps/"+appGUID+"/relationships/current_droplet", map[string]interface{}{
+						"data": map[string]string{"guid": dropletGUID},
+					})
+					Expect(status).To(Equal(http.StatusOK))
+
+					_, app := do(http.MethodPost, "/v3/apps/"+appGUID+"/actions/start", nil)
+					Expect(app["state"]).To(Equal("STARTED"))
+				})
+
+				It("runs the web process", func() {
+					_, process := do(http.MethodGet, "/v3/apps/"+appGUID+"/processes/web", nil)
+					_, stats := do(http.MethodGet, "/v3/processes/"+guid(process)+"/stats", nil)
+					Expect(stats["resources"]).To(ConsistOf(HaveKeyWithValue("state", "RUNNING")))
+				})
+
+				It("runs and cancels tasks", func() {
+					status, task := do(http.MethodPost, "/v3/apps/"+appGUID+"/tasks", map[string]interface{}{
+						"command": "some-command",
+					})
+					Expect(status).To(Equal(http.StatusAccepted))
+					Expect(task["sequence_id"]).To(BeNumerically("==", 1))
+					Expect(task["state"]).To(Equal("RUNNING"))
+
+					status, task = do(http.MethodPut, "/v3/tasks/"+guid(task)+"/cancel", nil)
+					Expect(status).To(Equal(http.StatusAccepted))
+
+					tasks := server.Tasks()
+					Expect(tasks).To(HaveLen(1))
+					Expect(tasks[0].Command).To(Equal("some-command"))
+					Expect(tasks[0].State).To(Equal("FAILED"))
+					Expect(tasks[0].FailureReason).To(Equal("task was cancelled"))
+				})
+
+				It("completes tasks", func() {
+					_, task := do(http.MethodPost, "/v3/apps/"+appGUID+"/tasks", map[string]interface{}{
+						"command": "some-command",
+					})
+
+					Expect(server.CompleteTask(guid(task))).To(Succeed())
+
+					_, task = do(http.MethodGet, "/v3/tasks/"+guid(task), nil)
+					Expect(task["state"]).To(Equal("SUCCEEDED"))
+
+					Expect(server.CompleteTask(guid(task))).To(MatchError("task " + guid(task) + " is SUCCEEDED, not RUNNING"))
+				})
+
+				It("fails tasks", func() {
+					_, task := do(http.MethodPost, "/v3/apps/"+appGUID+"/tasks", map[string]interface{}{
+						"command": "some-command",
+					})
+
+					Expect(server.FailTask(guid(task), "some-reason")).To(Succeed())
+
+					_, task = do(http.MethodGet, "/v3/tasks/"+guid(task), nil)
+					Expect(task["state"]).To(Equal("FAILED"))
+					Expect(task["result"]).To(HaveKeyWithValue("failure_reason", "some-reason"))
+
+					Expect(server.FailTask("unknown-guid", "some-reason")).To(MatchError("task unknown-guid not found"))
+				})
+			})
+		})
+
+		Describe("Fail", func() {
+			It("fails the matching requests the given number of times", func() {
+				server.Fail(Failure{
+					Method:     http.MethodGet,
+					Path:       "/v2/apps*",
+					StatusCode: http.StatusInternalServerError,
+					Body:       `{"code": 10001, "error_code": "CF-Boom"}`,
+					Times:      1,
+				})
+
+				status, body := do(http.MethodGet, "/v2/apps", nil)
+				Expect(status).To(Equal(http.StatusInternalServerError))
+				Expect(body["error_code"]).To(Equal("CF-Boom"))
+
+				status, _ = do(http.MethodGet, "/v2/apps", nil)
+				Expect(status).To(Equal(http.StatusOK))
+			})
+		})
+
+		It("records the requests", func() {
+			do(http.MethodGet, "/v2/apps?q=name:some-app", nil)
+			Expect(server.Requests()).To(ContainElement("GET /v2/apps?q=name:some-app"))
+		})
+
+		It("returns not found for unknown requests", func() {
+			status, body := do(http.MethodGet, "/v2/unknown", nil)
+			Expect(status).To(Equal(http.StatusNotFound))
+			Expect(body["error_code"]).To(Equal("CF-NotFound"))
+		})
+	})
+})
@@ -0,0 +1,187 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type serviceInstance struct {
+	guid      string
+	name      string
+	spaceGUID string
+}
+
+type serviceBinding struct {
+	guid                string
+	appGUID             string
+	serviceInstanceGUID string
+	name                string
+}
+
+// CreateServiceInstance creates a user-provided service instance in the space
+// and returns its GUID.
+func (server *Server) CreateServiceInstance(spaceGUID string, name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	instance := &serviceInstance{guid: server.state.newGUID(), name: name, spaceGUID: spaceGUID}
+	server.state.serviceInstances = append(server.state.serviceInstances, instance)
+	return instance.guid
+}
+
+func (s *state) findServiceInstance(guid string) *serviceInstance {
+	for _, instance := range s.serviceInstances {
+		if instance.guid == guid {
+			return instance
+		}
+	}
+	return nil
+}
+
+func (instance *serviceInstance) v2() v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("user_provided_service_instances", instance.guid),
+		Entity: map[string]interface{}{
+			"name":                 instance.name,
+			"space_guid":           instance.spaceGUID,
+			"type":                 "user_provided_service_instance",
+			"tags":                 []string{},
+			"credentials":          map[string]interface{}{},
+			"route_service_url":    "",
+			"syslog_drain_url":     "",
+			"space_url":            "/v2/spaces/" + instance.spaceGUID,
+			"service_bindings_url": "/v2/user_provided_service_instances/" + instance.guid + "/service_bindings",
+		},
+	}
+}
+
+func (instance *serviceInstance) v3() interface{} {
+	return map[string]interface{}{
+		"guid":       instance.guid,
+		"name":       instance.name,
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"relationships": map[string]interface{}{
+			"space": relationship(instance.spaceGUID),
+		},
+		"links": map[string]link{"self": {HREF: "/v3/service_instances/" + instance.guid}},
+	}
+}
+
+func (binding *serviceBinding) v2() v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("service_bindings", binding.guid),
+		Entity: map[string]interface{}{
+			"app_guid":              binding.appGUID,
+			"service_instance_guid": binding.serviceInstanceGUID,
+			"name":                  nilIfEmpty(binding.name),
+			"credentials":           map[string]interface{}{},
+			"app_url":               "/v2/apps/" + binding.appGUID,
+			"service_instance_url":  "/v2/user_provided_service_instances/" + binding.serviceInstanceGUID,
+		},
+	}
+}
+
+func (s *state) listSpaceServiceInstancesV2(request *http.Request, params params) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, instance := range s.serviceInstances {
+		if instance.spaceGUID == params["guid"] && filters.match("name", instance.name) {
+			resources = append(resources, instance.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) listServiceInstancesV2(request *http.Request, _ params) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, instance := range s.serviceInstances {
+		if filters.match("name", instance.name) && filters.match("space_guid", instance.spaceGUID) {
+			resources = append(resources, instance.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) getServiceInstanceV2(_ *http.Request, params params) response {
+	instance := s.findServiceInstance(params["guid"])
+	if instance == nil {
+		return v2NotFound(60004, "ServiceInstance", params["guid"])
+	}
+	return respond(http.StatusOK, instance.v2())
+}
+
+func (s *state) listServiceInstancesV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, instance := range s.serviceInstances {
+		if filters.match("names", instance.name) && filters.match("space_guids", instance.spaceGUID) {
+			resources = append(resources, instance.v3())
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+func (s *state) listServiceBindingsV2(request *http.Request, params params) response {
+	filters := v2Filters(request)
+	if appGUID, ok := params["app_guid"]; ok {
+		filters["app_guid"] = []string{appGUID}
+	}
+	if instanceGUID, ok := params["service_instance_guid"]; ok {
+		filters["service_instance_guid"] = []string{instanceGUID}
+	}
+
+	var resources []v2Resource
+	for _, binding := range s.serviceBindings {
+		if filters.match("app_guid", binding.appGUID) &&
+			filters.match("service_instance_guid", binding.serviceInstanceGUID) {
+			resources = append(resources, binding.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) createServiceBindingV2(request *http.Request, _ params) response {
+	var body struct {
+		AppGUID             string `json:"app_guid"`
+		ServiceInstanceGUID string `json:"service_instance_guid"`
+		Name                string `json:"name"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v2InvalidRequest(err.Error())
+	}
+	if s.findApp(body.AppGUID) == nil {
+		return v2NotFound(100004, "App", body.AppGUID)
+	}
+	if s.findServiceInstance(body.ServiceInstanceGUID) == nil {
+		return v2NotFound(60004, "ServiceInstance", body.ServiceInstanceGUID)
+	}
+	for _, binding := range s.serviceBindings {
+		if binding.appGUID == body.AppGUID && binding.serviceInstanceGUID == body.ServiceInstanceGUID {
+			return v2Error(http.StatusBadRequest, 90003, "CF-ServiceBindingAppServiceTaken",
+				"The app is already bound to the service.")
+		}
+	}
+
+	binding := &serviceBinding{
+		guid:                s.newGUID(),
+		appGUID:             body.AppGUID,
+		serviceInstanceGUID: body.ServiceInstanceGUID,
+		name:                body.Name,
+	}
+	s.serviceBindings = append(s.serviceBindings, binding)
+	return respond(http.StatusCreated, binding.v2())
+}
+
+func (s *state) deleteServiceBindingV2(_ *http.Request, params params) response {
+	for i, binding := range s.serviceBindings {
+		if binding.guid == params["guid"] {
+			s.serviceBindings = append(s.serviceBindings[:i], s.serviceBindings[i+1:]...)
+			return respond(http.StatusNoContent, nil)
+		}
+	}
+	return v2NotFound(90004, "ServiceBinding", params["guid"])
+}
@@ -0,0 +1,104 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type space struct {
+	guid             string
+	name             string
+	organizationGUID string
+}
+
+// CreateSpace creates a space in the organization and returns its GUID.
+func (server *Server) CreateSpace(organizationGUID string, name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	space := &space{guid: server.state.newGUID(), name: name, organizationGUID: organizationGUID}
+	server.state.spaces = append(server.state.spaces, space)
+	return space.guid
+}
+
+func (s *state) findSpace(guid string) *space {
+	for _, space := range s.spaces {
+		if space.guid == guid {
+			return space
+		}
+	}
+	return nil
+}
+
+func (space *space) v2() v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("spaces", space.guid),
+		Entity: map[string]interface{}{
+			"name":                        space.name,
+			"organization_guid":           space.organizationGUID,
+			"space_quota_definition_guid": nil,
+			"isolation_segment_guid":      nil,
+			"allow_ssh":                   true,
+			"organization_url":            "/v2/organizations/" + space.organizationGUID,
+			"apps_url":                    "/v2/spaces/" + space.guid + "/apps",
+			"routes_url":                  "/v2/spaces/" + space.guid + "/routes",
+			"domains_url":                 "/v2/spaces/" + space.guid + "/domains",
+			"service_instances_url":       "/v2/spaces/" + space.guid + "/service_instances",
+			"security_groups_url":         "/v2/spaces/" + space.guid + "/security_groups",
+		},
+	}
+}
+
+func (space *space) v3() interface{} {
+	return map[string]interface{}{
+		"guid":       space.guid,
+		"name":       space.name,
+		"created_at": timestamp(),
+		"updated_at": timestamp(),
+		"relationships": map[string]interface{}{
+			"organization": relationship(space.organizationGUID),
+		},
+		"links": map[string]link{"self": {HREF: "/v3/spaces/" + space.guid}},
+	}
+}
+
+// relationship is a V3 to-one relationship to the resource with the GUID.
+func relationship(guid string) map[string]interface{} {
+	return map[string]interface{}{"data": map[string]string{"guid": guid}}
+}
+
+func (s *state) listSpacesV2(request *http.Request, params params) response {
+	filters := v2Filters(request)
+	if orgGUID, ok := params["organization_guid"]; ok {
+		filters["organization_guid"] = []string{orgGUID}
+	}
+
+	var resources []v2Resource
+	for _, space := range s.spaces {
+		if filters.match("name", space.name) && filters.match("organization_guid", space.organizationGUID) {
+			resources = append(resources, space.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) getSpaceV2(_ *http.Request, params params) response {
+	space := s.findSpace(params["guid"])
+	if space == nil {
+		return v2NotFound(40004, "Space", params["guid"])
+	}
+	return respond(http.StatusOK, space.v2())
+}
+
+func (s *state) listSpacesV3(request *http.Request, _ params) response {
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, space := range s.spaces {
+		if filters.match("names", space.name) &&
+			filters.match("guids", space.guid) &&
+			filters.match("organization_guids", space.organizationGUID) {
+			resources = append(resources, space.v3())
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
@@ -0,0 +1,56 @@
+package fakecloudcontroller
+
+import (
+	"net/http"
+)
+
+type stack struct {
+	guid string
+	name string
+}
+
+func (s *state) createStack(name string) *stack {
+	stack := &stack{guid: s.newGUID(), name: name}
+	s.stacks = append(s.stacks, stack)
+	return stack
+}
+
+func (s *state) findStackByName(name string) *stack {
+	for _, stack := range s.stacks {
+		if stack.name == name {
+			return stack
+		}
+	}
+	return nil
+}
+
+func (stack *stack) v2() v2Resource {
+	return v2Resource{
+		Metadata: newMetadata("stacks", stack.guid),
+		Entity: map[string]interface{}{
+			"name":        stack.name,
+			"description": "Fake stack",
+		},
+	}
+}
+
+func (s *state) listStacksV2(request *http.Request, _ params) response {
+	filters := v2Filters(request)
+
+	var resources []v2Resource
+	for _, stack := range s.stacks {
+		if filters.match("name", stack.name) {
+			resources = append(resources, stack.v2())
+		}
+	}
+	return respond(http.StatusOK, newV2List(resources))
+}
+
+func (s *state) getStackV2(_ *http.Request, params params) response {
+	for _, stack := range s.stacks {
+		if stack.guid == params["guid"] {
+			return respond(http.StatusOK, stack.v2())
+		}
+	}
+	return v2NotFound(250003, "Stack", params["guid"])
+}
@@ -0,0 +1,155 @@
+package fakecloudcontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// state is the in-memory state of the server. It is only accessed with the
+// server locked.
+type state struct {
+	url      string
+	lastGUID int
+
+	users         map[string]string
+	accessTokens  map[string]string
+	refreshTokens map[string]string
+
+	organizations    []*organization
+	spaces           []*space
+	domains          []*domain
+	stacks           []*stack
+	routes           []*appRoute
+	serviceInstances []*serviceInstance
+	serviceBindings  []*serviceBinding
+
+	apps      []*app
+	processes []*process
+	packages  []*appPackage
+	droplets  []*droplet
+	builds    []*build
+	tasks     []*task
+	jobs      []*job
+}
+
+func newState() *state {
+	return &state{
+		users:         map[string]string{},
+		accessTokens:  map[string]string{},
+		refreshTokens: map[string]string{},
+	}
+}
+
+// newGUID returns a GUID that is unique within the server.
+func (s *state) newGUID() string {
+	s.lastGUID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.lastGUID)
+}
+
+// authorized returns whether the request has an access token issued by the
+// server.
+func (s *state) authorized(request *http.Request) bool {
+	fields := strings.Fields(request.Header.Get("Authorization"))
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "bearer") {
+		return false
+	}
+	_, ok := s.accessTokens[fields[1]]
+	return ok
+}
+
+// metadata is the V2 metadata of a resource.
+type metadata struct {
+	GUID      string `json:"guid"`
+	URL       string `json:"url"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func newMetadata(collection string, guid string) metadata {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return metadata{
+		GUID:      guid,
+		URL:       fmt.Sprintf("/v2/%s/%s", collection, guid),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+type v2Resource struct {
+	Metadata metadata    `json:"metadata"`
+	Entity   interface{} `json:"entity"`
+}
+
+type v2List struct {
+	TotalResults int          `json:"total_results"`
+	TotalPages   int          `json:"total_pages"`
+	PrevURL      *string      `json:"prev_url"`
+	NextURL      *string      `json:"next_url"`
+	Resources    []v2Resource `json:"resources"`
+}
+
+func newV2List(resources []v2Resource) v2List {
+	if resources == nil {
+		resources = []v2Resource{}
+	}
+	return v2List{
+		TotalResults: len(resources),
+		TotalPages:   1,
+		Resources:    resources,
+	}
+}
+
+type link struct {
+	HREF   string `json:"href"`
+	Method string `json:"method,omitempty"`
+}
+
+type v3Pagination struct {
+	TotalResults int   `json:"total_results"`
+	TotalPages   int   `json:"total_pages"`
+	First        link  `json:"first"`
+	Last         link  `json:"last"`
+	Next         *link `json:"next"`
+	Previous     *link `json:"previous"`
+}
+
+type v3List struct {
+	Pagination v3Pagination  `json:"pagination"`
+	Resources  []interface{} `json:"resources"`
+}
+
+func newV3List(request *http.Request, resources []interface{}) v3List {
+	if resources == nil {
+		resources = []interface{}{}
+	}
+	self := link{HREF: request.URL.String()}
+	return v3List{
+		Pagination: v3Pagination{
+			TotalResults: len(resources),
+			TotalPages:   1,
+			First:        self,
+			Last:         self,
+		},
+		Resources: resources,
+	}
+}
+
+// decodeBody decodes the JSON body of the request into v.
+func decodeBody(request *http.Request, v interface{}) error {
+	raw, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,215 @@
+package fakecloudcontroller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type task struct {
+	guid       string
+	appGUID    string
+	name       string
+	command    string
+	sequenceID int
+	state      string
+	memory     uint64
+	disk       uint64
+
+	failureReason string
+}
+
+// Task is a task on the server. Tasks run until they are completed with
+// CompleteTask, failed with FailTask or cancelled.
+type Task struct {
+	GUID       string
+	AppGUID    string
+	Name       string
+	Command    string
+	SequenceID int
+	State      string
+
+	// FailureReason is the reason the task failed, if it did.
+	FailureReason string
+}
+
+// Tasks returns the tasks on the server.
+func (server *Server) Tasks() []Task {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var tasks []Task
+	for _, task := range server.state.tasks {
+		tasks = append(tasks, Task{
+			GUID:       task.guid,
+			AppGUID:    task.appGUID,
+			Name:       task.name,
+			Command:    task.command,
+			SequenceID: task.sequenceID,
+			State:      task.state,
+
+			FailureReason: task.failureReason,
+		})
+	}
+	return tasks
+}
+
+// CompleteTask makes the running task with the given GUID succeed.
+func (server *Server) CompleteTask(guid string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	task, err := server.state.findRunningTask(guid)
+	if err != nil {
+		return err
+	}
+
+	task.state = "SUCCEEDED"
+	return nil
+}
+
+// FailTask makes the running task with the given GUID fail for the given
+// reason.
+func (server *Server) FailTask(guid string, reason string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	task, err := server.state.findRunningTask(guid)
+	if err != nil {
+		return err
+	}
+
+	task.state = "FAILED"
+	task.failureReason = reason
+	return nil
+}
+
+func (s *state) findTask(guid string) *task {
+	for _, task := range s.tasks {
+		if task.guid == guid {
+			return task
+		}
+	}
+	return nil
+}
+
+func (s *state) findRunningTask(guid string) (*task, error) {
+	task := s.findTask(guid)
+	if task == nil {
+		return nil, fmt.Errorf("task %s not found", guid)
+	}
+	if task.state != "RUNNING" {
+		return nil, fmt.Errorf("task %s is %s, not RUNNING", guid, task.state)
+	}
+	return task, nil
+}
+
+func (s *state) taskV3(task *task) interface{} {
+	return map[string]interface{}{
+		"guid":         task.guid,
+		"name":         task.name,
+		"command":      task.command,
+		"sequence_id":  task.sequenceID,
+		"state":        task.state,
+		"memory_in_mb": task.memory,
+		"disk_in_mb":   task.disk,
+		"result":       map[string]interface{}{"failure_reason": nilIfEmpty(task.failureReason)},
+		"created_at":   timestamp(),
+		"updated_at":   timestamp(),
+		"links": map[string]link{
+			"self":    {HREF: s.url + "/v3/tasks/" + task.guid},
+			"app":     {HREF: s.url + "/v3/apps/" + task.appGUID},
+			"droplet": {HREF: s.url + "/v3/apps/" + task.appGUID + "/droplets/current"},
+		},
+	}
+}
+
+func (s *state) listAppTasksV3(request *http.Request, params params) response {
+	if s.findApp(params["guid"]) == nil {
+		return v3NotFound("App")
+	}
+	filters := v3Filters(request)
+
+	var resources []interface{}
+	for _, task := range s.tasks {
+		if task.appGUID == params["guid"] &&
+			filters.match("names", task.name) &&
+			filters.match("states", task.state) &&
+			filters.match("sequence_ids", strconv.Itoa(task.sequenceID)) {
+			resources = append(resources, s.taskV3(task))
+		}
+	}
+	return respond(http.StatusOK, newV3List(request, resources))
+}
+
+// createAppTaskV3 runs a task with the app's current droplet. The task is
+// running once it has been created.
+func (s *state) createAppTaskV3(request *http.Request, params params) response {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return v3NotFound("App")
+	}
+
+	var body struct {
+		Name       string `json:"name"`
+		Command    string `json:"command"`
+		MemoryInMB uint64 `json:"memory_in_mb"`
+		DiskInMB   uint64 `json:"disk_in_mb"`
+	}
+	if err := decodeBody(request, &body); err != nil {
+		return v3UnprocessableEntity(err.Error())
+	}
+	if app.dropletGUID == "" {
+		return v3UnprocessableEntity("Task must have a droplet. Assign current droplet to app.")
+	}
+
+	task := &task{
+		guid:    s.newGUID(),
+		appGUID: app.guid,
+		name:    body.Name,
+		command: body.Command,
+		state:   "RUNNING",
+		memory:  body.MemoryInMB,
+		disk:    body.DiskInMB,
+	}
+	for _, existing := range s.tasks {
+		if existing.appGUID == app.guid && existing.sequenceID > task.sequenceID {
+			task.sequenceID = existing.sequenceID
+		}
+	}
+	task.sequenceID++
+	if task.name == "" {
+		task.name = task.guid[len(task.guid)-8:]
+	}
+	if task.memory == 0 {
+		task.memory = 1024
+	}
+	if task.disk == 0 {
+		task.disk = 1024
+	}
+
+	s.tasks = append(s.tasks, task)
+	return respond(http.StatusAccepted, s.taskV3(task))
+}
+
+func (s *state) getTaskV3(_ *http.Request, params params) response {
+	task := s.findTask(params["guid"])
+	if task == nil {
+		return v3NotFound("Task")
+	}
+	return respond(http.StatusOK, s.taskV3(task))
+}
+
+func (s *state) cancelTaskV3(_ *http.Request, params params) response {
+	task := s.findTask(params["guid"])
+	if task == nil {
+		return v3NotFound("Task")
+	}
+	if task.state != "RUNNING" {
+		return v3UnprocessableEntity("Task state is " + task.state + " and therefore cannot be canceled")
+	}
+
+	task.state = "FAILED"
+	task.failureReason = "task was cancelled"
+	return respond(http.StatusAccepted, s.taskV3(task))
+}
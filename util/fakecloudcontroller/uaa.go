@@ -0,0 +1,116 @@
+package fakecloudcontroller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// AddUser adds a user who can log in with the username and password.
+func (server *Server) AddUser(username string, password string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.state.users[username] = password
+}
+
+type uaaPrompts struct {
+	Username []string `json:"username"`
+	Password []string `json:"password"`
+}
+
+func (s *state) uaaLogin(_ *http.Request, _ params) response {
+	return respond(http.StatusOK, map[string]interface{}{
+		"app":   map[string]string{"version": "fake"},
+		"links": map[string]string{"uaa": s.url, "login": s.url},
+		"prompts": uaaPrompts{
+			Username: []string{"text", "Email"},
+			Password: []string{"password", "Password"},
+		},
+	})
+}
+
+type uaaTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Scope        string `json:"scope"`
+	JTI          string `json:"jti"`
+}
+
+// uaaToken issues tokens for the password and refresh_token grants.
+func (s *state) uaaToken(request *http.Request, _ params) response {
+	err := request.ParseForm()
+	if err != nil {
+		return uaaError(http.StatusBadRequest, "invalid_request", err.Error())
+	}
+
+	var username string
+	switch request.PostForm.Get("grant_type") {
+	case "password":
+		username = request.PostForm.Get("username")
+		password, ok := s.users[username]
+		if !ok || password != request.PostForm.Get("password") {
+			return uaaError(http.StatusUnauthorized, "unauthorized", "Bad credentials")
+		}
+	case "refresh_token":
+		var ok bool
+		username, ok = s.refreshTokens[request.PostForm.Get("refresh_token")]
+		if !ok {
+			return uaaError(http.StatusUnauthorized, "invalid_token", "Invalid refresh token")
+		}
+	default:
+		return uaaError(http.StatusBadRequest, "unsupported_grant_type", "Unsupported grant type")
+	}
+
+	jti := s.newGUID()
+	accessToken := s.newAccessToken(username, jti)
+	s.accessTokens[accessToken] = username
+
+	refreshToken := request.PostForm.Get("refresh_token")
+	if refreshToken == "" {
+		refreshToken = s.newGUID() + "-r"
+		s.refreshTokens[refreshToken] = username
+	}
+
+	return respond(http.StatusOK, uaaTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "bearer",
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(time.Hour / time.Second),
+		Scope:        "cloud_controller.admin cloud_controller.read cloud_controller.write openid",
+		JTI:          jti,
+	})
+}
+
+// newAccessToken returns an unsigned JWT with the claims the CLI reads.
+func (s *state) newAccessToken(username string, jti string) string {
+	encode := func(v interface{}) string {
+		raw, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	now := time.Now()
+	header := map[string]string{"alg": "HS256", "typ": "JWT"}
+	claims := map[string]interface{}{
+		"jti":       jti,
+		"user_id":   username + "-id",
+		"user_name": username,
+		"email":     username,
+		"client_id": "cf",
+		"scope":     []string{"cloud_controller.admin", "cloud_controller.read", "cloud_controller.write", "openid"},
+		"iat":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"iss":       s.url + "/oauth/token",
+	}
+	return encode(header) + "." + encode(claims) + "." + base64.RawURLEncoding.EncodeToString([]byte("fake-signature"))
+}
+
+func uaaError(statusCode int, errorType string, description string) response {
+	return respond(statusCode, map[string]string{
+		"error":             errorType,
+		"error_description": description,
+	})
+}
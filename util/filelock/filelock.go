@@ -0,0 +1,39 @@
+// Package filelock provides advisory file locks that serialize
+// read-modify-write cycles on files shared between CLI processes, such as
+// the config.json in CF_HOME.
+package filelock
+
+import "os"
+
+// FileLock is an exclusive advisory lock held on a lock file.
+type FileLock struct {
+	file *os.File
+}
+
+// Acquire blocks until it holds an exclusive advisory lock on the file at
+// path, creating the file if it does not exist. The lock is released when
+// Release is called or the process exits.
+func Acquire(path string) (*FileLock, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lock(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &FileLock{file: file}, nil
+}
+
+// Release releases the lock.
+func (l *FileLock) Release() error {
+	err := unlock(l.file)
+	closeErr := l.file.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
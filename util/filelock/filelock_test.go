@@ -0,0 +1,66 @@
+package filelock_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/filelock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileLock", func() {
+	var (
+		tempDir  string
+		lockPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "filelock-test")
+		Expect(err).ToNot(HaveOccurred())
+		lockPath = filepath.Join(tempDir, "config.json.lock")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("creates the lock file", func() {
+		lock, err := Acquire(lockPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lock.Release()).To(Succeed())
+
+		Expect(lockPath).To(BeAnExistingFile())
+	})
+
+	It("blocks other holders until it is released", func() {
+		lock, err := Acquire(lockPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		acquired := make(chan *FileLock)
+		go func() {
+			defer GinkgoRecover()
+			secondLock, secondErr := Acquire(lockPath)
+			Expect(secondErr).ToNot(HaveOccurred())
+			acquired <- secondLock
+		}()
+
+		Consistently(acquired).ShouldNot(Receive())
+
+		Expect(lock.Release()).To(Succeed())
+
+		var secondLock *FileLock
+		Eventually(acquired).Should(Receive(&secondLock))
+		Expect(secondLock.Release()).To(Succeed())
+	})
+
+	Context("when the lock file cannot be created", func() {
+		It("returns an error", func() {
+			_, err := Acquire(filepath.Join(tempDir, "missing", "config.json.lock"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
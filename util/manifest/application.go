@@ -11,6 +11,7 @@ type Application struct {
 	Buildpack      types.FilteredString
 	Buildpacks     []string
 	Command        types.FilteredString
+	DependsOn      []string
 	DiskQuota      types.NullByteSizeInMb
 	DockerImage    string
 	DockerPassword string
@@ -50,6 +51,7 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		Buildpack:               app.Buildpack.Value,
 		Buildpacks:              app.Buildpacks,
 		Command:                 app.Command.Value,
+		DependsOn:               app.DependsOn,
 		Docker:                  rawDockerInfo{Image: app.DockerImage, Username: app.DockerUsername},
 		DropletPath:             app.DropletPath,
 		EnvironmentVariables:    app.EnvironmentVariables,
@@ -114,6 +116,7 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 	if err != nil {
 		return err
 	}
+	app.DependsOn = m.DependsOn
 	app.DeprecatedDomain = m.DeprecatedDomain
 	app.DeprecatedDomains = m.DeprecatedDomains
 	app.DeprecatedHost = m.DeprecatedHost
@@ -40,6 +40,32 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 		return nil, err
 	}
 
+	rawManifest, err = InterpolateManifest(rawManifest, pathsToVarsFiles, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+
+	err = yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, app := range manifest.Applications {
+		if app.Path != "" && !filepath.IsAbs(app.Path) {
+			manifest.Applications[i].Path = filepath.Join(filepath.Dir(pathToManifest), app.Path)
+		}
+	}
+
+	return manifest.Applications, err
+}
+
+// InterpolateManifest substitutes the variables in the provided vars files and
+// vars into rawManifest. Vars take precedence over vars files, and later vars
+// files take precedence over earlier ones. Every variable in rawManifest must
+// be provided.
+func InterpolateManifest(rawManifest []byte, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error) {
 	tpl := template.NewTemplate(rawManifest)
 	fileVars := template.StaticVariables{}
 
@@ -51,7 +77,7 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 
 		var sv template.StaticVariables
 
-		err = yaml.Unmarshal(rawVarsFile, &sv)
+		err := yaml.Unmarshal(rawVarsFile, &sv)
 		if err != nil {
 			return nil, InvalidYAMLError{Err: err}
 		}
@@ -65,25 +91,12 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 		fileVars[kv.Name] = kv.Value
 	}
 
-	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	interpolated, err := tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
 	if err != nil {
 		return nil, InterpolationError{Err: err}
 	}
 
-	var manifest Manifest
-
-	err = yaml.Unmarshal(rawManifest, &manifest)
-	if err != nil {
-		return nil, err
-	}
-
-	for i, app := range manifest.Applications {
-		if app.Path != "" && !filepath.IsAbs(app.Path) {
-			manifest.Applications[i].Path = filepath.Join(filepath.Dir(pathToManifest), app.Path)
-		}
-	}
-
-	return manifest.Applications, err
+	return interpolated, nil
 }
 
 // WriteApplicationManifest writes the provided application to the given
@@ -304,6 +304,56 @@ applications:
 				})
 			})
 
+			Context("when the manifest specifies dependencies with depends-on", func() {
+				BeforeEach(func() {
+					manifest = `---
+applications:
+- name: app-1
+  depends-on:
+  - app-3
+  - app-2
+- name: app-2
+- name: app-3
+`
+					err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns the dependencies of each app in order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(apps).To(HaveLen(3))
+
+					Expect(apps[0].DependsOn).To(Equal([]string{"app-3", "app-2"}))
+					Expect(apps[1].DependsOn).To(BeEmpty())
+					Expect(apps[2].DependsOn).To(BeEmpty())
+				})
+			})
+
+			Context("when the dependencies form a cycle", func() {
+				BeforeEach(func() {
+					manifest = `---
+applications:
+- name: app-1
+  depends-on:
+  - app-2
+- name: app-2
+  depends-on:
+  - app-1
+`
+					err := ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				// Cycles are detected when the apps are validated for push.
+				It("returns the dependencies without validating them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(apps).To(HaveLen(2))
+
+					Expect(apps[0].DependsOn).To(Equal([]string{"app-2"}))
+					Expect(apps[1].DependsOn).To(Equal([]string{"app-1"}))
+				})
+			})
+
 			Context("when the manifest contains buildpacks (plural)", func() {
 				BeforeEach(func() {
 					manifest = `---
@@ -673,6 +723,27 @@ applications:
 			})
 		})
 
+		Context("when the app has dependencies", func() {
+			BeforeEach(func() {
+				application = Application{
+					Name:      "app-1",
+					DependsOn: []string{"app-3", "app-2"},
+				}
+			})
+
+			It("writes them in order", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  depends-on:
+  - app-3
+  - app-2
+`))
+			})
+		})
+
 		Context("when the file is a relative path", func() {
 			var pwd string
 
@@ -10,6 +10,7 @@ type rawManifestApplication struct {
 	DeprecatedHost          interface{}        `yaml:"host,omitempty"`
 	DeprecatedHosts         interface{}        `yaml:"hosts,omitempty"`
 	DeprecatedNoHostname    interface{}        `yaml:"no-hostname,omitempty"`
+	DependsOn               []string           `yaml:"depends-on,omitempty"`
 	DiskQuota               string             `yaml:"disk_quota,omitempty"`
 	Docker                  rawDockerInfo      `yaml:"docker,omitempty"`
 	DropletPath             string             `yaml:"droplet-path,omitempty"`
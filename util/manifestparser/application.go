@@ -0,0 +1,97 @@
+package manifestparser
+
+// Application is a single entry in the applications section of a v3
+// manifest.
+type Application struct {
+	Name                         string            `yaml:"name" validate:"required"`
+	Buildpacks                   []string          `yaml:"buildpacks,omitempty"`
+	Command                      string            `yaml:"command,omitempty"`
+	DefaultRoute                 bool              `yaml:"default-route,omitempty"`
+	DiskQuota                    string            `yaml:"disk_quota,omitempty"`
+	Docker                       *Docker           `yaml:"docker,omitempty"`
+	Env                          map[string]string `yaml:"env,omitempty"`
+	HealthCheckHTTPEndpoint      string            `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckInvocationTimeout int               `yaml:"health-check-invocation-timeout,omitempty"`
+	HealthCheckType              string            `yaml:"health-check-type,omitempty"`
+	Instances                    *int              `yaml:"instances,omitempty"`
+	Memory                       string            `yaml:"memory,omitempty"`
+	Metadata                     *Metadata         `yaml:"metadata,omitempty"`
+	NoRoute                      bool              `yaml:"no-route,omitempty"`
+	Path                         string            `yaml:"path,omitempty"`
+	Processes                    []Process         `yaml:"processes,omitempty"`
+	RandomRoute                  bool              `yaml:"random-route,omitempty"`
+	Routes                       []Route           `yaml:"routes,omitempty"`
+	Services                     []Service         `yaml:"services,omitempty"`
+	Sidecars                     []Sidecar         `yaml:"sidecars,omitempty"`
+	Stack                        string            `yaml:"stack,omitempty"`
+	Timeout                      int               `yaml:"timeout,omitempty"`
+
+	// DeprecatedBuildpack is still accepted by the Cloud Controller but has
+	// been replaced by Buildpacks.
+	DeprecatedBuildpack string `yaml:"buildpack,omitempty"`
+}
+
+// Process configures a single process type of an application. Fields that
+// are not set fall back to the application level values.
+type Process struct {
+	Type                         string `yaml:"type" validate:"required"`
+	Command                      string `yaml:"command,omitempty"`
+	DiskQuota                    string `yaml:"disk_quota,omitempty"`
+	HealthCheckHTTPEndpoint      string `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckInvocationTimeout int    `yaml:"health-check-invocation-timeout,omitempty"`
+	HealthCheckType              string `yaml:"health-check-type,omitempty"`
+	Instances                    *int   `yaml:"instances,omitempty"`
+	Memory                       string `yaml:"memory,omitempty"`
+	Timeout                      int    `yaml:"timeout,omitempty"`
+}
+
+// Sidecar is an additional process that runs in the same container as the
+// given process types.
+type Sidecar struct {
+	Name         string   `yaml:"name" validate:"required"`
+	Command      string   `yaml:"command" validate:"required"`
+	ProcessTypes []string `yaml:"process_types,omitempty"`
+	Memory       string   `yaml:"memory,omitempty"`
+}
+
+// Service is a service instance bound to an application. In a manifest it is
+// either the name of the service instance or a map with its name and the
+// binding parameters.
+type Service struct {
+	Name       string                 `yaml:"name" validate:"required"`
+	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
+}
+
+// UnmarshalYAML accepts both the name and the map form of a service.
+func (service *Service) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		*service = Service{Name: name}
+		return nil
+	}
+
+	type rawService Service
+	var raw rawService
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	*service = Service(raw)
+	return nil
+}
+
+// Route is a route mapped to an application.
+type Route struct {
+	Route string `yaml:"route" validate:"required"`
+}
+
+// Docker configures the image an application is pushed from.
+type Docker struct {
+	Image    string `yaml:"image" validate:"required"`
+	Username string `yaml:"username,omitempty"`
+}
+
+// Metadata is the labels and annotations applied to an application.
+type Metadata struct {
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
@@ -0,0 +1,18 @@
+package manifestparser
+
+import "strings"
+
+// InvalidManifestError is returned when a manifest contains unknown keys,
+// values of the wrong type or is missing required keys. It contains every
+// problem found in the manifest.
+type InvalidManifestError struct {
+	Errors []ValidationError
+}
+
+func (e InvalidManifestError) Error() string {
+	var messages []string
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,172 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+type position struct {
+	line   int
+	column int
+}
+
+// locator maps key paths, such as applications[0].env.KEY, to the position
+// they were written at in the original manifest. It understands block style
+// YAML; keys inside flow style collections and keys introduced by variable
+// interpolation resolve to the position of their closest located parent.
+type locator map[string]position
+
+type locatorFrame struct {
+	path         string
+	indent       int
+	parentIndent int
+	isSequence   bool
+	pending      bool
+	count        int
+}
+
+func newLocator(rawManifest []byte) locator {
+	positions := locator{}
+	frames := []*locatorFrame{{indent: -1}}
+	blockScalarIndent := -1
+
+	for i, line := range strings.Split(string(rawManifest), "\n") {
+		lineNumber := i + 1
+		content := strings.TrimRight(strings.TrimLeft(line, " "), " \t\r")
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if blockScalarIndent >= 0 {
+			if content == "" || indent > blockScalarIndent {
+				continue
+			}
+			blockScalarIndent = -1
+		}
+
+		if content == "" || strings.HasPrefix(content, "#") || content == "---" || content == "..." {
+			continue
+		}
+
+		isItem := isSequenceItem(content)
+
+		for len(frames) > 1 {
+			top := frames[len(frames)-1]
+			if top.pending {
+				if indent > top.parentIndent || (indent == top.parentIndent && isItem) {
+					top.pending = false
+					top.indent = indent
+					top.isSequence = isItem
+					break
+				}
+			} else if indent > top.indent || (indent == top.indent && top.isSequence == isItem) {
+				break
+			}
+			frames = frames[:len(frames)-1]
+		}
+
+		top := frames[len(frames)-1]
+		if top.indent == -1 {
+			top.indent = indent
+			top.isSequence = isItem
+		}
+
+		column := indent
+		for isSequenceItem(content) {
+			path := fmt.Sprintf("%s[%d]", top.path, top.count)
+			top.count++
+			positions.add(path, lineNumber, column+1)
+
+			rest := strings.TrimLeft(content[1:], " ")
+			column += len(content) - len(rest)
+			content = rest
+
+			if content == "" {
+				frames = append(frames, &locatorFrame{path: path, parentIndent: indent, pending: true})
+				break
+			}
+
+			top = &locatorFrame{path: path, indent: column, isSequence: isSequenceItem(content)}
+			frames = append(frames, top)
+		}
+
+		key, value, ok := splitKey(content)
+		if !ok {
+			continue
+		}
+
+		path := joinKeyPath(top.path, key)
+		positions.add(path, lineNumber, column+1)
+
+		switch {
+		case value == "" || strings.HasPrefix(value, "#") || strings.HasPrefix(value, "&") || strings.HasPrefix(value, "!"):
+			frames = append(frames, &locatorFrame{path: path, parentIndent: column, pending: true})
+		case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+			blockScalarIndent = column
+		}
+	}
+
+	return positions
+}
+
+// find returns the position of path, or of its closest parent that was
+// located.
+func (l locator) find(path string) position {
+	for {
+		if pos, ok := l[path]; ok {
+			return pos
+		}
+
+		cut := strings.LastIndexAny(path, ".[")
+		if cut <= 0 {
+			return position{line: 1, column: 1}
+		}
+		path = path[:cut]
+	}
+}
+
+func (l locator) add(path string, line int, column int) {
+	if _, ok := l[path]; !ok {
+		l[path] = position{line: line, column: column}
+	}
+}
+
+func isSequenceItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// splitKey splits a "key: value" line into its unquoted key and its value.
+func splitKey(content string) (string, string, bool) {
+	if strings.HasPrefix(content, "\"") || strings.HasPrefix(content, "'") {
+		quote := content[0:1]
+		end := strings.Index(content[1:], quote)
+		if end < 0 {
+			return "", "", false
+		}
+		rest := content[end+2:]
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", false
+		}
+		return content[1 : end+1], strings.TrimSpace(rest[1:]), true
+	}
+
+	if strings.HasPrefix(content, "{") || strings.HasPrefix(content, "[") {
+		return "", "", false
+	}
+
+	for i := 0; i < len(content); i++ {
+		if content[i] == '#' && i > 0 && content[i-1] == ' ' {
+			return "", "", false
+		}
+		if content[i] == ':' && (i == len(content)-1 || content[i+1] == ' ' || content[i+1] == '\t') {
+			return strings.TrimSpace(content[:i]), strings.TrimSpace(content[i+1:]), true
+		}
+	}
+
+	return "", "", false
+}
+
+func joinKeyPath(parent string, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
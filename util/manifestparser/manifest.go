@@ -0,0 +1,7 @@
+package manifestparser
+
+// Manifest is the typed model of a v3 manifest.
+type Manifest struct {
+	Version      int           `yaml:"version,omitempty"`
+	Applications []Application `yaml:"applications"`
+}
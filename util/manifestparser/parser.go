@@ -3,14 +3,14 @@ package manifestparser
 import (
 	"errors"
 	"io/ioutil"
+	"reflect"
+	"sort"
 
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 	yaml "gopkg.in/yaml.v2"
 )
 
-type Application struct {
-	Name string `yaml:"name"`
-}
-
 type Parser struct {
 	PathToManifest string
 
@@ -23,24 +23,62 @@ func NewParser() *Parser {
 	return new(Parser)
 }
 
+// Parse validates and parses the manifest at manifestPath without any
+// variable substitution.
 func (parser *Parser) Parse(manifestPath string) error {
-	bytes, err := ioutil.ReadFile(manifestPath)
+	return parser.InterpolateAndParse(manifestPath, nil, nil)
+}
+
+// InterpolateAndParse substitutes the provided variables into the manifest at
+// pathToManifest, validates the result against the manifest model and parses
+// it. When the manifest is invalid, an InvalidManifestError containing every
+// problem is returned.
+func (parser *Parser) InterpolateAndParse(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) error {
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
 	if err != nil {
 		return err
 	}
-	parser.rawManifest = bytes
 
-	var raw struct {
-		Applications []Application `yaml:"applications"`
+	interpolatedManifest, err := manifest.InterpolateManifest(rawManifest, pathsToVarsFiles, vars)
+	if err != nil {
+		return err
 	}
 
-	err = yaml.Unmarshal(bytes, &raw)
+	var document yaml.MapSlice
+	err = yaml.Unmarshal(interpolatedManifest, &document)
 	if err != nil {
 		return err
 	}
 
-	parser.Applications = raw.Applications
+	validator := validator{
+		file:      pathToManifest,
+		positions: newLocator(rawManifest),
+	}
+	validator.validate("", document, reflect.TypeOf(Manifest{}))
+	if len(validator.errors) > 0 {
+		sort.SliceStable(validator.errors, func(i int, j int) bool {
+			if validator.errors[i].Line == validator.errors[j].Line {
+				return validator.errors[i].Column < validator.errors[j].Column
+			}
+			return validator.errors[i].Line < validator.errors[j].Line
+		})
+		return InvalidManifestError{Errors: validator.errors}
+	}
+
+	var parsedManifest Manifest
+	err = yaml.Unmarshal(interpolatedManifest, &parsedManifest)
+	if err != nil {
+		return err
+	}
+
+	parser.PathToManifest = pathToManifest
+	parser.Applications = parsedManifest.Applications
+	parser.rawManifest = interpolatedManifest
+
+	return parser.checkApplicationNames()
+}
 
+func (parser Parser) checkApplicationNames() error {
 	if len(parser.Applications) == 0 {
 		return errors.New("must have at least one application")
 	}
@@ -4,7 +4,9 @@ import (
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/util/manifest"
 	. "code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -65,6 +67,56 @@ var _ = Describe("Parser", func() {
 			})
 		})
 
+		Context("when the manifest has a version and services in both forms", func() {
+			BeforeEach(func() {
+				manifest = map[string]interface{}{
+					"version": 1,
+					"applications": []map[string]interface{}{
+						{
+							"name": "app-1",
+							"services": []interface{}{
+								"some-service",
+								map[string]interface{}{
+									"name":       "other-service",
+									"parameters": map[string]string{"key": "value"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("parses the services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parser.AppNames()).To(ConsistOf("app-1"))
+				Expect(parser.Applications[0].Services).To(Equal([]Service{
+					{Name: "some-service"},
+					{Name: "other-service", Parameters: map[string]interface{}{"key": "value"}},
+				}))
+			})
+		})
+
+		Context("when the manifest contains keys that are not in the manifest model", func() {
+			BeforeEach(func() {
+				manifest = map[string]interface{}{
+					"applications": []map[string]interface{}{
+						{
+							"name":     "app-1",
+							"instnces": 2,
+						},
+					},
+				}
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InvalidManifestError{}))
+				errors := executeErr.(InvalidManifestError).Errors
+				Expect(errors).To(HaveLen(1))
+				Expect(errors[0].Key).To(Equal("applications[0].instnces"))
+				Expect(errors[0].Message).To(Equal("unknown key"))
+			})
+		})
+
 		Context("when given an invalid manifest file", func() {
 			BeforeEach(func() {
 				manifest = map[string]interface{}{}
@@ -76,6 +128,221 @@ var _ = Describe("Parser", func() {
 		})
 	})
 
+	Describe("InterpolateAndParse", func() {
+		var (
+			manifestPath     string
+			rawManifest      string
+			pathsToVarsFiles []string
+			vars             []template.VarKV
+
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			pathsToVarsFiles = nil
+			vars = nil
+		})
+
+		JustBeforeEach(func() {
+			tmpfile, err := ioutil.TempFile("", "")
+			Expect(err).ToNot(HaveOccurred())
+			manifestPath = tmpfile.Name()
+			_, err = tmpfile.WriteString(rawManifest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+
+			executeErr = parser.InterpolateAndParse(manifestPath, pathsToVarsFiles, vars)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(manifestPath)).ToNot(HaveOccurred())
+		})
+
+		Context("when the manifest uses every supported key", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+version: 1
+applications:
+- name: app-1
+  buildpacks:
+  - ruby_buildpack
+  command: bundle exec rackup
+  disk_quota: 1G
+  env:
+    KEY: value
+    NUMBER: 1
+  health-check-type: http
+  health-check-http-endpoint: /health
+  instances: 2
+  memory: 512M
+  metadata:
+    labels:
+      env: production
+    annotations:
+      owner: team
+  processes:
+  - type: web
+    instances: 3
+    health-check-invocation-timeout: 10
+  - type: worker
+    command: bundle exec work
+  routes:
+  - route: app-1.example.com
+  services:
+  - some-service
+  - name: other-service
+    parameters:
+      key: value
+  sidecars:
+  - name: sidecar
+    command: ./sidecar
+    process_types:
+    - web
+  stack: cflinuxfs2
+  timeout: 60
+- name: app-2
+  docker:
+    image: some-image
+    username: some-user
+`
+			})
+
+			It("parses the typed model of every application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parser.AppNames()).To(Equal([]string{"app-1", "app-2"}))
+
+				app := parser.Applications[0]
+				Expect(app.Buildpacks).To(Equal([]string{"ruby_buildpack"}))
+				Expect(app.Env).To(Equal(map[string]string{"KEY": "value", "NUMBER": "1"}))
+				Expect(*app.Instances).To(Equal(2))
+				Expect(app.Metadata.Labels).To(Equal(map[string]string{"env": "production"}))
+				Expect(app.Processes).To(HaveLen(2))
+				Expect(*app.Processes[0].Instances).To(Equal(3))
+				Expect(app.Processes[0].HealthCheckInvocationTimeout).To(Equal(10))
+				Expect(app.Routes).To(Equal([]Route{{Route: "app-1.example.com"}}))
+				Expect(app.Services).To(Equal([]Service{
+					{Name: "some-service"},
+					{Name: "other-service", Parameters: map[string]interface{}{"key": "value"}},
+				}))
+				Expect(app.Sidecars).To(Equal([]Sidecar{{Name: "sidecar", Command: "./sidecar", ProcessTypes: []string{"web"}}}))
+
+				Expect(parser.Applications[1].Docker).To(Equal(&Docker{Image: "some-image", Username: "some-user"}))
+			})
+		})
+
+		Context("when the manifest contains unknown keys and values of the wrong type", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+applications:
+- name: app-1
+  instnces: 2
+  processes:
+  - type: web
+    memory: [512M]
+    instances: three
+  routes:
+    route: app-1.example.com
+  services:
+  - parameters: {key: value}
+- no-route: "true"
+  sidecars:
+  - name: sidecar
+`
+			})
+
+			It("returns every problem with its file, line and column in file order", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{Errors: []ValidationError{
+					{File: manifestPath, Line: 4, Column: 3, Key: "applications[0].instnces", Message: "unknown key"},
+					{File: manifestPath, Line: 7, Column: 5, Key: "applications[0].processes[0].memory", Message: "expected a string, got a list"},
+					{File: manifestPath, Line: 8, Column: 5, Key: "applications[0].processes[0].instances", Message: "expected an integer, got a string"},
+					{File: manifestPath, Line: 9, Column: 3, Key: "applications[0].routes", Message: "expected a list, got a map"},
+					{File: manifestPath, Line: 12, Column: 3, Key: "applications[0].services[0].name", Message: "required key is missing"},
+					{File: manifestPath, Line: 13, Column: 1, Key: "applications[1].name", Message: "required key is missing"},
+					{File: manifestPath, Line: 13, Column: 3, Key: "applications[1].no-route", Message: "expected a boolean, got a string"},
+					{File: manifestPath, Line: 15, Column: 3, Key: "applications[1].sidecars[0].command", Message: "required key is missing"},
+				}}))
+			})
+		})
+
+		Context("when the manifest uses block scalars, quoted keys and flow collections", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+applications:
+-
+  name: app-1
+  command: |
+    first: line
+    second line
+  "env": {KEY: value}
+  'stak': cflinuxfs2
+  routes: [{route: a.example.com, path: /foo}]
+`
+			})
+
+			It("reports positions in the original manifest", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{Errors: []ValidationError{
+					{File: manifestPath, Line: 9, Column: 3, Key: "applications[0].stak", Message: "unknown key"},
+					{File: manifestPath, Line: 10, Column: 3, Key: "applications[0].routes[0].path", Message: "unknown key"},
+				}}))
+			})
+		})
+
+		Context("when the manifest contains variables", func() {
+			var varsFilePath string
+
+			BeforeEach(func() {
+				rawManifest = `---
+applications:
+- name: ((name))
+  instances: ((instances))
+`
+				varsFile, err := ioutil.TempFile("", "")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = varsFile.WriteString("name: some-app\ninstances: many\n")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(varsFile.Close()).ToNot(HaveOccurred())
+				varsFilePath = varsFile.Name()
+				pathsToVarsFiles = []string{varsFilePath}
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(varsFilePath)).ToNot(HaveOccurred())
+			})
+
+			It("validates the interpolated manifest at the position of the variable", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{Errors: []ValidationError{
+					{File: manifestPath, Line: 4, Column: 3, Key: "applications[0].instances", Message: "expected an integer, got a string"},
+				}}))
+			})
+
+			Context("when vars override the vars files", func() {
+				BeforeEach(func() {
+					vars = []template.VarKV{{Name: "instances", Value: 2}}
+				})
+
+				It("parses the interpolated manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(parser.AppNames()).To(Equal([]string{"some-app"}))
+					Expect(*parser.Applications[0].Instances).To(Equal(2))
+
+					rawManifest, err := parser.RawManifest("some-app")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rawManifest).To(MatchYAML("applications: [{name: some-app, instances: 2}]"))
+				})
+			})
+
+			Context("when a variable is not provided", func() {
+				BeforeEach(func() {
+					pathsToVarsFiles = nil
+				})
+
+				It("returns an InterpolationError", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(manifest.InterpolationError{}))
+				})
+			})
+		})
+	})
+
 	Describe("AppNames", func() {
 		Context("when given a valid manifest file", func() {
 			BeforeEach(func() {
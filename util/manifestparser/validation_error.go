@@ -0,0 +1,18 @@
+package manifestparser
+
+import "fmt"
+
+// ValidationError is a single problem found at Line and Column of the
+// manifest File. Key is the path to the offending key, such as
+// applications[0].processes[1].instances.
+type ValidationError struct {
+	File    string
+	Line    int
+	Column  int
+	Key     string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Column, e.Key, e.Message)
+}
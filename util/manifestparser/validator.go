@@ -0,0 +1,149 @@
+package manifestparser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var unmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+
+// validator checks a decoded manifest against the fields of the typed model,
+// collecting every unknown key, value of the wrong type and missing required
+// key instead of stopping at the first one.
+type validator struct {
+	file      string
+	positions locator
+	errors    []ValidationError
+}
+
+func (v *validator) validate(path string, value interface{}, t reflect.Type) {
+	if value == nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		v.validate(path, value, t.Elem())
+	case reflect.Struct:
+		if _, isString := value.(string); isString && reflect.PtrTo(t).Implements(unmarshalerType) {
+			// The type has a short form that is written as a single string.
+			return
+		}
+		v.validateStruct(path, value, t)
+	case reflect.Slice:
+		items, ok := value.([]interface{})
+		if !ok {
+			v.addTypeError(path, "a list", value)
+			return
+		}
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, t.Elem())
+		}
+	case reflect.Map:
+		entries, ok := value.(yaml.MapSlice)
+		if !ok {
+			v.addTypeError(path, "a map", value)
+			return
+		}
+		for _, entry := range entries {
+			v.validate(joinKeyPath(path, fmt.Sprint(entry.Key)), entry.Value, t.Elem())
+		}
+	case reflect.String:
+		switch value.(type) {
+		case yaml.MapSlice, []interface{}:
+			v.addTypeError(path, "a string", value)
+		}
+	case reflect.Int:
+		switch value.(type) {
+		case int, int64, uint64:
+		default:
+			v.addTypeError(path, "an integer", value)
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			v.addTypeError(path, "a boolean", value)
+		}
+	}
+}
+
+func (v *validator) validateStruct(path string, value interface{}, t reflect.Type) {
+	entries, ok := value.(yaml.MapSlice)
+	if !ok {
+		v.addTypeError(path, "a map", value)
+		return
+	}
+
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fields[yamlKey(field)] = field
+	}
+
+	found := map[string]bool{}
+	for _, entry := range entries {
+		key := fmt.Sprint(entry.Key)
+		keyPath := joinKeyPath(path, key)
+
+		field, ok := fields[key]
+		if !ok {
+			v.addError(keyPath, "unknown key")
+			continue
+		}
+
+		found[key] = true
+		v.validate(keyPath, entry.Value, field.Type)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := yamlKey(field)
+		if field.Tag.Get("validate") == "required" && !found[key] {
+			v.addErrorAt(joinKeyPath(path, key), path, "required key is missing")
+		}
+	}
+}
+
+func (v *validator) addTypeError(path string, expected string, value interface{}) {
+	v.addError(path, fmt.Sprintf("expected %s, got %s", expected, describeValue(value)))
+}
+
+func (v *validator) addError(path string, message string) {
+	v.addErrorAt(path, path, message)
+}
+
+func (v *validator) addErrorAt(path string, positionPath string, message string) {
+	pos := v.positions.find(positionPath)
+	v.errors = append(v.errors, ValidationError{
+		File:    v.file,
+		Line:    pos.line,
+		Column:  pos.column,
+		Key:     path,
+		Message: message,
+	})
+}
+
+func yamlKey(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("yaml"), ",")[0]
+}
+
+func describeValue(value interface{}) string {
+	switch value.(type) {
+	case yaml.MapSlice:
+		return "a map"
+	case []interface{}:
+		return "a list"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case int, int64, uint64:
+		return "an integer"
+	case float64:
+		return "a number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
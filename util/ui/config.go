@@ -14,4 +14,6 @@ type Config interface {
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
 	TerminalWidth() int
+	// OutputFormat is the format requested with the global --output flag
+	OutputFormat() configv3.OutputFormat
 }
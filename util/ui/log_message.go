@@ -25,6 +25,10 @@ type LogMessage interface {
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
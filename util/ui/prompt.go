@@ -1,10 +1,15 @@
 package ui
 
-import "github.com/vito/go-interact/interact"
+import (
+	"io"
+
+	"github.com/vito/go-interact/interact"
+)
 
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
-// defaultResponse.
+// defaultResponse. The prompt is output to ui.Err when structured output was
+// requested.
 func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
@@ -12,13 +17,14 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	response := defaultResponse
 	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
 	interactivePrompt.Input = ui.In
-	interactivePrompt.Output = ui.OutForInteration
+	interactivePrompt.Output = ui.promptOutput()
 	err := interactivePrompt.Resolve(&response)
 	return response, err
 }
 
 // DisplayPasswordPrompt outputs the prompt and waits for user input. Hides
-// user's response from the screen.
+// user's response from the screen. The prompt is output to ui.Err when
+// structured output was requested.
 func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
@@ -26,7 +32,16 @@ func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[strin
 	var password interact.Password
 	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
 	interactivePrompt.Input = ui.In
-	interactivePrompt.Output = ui.OutForInteration
+	interactivePrompt.Output = ui.promptOutput()
 	err := interactivePrompt.Resolve(interact.Required(&password))
 	return string(password), err
 }
+
+// promptOutput returns the writer for prompts, which is ui.Err when structured
+// output was requested so that ui.Out only holds the structured documents.
+func (ui *UI) promptOutput() io.Writer {
+	if ui.IsStructuredOutput() {
+		return ui.Err
+	}
+	return ui.OutForInteration
+}
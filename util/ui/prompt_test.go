@@ -91,6 +91,24 @@ var _ = Describe("Prompts", func() {
 			})
 		})
 
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputFormatJSON
+
+				_, err := inBuffer.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("displays the prompt on stderr and returns the response", func() {
+				response, err := ui.DisplayBoolPrompt(false, "some-prompt", nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(BeTrue())
+
+				Expect(errBuff).To(Say("some-prompt \\[yN\\]:"))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+
 		Context("when the interact library returns an error", func() {
 			It("returns the error", func() {
 				_, err := inBuffer.Write([]byte("invalid\n"))
@@ -123,6 +141,22 @@ var _ = Describe("Prompts", func() {
 			Expect(out).ToNot(Say("some-input"))
 		})
 
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputFormatYAML
+			})
+
+			It("displays the prompt on stderr and returns the user input", func() {
+				userInput, err := ui.DisplayPasswordPrompt("some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userInput).To(Equal("some-input"))
+
+				Expect(errBuff).To(Say("some-prompt"))
+				Expect(errBuff).ToNot(Say("some-input"))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+
 		Context("when the locale is not set to English", func() {
 			BeforeEach(func() {
 				fakeConfig.LocaleReturns("fr-FR")
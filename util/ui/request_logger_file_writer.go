@@ -1,12 +1,16 @@
 package ui
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type RequestLoggerFileWriter struct {
@@ -14,20 +18,40 @@ type RequestLoggerFileWriter struct {
 	lock          *sync.Mutex
 	filePaths     []string
 	logFiles      []*os.File
+	har           *harWriter
 	dumpSanitizer *regexp.Regexp
 }
 
 func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string) *RequestLoggerFileWriter {
+	var textFilePaths, harFilePaths []string
+	for _, filePath := range filePaths {
+		if strings.HasPrefix(filePath, configv3.TraceHARPrefix) {
+			harFilePaths = append(harFilePaths, strings.TrimPrefix(filePath, configv3.TraceHARPrefix))
+		} else {
+			textFilePaths = append(textFilePaths, filePath)
+		}
+	}
+
+	var har *harWriter
+	if len(harFilePaths) > 0 {
+		har = newHARWriter(harFilePaths)
+	}
+
 	return &RequestLoggerFileWriter{
 		ui:            ui,
 		lock:          lock,
-		filePaths:     filePaths,
+		filePaths:     textFilePaths,
 		logFiles:      []*os.File{},
-		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+		har:           har,
+		dumpSanitizer: regexp.MustCompile(TokenRegexp),
 	}
 }
 
 func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
+	if display.har != nil {
+		display.har.displayBody(RedactedValue)
+	}
+
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(RedactedValue)
 		if err != nil {
@@ -49,11 +73,17 @@ func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayHeader(name string, value string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s: %s", name, value))
+	if display.har != nil {
+		display.har.displayHeader(name, display.dumpSanitizer.ReplaceAllString(value, RedactedValue))
+	}
+	return display.writeLine(fmt.Sprintf("%s: %s", name, value))
 }
 
 func (display *RequestLoggerFileWriter) DisplayHost(name string) error {
-	return display.DisplayMessage(fmt.Sprintf("Host: %s", name))
+	if display.har != nil {
+		display.har.displayHost(name)
+	}
+	return display.writeLine(fmt.Sprintf("Host: %s", name))
 }
 
 func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
@@ -66,6 +96,10 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 		return display.DisplayMessage(string(body))
 	}
 
+	if display.har != nil {
+		display.har.displayBody(string(bytes.TrimSpace(sanitized)))
+	}
+
 	for _, logFile := range display.logFiles {
 		_, err = logFile.Write(sanitized)
 		if err != nil {
@@ -76,6 +110,13 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
+	if display.har != nil {
+		display.har.displayBody(msg)
+	}
+	return display.writeLine(msg)
+}
+
+func (display *RequestLoggerFileWriter) writeLine(msg string) error {
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(fmt.Sprintf("%s\n", msg))
 		if err != nil {
@@ -86,15 +127,33 @@ func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s %s %s", method, uri, httpProtocol))
+	if display.har != nil {
+		display.har.displayRequestHeader(method, uri, httpProtocol)
+	}
+	return display.writeLine(fmt.Sprintf("%s %s %s", method, uri, httpProtocol))
+}
+
+// DisplayRequestID pairs the request or response being displayed with the
+// other half of its exchange in HAR files. It is not written to text files.
+func (display *RequestLoggerFileWriter) DisplayRequestID(id string) error {
+	if display.har != nil {
+		display.har.displayRequestID(id)
+	}
+	return nil
 }
 
 func (display *RequestLoggerFileWriter) DisplayResponseHeader(httpProtocol string, status string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s %s", httpProtocol, status))
+	if display.har != nil {
+		display.har.displayResponseHeader(httpProtocol, status)
+	}
+	return display.writeLine(fmt.Sprintf("%s %s", httpProtocol, status))
 }
 
 func (display *RequestLoggerFileWriter) DisplayType(name string, requestDate time.Time) error {
-	return display.DisplayMessage(fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339)))
+	if display.har != nil {
+		display.har.displayType(name, requestDate)
+	}
+	return display.writeLine(fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339)))
 }
 
 func (display *RequestLoggerFileWriter) HandleInternalError(err error) {
@@ -133,6 +192,13 @@ func (display *RequestLoggerFileWriter) Stop() error {
 		}
 	}
 	display.logFiles = []*os.File{}
+
+	if display.har != nil {
+		if harErr := display.har.stop(); harErr != nil && err == nil {
+			err = harErr
+		}
+	}
+
 	display.lock.Unlock()
 	return err
 }
@@ -0,0 +1,365 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/version"
+)
+
+const harTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harRequestInProgress is a request that has been displayed and is waiting
+// for its response.
+type harRequestInProgress struct {
+	id        string
+	entry     harEntry
+	startedAt time.Time
+	host      string
+	uri       string
+	body      *string
+}
+
+// harWriter builds HAR 1.2 entries from the requests and responses displayed
+// by a request logger, and streams them to HAR files once the response has
+// been displayed.
+//
+// A response is matched with its request by the request ID displayed with
+// both, as concurrent requests can display their responses in any order.
+// Requests that fail without a response are never written.
+type harWriter struct {
+	filePaths []string
+
+	pending     map[string]*harRequestInProgress
+	current     *harRequestInProgress
+	isResponse  bool
+	respondedAt time.Time
+}
+
+func newHARWriter(filePaths []string) *harWriter {
+	return &harWriter{
+		filePaths: filePaths,
+		pending:   map[string]*harRequestInProgress{},
+	}
+}
+
+func (writer *harWriter) displayType(name string, date time.Time) {
+	switch name {
+	case "REQUEST":
+		writer.current = &harRequestInProgress{
+			startedAt: date,
+			entry: harEntry{
+				StartedDateTime: date.Format(harTimeFormat),
+				Request: harRequest{
+					Cookies:     []harNameValue{},
+					Headers:     []harNameValue{},
+					QueryString: []harNameValue{},
+					HeadersSize: -1,
+					BodySize:    -1,
+				},
+				Response: harResponse{
+					Cookies:     []harNameValue{},
+					Headers:     []harNameValue{},
+					HeadersSize: -1,
+					BodySize:    -1,
+				},
+			},
+		}
+		writer.isResponse = false
+	case "RESPONSE":
+		// The request is looked up once its ID has been displayed.
+		writer.current = nil
+		writer.isResponse = true
+		writer.respondedAt = date
+	default:
+		writer.current = nil
+		writer.isResponse = false
+	}
+}
+
+func (writer *harWriter) displayRequestID(id string) {
+	if !writer.isResponse {
+		if writer.current != nil {
+			writer.current.id = id
+		}
+		return
+	}
+
+	writer.current = writer.pending[id]
+	delete(writer.pending, id)
+}
+func (writer *harWriter) displayRequestHeader(method string, uri string, httpProtocol string) {
+	if writer.current == nil {
+		return
+	}
+
+	writer.current.entry.Request.Method = method
+	writer.current.entry.Request.HTTPVersion = httpProtocol
+	writer.current.uri = uri
+
+	if i := strings.Index(uri, "?"); i >= 0 {
+		for _, param := range strings.Split(uri[i+1:], "&") {
+			if param == "" {
+				continue
+			}
+			nameValue := strings.SplitN(param, "=", 2)
+			name, _ := url.QueryUnescape(nameValue[0])
+			var value string
+			if len(nameValue) == 2 {
+				value, _ = url.QueryUnescape(nameValue[1])
+			}
+			writer.current.entry.Request.QueryString = append(writer.current.entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+}
+
+func (writer *harWriter) displayHost(host string) {
+	if writer.current == nil {
+		return
+	}
+
+	writer.current.host = host
+}
+
+func (writer *harWriter) displayHeader(name string, value string) {
+	if writer.current == nil {
+		return
+	}
+
+	header := harNameValue{Name: name, Value: value}
+	if writer.isResponse {
+		writer.current.entry.Response.Headers = append(writer.current.entry.Response.Headers, header)
+	} else {
+		writer.current.entry.Request.Headers = append(writer.current.entry.Request.Headers, header)
+	}
+}
+
+func (writer *harWriter) displayResponseHeader(httpProtocol string, status string) {
+	if writer.current == nil {
+		return
+	}
+
+	writer.current.entry.Response.HTTPVersion = httpProtocol
+	statusParts := strings.SplitN(status, " ", 2)
+	writer.current.entry.Response.Status, _ = strconv.Atoi(statusParts[0])
+	if len(statusParts) == 2 {
+		writer.current.entry.Response.StatusText = statusParts[1]
+	}
+}
+
+func (writer *harWriter) displayBody(body string) {
+	if writer.current == nil {
+		return
+	}
+
+	writer.current.body = &body
+}
+
+// stop finishes the request or response being displayed, and writes the
+// entry to the HAR files once its response has been displayed.
+func (writer *harWriter) stop() error {
+	current := writer.current
+	writer.current = nil
+	if current == nil {
+		return nil
+	}
+
+	if !writer.isResponse {
+		if current.body != nil {
+			current.entry.Request.PostData = &harPostData{
+				MimeType: headerValue(current.entry.Request.Headers, "Content-Type"),
+				Text:     *current.body,
+			}
+			current.entry.Request.BodySize = len(*current.body)
+		} else {
+			current.entry.Request.BodySize = 0
+		}
+		// The request loggers do not display the scheme; the Cloud Foundry
+		// APIs are served over HTTPS.
+		current.entry.Request.URL = "https://" + current.host + current.uri
+		current.body = nil
+		writer.pending[current.id] = current
+		return nil
+	}
+
+	entry := current.entry
+	entry.Response.Content.MimeType = headerValue(entry.Response.Headers, "Content-Type")
+	if current.body != nil {
+		entry.Response.Content.Text = *current.body
+		entry.Response.Content.Size = len(*current.body)
+	}
+	entry.Response.BodySize = entry.Response.Content.Size
+	entry.Response.RedirectURL = headerValue(entry.Response.Headers, "Location")
+
+	elapsed := float64(writer.respondedAt.Sub(current.startedAt)) / float64(time.Millisecond)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	entry.Time = elapsed
+	entry.Timings = harTimings{Wait: elapsed}
+
+	raw, err := json.MarshalIndent(entry, harEntryIndent, "  ")
+	if err != nil {
+		return err
+	}
+
+	for _, filePath := range writer.filePaths {
+		err = appendHAREntry(filePath, raw)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func headerValue(headers []harNameValue, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// harEntryIndent is the indentation of the entries in the log of a HAR file.
+const harEntryIndent = "      "
+
+// harFileStart and harFileEnd surround the entries of the HAR files written
+// by harWriter.
+var harFileStart, harFileEnd = harFileBounds()
+
+func harFileBounds() (string, string) {
+	raw, _ := json.MarshalIndent(harFile{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "cf", Version: version.VersionString()},
+			Entries: []harEntry{},
+		},
+	}, "", "  ")
+
+	i := bytes.Index(raw, []byte("[]"))
+	return string(raw[:i+1]), "\n    " + string(raw[i+1:]) + "\n"
+}
+
+// appendHAREntry adds a marshalled entry to the HAR file at filePath, creating
+// the file if it does not exist. The entry is written over the end of the
+// file, so that the file stays valid without rereading its entries.
+func appendHAREntry(filePath string, entry []byte) error {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var prefix string
+	if info.Size() == 0 {
+		prefix = harFileStart + "\n" + harEntryIndent
+	} else {
+		offset := info.Size() - int64(len(harFileEnd))
+		if offset < 0 {
+			return fmt.Errorf("%s is not a HAR file written by cf", filePath)
+		}
+		end := make([]byte, len(harFileEnd))
+		if _, err = file.ReadAt(end, offset); err != nil {
+			return err
+		}
+		if string(end) != harFileEnd {
+			return fmt.Errorf("%s is not a HAR file written by cf", filePath)
+		}
+		if _, err = file.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		prefix = ",\n" + harEntryIndent
+	}
+
+	_, err = file.WriteString(prefix + string(entry) + harFileEnd)
+	return err
+}
@@ -0,0 +1,233 @@
+package ui_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Logger HAR Writer", func() {
+	type harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	type harEntry struct {
+		StartedDateTime string  `json:"startedDateTime"`
+		Time            float64 `json:"time"`
+		Request         struct {
+			Method      string         `json:"method"`
+			URL         string         `json:"url"`
+			HTTPVersion string         `json:"httpVersion"`
+			Headers     []harNameValue `json:"headers"`
+			QueryString []harNameValue `json:"queryString"`
+			PostData    *struct {
+				MimeType string `json:"mimeType"`
+				Text     string `json:"text"`
+			} `json:"postData"`
+		} `json:"request"`
+		Response struct {
+			Status      int            `json:"status"`
+			StatusText  string         `json:"statusText"`
+			HTTPVersion string         `json:"httpVersion"`
+			Headers     []harNameValue `json:"headers"`
+			Content     struct {
+				Size     int    `json:"size"`
+				MimeType string `json:"mimeType"`
+				Text     string `json:"text"`
+			} `json:"content"`
+		} `json:"response"`
+		Timings struct {
+			Wait float64 `json:"wait"`
+		} `json:"timings"`
+	}
+
+	type harFile struct {
+		Log struct {
+			Version string     `json:"version"`
+			Entries []harEntry `json:"entries"`
+		} `json:"log"`
+	}
+
+	var (
+		ui        *UI
+		display   *RequestLoggerFileWriter
+		tmpdir    string
+		harPath   string
+		startTime time.Time
+	)
+
+	readHAR := func() harFile {
+		raw, err := ioutil.ReadFile(harPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		var har harFile
+		Expect(json.Unmarshal(raw, &har)).To(Succeed())
+		return har
+	}
+
+	displayRequest := func(id string, method string, uri string, body []byte) {
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayType("REQUEST", startTime)).To(Succeed())
+		Expect(display.DisplayRequestID(id)).To(Succeed())
+		Expect(display.DisplayRequestHeader(method, uri, "HTTP/1.1")).To(Succeed())
+		Expect(display.DisplayHost("api.example.com")).To(Succeed())
+		Expect(display.DisplayHeader("Authorization", "bearer some-token")).To(Succeed())
+		Expect(display.DisplayHeader("Content-Type", "application/json")).To(Succeed())
+		Expect(display.DisplayJSONBody(body)).To(Succeed())
+		Expect(display.Stop()).To(Succeed())
+	}
+
+	displayResponse := func(id string, status string, body []byte) {
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayType("RESPONSE", startTime.Add(250*time.Millisecond))).To(Succeed())
+		Expect(display.DisplayRequestID(id)).To(Succeed())
+		Expect(display.DisplayResponseHeader("HTTP/1.1", status)).To(Succeed())
+		Expect(display.DisplayHeader("Content-Type", "application/json;charset=utf-8")).To(Succeed())
+		Expect(display.DisplayJSONBody(body)).To(Succeed())
+		Expect(display.Stop()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ui = NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "request_logger_har")
+		Expect(err).ToNot(HaveOccurred())
+
+		harPath = filepath.Join(tmpdir, "sub", "trace.har")
+		display = ui.RequestLoggerFileWriter([]string{"har:" + harPath})
+		startTime = time.Date(2018, 5, 1, 10, 30, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	It("does not write an entry until the response has been displayed", func() {
+		displayRequest("request-1", "GET", "/v3/apps", nil)
+
+		_, err := os.Stat(harPath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("writes each request and its response as a HAR entry", func() {
+		displayRequest("request-1", "POST", "/v3/apps?names=some-app&space_guids=some%20space", []byte(`{"name":"some-app","password":"secret"}`))
+		displayResponse("request-1", "201 Created", []byte(`{"guid":"some-app-guid","access_token":"some-token"}`))
+
+		har := readHAR()
+		Expect(har.Log.Version).To(Equal("1.2"))
+		Expect(har.Log.Entries).To(HaveLen(1))
+
+		entry := har.Log.Entries[0]
+		Expect(entry.StartedDateTime).To(Equal("2018-05-01T10:30:00.000Z"))
+		Expect(entry.Time).To(Equal(250.0))
+		Expect(entry.Timings.Wait).To(Equal(250.0))
+
+		Expect(entry.Request.Method).To(Equal("POST"))
+		Expect(entry.Request.URL).To(Equal("https://api.example.com/v3/apps?names=some-app&space_guids=some%20space"))
+		Expect(entry.Request.HTTPVersion).To(Equal("HTTP/1.1"))
+		Expect(entry.Request.QueryString).To(Equal([]harNameValue{
+			{Name: "names", Value: "some-app"},
+			{Name: "space_guids", Value: "some space"},
+		}))
+		Expect(entry.Request.Headers).To(ContainElement(harNameValue{Name: "Authorization", Value: RedactedValue}))
+		Expect(entry.Request.PostData).ToNot(BeNil())
+		Expect(entry.Request.PostData.MimeType).To(Equal("application/json"))
+		Expect(entry.Request.PostData.Text).To(MatchJSON(`{"name":"some-app","password":"` + RedactedValue + `"}`))
+
+		Expect(entry.Response.Status).To(Equal(201))
+		Expect(entry.Response.StatusText).To(Equal("Created"))
+		Expect(entry.Response.HTTPVersion).To(Equal("HTTP/1.1"))
+		Expect(entry.Response.Content.MimeType).To(Equal("application/json;charset=utf-8"))
+		Expect(entry.Response.Content.Text).To(MatchJSON(`{"guid":"some-app-guid","access_token":"` + RedactedValue + `"}`))
+		Expect(entry.Response.Content.Size).To(Equal(len(entry.Response.Content.Text)))
+	})
+
+	It("appends entries to an existing HAR file", func() {
+		displayRequest("request-1", "GET", "/v3/apps", nil)
+		displayResponse("request-1", "200 OK", []byte(`{}`))
+
+		display = ui.RequestLoggerFileWriter([]string{"har:" + harPath})
+		displayRequest("request-2", "GET", "/v3/spaces", nil)
+		displayResponse("request-2", "200 OK", []byte(`{}`))
+
+		har := readHAR()
+		Expect(har.Log.Entries).To(HaveLen(2))
+		Expect(har.Log.Entries[0].Request.URL).To(Equal("https://api.example.com/v3/apps"))
+		Expect(har.Log.Entries[1].Request.URL).To(Equal("https://api.example.com/v3/spaces"))
+	})
+
+	It("matches each response with the request that has the same ID", func() {
+		displayRequest("request-1", "GET", "/v3/apps", nil)
+		displayRequest("request-2", "GET", "/v3/spaces", nil)
+		displayResponse("request-1", "200 OK", []byte(`{"resources":"apps"}`))
+		displayResponse("request-2", "200 OK", []byte(`{"resources":"spaces"}`))
+
+		har := readHAR()
+		Expect(har.Log.Entries).To(HaveLen(2))
+		Expect(har.Log.Entries[0].Request.URL).To(Equal("https://api.example.com/v3/apps"))
+		Expect(har.Log.Entries[0].Response.Content.Text).To(MatchJSON(`{"resources":"apps"}`))
+		Expect(har.Log.Entries[1].Request.URL).To(Equal("https://api.example.com/v3/spaces"))
+		Expect(har.Log.Entries[1].Response.Content.Text).To(MatchJSON(`{"resources":"spaces"}`))
+	})
+
+	It("does not write a response whose request was not displayed", func() {
+		displayRequest("request-1", "GET", "/v3/apps", nil)
+		displayResponse("request-2", "200 OK", []byte(`{}`))
+
+		_, err := os.Stat(harPath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("ignores other displayed types", func() {
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayType("WEBSOCKET REQUEST", startTime)).To(Succeed())
+		Expect(display.DisplayDump("GET /apps/some-app-guid/stream HTTP/1.1")).To(Succeed())
+		Expect(display.Stop()).To(Succeed())
+
+		_, err := os.Stat(harPath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("returns an error when the file is not a HAR file", func() {
+		Expect(os.MkdirAll(filepath.Dir(harPath), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(harPath, []byte("not json"), 0600)).To(Succeed())
+
+		displayRequest("request-1", "GET", "/v3/apps", nil)
+
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayType("RESPONSE", startTime)).To(Succeed())
+		Expect(display.DisplayRequestID("request-1")).To(Succeed())
+		Expect(display.DisplayResponseHeader("HTTP/1.1", "200 OK")).To(Succeed())
+		Expect(display.Stop()).To(MatchError(ContainSubstring("is not a HAR file")))
+	})
+
+	Context("when text and HAR files are both given", func() {
+		var textPath string
+
+		BeforeEach(func() {
+			textPath = filepath.Join(tmpdir, "trace.log")
+			display = ui.RequestLoggerFileWriter([]string{textPath, "har:" + harPath})
+		})
+
+		It("writes text to the text file and entries to the HAR file", func() {
+			displayRequest("request-1", "GET", "/v3/apps", nil)
+			displayResponse("request-1", "200 OK", []byte(`{}`))
+
+			contents, err := ioutil.ReadFile(textPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring("GET /v3/apps HTTP/1.1"))
+
+			Expect(readHAR().Log.Entries).To(HaveLen(1))
+		})
+	})
+})
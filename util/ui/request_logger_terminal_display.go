@@ -19,7 +19,7 @@ func newRequestLoggerTerminalDisplay(ui *UI, lock *sync.Mutex) *RequestLoggerTer
 	return &RequestLoggerTerminalDisplay{
 		ui:            ui,
 		lock:          lock,
-		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+		dumpSanitizer: regexp.MustCompile(TokenRegexp),
 	}
 }
 
@@ -70,6 +70,12 @@ func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string,
 	return nil
 }
 
+// DisplayRequestID does nothing; the terminal displays each response right
+// after its request.
+func (display *RequestLoggerTerminalDisplay) DisplayRequestID(id string) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayResponseHeader(httpProtocol string, status string) error {
 	fmt.Fprintf(display.ui.Out, "%s %s\n", httpProtocol, status)
 	return nil
@@ -42,7 +42,7 @@ func iterateAndRedact(blob interface{}) interface{} {
 	case string:
 		return sanitizeURL(v)
 	case []interface{}:
-		var list []interface{}
+		list := make([]interface{}, 0, len(v))
 		for _, val := range v {
 			list = append(list, iterateAndRedact(val))
 		}
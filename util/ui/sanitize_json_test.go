@@ -83,4 +83,10 @@ var _ = Describe("SanitizeJSON", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(redacted).To(Equal([]byte(expected)))
 	})
+
+	It("keeps empty lists", func() {
+		redacted, err := SanitizeJSON([]byte(`{"resources":[]}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(redacted).To(MatchJSON(`{"resources":[]}`))
+	})
 })
@@ -18,6 +18,10 @@ const DefaultTableSpacePadding = 3
 // will still be confined to the last column. Wrapping will occur on word
 // boundaries.
 func (ui *UI) DisplayKeyValueTable(prefix string, table [][]string, padding int) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	rows := len(table)
 	if rows == 0 {
 		return
@@ -37,6 +41,10 @@ func (ui *UI) DisplayKeyValueTable(prefix string, table [][]string, padding int)
 // Prefix will be prepended to each row and padding adds the specified number
 // of spaces between columns.
 func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding int) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -1,3 +1,4 @@
 package ui
 
-const tokenRegexp = "bearer [\\w\\.-]+"
+// TokenRegexp matches the bearer tokens in request dumps and headers.
+const TokenRegexp = "bearer [\\w\\.-]+"
@@ -41,6 +41,10 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format requested with the global --output flag. When
+	// it is not the default, human readable text and tables are not displayed.
+	OutputFormat configv3.OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -65,6 +69,7 @@ func NewUI(config Config) (*UI, error) {
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     config.OutputFormat(),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -100,6 +105,12 @@ func (ui *UI) DisplayError(err error) {
 	} else {
 		errMsg = err.Error()
 	}
+
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredError(errMsg)
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
 	ui.terminalLock.Lock()
@@ -111,6 +122,10 @@ func (ui *UI) DisplayError(err error) {
 // DisplayHeader translates the header, bolds and adds the default color to the
 // header, and outputs the result to ui.Out.
 func (ui *UI) DisplayHeader(text string) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -119,6 +134,10 @@ func (ui *UI) DisplayHeader(text string) {
 
 // DisplayNewline outputs a newline to UI.Out.
 func (ui *UI) DisplayNewline() {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -127,6 +146,10 @@ func (ui *UI) DisplayNewline() {
 
 // DisplayOK outputs a bold green translated "OK" to UI.Out.
 func (ui *UI) DisplayOK() {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -136,6 +159,10 @@ func (ui *UI) DisplayOK() {
 // DisplayText translates the template, substitutes in templateValues, and
 // outputs the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayText(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -146,6 +173,10 @@ func (ui *UI) DisplayText(template string, templateValues ...map[string]interfac
 // substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -160,6 +191,10 @@ func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]
 // templateValues, substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -173,11 +208,25 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 // DisplayWarning translates the warning, substitutes in templateValues, and
 // outputs to ui.Err. Only the first map in templateValues is used.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredWarnings([]string{ui.TranslateText(template, templateValues...)})
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n\n", ui.TranslateText(template, templateValues...))
 }
 
 // DisplayWarnings translates the warnings and outputs to ui.Err.
 func (ui *UI) DisplayWarnings(warnings []string) {
+	if ui.IsStructuredOutput() {
+		var translated []string
+		for _, warning := range warnings {
+			translated = append(translated, ui.TranslateText(warning))
+		}
+		ui.displayStructuredWarnings(translated)
+		return
+	}
+
 	for _, warning := range warnings {
 		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
 	}
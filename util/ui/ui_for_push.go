@@ -1,11 +1,14 @@
 package ui
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/types"
 
@@ -90,6 +93,44 @@ func (ui *UI) DisplayChangesForPush(changeSet []Change) error {
 	return nil
 }
 
+// WithAppPrefix returns a copy of the UI that prefixes every line written to
+// Out and Err with the app name, so that the output of apps pushed at the same
+// time can be told apart. Lines are only written once they are complete, so
+// that lines from different apps are never interleaved.
+func (ui *UI) WithAppPrefix(appName string) *UI {
+	prefixedUI := *ui
+	prefix := fmt.Sprintf("[%s] ", appName)
+	prefixedUI.Out = &prefixedLineWriter{writer: ui.Out, prefix: prefix}
+	prefixedUI.Err = &prefixedLineWriter{writer: ui.Err, prefix: prefix}
+	return &prefixedUI
+}
+
+type prefixedLineWriter struct {
+	writer io.Writer
+	prefix string
+
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (w *prefixedLineWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.buffer.Write(p)
+	for {
+		index := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if index == -1 {
+			return len(p), nil
+		}
+
+		line := append([]byte(w.prefix), w.buffer.Next(index+1)...)
+		if _, err := w.writer.Write(line); err != nil {
+			return len(p), err
+		}
+	}
+}
+
 func (ui UI) displayDiffForInt(offset string, header string, oldValue int, newValue int) {
 	if oldValue != newValue {
 		formattedOld := fmt.Sprintf("- %s%s%d", ui.TranslateText(header), offset, oldValue)
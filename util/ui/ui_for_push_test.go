@@ -354,4 +354,40 @@ var _ = Describe("UI", func() {
 			})
 		})
 	})
+
+	Describe("WithAppPrefix", func() {
+		var prefixedUI *UI
+
+		JustBeforeEach(func() {
+			prefixedUI = ui.WithAppPrefix("some-app")
+		})
+
+		It("prefixes each line of output with the app name", func() {
+			prefixedUI.DisplayText("some-text")
+			prefixedUI.DisplayKeyValueTable("", [][]string{{"name:", "some-app"}, {"state:", "started"}}, 3)
+			Expect(out).To(Say(`(?m)^\[some-app\] some-text$`))
+			Expect(out).To(Say(`(?m)^\[some-app\] name:\s+some-app$`))
+			Expect(out).To(Say(`(?m)^\[some-app\] state:\s+started$`))
+		})
+
+		It("prefixes each line of error output with the app name", func() {
+			prefixedUI.DisplayWarning("some-warning")
+			Expect(ui.Err).To(Say(`(?m)^\[some-app\] some-warning$`))
+		})
+
+		It("holds back partial lines until they are complete", func() {
+			_, err := prefixedUI.Out.Write([]byte("some-"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.Contents()).To(BeEmpty())
+
+			_, err = prefixedUI.Out.Write([]byte("text\nsome-other-"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(Equal("[some-app] some-text\n"))
+		})
+
+		It("does not change the original UI", func() {
+			ui.DisplayText("some-text")
+			Expect(out).To(Say(`(?m)^some-text$`))
+		})
+	})
 })
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// StructuredOutputVersion is the version of the document envelope displayed
+// by DisplayStructuredOutput. It is incremented whenever a field is removed or
+// changes meaning in any of the documents.
+const StructuredOutputVersion = 1
+
+// StructuredOutput is the envelope around every document displayed by
+// DisplayStructuredOutput.
+type StructuredOutput struct {
+	Version int         `json:"version" yaml:"version"`
+	Kind    string      `json:"kind" yaml:"kind"`
+	Data    interface{} `json:"data" yaml:"data"`
+}
+
+type structuredWarnings struct {
+	Warnings []string `json:"warnings" yaml:"warnings"`
+}
+
+type structuredError struct {
+	Error string `json:"error" yaml:"error"`
+}
+
+// IsStructuredOutput returns true when the user requested JSON or YAML output
+// with the global --output flag.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.OutputFormat == configv3.OutputFormatJSON || ui.OutputFormat == configv3.OutputFormatYAML
+}
+
+// DisplayStructuredOutput wraps data in a versioned StructuredOutput envelope
+// of the given kind and outputs it to ui.Out in the requested format. It does
+// nothing unless structured output was requested.
+func (ui *UI) DisplayStructuredOutput(kind string, data interface{}) error {
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	return ui.encodeStructured(ui.Out, StructuredOutput{
+		Version: StructuredOutputVersion,
+		Kind:    kind,
+		Data:    data,
+	})
+}
+
+// DisplayStructuredOutputLine is like DisplayStructuredOutput but outputs
+// JSON documents on a single line, so that a stream of documents can be
+// consumed one line at a time. YAML documents are separated by '---'.
+func (ui *UI) DisplayStructuredOutputLine(kind string, data interface{}) error {
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	return ui.encode(ui.Out, StructuredOutput{
+		Version: StructuredOutputVersion,
+		Kind:    kind,
+		Data:    data,
+	}, false)
+}
+
+func (ui *UI) displayStructuredError(errMsg string) {
+	ui.encodeStructured(ui.Err, structuredError{Error: errMsg})
+}
+
+func (ui *UI) displayStructuredWarnings(warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	ui.encodeStructured(ui.Err, structuredWarnings{Warnings: warnings})
+}
+
+func (ui *UI) encodeStructured(w io.Writer, value interface{}) error {
+	return ui.encode(w, value, true)
+}
+
+func (ui *UI) encode(w io.Writer, value interface{}, indent bool) error {
+	var (
+		raw []byte
+		err error
+	)
+
+	switch ui.OutputFormat {
+	case configv3.OutputFormatYAML:
+		raw, err = yaml.Marshal(value)
+		if err == nil {
+			raw = append([]byte("---\n"), raw...)
+		}
+	default:
+		if indent {
+			raw, err = json.MarshalIndent(value, "", "  ")
+		} else {
+			raw, err = json.Marshal(value)
+		}
+		if err == nil {
+			raw = append(raw, '\n')
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s", raw)
+	return err
+}
@@ -0,0 +1,148 @@
+package ui_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("UI", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+	)
+
+	type document struct {
+		Name string `json:"name" yaml:"name"`
+	}
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		errBuff = NewBuffer()
+		ui.Err = errBuff
+	})
+
+	Context("when the output format is the default", func() {
+		It("is not structured output", func() {
+			Expect(ui.IsStructuredOutput()).To(BeFalse())
+		})
+
+		It("does not display structured output", func() {
+			Expect(ui.DisplayStructuredOutput("some-kind", document{Name: "some-name"})).To(Succeed())
+			Expect(ui.DisplayStructuredOutputLine("some-kind", document{Name: "some-name"})).To(Succeed())
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+
+	Context("when the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		})
+
+		It("is structured output", func() {
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+		})
+
+		Describe("DisplayStructuredOutput", func() {
+			It("displays the versioned document as json", func() {
+				Expect(ui.DisplayStructuredOutput("some-kind", document{Name: "some-name"})).To(Succeed())
+				Expect(out.Contents()).To(MatchJSON(`{"version": 1, "kind": "some-kind", "data": {"name": "some-name"}}`))
+			})
+		})
+
+		Describe("DisplayStructuredOutputLine", func() {
+			It("displays each versioned document as json on its own line", func() {
+				Expect(ui.DisplayStructuredOutputLine("some-kind", document{Name: "name-1"})).To(Succeed())
+				Expect(ui.DisplayStructuredOutputLine("some-kind", document{Name: "name-2"})).To(Succeed())
+				Expect(string(out.Contents())).To(Equal(
+					`{"version":1,"kind":"some-kind","data":{"name":"name-1"}}` + "\n" +
+						`{"version":1,"kind":"some-kind","data":{"name":"name-2"}}` + "\n"))
+			})
+		})
+
+		It("suppresses human readable text and tables", func() {
+			ui.DisplayText("some text")
+			ui.DisplayTextWithFlavor("some {{.Flavor}}", map[string]interface{}{"Flavor": "flavor"})
+			ui.DisplayOK()
+			ui.DisplayNewline()
+			ui.DisplayHeader("some header")
+			ui.DisplayKeyValueTable("", [][]string{{"key:", "value"}}, 3)
+			ui.DisplayTableWithHeader("", [][]string{{"header"}, {"value"}}, 3)
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		Describe("DisplayWarnings", func() {
+			It("displays the warnings as json on Err", func() {
+				ui.DisplayWarnings([]string{"warning-1", "warning-2"})
+				Expect(errBuff.Contents()).To(MatchJSON(`{"warnings": ["warning-1", "warning-2"]}`))
+			})
+
+			It("displays nothing when there are no warnings", func() {
+				ui.DisplayWarnings(nil)
+				Expect(errBuff.Contents()).To(BeEmpty())
+			})
+		})
+
+		Describe("DisplayWarning", func() {
+			It("displays the warning as json on Err", func() {
+				ui.DisplayWarning("some {{.Warning}}", map[string]interface{}{"Warning": "warning"})
+				Expect(errBuff.Contents()).To(MatchJSON(`{"warnings": ["some warning"]}`))
+			})
+		})
+
+		Describe("DisplayError", func() {
+			It("displays the error as json on Err", func() {
+				ui.DisplayError(errors.New("some error"))
+				Expect(errBuff.Contents()).To(MatchJSON(`{"error": "some error"}`))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when the output format is yaml", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+		})
+
+		Describe("DisplayStructuredOutput", func() {
+			It("displays the versioned document as yaml", func() {
+				Expect(ui.DisplayStructuredOutput("some-kind", document{Name: "some-name"})).To(Succeed())
+				Expect(string(out.Contents())).To(Equal("---\nversion: 1\nkind: some-kind\ndata:\n  name: some-name\n"))
+			})
+		})
+
+		Describe("DisplayStructuredOutputLine", func() {
+			It("displays each versioned document as a yaml document", func() {
+				Expect(ui.DisplayStructuredOutputLine("some-kind", document{Name: "name-1"})).To(Succeed())
+				Expect(ui.DisplayStructuredOutputLine("some-kind", document{Name: "name-2"})).To(Succeed())
+				Expect(string(out.Contents())).To(Equal(
+					"---\nversion: 1\nkind: some-kind\ndata:\n  name: name-1\n" +
+						"---\nversion: 1\nkind: some-kind\ndata:\n  name: name-2\n"))
+			})
+		})
+
+		Describe("DisplayError", func() {
+			It("displays the error as yaml on Err", func() {
+				ui.DisplayError(errors.New("some error"))
+				Expect(string(errBuff.Contents())).To(Equal("---\nerror: some error\n"))
+			})
+		})
+	})
+})
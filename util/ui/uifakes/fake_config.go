@@ -45,6 +45,15 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -209,6 +218,46 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -220,6 +269,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.isTTYMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value